@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// GRPC метрики RED по методам: число вызовов по коду ответа и длительность
+type GRPC struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func NewGRPC(namespace string) *GRPC {
+	return &GRPC{
+		requests: register(prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Number of gRPC calls by method and status code.",
+		}, []string{"method", "code"})).(*prometheus.CounterVec),
+		duration: register(prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of gRPC calls by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"})).(*prometheus.HistogramVec),
+	}
+}
+
+// UnaryServerInterceptor ставится первым в цепочке, чтобы учесть и отказы других перехватчиков
+func (g *GRPC) UnaryServerInterceptor(c context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	var start = time.Now()
+	response, err := handler(c, request)
+	g.observe(info.FullMethod, start, err)
+	return response, err
+}
+
+// StreamServerInterceptor длительность потока -- от открытия до ответа сервера
+func (g *GRPC) StreamServerInterceptor(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	var start = time.Now()
+	err := handler(server, stream)
+	g.observe(info.FullMethod, start, err)
+	return err
+}
+
+func (g *GRPC) observe(method string, start time.Time, err error) {
+	g.requests.WithLabelValues(method, status.Code(err).String()).Inc()
+	g.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// routeUnmatched метка запросов, для которых не нашёлся маршрут: путь в метку не попадает,
+// иначе число рядов растёт с каждым новым адресом
+const routeUnmatched = "unmatched"
+
+// HTTP метрики RED по маршрутам: число запросов по статусу и длительность
+type HTTP struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	inFlight prometheus.Gauge
+}
+
+func NewHTTP(namespace string) *HTTP {
+	return &HTTP{
+		requests: register(prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Number of HTTP requests by method, route and status.",
+		}, []string{"method", "route", "status"})).(*prometheus.CounterVec),
+		duration: register(prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of HTTP requests by method and route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"})).(*prometheus.HistogramVec),
+		inFlight: register(prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_in_flight",
+			Help:      "Number of HTTP requests being served.",
+		})).(prometheus.Gauge),
+	}
+}
+
+// Middleware считает запрос по шаблону маршрута (/contacts/:id), а не по пути
+func (h *HTTP) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var start = time.Now()
+		h.inFlight.Inc()
+		defer h.inFlight.Dec()
+
+		c.Next()
+
+		var route = c.FullPath()
+		if route == "" {
+			route = routeUnmatched
+		}
+
+		h.requests.WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).Inc()
+		h.duration.WithLabelValues(c.Request.Method, route).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		metrics = NewHTTP("test")
+		router  = gin.New()
+	)
+	router.Use(metrics.Middleware())
+	router.GET("/contacts/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	for _, path := range []string{"/contacts/1", "/contacts/2", "/unknown/1"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.requests.WithLabelValues(http.MethodGet, "/contacts/:id", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues(http.MethodGet, routeUnmatched, "404")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.inFlight))
+
+	// повторный конструктор возвращает уже зарегистрированные метрики
+	assert.Same(t, metrics.requests, NewHTTP("test").requests)
+}
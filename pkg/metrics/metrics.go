@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry реестр метрик сервиса: среда Go, процесс и всё, что создают конструкторы пакета
+var Registry = prometheus.NewRegistry()
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler отдаёт метрики Registry в текстовом формате Prometheus, например на /metrics
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Serve отдаёт метрики на /metrics по адресу address. Это служебный адрес: в метриках видны нагрузка
+// и объём данных всех арендаторов, поэтому его не публикуют вместе с API, а открывают только сборщику
+func Serve(address string) error {
+	var mux = http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	return http.ListenAndServe(address, mux)
+}
+
+// register регистрирует collector в Registry. Если такая метрика уже есть, возвращает её:
+// конструктор можно вызвать повторно, например в тестах
+func register(collector prometheus.Collector) prometheus.Collector {
+	if err := Registry.Register(collector); err != nil {
+		var already prometheus.AlreadyRegisteredError
+		if errors.As(err, &already) {
+			return already.ExistingCollector
+		}
+		panic(err)
+	}
+	return collector
+}
+
+// NewGauge значение, которое сервис выставляет сам: версия схемы, число записей и т.п.
+func NewGauge(namespace, name, help string) prometheus.Gauge {
+	return register(prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+	})).(prometheus.Gauge)
+}
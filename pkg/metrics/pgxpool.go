@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// pool статистика пула соединений; читается из pgxpool при каждом сборе метрик
+type pool struct {
+	pool *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	constructingConns    *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+}
+
+// NewPool метрики пула соединений с базой: занятые, свободные и все соединения,
+// число и суммарная длительность ожидания выдачи соединения
+func NewPool(namespace string, value *pgxpool.Pool) {
+	var desc = func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	register(&pool{
+		pool:                 value,
+		acquiredConns:        desc("acquired_connections", "Number of connections currently acquired from the pool."),
+		idleConns:            desc("idle_connections", "Number of idle connections in the pool."),
+		constructingConns:    desc("constructing_connections", "Number of connections being established."),
+		totalConns:           desc("connections", "Total number of connections in the pool."),
+		maxConns:             desc("max_connections", "Maximum size of the pool."),
+		acquireCount:         desc("acquires_total", "Number of successful acquires from the pool."),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Total time spent waiting for a connection from the pool."),
+		emptyAcquireCount:    desc("empty_acquires_total", "Number of acquires that waited for a connection because the pool was empty."),
+		canceledAcquireCount: desc("canceled_acquires_total", "Number of acquires canceled by context."),
+	})
+}
+
+func (p *pool) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		p.acquiredConns, p.idleConns, p.constructingConns, p.totalConns, p.maxConns,
+		p.acquireCount, p.acquireDuration, p.emptyAcquireCount, p.canceledAcquireCount,
+	} {
+		ch <- desc
+	}
+}
+
+func (p *pool) Collect(ch chan<- prometheus.Metric) {
+	var stat = p.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(p.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(p.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(p.constructingConns, prometheus.GaugeValue, float64(stat.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(p.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(p.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(p.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(p.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(p.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(p.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// результат вызова сценария в метке result
+const (
+	resultOK    = "ok"
+	resultError = "error"
+)
+
+// UseCase длительность методов сценариев
+type UseCase struct {
+	duration *prometheus.HistogramVec
+}
+
+func NewUseCase(namespace string) *UseCase {
+	return &UseCase{
+		duration: register(prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "use_case",
+			Name:      "duration_seconds",
+			Help:      "Duration of use case methods by use case, method and result.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"use_case", "method", "result"})).(*prometheus.HistogramVec),
+	}
+}
+
+// Observe учитывает вызов метода method сценария useCase, начатый в start
+func (u *UseCase) Observe(useCase, method string, start time.Time, err error) {
+	var result = resultOK
+	if err != nil {
+		result = resultError
+	}
+	u.duration.WithLabelValues(useCase, method, result).Observe(time.Since(start).Seconds())
+}
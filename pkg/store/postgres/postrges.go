@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
@@ -80,6 +81,9 @@ type Settings struct {
 	User     string
 	Password string
 	SSLMode  string
+
+	// BeforeAcquire вызывается перед выдачей соединения из пула, false закрывает соединение
+	BeforeAcquire func(context.Context, *pgx.Conn) bool
 }
 
 func (s Settings) toDSN() string {
@@ -118,6 +122,7 @@ func New(settings Settings) (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
+	config.BeforeAcquire = settings.BeforeAcquire
 
 	conn, err := pgxpool.ConnectConfig(context.Background(), config)
 	if err != nil {
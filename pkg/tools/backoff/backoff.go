@@ -0,0 +1,16 @@
+package backoff
+
+import "time"
+
+// Exponential задержка перед следующей попыткой после attempts неудачных:
+// min, 2*min, 4*min ... но не больше max
+func Exponential(min, max time.Duration, attempts uint32) time.Duration {
+	var delay = min
+	for i := uint32(0); i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+	return delay
+}
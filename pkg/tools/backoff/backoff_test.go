@@ -0,0 +1,25 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExponential(t *testing.T) {
+	var cases = []struct {
+		attempts uint32
+		expected time.Duration
+	}{
+		{attempts: 0, expected: time.Second},
+		{attempts: 1, expected: 2 * time.Second},
+		{attempts: 3, expected: 8 * time.Second},
+		{attempts: 4, expected: 10 * time.Second},
+		{attempts: 100, expected: 10 * time.Second},
+	}
+
+	for _, c := range cases {
+		if delay := Exponential(time.Second, 10*time.Second, c.attempts); delay != c.expected {
+			t.Errorf("attempts %d: expected %s, got %s", c.attempts, c.expected, delay)
+		}
+	}
+}
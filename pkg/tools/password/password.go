@@ -0,0 +1,152 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Algorithm алгоритм хеширования новых паролей
+type Algorithm string
+
+const (
+	Argon2id Algorithm = "argon2id"
+	Bcrypt   Algorithm = "bcrypt"
+)
+
+var (
+	ErrWrongHash      = errors.New("password hash has unknown format")
+	ErrWrongAlgorithm = errors.New("password algorithm must be argon2id or bcrypt")
+)
+
+// Params параметры хеширования. Хеш хранит свои параметры, поэтому их можно менять
+// без потери старых паролей: NeedsRehash подскажет, какие хеши пора пересчитать.
+type Params struct {
+	Algorithm Algorithm
+
+	// Memory память argon2id в КиБ
+	Memory uint32
+	// Iterations число проходов argon2id
+	Iterations uint32
+	// Parallelism число потоков argon2id
+	Parallelism uint8
+
+	// Cost стоимость bcrypt
+	Cost int
+}
+
+// Default параметры по рекомендации OWASP: argon2id, 64 МиБ, 3 прохода
+var Default = Params{
+	Algorithm:   Argon2id,
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	Cost:        bcrypt.DefaultCost,
+}
+
+const (
+	saltLength = 16
+	keyLength  = 32
+)
+
+// Hash хеш пароля: argon2id в формате PHC "$argon2id$v=19$m=...,t=...,p=...$<соль>$<хеш>"
+// или bcrypt в формате "$2a$..."
+func Hash(value string, params Params) (string, error) {
+	switch params.Algorithm {
+	case Argon2id:
+		var salt = make([]byte, saltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+
+		var key = argon2.IDKey([]byte(value), salt, params.Iterations, params.Memory, params.Parallelism, keyLength)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+			argon2.Version,
+			params.Memory,
+			params.Iterations,
+			params.Parallelism,
+			base64.RawStdEncoding.EncodeToString(salt),
+			base64.RawStdEncoding.EncodeToString(key),
+		), nil
+	case Bcrypt:
+		result, err := bcrypt.GenerateFromPassword([]byte(value), params.Cost)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		return string(result), nil
+	default:
+		return "", ErrWrongAlgorithm
+	}
+}
+
+// Verify совпадает ли пароль с хешем любого из поддерживаемых алгоритмов
+func Verify(hash, value string) (bool, error) {
+	if isBcrypt(hash) {
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(value))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return err == nil, errors.WithStack(err)
+	}
+
+	params, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return false, err
+	}
+
+	var other = argon2.IDKey([]byte(value), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+// NeedsRehash хеш сделан другим алгоритмом или с другими параметрами, чем params
+func NeedsRehash(hash string, params Params) bool {
+	if isBcrypt(hash) {
+		if params.Algorithm != Bcrypt {
+			return true
+		}
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != params.Cost
+	}
+
+	current, _, _, err := decodeArgon2id(hash)
+	if err != nil || params.Algorithm != Argon2id {
+		return true
+	}
+	return current.Memory != params.Memory || current.Iterations != params.Iterations || current.Parallelism != params.Parallelism
+}
+
+func isBcrypt(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func decodeArgon2id(hash string) (params Params, salt, key []byte, err error) {
+	var parts = strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != string(Argon2id) {
+		return params, nil, nil, ErrWrongHash
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrWrongHash
+	}
+
+	params.Algorithm = Argon2id
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, ErrWrongHash
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return params, nil, nil, ErrWrongHash
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(key) == 0 {
+		return params, nil, nil, ErrWrongHash
+	}
+
+	return params, salt, key, nil
+}
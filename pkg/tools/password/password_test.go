@@ -0,0 +1,40 @@
+package password
+
+import "testing"
+
+func TestHashVerify(t *testing.T) {
+	// дешёвые параметры: тест проверяет формат, а не стойкость
+	var cheap = Params{Algorithm: Argon2id, Memory: 1024, Iterations: 1, Parallelism: 1, Cost: 4}
+
+	for _, algorithm := range []Algorithm{Argon2id, Bcrypt} {
+		var params = cheap
+		params.Algorithm = algorithm
+
+		hash, err := Hash("correct horse", params)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ok, err := Verify(hash, "correct horse"); err != nil || !ok {
+			t.Errorf("%s: password must match: %v", algorithm, err)
+		}
+		if ok, err := Verify(hash, "battery staple"); err != nil || ok {
+			t.Errorf("%s: wrong password must not match: %v", algorithm, err)
+		}
+		if NeedsRehash(hash, params) {
+			t.Errorf("%s: hash with current params must not need rehash", algorithm)
+		}
+	}
+
+	bcryptHash, err := Hash("correct horse", Params{Algorithm: Bcrypt, Cost: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !NeedsRehash(bcryptHash, cheap) {
+		t.Error("bcrypt hash must be rehashed to argon2id")
+	}
+
+	if _, err = Verify("plain", "plain"); err != ErrWrongHash {
+		t.Errorf("expected ErrWrongHash, got %v", err)
+	}
+}
@@ -0,0 +1,24 @@
+package secret
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// Generate случайная строка из size байт в base64url с префиксом, по которому
+// секрет узнаётся в логах и сканерах утечек
+func Generate(prefix string, size int) (string, error) {
+	var buffer = make([]byte, size)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+	return prefix + base64.RawURLEncoding.EncodeToString(buffer), nil
+}
+
+// Hash SHA-256 секрета. Секреты из Generate достаточно длинные и случайные,
+// поэтому медленный хеш паролей для них не нужен, а поиск по хешу остаётся точным
+func Hash(value string) []byte {
+	var sum = sha256.Sum256([]byte(value))
+	return sum[:]
+}
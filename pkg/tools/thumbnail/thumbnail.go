@@ -0,0 +1,38 @@
+package thumbnail
+
+import (
+	"image"
+	"image/color"
+
+	"golang.org/x/image/draw"
+)
+
+// Fit уменьшает изображение так, чтобы оно помещалось в квадрат size x size с сохранением пропорций.
+// Прозрачные области заливаются белым, чтобы результат можно было сохранить в JPEG.
+// Изображения меньше size не увеличиваются.
+func Fit(src image.Image, size int) image.Image {
+	var bounds = src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	if width > size || height > size {
+		if width >= height {
+			height = max(1, height*size/width)
+			width = size
+		} else {
+			width = max(1, width*size/height)
+			height = size
+		}
+	}
+
+	var dst = image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+	return dst
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,37 @@
+package thumbnail
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFit(t *testing.T) {
+	assertion := assert.New(t)
+
+	var cases = []struct {
+		width, height        int
+		expectedW, expectedH int
+	}{
+		{width: 1024, height: 768, expectedW: 256, expectedH: 192},
+		{width: 300, height: 1200, expectedW: 64, expectedH: 256},
+		{width: 100, height: 50, expectedW: 100, expectedH: 50},
+		{width: 5000, height: 1, expectedW: 256, expectedH: 1},
+	}
+
+	for _, c := range cases {
+		result := Fit(image.NewRGBA(image.Rect(0, 0, c.width, c.height)), 256)
+		assertion.Equal(c.expectedW, result.Bounds().Dx())
+		assertion.Equal(c.expectedH, result.Bounds().Dy())
+	}
+}
+
+func TestFitTransparent(t *testing.T) {
+	result := Fit(image.NewNRGBA(image.Rect(0, 0, 10, 10)), 256)
+
+	r, g, b, a := result.At(5, 5).RGBA()
+	wr, wg, wb, wa := color.White.RGBA()
+	assert.Equal(t, []uint32{wr, wg, wb, wa}, []uint32{r, g, b, a})
+}
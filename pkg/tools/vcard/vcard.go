@@ -0,0 +1,164 @@
+// Package vcard формирует карточки в формате vCard 4.0 (RFC 6350).
+package vcard
+
+import (
+	"bufio"
+	"encoding/base64"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	Version     = "4.0"
+	ContentType = "text/vcard; charset=utf-8"
+
+	// maxLineLength длина строки в октетах, после которой строка переносится
+	maxLineLength = 75
+)
+
+// Param параметр свойства, например TYPE=home
+type Param struct {
+	Name   string
+	Values []string
+}
+
+// Property свойство карточки. Value записывается как есть, поэтому текст нужно экранировать функциями Text и Structured
+type Property struct {
+	Name   string
+	Params []Param
+	Value  string
+}
+
+type Card []Property
+
+// Add добавляет свойство с текстовым значением, пустые значения пропускаются
+func (c *Card) Add(name, value string, params ...Param) {
+	if value == "" {
+		return
+	}
+	*c = append(*c, Property{Name: name, Params: params, Value: Text(value)})
+}
+
+// AddRaw добавляет свойство с уже подготовленным значением
+func (c *Card) AddRaw(name, value string, params ...Param) {
+	*c = append(*c, Property{Name: name, Params: params, Value: value})
+}
+
+// Type параметр TYPE, пустые значения пропускаются
+func Type(values ...string) []Param {
+	var result []string
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+	return []Param{{Name: "TYPE", Values: result}}
+}
+
+// Text экранирует текстовое значение
+func Text(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// Structured экранирует компоненты составного значения (N, ADR) и соединяет их через ';'
+func Structured(components ...string) string {
+	var escaped = make([]string, len(components))
+	for i, component := range components {
+		escaped[i] = Text(component)
+	}
+	return strings.Join(escaped, ";")
+}
+
+// List экранирует значения списка (CATEGORIES) и соединяет их через ','
+func List(values ...string) string {
+	var escaped = make([]string, len(values))
+	for i, value := range values {
+		escaped[i] = Text(value)
+	}
+	return strings.Join(escaped, ",")
+}
+
+// DataURI значение для встраивания двоичных данных (PHOTO) по RFC 2397
+func DataURI(contentType string, data []byte) string {
+	return "data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(data)
+}
+
+var (
+	textEscaper = strings.NewReplacer(
+		`\`, `\\`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		",", `\,`,
+		";", `\;`,
+	)
+	paramEscaper = strings.NewReplacer(
+		"^", "^^",
+		"\r\n", "^n",
+		"\n", "^n",
+		`"`, "^'",
+	)
+)
+
+// Encode записывает карточки, строки разделяются CRLF
+func Encode(w io.Writer, cards ...Card) error {
+	var buffer = bufio.NewWriter(w)
+
+	for _, card := range cards {
+		writeLine(buffer, "BEGIN:VCARD")
+		writeLine(buffer, "VERSION:"+Version)
+		for _, property := range card {
+			writeLine(buffer, property.String())
+		}
+		writeLine(buffer, "END:VCARD")
+	}
+
+	return buffer.Flush()
+}
+
+func (p Property) String() string {
+	var builder strings.Builder
+	builder.WriteString(strings.ToUpper(p.Name))
+
+	for _, param := range p.Params {
+		builder.WriteString(";")
+		builder.WriteString(strings.ToUpper(param.Name))
+		builder.WriteString("=")
+		for i, value := range param.Values {
+			if i > 0 {
+				builder.WriteString(",")
+			}
+			value = paramEscaper.Replace(value)
+			if strings.ContainsAny(value, ":;,") {
+				value = `"` + value + `"`
+			}
+			builder.WriteString(value)
+		}
+	}
+
+	builder.WriteString(":")
+	builder.WriteString(p.Value)
+	return builder.String()
+}
+
+// writeLine переносит длинные строки, не разрывая символы UTF-8
+func writeLine(w *bufio.Writer, line string) {
+	var limit = maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		// перенос начинается с пробела, который занимает один октет
+		limit = maxLineLength - 1
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
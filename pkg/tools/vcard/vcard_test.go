@@ -0,0 +1,55 @@
+package vcard
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode(t *testing.T) {
+	assertion := assert.New(t)
+
+	var card Card
+	card.Add("FN", "Иванов Иван")
+	card.Add("NOTE", "")
+	card.AddRaw("N", Structured("Иванов", "Иван", "Иванович", "", ""))
+	card.AddRaw("ADR", Structured("", "", "ул. Ленина, 1; кв. 2", "Москва", "", "101000", "RU"), Type("home")...)
+	card.AddRaw("CATEGORIES", List("vip", "a,b"))
+
+	var buffer bytes.Buffer
+	assertion.NoError(Encode(&buffer, card))
+
+	assertion.Equal(strings.Join([]string{
+		"BEGIN:VCARD",
+		"VERSION:4.0",
+		"FN:Иванов Иван",
+		"N:Иванов;Иван;Иванович;;",
+		`ADR;TYPE=home:;;ул. Ленина\, 1\; кв. 2;Москва;;101000;RU`,
+		`CATEGORIES:vip,a\,b`,
+		"END:VCARD",
+		"",
+	}, "\r\n"), buffer.String())
+}
+
+func TestEncodeFolding(t *testing.T) {
+	assertion := assert.New(t)
+
+	var card Card
+	card.Add("NOTE", strings.Repeat("я", 100))
+
+	var buffer bytes.Buffer
+	assertion.NoError(Encode(&buffer, card))
+
+	var unfolded = strings.ReplaceAll(buffer.String(), "\r\n ", "")
+	assertion.Contains(unfolded, "NOTE:"+strings.Repeat("я", 100)+"\r\n")
+
+	for _, line := range strings.Split(buffer.String(), "\r\n") {
+		assertion.LessOrEqual(len(line), maxLineLength)
+	}
+}
+
+func TestDataURI(t *testing.T) {
+	assert.Equal(t, "data:image/jpeg;base64,AQID", DataURI("image/jpeg", []byte{1, 2, 3}))
+}
@@ -0,0 +1,21 @@
+package tracing
+
+import (
+	"context"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/uber/jaeger-client-go"
+)
+
+// TraceID идентификатор трассировки текущего запроса, пустая строка -- трассировки нет
+func TraceID(ctx context.Context) string {
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return ""
+	}
+
+	if spanContext, ok := span.Context().(jaeger.SpanContext); ok {
+		return spanContext.TraceID().String()
+	}
+	return ""
+}
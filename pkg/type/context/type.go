@@ -14,8 +14,26 @@ func init() {
 	if value := os.Getenv("CONTEXT_KEY_REQUEST_ID"); len(value) > 0 {
 		keyRequestID = value
 	}
+
+	KeyActor = "actor"
+	if value := os.Getenv("CONTEXT_KEY_ACTOR"); len(value) > 0 {
+		KeyActor = value
+	}
+
+	KeyPrincipal = "principal"
+	if value := os.Getenv("CONTEXT_KEY_PRINCIPAL"); len(value) > 0 {
+		KeyPrincipal = value
+	}
+
+	KeyTenant = "tenant"
+	if value := os.Getenv("CONTEXT_KEY_TENANT"); len(value) > 0 {
+		KeyTenant = value
+	}
 }
 
+// TenantAll арендатор фоновых обработчиков, которые работают с данными всех арендаторов
+const TenantAll = "*"
+
 type Context interface {
 	context.Context
 
@@ -24,6 +42,7 @@ type Context interface {
 
 	WithTimeout(timeout time.Duration)
 	CopyWithTimeout(timeout time.Duration) Context
+	CopyWithCancel() Context
 	Cancel()
 
 	Copy() Context
@@ -33,6 +52,13 @@ type Context interface {
 
 var (
 	keyRequestID string
+
+	// KeyActor ключ, под которым в gin.Context сохраняется автор запроса
+	KeyActor string
+	// KeyPrincipal ключ, под которым в gin.Context сохраняется *principal.Principal
+	KeyPrincipal string
+	// KeyTenant ключ, под которым в gin.Context сохраняется арендатор запроса
+	KeyTenant string
 )
 
 type local struct {
@@ -99,6 +125,13 @@ func New(option interface{}) Context {
 		}
 	case Context:
 		ctx.withValue(keyRequestID, baseCtx.ID())
+		ctx.withValue(KeyActor, baseCtx.Actor())
+		if value := baseCtx.Principal(); value != nil {
+			ctx.withValue(KeyPrincipal, value)
+		}
+		if value := baseCtx.Tenant(); value != "" {
+			ctx.withValue(KeyTenant, value)
+		}
 	case context.Context:
 		ctx.base = baseCtx
 	}
@@ -3,6 +3,8 @@ package context
 import (
 	"context"
 	"time"
+
+	"architecture_go/pkg/type/principal"
 )
 
 type Value interface {
@@ -10,6 +12,9 @@ type Value interface {
 	WithValue(key, value any)
 
 	ID() string
+	Actor() string
+	Principal() *principal.Principal
+	Tenant() string
 }
 
 func (l *local) ID() string {
@@ -23,6 +28,24 @@ func (l *local) id() (string, bool) {
 	return id, ok
 }
 
+// Actor автор запроса, пустая строка -- автор неизвестен
+func (l *local) Actor() string {
+	actor, _ := l.Value(KeyActor).(string)
+	return actor
+}
+
+// Principal аутентифицированный автор запроса, nil -- запрос без аутентификации
+func (l *local) Principal() *principal.Principal {
+	value, _ := l.Value(KeyPrincipal).(*principal.Principal)
+	return value
+}
+
+// Tenant арендатор, к данным которого относится запрос; пустая строка -- арендатор не определён
+func (l *local) Tenant() string {
+	tenant, _ := l.Value(KeyTenant).(string)
+	return tenant
+}
+
 func (l *local) Value(key any) any {
 	return l.base.Value(key)
 }
@@ -48,6 +71,11 @@ func (l local) CopyWithTimeout(timeout time.Duration) Context {
 	return &l
 }
 
+func (l local) CopyWithCancel() Context {
+	l.base, l.cancelFunc = context.WithCancel(l.base)
+	return &l
+}
+
 func (l *local) WithDeadline(d time.Time) {
 	l.base, l.cancelFunc = context.WithDeadline(l.base, d)
 }
@@ -0,0 +1,19 @@
+package filter
+
+import "architecture_go/pkg/type/columnCode"
+
+type Filter struct {
+	Key    columnCode.ColumnCode
+	Values []string
+}
+
+type Filters []*Filter
+
+func (f Filters) Get(key columnCode.ColumnCode) (*Filter, bool) {
+	for _, value := range f {
+		if value.Key == key {
+			return value, true
+		}
+	}
+	return nil, false
+}
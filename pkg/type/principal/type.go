@@ -0,0 +1,43 @@
+package principal
+
+import (
+	"time"
+)
+
+// Method способ, которым субъект подтвердил свою личность
+type Method string
+
+const (
+	MethodToken   Method = "token"
+	MethodAPIKey  Method = "apiKey"
+	MethodSession Method = "session"
+)
+
+func (m Method) String() string {
+	return string(m)
+}
+
+// Principal аутентифицированный автор запроса
+type Principal struct {
+	// Subject идентификатор субъекта: sub токена, владелец ключа или сессии
+	Subject string
+	// Name отображаемое имя, может быть пустым
+	Name   string
+	Method Method
+	// CredentialID идентификатор ключа или сессии, для токена -- jti, если он есть
+	CredentialID string
+	// Tenant арендатор субъекта, пустая строка -- арендатор выбирается заголовком запроса
+	Tenant string
+	Roles  []string
+	// ExpiresAt окончание действия учётных данных, нулевое значение -- без срока
+	ExpiresAt time.Time
+}
+
+func (p Principal) HasRole(role string) bool {
+	for _, value := range p.Roles {
+		if value == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package problem
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Language язык сообщений об ошибках
+type Language string
+
+const (
+	LanguageEN Language = "en"
+	LanguageRU Language = "ru"
+)
+
+// DefaultLanguage язык, если клиент не назвал ни одного поддерживаемого
+const DefaultLanguage = LanguageEN
+
+func (l Language) String() string {
+	return string(l)
+}
+
+var (
+	languages = []Language{LanguageEN, LanguageRU}
+	matcher   = language.NewMatcher([]language.Tag{language.English, language.Russian})
+)
+
+// ParseLanguage язык по заголовку Accept-Language с учётом весов q
+func ParseLanguage(acceptLanguage string) Language {
+	if strings.TrimSpace(acceptLanguage) == "" {
+		return DefaultLanguage
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLanguage
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLanguage
+	}
+	return languages[index]
+}
+
+// Params значения для шаблона сообщения: {max} заменяется на Params["max"]
+type Params map[string]interface{}
+
+// Messages шаблоны сообщений по языку и коду
+type Messages map[Language]map[Code]string
+
+// Message сообщение на языке lang; без перевода -- на языке по умолчанию, без шаблона -- false
+func (m Messages) Message(lang Language, code Code, params Params) (string, bool) {
+	template, ok := m[lang][code]
+	if !ok {
+		if template, ok = m[DefaultLanguage][code]; !ok {
+			return "", false
+		}
+	}
+
+	if len(params) == 0 {
+		return template, true
+	}
+
+	var replacements = make([]string, 0, len(params)*2)
+	for name, value := range params {
+		replacements = append(replacements, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(replacements...).Replace(template), true
+}
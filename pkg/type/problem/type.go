@@ -0,0 +1,154 @@
+package problem
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ContentType ответа об ошибке по RFC 7807
+const ContentType = "application/problem+json"
+
+// Code стабильный код ошибки: клиенты сравнивают код, а не текст, который может меняться
+type Code string
+
+func (c Code) String() string {
+	return string(c)
+}
+
+// Type URI типа проблемы для поля type
+func (c Code) Type() string {
+	return "urn:problem:" + string(c)
+}
+
+// Kind класс ошибки, от него зависят статус HTTP и код gRPC
+type Kind uint8
+
+const (
+	KindInternal Kind = iota
+	KindInvalid
+	KindUnauthenticated
+	KindPermissionDenied
+	KindNotFound
+	KindConflict
+	KindPreconditionFailed
+	KindTooLarge
+	KindUnsupportedMediaType
+	KindRateLimited
+	KindUnavailable
+	KindTimeout
+	KindGone
+)
+
+// HTTPStatus статус ответа HTTP
+func (k Kind) HTTPStatus() int {
+	switch k {
+	case KindInvalid:
+		return http.StatusBadRequest
+	case KindUnauthenticated:
+		return http.StatusUnauthorized
+	case KindPermissionDenied:
+		return http.StatusForbidden
+	case KindNotFound:
+		return http.StatusNotFound
+	case KindConflict:
+		return http.StatusConflict
+	case KindPreconditionFailed:
+		return http.StatusPreconditionFailed
+	case KindTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case KindUnsupportedMediaType:
+		return http.StatusUnsupportedMediaType
+	case KindRateLimited:
+		return http.StatusTooManyRequests
+	case KindUnavailable:
+		return http.StatusServiceUnavailable
+	case KindTimeout:
+		return http.StatusGatewayTimeout
+	case KindGone:
+		return http.StatusGone
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GRPCCode код статуса gRPC
+func (k Kind) GRPCCode() codes.Code {
+	switch k {
+	case KindInvalid, KindUnsupportedMediaType:
+		return codes.InvalidArgument
+	case KindUnauthenticated:
+		return codes.Unauthenticated
+	case KindPermissionDenied:
+		return codes.PermissionDenied
+	case KindNotFound:
+		return codes.NotFound
+	case KindConflict:
+		return codes.AlreadyExists
+	case KindPreconditionFailed:
+		return codes.FailedPrecondition
+	case KindTooLarge, KindRateLimited:
+		return codes.ResourceExhausted
+	case KindUnavailable:
+		return codes.Unavailable
+	case KindTimeout:
+		return codes.DeadlineExceeded
+	case KindGone:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// KindOf класс ошибки по статусу HTTP, который выбрал обработчик
+func KindOf(status int) Kind {
+	for _, kind := range []Kind{KindInvalid, KindUnauthenticated, KindPermissionDenied, KindNotFound, KindConflict,
+		KindPreconditionFailed, KindTooLarge, KindUnsupportedMediaType, KindRateLimited, KindUnavailable, KindTimeout, KindGone} {
+		if kind.HTTPStatus() == status {
+			return kind
+		}
+	}
+	if status >= 400 && status < 500 {
+		return KindInvalid
+	}
+	return KindInternal
+}
+
+// CodeOf код ошибки, которой нет в каталоге: по статусу HTTP, например not_found
+func CodeOf(status int) Code {
+	var text = http.StatusText(status)
+	if text == "" {
+		text = http.StatusText(http.StatusInternalServerError)
+	}
+	return Code(strings.ReplaceAll(strings.ToLower(text), " ", "_"))
+}
+
+// InvalidParam поле запроса и причина, по которой оно не принято
+type InvalidParam struct {
+	Name   string `json:"name" example:"phoneNumber"`
+	Reason string `json:"reason" example:"is required"`
+}
+
+// Entry ошибка каталога. Param -- поле запроса, к которому относится ошибка, если оно одно;
+// Params -- значения для шаблона сообщения, например наибольшая длина поля
+type Entry struct {
+	Err    error
+	Code   Code
+	Kind   Kind
+	Param  string
+	Params Params
+}
+
+// Catalogue ошибки сервиса с кодами; ищется первая запись, которой соответствует ошибка
+type Catalogue []Entry
+
+func (c Catalogue) Find(err error) (Entry, bool) {
+	for _, entry := range c {
+		if errors.Is(err, entry.Err) {
+			return entry, true
+		}
+	}
+	return Entry{}, false
+}
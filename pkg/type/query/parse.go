@@ -1,10 +1,12 @@
 package query
 
 import (
+	stdSort "sort"
 	"strconv"
 	"strings"
 
 	"architecture_go/pkg/type/columnCode"
+	"architecture_go/pkg/type/filter"
 	"architecture_go/pkg/type/sort"
 )
 
@@ -46,7 +48,7 @@ func parseSorts(strQuery string, options SortsOptions) (sort.Sorts, error) {
 			return nil, err
 		}
 
-		if _, ok := options[key.String()]; !ok {
+		if !options.Has(key.String()) {
 			continue
 		}
 
@@ -60,6 +62,44 @@ func parseSorts(strQuery string, options SortsOptions) (sort.Sorts, error) {
 
 }
 
+func parseFilters(values map[string]string, options FiltersOptions) (filter.Filters, error) {
+	var names = make([]string, 0, len(values))
+	for name := range values {
+		if options.Has(name) {
+			names = append(names, name)
+		}
+	}
+	stdSort.Strings(names)
+
+	var result = make(filter.Filters, 0, len(names))
+	for _, name := range names {
+		var value = values[name]
+
+		key, err := columnCode.New(name)
+		if err != nil {
+			return nil, err
+		}
+
+		var list []string
+		for _, item := range strings.Split(value, fieldSeparationCharacter) {
+			if item = strings.TrimSpace(item); len(item) > 0 {
+				list = append(list, item)
+			}
+		}
+
+		if len(list) == 0 {
+			continue
+		}
+
+		result = append(result, &filter.Filter{
+			Key:    key,
+			Values: list,
+		})
+	}
+
+	return result, nil
+}
+
 func parseLimit(strLimit string) uint64 {
 	limit, err := strconv.ParseUint(strLimit, 10, 64)
 	if err != nil || limit == 0 {
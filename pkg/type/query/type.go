@@ -1,32 +1,73 @@
 package query
 
 import (
+	"strings"
+
 	"github.com/gin-gonic/gin"
 
+	"architecture_go/pkg/type/filter"
 	"architecture_go/pkg/type/sort"
 )
 
 type Query struct {
-	Sorts  sort.Sorts
-	Limit  uint64
-	Offset uint64
+	Sorts   sort.Sorts
+	Filters filter.Filters
+	Limit   uint64
+	Offset  uint64
 }
 
 type SortOptions struct {
 }
 
+type FilterOptions struct {
+}
+
 type Options struct {
-	// Тут можно добавить фильтр
-	Sorts SortsOptions
+	Sorts   SortsOptions
+	Filters FiltersOptions
+}
+
+// SortsOptions map[front_key]SortOptions
+// Ключ вида "prefix.*" разрешает любое поле с префиксом "prefix.", например "customFields.*"
+type SortsOptions map[string]SortOptions
+
+func (s SortsOptions) Has(key string) bool {
+	if _, ok := s[key]; ok {
+		return true
+	}
+	_, ok := s[wildcardKey(key)]
+	return ok
+}
+
+// FiltersOptions map[front_key]FilterOptions
+// Ключи задаются так же, как в SortsOptions
+type FiltersOptions map[string]FilterOptions
+
+func (f FiltersOptions) Has(key string) bool {
+	if _, ok := f[key]; ok {
+		return true
+	}
+	_, ok := f[wildcardKey(key)]
+	return ok
 }
 
-type SortsOptions map[string]SortOptions // map[front_key]FilterOptions
+func wildcardKey(key string) string {
+	index := strings.LastIndex(key, wildcardSeparator)
+	if index <= 0 || index == len(key)-1 {
+		return ""
+	}
+	return key[:index+1] + wildcardCharacter
+}
 
 var (
 	keyForSort        = "sort"
 	defaultKeyForSort = ""
+	keyForFilter      = "filter"
 	keyForLimit       = "limit"
 	keyForOffset      = "offset"
+
+	wildcardSeparator = "."
+	wildcardCharacter = "*"
 )
 
 func ParseQuery(c *gin.Context, options Options) (*Query, error) {
@@ -35,10 +76,16 @@ func ParseQuery(c *gin.Context, options Options) (*Query, error) {
 		return nil, err
 	}
 
+	filters, err := parseFilters(c.QueryMap(keyForFilter), options.Filters)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Query{
-		Sorts:  sorts,
-		Limit:  parseLimit(c.Query(keyForLimit)),
-		Offset: parseOffset(c.Query(keyForOffset)),
+		Sorts:   sorts,
+		Filters: filters,
+		Limit:   parseLimit(c.Query(keyForLimit)),
+		Offset:  parseOffset(c.Query(keyForOffset)),
 	}, nil
 }
 
@@ -46,6 +93,11 @@ func ParseSorts(c *gin.Context, options SortsOptions) (sort.Sorts, error) {
 	return parseSorts(c.DefaultQuery(keyForSort, defaultKeyForSort), options)
 }
 
+// ParseFilters разбирает фильтры вида ?filter[city]=Москва&filter[tags]=vip,partner
+func ParseFilters(c *gin.Context, options FiltersOptions) (filter.Filters, error) {
+	return parseFilters(c.QueryMap(keyForFilter), options)
+}
+
 func ParseLimit(c *gin.Context) uint64 {
 	return parseLimit(c.Query(keyForLimit))
 }
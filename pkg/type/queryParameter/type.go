@@ -1,6 +1,7 @@
 package queryParameter
 
 import (
+	"architecture_go/pkg/type/filter"
 	"architecture_go/pkg/type/pagination"
 	"architecture_go/pkg/type/sort"
 )
@@ -8,5 +9,5 @@ import (
 type QueryParameter struct {
 	Sorts      sort.Sorts
 	Pagination pagination.Pagination
-	/*Тут можно добавить фильтр*/
+	Filters    filter.Filters
 }
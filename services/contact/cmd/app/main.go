@@ -1,13 +1,17 @@
 package main
 
 import (
+	stdContext "context"
 	"fmt"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 
 	"github.com/spf13/viper"
+	"google.golang.org/grpc"
 
+	"architecture_go/pkg/metrics"
 	"architecture_go/pkg/store/postgres"
 	"architecture_go/pkg/tracing"
 	"architecture_go/pkg/type/context"
@@ -16,25 +20,79 @@ import (
 	deliveryHttp "architecture_go/services/contact/internal/delivery/http"
 	// repositoryContact "architecture_go/services/contact/internal/repository/contact/postgres"
 	// repositoryGroup "architecture_go/services/contact/internal/repository/group/postgres"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+	repositoryBlobLocal "architecture_go/services/contact/internal/repository/blob/local"
+	repositoryBlobS3 "architecture_go/services/contact/internal/repository/blob/s3"
+	repositoryBrokerLog "architecture_go/services/contact/internal/repository/broker/log"
+	repositoryBrokerNats "architecture_go/services/contact/internal/repository/broker/nats"
+	"architecture_go/services/contact/internal/repository/event/bus"
+	repositoryLimiterMemory "architecture_go/services/contact/internal/repository/limiter/memory"
+	repositoryLimiterRedis "architecture_go/services/contact/internal/repository/limiter/redis"
 	repositoryStorage "architecture_go/services/contact/internal/repository/storage/postgres"
+	repositoryTokenJwt "architecture_go/services/contact/internal/repository/token/jwt"
+	repositoryTokenUser "architecture_go/services/contact/internal/repository/token/user"
+	repositoryWebhookHttp "architecture_go/services/contact/internal/repository/webhook/http"
+	"architecture_go/services/contact/internal/useCase/adapters/blob"
+	"architecture_go/services/contact/internal/useCase/adapters/broker"
+	"architecture_go/services/contact/internal/useCase/adapters/limiter"
+	"architecture_go/services/contact/internal/useCase/adapters/token"
+	useCaseAudit "architecture_go/services/contact/internal/useCase/audit"
+	useCaseAuth "architecture_go/services/contact/internal/useCase/auth"
 	useCaseContact "architecture_go/services/contact/internal/useCase/contact"
+	useCaseCustomField "architecture_go/services/contact/internal/useCase/customField"
+	useCaseDelta "architecture_go/services/contact/internal/useCase/delta"
+	useCaseFeed "architecture_go/services/contact/internal/useCase/feed"
 	useCaseGroup "architecture_go/services/contact/internal/useCase/group"
+	useCaseMetrics "architecture_go/services/contact/internal/useCase/metrics"
+	useCaseNote "architecture_go/services/contact/internal/useCase/note"
+	useCaseOrganization "architecture_go/services/contact/internal/useCase/organization"
+	useCaseOutbox "architecture_go/services/contact/internal/useCase/outbox"
+	useCasePhoto "architecture_go/services/contact/internal/useCase/photo"
+	useCasePolicy "architecture_go/services/contact/internal/useCase/policy"
+	useCaseRateLimit "architecture_go/services/contact/internal/useCase/rateLimit"
+	useCaseShare "architecture_go/services/contact/internal/useCase/share"
+	useCaseTag "architecture_go/services/contact/internal/useCase/tag"
+	useCaseTenant "architecture_go/services/contact/internal/useCase/tenant"
+	useCaseWebhook "architecture_go/services/contact/internal/useCase/webhook"
 )
 
+// metricsNamespace префикс имён метрик сервиса
+const metricsNamespace = "contact"
+
 func init() {
 	viper.SetConfigName(".env")
 	viper.SetConfigType("dotenv")
 	viper.AddConfigPath(".")
 	viper.AutomaticEnv()
 	viper.SetDefault("SERVICE_NAME", "contactService")
+	viper.SetDefault("BLOB_STORAGE", "local")
+	viper.SetDefault("BROKER", "log")
+	// роли запросов без учётных данных при AUTH_ANONYMOUS=true, например "admin" для локальной разработки
+	viper.SetDefault("AUTH_ANONYMOUS_ROLES", "")
+	// лимиты запросов вида "100/1m", "0" -- без ограничения; RATE_LIMIT_STORE: memory или redis
+	viper.SetDefault("RATE_LIMIT_STORE", "memory")
+	viper.SetDefault("RATE_LIMIT_DEFAULT", "600/1m")
+	viper.SetDefault("RATE_LIMIT_CONTACTS", "300/1m")
+	viper.SetDefault("RATE_LIMIT_GROUPS", "300/1m")
+	viper.SetDefault("RATE_LIMIT_TRANSFER", "20/1m")
+	// RATE_LIMIT_ADDRESS все запросы с одного адреса, считается до аутентификации
+	viper.SetDefault("RATE_LIMIT_ADDRESS", "1200/1m")
+	// как часто пересчитываются число контактов и групп на /metrics
+	viper.SetDefault("METRICS_INTERVAL", "1m")
+	// /metrics отдаётся на отдельном порту, закрытом от клиентов API; 0 -- не отдавать
+	viper.SetDefault("METRICS_PORT", 9100)
+	// сколько контактов принимает один пакетный импорт по HTTP и gRPC
+	viper.SetDefault("CONTACT_MAX_BATCH_SIZE", 100)
 }
 
 func main() {
-	conn, err := postgres.New(postgres.Settings{})
+	// соединение получает арендатора запроса при каждой выдаче из пула, на этом держатся политики RLS
+	conn, err := postgres.New(postgres.Settings{BeforeAcquire: repositoryStorage.AcquireTenant})
 	if err != nil {
 		panic(err)
 	}
 	defer conn.Pool.Close()
+	metrics.NewPool(metricsNamespace, conn.Pool)
 
 	closer, err := tracing.New(context.Empty())
 	if err != nil {
@@ -59,12 +117,83 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	metrics.NewGauge(metricsNamespace, "migration_version", "Version of the last applied schema migration.").Set(float64(repoStorage.MigrationVersion()))
+
+	repoBlob, err := newBlob()
+	if err != nil {
+		panic(err)
+	}
+
+	repoBroker, closeBroker, err := newBroker()
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = closeBroker(); err != nil {
+			log.Error(err)
+		}
+	}()
+
+	verifier, closeVerifier, err := newVerifier()
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = closeVerifier(); err != nil {
+			log.Error(err)
+		}
+	}()
+
+	repoLimiter, closeLimiter, err := newLimiter()
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = closeLimiter(); err != nil {
+			log.Error(err)
+		}
+	}()
+
+	rateLimitOptions, err := newRateLimitOptions()
+	if err != nil {
+		panic(err)
+	}
+
+	var eventBus = bus.New()
+	eventBus.Subscribe(bus.LogHandler)
+
+	// лента изменений будит своих подписчиков сразу после изменения контакта или группы
+	var ucFeed = useCaseFeed.New(repoStorage, useCaseFeed.Options{})
+	eventBus.Subscribe(ucFeed.Notify)
+
+	// права проверяются на границе сценариев: HTTP и gRPC получают одни и те же обёрнутые сценарии
+	var ucPolicy = useCasePolicy.New(repoStorage, useCasePolicy.Options{AnonymousRoles: viper.GetString("AUTH_ANONYMOUS_ROLES")})
+
+	// квоты арендатора проверяются после прав: отказ в правах не должен раскрывать потребление
+	var ucTenant = useCaseTenant.New(repoStorage, useCaseTenant.Options{Default: viper.GetString("TENANT_DEFAULT")})
+
+	// длительность сценариев измеряется снаружи, вместе с проверками прав и квот
+	var ucMetrics = useCaseMetrics.New(repoStorage, useCaseMetrics.Options{Namespace: metricsNamespace, Interval: viper.GetDuration("METRICS_INTERVAL")})
+
 	var (
-		ucContact = useCaseContact.New(repoStorage, useCaseContact.Options{})
+		ucContact = ucMetrics.Contact(ucPolicy.Contact(ucTenant.Contact(useCaseContact.New(repoStorage, eventBus, useCaseContact.Options{MaxBatchSize: viper.GetInt("CONTACT_MAX_BATCH_SIZE")}))))
 		// ucGroup      = useCaseGroup.New(repoGroup, useCaseGroup.Options{})
-		ucGroup      = useCaseGroup.New(repoStorage, useCaseGroup.Options{})
-		_            = deliveryGrpc.New(ucContact, ucGroup, deliveryGrpc.Options{})
-		listenerHttp = deliveryHttp.New(ucContact, ucGroup, deliveryHttp.Options{})
+		ucGroup        = ucMetrics.Group(ucPolicy.Group(ucTenant.Group(useCaseGroup.New(repoStorage, eventBus, useCaseGroup.Options{}))))
+		ucCustomField  = ucPolicy.CustomField(useCaseCustomField.New(repoStorage, useCaseCustomField.Options{}))
+		ucTag          = ucPolicy.Tag(useCaseTag.New(repoStorage, useCaseTag.Options{}))
+		ucOrganization = ucPolicy.Organization(useCaseOrganization.New(repoStorage, useCaseOrganization.Options{}))
+		ucNote         = ucPolicy.Note(useCaseNote.New(repoStorage, useCaseNote.Options{}))
+		ucPhoto        = ucPolicy.Photo(useCasePhoto.New(repoStorage, repoBlob, useCasePhoto.Options{}))
+		ucAudit        = ucPolicy.Audit(useCaseAudit.New(repoStorage, useCaseAudit.Options{}))
+		ucOutbox       = useCaseOutbox.New(repoStorage, repoBroker, useCaseOutbox.Options{})
+		ucDelta        = ucPolicy.Delta(useCaseDelta.New(repoStorage, useCaseDelta.Options{}))
+		ucAuth         = useCaseAuth.New(repoStorage, verifier, useCaseAuth.Options{Methods: viper.GetString("AUTH_METHODS"), Anonymous: viper.GetBool("AUTH_ANONYMOUS")})
+		ucShare        = ucPolicy.Share(useCaseShare.New(repoStorage, useCaseShare.Options{}))
+		ucWebhook      = useCaseWebhook.New(repoStorage, repositoryWebhookHttp.New(repositoryWebhookHttp.Options{}), useCaseWebhook.Options{})
+		ucRateLimit    = useCaseRateLimit.New(repoLimiter, rateLimitOptions)
+		listenerGrpc   = deliveryGrpc.New(ucContact, ucGroup, ucAuth, ucPolicy.Tenant(ucTenant), ucRateLimit, deliveryGrpc.Options{Metrics: metrics.NewGRPC(metricsNamespace), MaxBatchSize: viper.GetInt("CONTACT_MAX_BATCH_SIZE")})
+		listenerHttp   = deliveryHttp.New(ucContact, ucGroup, ucCustomField, ucTag, ucOrganization, ucNote, ucPhoto, ucAudit, ucPolicy.Webhook(ucWebhook), ucPolicy.Feed(ucFeed), ucDelta, ucAuth, ucPolicy.Tenant(ucTenant), ucShare, ucRateLimit, deliveryHttp.Options{Metrics: metrics.NewHTTP(metricsNamespace)})
+		serverGrpc     = grpc.NewServer(listenerGrpc.ServerOptions()...)
 	)
 
 	go func() {
@@ -74,8 +203,122 @@ func main() {
 		}
 	}()
 
+	go func() {
+		fmt.Printf("service started successfully on grpc port: %d", viper.GetUint("GRPC_PORT"))
+		if err = listenerGrpc.Run(serverGrpc); err != nil {
+			panic(err)
+		}
+	}()
+
+	if port := uint16(viper.GetUint("METRICS_PORT")); port != 0 {
+		go func() {
+			fmt.Printf("metrics started successfully on port: %d", port)
+			if err = metrics.Serve(fmt.Sprintf(":%d", port)); err != nil {
+				panic(err)
+			}
+		}()
+	}
+
+	// фоновые обработчики: доставка outbox брокеру, доставка вебхуков подписчикам и пересчёт метрик.
+	// Они обслуживают всех арендаторов сразу, поэтому работают с арендатором context.TenantAll.
+	backgroundCtx, stopBackground := stdContext.WithCancel(stdContext.Background())
+	var background sync.WaitGroup
+	for _, run := range []func(ctx context.Context){ucOutbox.Run, ucWebhook.Run, ucMetrics.Run} {
+		background.Add(1)
+		go func(run func(ctx context.Context)) {
+			defer background.Done()
+			var ctx = context.New(backgroundCtx)
+			ctx.WithValue(context.KeyTenant, context.TenantAll)
+			run(ctx)
+		}(run)
+	}
+
 	signalCh := make(chan os.Signal, 1)
 	signal.Notify(signalCh, syscall.SIGINT, syscall.SIGTERM)
 	<-signalCh
 
+	serverGrpc.GracefulStop()
+
+	stopBackground()
+	background.Wait()
+
+}
+
+// newBlob хранилище файлов выбирается переменной BLOB_STORAGE: local или s3
+func newBlob() (blob.Blob, error) {
+	switch viper.GetString("BLOB_STORAGE") {
+	case "s3":
+		return repositoryBlobS3.New(context.Empty(), repositoryBlobS3.Options{})
+	default:
+		return repositoryBlobLocal.New(repositoryBlobLocal.Options{})
+	}
+}
+
+// newBroker брокер для событий outbox выбирается переменной BROKER: log или nats
+func newBroker() (broker.Broker, func() error, error) {
+	switch viper.GetString("BROKER") {
+	case "nats":
+		repoNats, err := repositoryBrokerNats.New(repositoryBrokerNats.Options{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return repoNats, repoNats.Close, nil
+	default:
+		return repositoryBrokerLog.New(), func() error { return nil }, nil
+	}
+}
+
+// newLimiter хранилище вёдер лимита запросов выбирается переменной RATE_LIMIT_STORE: memory или redis.
+// В памяти у каждой реплики свой лимит, в Redis -- общий.
+func newLimiter() (limiter.Limiter, func() error, error) {
+	switch viper.GetString("RATE_LIMIT_STORE") {
+	case "redis":
+		repoRedis, err := repositoryLimiterRedis.New(repositoryLimiterRedis.Options{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return repoRedis, repoRedis.Close, nil
+	default:
+		return repositoryLimiterMemory.New(repositoryLimiterMemory.Options{}), func() error { return nil }, nil
+	}
+}
+
+// newRateLimitOptions лимиты групп маршрутов из RATE_LIMIT_DEFAULT, RATE_LIMIT_CONTACTS,
+// RATE_LIMIT_GROUPS, RATE_LIMIT_TRANSFER и лимит адреса из RATE_LIMIT_ADDRESS
+func newRateLimitOptions() (options useCaseRateLimit.Options, err error) {
+	for key, limit := range map[string]*rateLimit.Limit{
+		"RATE_LIMIT_DEFAULT":  &options.Default,
+		"RATE_LIMIT_CONTACTS": &options.Contacts,
+		"RATE_LIMIT_GROUPS":   &options.Groups,
+		"RATE_LIMIT_TRANSFER": &options.Transfer,
+		"RATE_LIMIT_ADDRESS":  &options.Address,
+	} {
+		if *limit, err = rateLimit.Parse(viper.GetString(key)); err != nil {
+			return options, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return options, nil
+}
+
+// newVerifier токены проверяет сервис user, если задан USER_GRPC_ADDR, иначе -- JWT на месте,
+// если задан ключ: JWT_JWKS_URL, JWT_PUBLIC_KEY или JWT_SECRET.
+// Без того и другого вход по токенам недоступен, остаются ключи доступа и сессии.
+func newVerifier() (token.Verifier, func() error, error) {
+	if viper.GetString("USER_GRPC_ADDR") != "" {
+		repoUser, err := repositoryTokenUser.New(repositoryTokenUser.Options{})
+		if err != nil {
+			return nil, nil, err
+		}
+		return repoUser, repoUser.Close, nil
+	}
+
+	if viper.GetString("JWT_JWKS_URL") == "" && viper.GetString("JWT_PUBLIC_KEY") == "" && viper.GetString("JWT_SECRET") == "" {
+		return nil, func() error { return nil }, nil
+	}
+
+	repoJwt, err := repositoryTokenJwt.New(repositoryTokenJwt.Options{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return repoJwt, repoJwt.Close, nil
 }
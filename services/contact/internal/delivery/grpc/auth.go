@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	stdContext "context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// метаданные запроса повторяют заголовки HTTP
+const (
+	metadataAuthorization = "authorization"
+	metadataAPIKey        = "x-api-key"
+	metadataTenant        = "x-tenant-id"
+)
+
+// actorAnonymous автор запроса без учётных данных, как в HTTP
+const actorAnonymous = "anonymous"
+
+// UnaryAuthenticate определяет автора и арендатора вызова по тем же правилам, что и HTTP
+func (d *Delivery) UnaryAuthenticate(c stdContext.Context, request interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := d.authenticate(c)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+func (d *Delivery) StreamAuthenticate(server interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := d.authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(server, &authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticatedStream поток с контекстом, в котором уже есть автор и арендатор
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx stdContext.Context
+}
+
+func (s *authenticatedStream) Context() stdContext.Context {
+	return s.ctx
+}
+
+// authenticate контекст вызова с автором под context.KeyPrincipal и арендатором под context.KeyTenant
+func (d *Delivery) authenticate(c stdContext.Context) (stdContext.Context, error) {
+	var (
+		md, _       = metadata.FromIncomingContext(c)
+		credentials = useCase.Credentials{
+			Authorization: firstValue(md, metadataAuthorization),
+			APIKey:        firstValue(md, metadataAPIKey),
+			Tenant:        firstValue(md, metadataTenant),
+		}
+		ctx = context.New(c)
+	)
+
+	result, err := d.ucAuth.Authenticate(ctx, credentials)
+	if err != nil {
+		return nil, toStatus(c, err)
+	}
+
+	// арендатор проверяется до того, как автор попадёт в контекст: чужой или отключённый арендатор -- отказ
+	tenantID, err := d.ucTenant.Select(ctx, result, credentials.Tenant)
+	if err != nil {
+		return nil, toStatus(c, err)
+	}
+
+	c = stdContext.WithValue(c, context.KeyTenant, tenantID)
+	if result == nil {
+		return stdContext.WithValue(c, context.KeyActor, actorAnonymous), nil
+	}
+
+	result.Tenant = tenantID
+	c = stdContext.WithValue(c, context.KeyPrincipal, result)
+	return stdContext.WithValue(c, context.KeyActor, result.Subject), nil
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,207 @@
+package grpc
+
+import (
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/email"
+	"architecture_go/pkg/type/gender"
+	"architecture_go/pkg/type/phoneNumber"
+	contact "architecture_go/services/contact/internal/delivery/grpc/interface"
+	domainContact "architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/contact/address"
+	"architecture_go/services/contact/internal/domain/contact/age"
+	"architecture_go/services/contact/internal/domain/contact/birthday"
+	"architecture_go/services/contact/internal/domain/contact/employment"
+	"architecture_go/services/contact/internal/domain/contact/name"
+	"architecture_go/services/contact/internal/domain/contact/patronymic"
+	"architecture_go/services/contact/internal/domain/contact/surname"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func (d *Delivery) CreateContacts(stream contact.ContactService_CreateContactsServer) error {
+	var ctx = context.New(stream.Context())
+
+	var (
+		mode  = useCase.BatchModeAtomic
+		items []*useCase.BatchItem
+	)
+	for {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		// пакет не накапливается сверх лимита: клиент узнаёт об отказе, не дописывая поток
+		if len(items) == d.options.MaxBatchSize {
+			return toStatus(stream.Context(), useCase.ErrBatchTooLarge)
+		}
+
+		if len(items) == 0 && request.GetMode() == contact.BatchMode_BATCH_MODE_BEST_EFFORT {
+			mode = useCase.BatchModeBestEffort
+		}
+
+		dContact, err := toDomainContact(request.GetContact())
+		items = append(items, &useCase.BatchItem{Contact: dContact, Err: err})
+	}
+
+	items, err := d.ucContact.CreateBatch(ctx, mode, items...)
+	if err != nil && !errors.Is(err, useCase.ErrBatchRejected) {
+		return toStatus(stream.Context(), err)
+	}
+
+	var response = &contact.CreateContactsResponse{
+		Results: make([]*contact.CreateContactResult, len(items)),
+	}
+	var lang = acceptLanguage(stream.Context())
+	for i, item := range items {
+		var result = &contact.CreateContactResult{Index: uint32(i)}
+		switch {
+		case item.Created:
+			result.Status = contact.BatchStatus_BATCH_STATUS_CREATED
+			result.Contact = toContactResponse(item.Contact)
+			response.Created++
+		case item.Err != nil:
+			result.Status = contact.BatchStatus_BATCH_STATUS_FAILED
+			result.Error = useCase.Message(lang, item.Err)
+			response.Failed++
+		default:
+			result.Status = contact.BatchStatus_BATCH_STATUS_SKIPPED
+		}
+		response.Results[i] = result
+	}
+
+	return stream.SendAndClose(response)
+}
+
+func toDomainContact(request *contact.ShortContact) (*domainContact.Contact, error) {
+	if request == nil {
+		return nil, domainContact.ErrPhoneNumberRequired
+	}
+
+	if request.GetAge() > uint32(age.MaxLength) {
+		return nil, age.ErrWrongLength
+	}
+
+	contactAge, err := age.New(uint8(request.GetAge()))
+	if err != nil {
+		return nil, err
+	}
+
+	contactName, err := name.New(request.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	contactSurname, err := surname.New(request.GetSurname())
+	if err != nil {
+		return nil, err
+	}
+
+	contactPatronymic, err := patronymic.New(request.GetPatronymic())
+	if err != nil {
+		return nil, err
+	}
+
+	contactEmail, err := email.New(request.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+
+	contactBirthday, err := birthday.Parse(request.GetBirthday())
+	if err != nil {
+		return nil, err
+	}
+
+	var contactAddresses = make([]address.Address, len(request.GetAddresses()))
+	for i, value := range request.GetAddresses() {
+		contactAddresses[i], err = address.New(
+			value.GetLabel(),
+			value.GetCountry(),
+			value.GetRegion(),
+			value.GetCity(),
+			value.GetStreet(),
+			value.GetPostcode(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var organizationID = uuid.Nil
+	if request.GetOrganizationId() != "" {
+		if organizationID, err = uuid.Parse(request.GetOrganizationId()); err != nil {
+			return nil, err
+		}
+	}
+
+	contactEmployment, err := employment.New(organizationID, request.GetJobTitle())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := domainContact.New(
+		*phoneNumber.New(request.GetPhoneNumber()),
+		contactEmail,
+		*contactName,
+		*contactSurname,
+		*contactPatronymic,
+		*contactAge,
+		gender.New(uint8(request.GetGender())),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result.SetBirthday(contactBirthday)
+	result.SetAddresses(contactAddresses...)
+	result.SetEmployment(contactEmployment)
+	return result, nil
+}
+
+func toContactResponse(response *domainContact.Contact) *contact.ContactResponse {
+	var addresses = make([]*contact.Address, len(response.Addresses()))
+	for i, value := range response.Addresses() {
+		addresses[i] = &contact.Address{
+			Label:    value.Label(),
+			Country:  value.Country(),
+			Region:   value.Region(),
+			City:     value.City(),
+			Street:   value.Street(),
+			Postcode: value.Postcode(),
+		}
+	}
+
+	var organizationID string
+	if response.Employment().HasOrganization() {
+		organizationID = response.Employment().OrganizationID().String()
+	}
+
+	return &contact.ContactResponse{
+		Id:         response.ID().String(),
+		CreatedAt:  timestamppb.New(response.CreatedAt()),
+		ModifiedAt: timestamppb.New(response.ModifiedAt()),
+		Contact: &contact.ShortContact{
+			PhoneNumber: response.PhoneNumber().String(),
+			Email:       response.Email().String(),
+			Gender:      uint32(response.Gender().Number()),
+			Age:         uint32(response.Age()),
+			Name:        response.Name().String(),
+			Surname:     response.Surname().String(),
+			Patronymic:  response.Patronymic().String(),
+			Birthday:    response.Birthday().String(),
+			Addresses:   addresses,
+
+			OrganizationId:   organizationID,
+			JobTitle:         response.Employment().JobTitle(),
+			OrganizationName: response.Employment().OrganizationName(),
+		},
+	}
+}
@@ -1,24 +1,48 @@
 package grpc
 
 import (
+	"fmt"
+	"net"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"architecture_go/pkg/metrics"
+	log "architecture_go/pkg/type/logger"
 	contact "architecture_go/services/contact/internal/delivery/grpc/interface"
 	"architecture_go/services/contact/internal/useCase"
 )
 
+func init() {
+	viper.SetDefault("GRPC_PORT", 9090)
+}
+
 type Delivery struct {
 	contact.UnimplementedContactServiceServer
-	ucContact useCase.Contact
-	ucGroup   useCase.Group
+	ucContact   useCase.Contact
+	ucGroup     useCase.Group
+	ucAuth      useCase.Auth
+	ucTenant    useCase.Tenant
+	ucRateLimit useCase.RateLimit
 
 	options Options
 }
 
-type Options struct{}
+type Options struct {
+	// Metrics метрики вызовов; если заданы, их перехватчики ставятся первыми
+	Metrics *metrics.GRPC
+	// MaxBatchSize сколько контактов принимает поток CreateContacts, лишние не дочитываются
+	MaxBatchSize int
+}
 
-func New(ucContact useCase.Contact, ucGroup useCase.Group, o Options) *Delivery {
+func New(ucContact useCase.Contact, ucGroup useCase.Group, ucAuth useCase.Auth, ucTenant useCase.Tenant, ucRateLimit useCase.RateLimit, o Options) *Delivery {
 	var d = &Delivery{
-		ucContact: ucContact,
-		ucGroup:   ucGroup,
+		ucContact:   ucContact,
+		ucGroup:     ucGroup,
+		ucAuth:      ucAuth,
+		ucTenant:    ucTenant,
+		ucRateLimit: ucRateLimit,
 	}
 
 	d.SetOptions(o)
@@ -26,7 +50,43 @@ func New(ucContact useCase.Contact, ucGroup useCase.Group, o Options) *Delivery
 }
 
 func (d *Delivery) SetOptions(options Options) {
+	if options.MaxBatchSize <= 0 {
+		options.MaxBatchSize = 100
+		log.Debug("set default options.MaxBatchSize", zap.Any("maxBatchSize", options.MaxBatchSize))
+	}
+
 	if d.options != options {
 		d.options = options
 	}
 }
+
+// ServerOptions перехватчики, без которых сервер не должен обслуживать Delivery.
+// Восстановление после паники стоит перед остальными; как и в HTTP, лимит по адресу считается до аутентификации,
+// а лимит группы методов -- после неё
+func (d *Delivery) ServerOptions() []grpc.ServerOption {
+	var (
+		unary  = []grpc.UnaryServerInterceptor{d.UnaryRecover, d.UnaryRateLimitAddress, d.UnaryAuthenticate, d.UnaryRateLimit}
+		stream = []grpc.StreamServerInterceptor{d.StreamRecover, d.StreamRateLimitAddress, d.StreamAuthenticate, d.StreamRateLimit}
+	)
+
+	if d.options.Metrics != nil {
+		unary = append([]grpc.UnaryServerInterceptor{d.options.Metrics.UnaryServerInterceptor}, unary...)
+		stream = append([]grpc.StreamServerInterceptor{d.options.Metrics.StreamServerInterceptor}, stream...)
+	}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+}
+
+// Run принимает запросы на GRPC_PORT до остановки server
+func (d *Delivery) Run(server *grpc.Server) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", uint16(viper.GetUint("GRPC_PORT"))))
+	if err != nil {
+		return err
+	}
+
+	contact.RegisterContactServiceServer(server, d)
+	return server.Serve(listener)
+}
@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	stdContext "context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"architecture_go/pkg/type/problem"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// errorDomain домен кодов ошибок в ErrorInfo
+const errorDomain = "contact"
+
+// metadataAcceptLanguage метаданные запроса с языком сообщений, как заголовок Accept-Language в HTTP
+const metadataAcceptLanguage = "accept-language"
+
+// toStatus ошибка из каталога useCase.Problems получает код gRPC своего класса и детали:
+// ErrorInfo с тем же кодом, что в ответах HTTP, LocalizedMessage на языке из accept-language
+// и BadRequest, если ошибка относится к полю. Остальные -- Internal.
+func toStatus(c stdContext.Context, err error) error {
+	return newStatus(c, err).Err()
+}
+
+func newStatus(c stdContext.Context, err error) *status.Status {
+	var entry, ok = useCase.Problems.Find(err)
+	if !ok {
+		entry = problem.Entry{Code: problem.CodeOf(problem.KindInternal.HTTPStatus()), Kind: problem.KindInternal}
+	}
+
+	var (
+		lang    = acceptLanguage(c)
+		message = useCase.Message(lang, err)
+	)
+	if !ok {
+		message, _ = useCase.Messages.Message(lang, entry.Code, nil)
+	}
+
+	var (
+		st        = status.New(entry.Kind.GRPCCode(), err.Error())
+		info      = &errdetails.ErrorInfo{Reason: entry.Code.String(), Domain: errorDomain}
+		localized = &errdetails.LocalizedMessage{Locale: lang.String(), Message: message}
+	)
+
+	var detailed, detailsErr = st.WithDetails(info, localized)
+	if entry.Param != "" {
+		detailed, detailsErr = st.WithDetails(info, localized, &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: entry.Param, Description: message},
+		}})
+	}
+
+	// детали не обязательны: если их не удалось упаковать, клиент получит статус без них
+	if detailsErr != nil {
+		return st
+	}
+	return detailed
+}
+
+// acceptLanguage язык сообщений по метаданным запроса
+func acceptLanguage(c stdContext.Context) problem.Language {
+	if md, ok := metadata.FromIncomingContext(c); ok {
+		if values := md.Get(metadataAcceptLanguage); len(values) > 0 {
+			return problem.ParseLanguage(values[0])
+		}
+	}
+	return problem.DefaultLanguage
+}
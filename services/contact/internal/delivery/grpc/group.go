@@ -3,17 +3,23 @@ package grpc
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	contact "architecture_go/services/contact/internal/delivery/grpc/interface"
 )
 
+// errGroupUnimplemented группы пока управляются только через HTTP
+var errGroupUnimplemented = status.Error(codes.Unimplemented, "group management is available over HTTP only")
+
 func (d *Delivery) CreateGroup(ctx context.Context, request *contact.CreateGroupRequest) (*contact.CreateGroupResponse, error) {
-	panic("implement me")
+	return nil, errGroupUnimplemented
 }
 
 func (d *Delivery) UpdateGroup(ctx context.Context, request *contact.UpdateGroupRequest) (*contact.UpdateGroupResponse, error) {
-	panic("implement me")
+	return nil, errGroupUnimplemented
 }
 
 func (d *Delivery) DeleteGroup(ctx context.Context, request *contact.DeleteGroupRequest) (*contact.DeleteGroupResponse, error) {
-	panic("implement me")
+	return nil, errGroupUnimplemented
 }
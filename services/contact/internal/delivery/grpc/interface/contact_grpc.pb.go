@@ -25,6 +25,7 @@ type ContactServiceClient interface {
 	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
 	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error)
 	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	CreateContacts(ctx context.Context, opts ...grpc.CallOption) (ContactService_CreateContactsClient, error)
 }
 
 type contactServiceClient struct {
@@ -62,6 +63,40 @@ func (c *contactServiceClient) DeleteGroup(ctx context.Context, in *DeleteGroupR
 	return out, nil
 }
 
+func (c *contactServiceClient) CreateContacts(ctx context.Context, opts ...grpc.CallOption) (ContactService_CreateContactsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ContactService_ServiceDesc.Streams[0], "/contact.ContactService/CreateContacts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contactServiceCreateContactsClient{stream}
+	return x, nil
+}
+
+type ContactService_CreateContactsClient interface {
+	Send(*CreateContactsRequest) error
+	CloseAndRecv() (*CreateContactsResponse, error)
+	grpc.ClientStream
+}
+
+type contactServiceCreateContactsClient struct {
+	grpc.ClientStream
+}
+
+func (x *contactServiceCreateContactsClient) Send(m *CreateContactsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *contactServiceCreateContactsClient) CloseAndRecv() (*CreateContactsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CreateContactsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ContactServiceServer is the server API for ContactService service.
 // All implementations must embed UnimplementedContactServiceServer
 // for forward compatibility
@@ -69,6 +104,7 @@ type ContactServiceServer interface {
 	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
 	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
 	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	CreateContacts(ContactService_CreateContactsServer) error
 	mustEmbedUnimplementedContactServiceServer()
 }
 
@@ -85,6 +121,9 @@ func (UnimplementedContactServiceServer) UpdateGroup(context.Context, *UpdateGro
 func (UnimplementedContactServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
 }
+func (UnimplementedContactServiceServer) CreateContacts(ContactService_CreateContactsServer) error {
+	return status.Errorf(codes.Unimplemented, "method CreateContacts not implemented")
+}
 func (UnimplementedContactServiceServer) mustEmbedUnimplementedContactServiceServer() {}
 
 // UnsafeContactServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -152,6 +191,32 @@ func _ContactService_DeleteGroup_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContactService_CreateContacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContactServiceServer).CreateContacts(&contactServiceCreateContactsServer{stream})
+}
+
+type ContactService_CreateContactsServer interface {
+	SendAndClose(*CreateContactsResponse) error
+	Recv() (*CreateContactsRequest, error)
+	grpc.ServerStream
+}
+
+type contactServiceCreateContactsServer struct {
+	grpc.ServerStream
+}
+
+func (x *contactServiceCreateContactsServer) SendAndClose(m *CreateContactsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *contactServiceCreateContactsServer) Recv() (*CreateContactsRequest, error) {
+	m := new(CreateContactsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ContactService_ServiceDesc is the grpc.ServiceDesc for ContactService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -172,6 +237,12 @@ var ContactService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ContactService_DeleteGroup_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CreateContacts",
+			Handler:       _ContactService_CreateContacts_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "contact.proto",
 }
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	stdContext "context"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// метаданные ответа повторяют заголовки HTTP
+const (
+	headerRateLimitLimit     = "ratelimit-limit"
+	headerRateLimitRemaining = "ratelimit-remaining"
+	headerRateLimitReset     = "ratelimit-reset"
+)
+
+// UnaryRateLimitAddress лимит запросов с адреса клиента до аутентификации: ограничивает перебор учётных данных
+func (d *Delivery) UnaryRateLimitAddress(ctx stdContext.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := d.takeRateLimit(ctx, rateLimit.GroupAddress); err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// StreamRateLimitAddress потоковый вызов расходует один запрос адреса при открытии потока
+func (d *Delivery) StreamRateLimitAddress(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := d.takeRateLimit(stream.Context(), rateLimit.GroupAddress); err != nil {
+		return err
+	}
+	return handler(server, stream)
+}
+
+// UnaryRateLimit лимит запросов к методам сервиса с теми же группами, что и у HTTP
+func (d *Delivery) UnaryRateLimit(ctx stdContext.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := d.takeRateLimit(ctx, methodGroup(info.FullMethod)); err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// StreamRateLimit потоковый вызов расходует один запрос при открытии потока
+func (d *Delivery) StreamRateLimit(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := d.takeRateLimit(stream.Context(), methodGroup(info.FullMethod)); err != nil {
+		return err
+	}
+	return handler(server, stream)
+}
+
+// takeRateLimit ведро выбирается так же, как в HTTP: по ключу доступа или субъекту из контекста,
+// который заполнил authenticate, и только для анонимного вызова и группы адреса -- по адресу клиента
+func (d *Delivery) takeRateLimit(c stdContext.Context, group rateLimit.Group) error {
+	if d.ucRateLimit == nil {
+		return nil
+	}
+
+	result, err := d.ucRateLimit.Take(context.New(c), group, peerAddress(c))
+	if err != nil {
+		return toStatus(c, err)
+	}
+	if result == nil {
+		return nil
+	}
+
+	_ = grpc.SetHeader(c, metadata.Pairs(
+		headerRateLimitLimit, strconv.FormatUint(result.Limit.Burst, 10),
+		headerRateLimitRemaining, strconv.FormatUint(result.Remaining, 10),
+		headerRateLimitReset, strconv.FormatInt(int64(math.Ceil(result.Reset.Seconds())), 10),
+	))
+
+	if result.Allowed {
+		return nil
+	}
+
+	var st = newStatus(c, useCase.ErrRateLimited)
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(result.RetryAfter.Round(time.Millisecond))}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// methodGroup группа лимита по полному имени метода: потоковое создание контактов -- импорт
+func methodGroup(method string) rateLimit.Group {
+	var name = method[strings.LastIndex(method, "/")+1:]
+	switch {
+	case name == "CreateContacts":
+		return rateLimit.GroupTransfer
+	case strings.HasSuffix(name, "Group"):
+		return rateLimit.GroupGroups
+	case strings.HasSuffix(name, "Contact"):
+		return rateLimit.GroupContacts
+	default:
+		return rateLimit.GroupDefault
+	}
+}
+
+func peerAddress(c stdContext.Context) string {
+	p, ok := peer.FromContext(c)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	stdContext "context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	log "architecture_go/pkg/type/logger"
+)
+
+// errPanic клиенту не передаются подробности паники, только код Internal
+var errPanic = errors.New("internal error")
+
+// UnaryRecover паника в обработчике превращается в Internal и не роняет сервер, как gin Recovery в HTTP
+func (d *Delivery) UnaryRecover(c stdContext.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (response interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(c, info.FullMethod, r)
+		}
+	}()
+	return handler(c, request)
+}
+
+func (d *Delivery) StreamRecover(server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(stream.Context(), info.FullMethod, r)
+		}
+	}()
+	return handler(server, stream)
+}
+
+func recovered(c stdContext.Context, method string, r interface{}) error {
+	log.Error(fmt.Sprintf("panic in %s: %v", method, r), zap.Stack("stack"))
+	return toStatus(c, errPanic)
+}
@@ -0,0 +1,29 @@
+package http
+
+import (
+	"architecture_go/pkg/type/filter"
+	"architecture_go/services/contact/internal/domain/contact/address"
+)
+
+const (
+	filterCity    = "city"
+	filterCountry = "country"
+)
+
+// normalizeCountryFilters коды стран хранятся в верхнем регистре
+func normalizeCountryFilters(filters filter.Filters) error {
+	for _, f := range filters {
+		if f.Key != filterCountry {
+			continue
+		}
+
+		for i, value := range f.Values {
+			country, err := address.NewCountry(value)
+			if err != nil {
+				return err
+			}
+			f.Values[i] = country
+		}
+	}
+	return nil
+}
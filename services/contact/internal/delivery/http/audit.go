@@ -0,0 +1,220 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonAudit "architecture_go/services/contact/internal/delivery/http/audit"
+	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	"architecture_go/services/contact/internal/domain/audit"
+)
+
+const (
+	filterAuditEntity      = "entity"
+	filterAuditEntityID    = "entityId"
+	filterAuditActor       = "actor"
+	filterAuditAction      = "action"
+	filterAuditRequestID   = "requestId"
+	filterAuditTraceID     = "traceId"
+	filterAuditCreatedFrom = "createdFrom"
+	filterAuditCreatedTo   = "createdTo"
+)
+
+var mappingFiltersAudit = query.FiltersOptions{
+	filterAuditEntity:      {},
+	filterAuditEntityID:    {},
+	filterAuditActor:       {},
+	filterAuditAction:      {},
+	filterAuditRequestID:   {},
+	filterAuditTraceID:     {},
+	filterAuditCreatedFrom: {},
+	filterAuditCreatedTo:   {},
+}
+
+// mappingFiltersHistory история всегда относится к одному контакту
+var mappingFiltersHistory = query.FiltersOptions{
+	filterAuditActor:       {},
+	filterAuditAction:      {},
+	filterAuditRequestID:   {},
+	filterAuditTraceID:     {},
+	filterAuditCreatedFrom: {},
+	filterAuditCreatedTo:   {},
+}
+
+var (
+	auditEntities = map[string]struct{}{
+		audit.EntityContact.String(): {},
+		audit.EntityGroup.String():   {},
+		audit.EntityTag.String():     {},
+	}
+	auditActions = map[string]struct{}{
+		audit.ActionCreate.String():          {},
+		audit.ActionUpdate.String():          {},
+		audit.ActionDelete.String():          {},
+		audit.ActionRestore.String():         {},
+		audit.ActionAddToGroup.String():      {},
+		audit.ActionRemoveFromGroup.String(): {},
+		audit.ActionMerge.String():           {},
+	}
+)
+
+// checkAuditFilters даты передаются в формате RFC 3339, сущности и виды изменений -- из справочника
+func checkAuditFilters(filters filter.Filters) error {
+	for _, f := range filters {
+		for _, value := range f.Values {
+			switch f.Key {
+			case filterAuditEntity:
+				if _, ok := auditEntities[value]; !ok {
+					return fmt.Errorf("filter %s: unknown entity %q", filterAuditEntity, value)
+				}
+			case filterAuditAction:
+				if _, ok := auditActions[value]; !ok {
+					return fmt.Errorf("filter %s: unknown action %q", filterAuditAction, value)
+				}
+			case filterAuditEntityID:
+				if _, err := uuid.Parse(value); err != nil {
+					return fmt.Errorf("filter %s: wrong entity id %q", filterAuditEntityID, value)
+				}
+			case filterAuditCreatedFrom, filterAuditCreatedTo:
+				if _, err := time.Parse(time.RFC3339, value); err != nil {
+					return fmt.Errorf("filter %s: wrong time %q, expected RFC 3339", f.Key, value)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ListAudit
+// @Summary Получить журнал изменений.
+// @Description Метод позволяет получить журнал изменений контактов, групп и тегов. Новые записи первыми.
+// @Tags audit
+// @Accept  json
+// @Produce json
+// @Param 	limit 		query 		int 					false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 					false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	filter 		query 		object 					false "Фильтр вида filter[entity]=contact,group,tag, filter[entityId]=идентификатор, filter[actor]=автор, filter[action]=create,update,delete,restore,addToGroup,removeFromGroup,merge, filter[requestId]=идентификатор запроса, filter[traceId]=идентификатор трассировки, по времени filter[createdFrom]=2023-08-08T00:00:00Z, filter[createdTo]=2023-08-09T00:00:00Z"
+// @Success 200			{object}  	jsonAudit.ListEntry 	true  "Журнал изменений"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Router /audit/ [get]
+func (d *Delivery) ListAudit(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	params, err := query.ParseQuery(c, query.Options{
+		Filters: mappingFiltersAudit,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = checkAuditFilters(params.Filters); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	entries, err := d.ucAudit.List(ctx, queryParameter.QueryParameter{
+		Filters: params.Filters,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucAudit.Count(ctx, params.Filters)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toListEntry(count, params, entries))
+}
+
+// ContactHistory
+// @Summary Получить историю изменений контакта.
+// @Description Метод позволяет получить историю изменений контакта, включая добавление в группы и исключение из групп. История доступна и для удалённых контактов.
+// @Tags contacts
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор контакта"
+// @Param 	limit 		query 		int 					false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 					false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	filter 		query 		object 					false "Фильтр вида filter[actor]=автор, filter[action]=update,addToGroup, filter[requestId]=идентификатор запроса, filter[traceId]=идентификатор трассировки, по времени filter[createdFrom]=2023-08-08T00:00:00Z, filter[createdTo]=2023-08-09T00:00:00Z"
+// @Success 200			{object}  	jsonAudit.ListEntry 	true  "История изменений"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Router /contacts/{id}/history [get]
+func (d *Delivery) ContactHistory(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	params, err := query.ParseQuery(c, query.Options{
+		Filters: mappingFiltersHistory,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = checkAuditFilters(params.Filters); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var contactID = converter.StringToUUID(id.Value)
+
+	entries, err := d.ucAudit.History(ctx, contactID, queryParameter.QueryParameter{
+		Filters: params.Filters,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucAudit.CountHistory(ctx, contactID, params.Filters)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toListEntry(count, params, entries))
+}
+
+func toListEntry(count uint64, params *query.Query, entries []*audit.Entry) jsonAudit.ListEntry {
+	var result = jsonAudit.ListEntry{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonAudit.EntryResponse{},
+	}
+	for _, value := range entries {
+		result.List = append(result.List, jsonAudit.ToEntryResponse(value))
+	}
+	return result
+}
@@ -0,0 +1,28 @@
+package audit
+
+import (
+	domainAudit "architecture_go/services/contact/internal/domain/audit"
+)
+
+func ToEntryResponse(response *domainAudit.Entry) *EntryResponse {
+	var changes = make([]*Change, len(response.Changes()))
+	for i, change := range response.Changes() {
+		changes[i] = &Change{
+			Field:  change.Field(),
+			Before: change.Before(),
+			After:  change.After(),
+		}
+	}
+
+	return &EntryResponse{
+		ID:        response.ID().String(),
+		CreatedAt: response.CreatedAt(),
+		Actor:     response.Actor(),
+		RequestID: response.RequestID(),
+		TraceID:   response.TraceID(),
+		Entity:    response.Entity().String(),
+		EntityID:  response.EntityID().String(),
+		Action:    response.Action().String(),
+		Changes:   changes,
+	}
+}
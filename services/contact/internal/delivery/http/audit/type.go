@@ -0,0 +1,44 @@
+package audit
+
+import "time"
+
+type EntryResponse struct {
+	// Идентификатор записи журнала
+	ID string `json:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Время изменения
+	CreatedAt time.Time `json:"createdAt" binding:"required"`
+	// Автор изменения, system -- изменение выполнено не по запросу пользователя
+	Actor string `json:"actor" example:"anonymous"`
+	// Идентификатор запроса
+	RequestID string `json:"requestId" example:"00000000-0000-0000-0000-000000000000"`
+	// Идентификатор трассировки
+	TraceID string `json:"traceId,omitempty" example:"3a5e2c1b9f0d4e7a"`
+	// Тип сущности
+	Entity string `json:"entity" enums:"contact,group,tag" example:"contact"`
+	// Идентификатор сущности
+	EntityID string `json:"entityId" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Вид изменения
+	Action string `json:"action" enums:"create,update,delete,restore,addToGroup,removeFromGroup,merge" example:"update"`
+	// Изменённые поля
+	Changes []*Change `json:"changes"`
+}
+
+type Change struct {
+	// Название поля
+	Field string `json:"field" example:"name"`
+	// Значение до изменения
+	Before interface{} `json:"before" swaggertype:"string" example:"Иван"`
+	// Значение после изменения
+	After interface{} `json:"after" swaggertype:"string" example:"Пётр"`
+}
+
+type ListEntry struct {
+	// Всего
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*EntryResponse `json:"list"`
+}
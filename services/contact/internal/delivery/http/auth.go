@@ -0,0 +1,360 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonAuth "architecture_go/services/contact/internal/delivery/http/auth"
+	"architecture_go/services/contact/internal/domain/apiKey"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+const (
+	headerAuthorization   = "Authorization"
+	headerAPIKey          = "X-API-Key"
+	headerAuthenticate    = "WWW-Authenticate"
+	authenticateChallenge = `Bearer realm="contact"`
+)
+
+var mappingSortsAPIKey = query.SortsOptions{
+	"id":         {},
+	"name":       {},
+	"createdAt":  {},
+	"lastUsedAt": {},
+}
+
+// authMethods включённые способы аутентификации из AUTH_METHODS
+func authMethods() map[principal.Method]bool {
+	var result = make(map[principal.Method]bool)
+	for _, value := range strings.Split(viper.GetString("AUTH_METHODS"), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			result[principal.Method(value)] = true
+		}
+	}
+	return result
+}
+
+// authenticate определяет автора запроса и кладёт его в контекст под context.KeyPrincipal.
+// Учётные данные проверяет сценарий useCase.Auth: заголовок Authorization, заголовок X-API-Key, cookie сессии.
+// Вместе с автором в контекст под context.KeyTenant попадает арендатор запроса.
+// Те же правила применяет перехватчик gRPC.
+func (d *Delivery) authenticate(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var credentials = useCase.Credentials{
+		Authorization: c.GetHeader(headerAuthorization),
+		APIKey:        c.GetHeader(headerAPIKey),
+		Tenant:        c.GetHeader(headerTenant),
+	}
+	if d.authMethods[principal.MethodSession] {
+		credentials.Session, _ = c.Cookie(viper.GetString("AUTH_SESSION_COOKIE"))
+	}
+
+	result, err := d.ucAuth.Authenticate(ctx, credentials)
+	switch {
+	case err == nil:
+	case errors.Is(err, useCase.ErrNoCredentials) || errors.Is(err, useCase.ErrUnauthenticated):
+		c.Header(headerAuthenticate, authenticateChallenge)
+		SetError(c, http.StatusUnauthorized, err)
+		c.Abort()
+		return
+	default:
+		SetError(c, http.StatusInternalServerError, err)
+		c.Abort()
+		return
+	}
+
+	// арендатор проверяется до того, как автор попадёт в контекст: чужой или отключённый арендатор -- отказ
+	tenantID, err := d.ucTenant.Select(ctx, result, credentials.Tenant)
+	switch {
+	case err == nil:
+	case errors.Is(err, useCase.ErrTenantNotFound):
+		SetError(c, http.StatusBadRequest, err)
+		c.Abort()
+		return
+	case errors.Is(err, useCase.ErrTenantMismatch) || errors.Is(err, useCase.ErrTenantDisabled):
+		SetError(c, http.StatusForbidden, err)
+		c.Abort()
+		return
+	default:
+		SetError(c, http.StatusInternalServerError, err)
+		c.Abort()
+		return
+	}
+
+	c.Set(context.KeyTenant, tenantID)
+	if result != nil {
+		result.Tenant = tenantID
+		c.Set(context.KeyPrincipal, result)
+		c.Set(context.KeyActor, result.Subject)
+	} else {
+		c.Set(context.KeyActor, actorAnonymous)
+	}
+
+	c.Next()
+}
+
+// requirePrincipal автор запроса; при анонимном запросе отвечает 401
+func requirePrincipal(c *gin.Context, ctx context.Context) (*principal.Principal, bool) {
+	var result = ctx.Principal()
+	if result == nil {
+		c.Header(headerAuthenticate, authenticateChallenge)
+		SetError(c, http.StatusUnauthorized, useCase.ErrNoCredentials)
+		return nil, false
+	}
+	return result, true
+}
+
+func setSessionCookie(c *gin.Context, value string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(viper.GetString("AUTH_SESSION_COOKIE"), value, maxAge, "/", "", viper.GetBool("AUTH_SESSION_SECURE"), true)
+}
+
+// ReadPrincipal
+// @Summary Автор запроса.
+// @Description Метод возвращает субъекта и роли, с которыми выполняются запросы.
+// @Tags auth
+// @Produce json
+// @Success 200			{object}  	jsonAuth.PrincipalResponse 	true  "Автор запроса"
+// @Failure 401 		{object}    ErrorResponse
+// @Security Bearer
+// @Security ApiKey
+// @Security Cookies
+// @Router /auth/me [get]
+func (d *Delivery) ReadPrincipal(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	result, ok := requirePrincipal(c, ctx)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonAuth.ToPrincipalResponse(result))
+}
+
+// CreateSession
+// @Summary Открыть сессию браузера.
+// @Description Метод обменивает bearer-токен или ключ доступа на cookie сессии с флагами HttpOnly и SameSite=Lax.
+// @Description Сессия получает субъекта и роли автора запроса и не переживает его учётные данные.
+// @Tags auth
+// @Produce json
+// @Success 201			{object}  	jsonAuth.SessionResponse 	true  "Сессия открыта"
+// @Failure 401 		{object}    ErrorResponse
+// @Failure 404 		{object}    ErrorResponse				"Вход по сессиям выключен"
+// @Security Bearer
+// @Security ApiKey
+// @Router /auth/session [post]
+func (d *Delivery) CreateSession(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	if !d.authMethods[principal.MethodSession] {
+		SetError(c, http.StatusNotFound, errors.New("session authentication is disabled"))
+		return
+	}
+
+	result, ok := requirePrincipal(c, ctx)
+	if !ok {
+		return
+	}
+
+	response, err := d.ucAuth.CreateSession(ctx, *result)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	setSessionCookie(c, response.Token(), int(time.Until(response.ExpiresAt()).Seconds()))
+	c.JSON(http.StatusCreated, jsonAuth.SessionResponse{ExpiresAt: response.ExpiresAt()})
+}
+
+// DeleteSession
+// @Summary Закрыть сессию браузера.
+// @Description Метод закрывает сессию из cookie и удаляет cookie.
+// @Tags auth
+// @Success 200
+// @Failure 401 		{object}    ErrorResponse
+// @Security Cookies
+// @Router /auth/session [delete]
+func (d *Delivery) DeleteSession(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	if value, err := c.Cookie(viper.GetString("AUTH_SESSION_COOKIE")); err == nil && value != "" {
+		if err = d.ucAuth.DeleteSession(ctx, value); err != nil {
+			SetError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	setSessionCookie(c, "", -1)
+	c.Status(http.StatusOK)
+}
+
+// CreateAPIKey
+// @Summary Выпустить ключ доступа.
+// @Description Метод выпускает ключ доступа для автора запроса. Ключ возвращается только в ответе на создание,
+// @Description в сервисе хранится его хеш. Ключ передаётся в заголовке X-API-Key или Authorization: Bearer.
+// @Tags auth
+// @Accept  json
+// @Produce json
+// @Param   apiKey 		body 		jsonAuth.ShortAPIKey 				true  "Данные ключа"
+// @Success 201			{object}  	jsonAuth.CreatedAPIKeyResponse 		true  "Ключ доступа"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse						"Запрошена роль, которой нет у автора запроса"
+// @Security Bearer
+// @Security ApiKey
+// @Security Cookies
+// @Router /apiKeys/ [post]
+func (d *Delivery) CreateAPIKey(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	result, ok := requirePrincipal(c, ctx)
+	if !ok {
+		return
+	}
+
+	var request jsonAuth.ShortAPIKey
+	if err := c.ShouldBindJSON(&request); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var expiresAt time.Time
+	if request.ExpiresAt != nil {
+		expiresAt = *request.ExpiresAt
+	}
+
+	response, err := d.ucAuth.CreateAPIKey(ctx, *result, request.Name, request.Roles, expiresAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, useCase.ErrRoleNotGranted):
+			SetError(c, http.StatusForbidden, err)
+		case errors.Is(err, apiKey.ErrWrongName), errors.Is(err, apiKey.ErrWrongExpires), errors.Is(err, apiKey.ErrWrongSubject):
+			SetError(c, http.StatusBadRequest, err)
+		default:
+			SetError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, jsonAuth.ToCreatedAPIKeyResponse(response))
+}
+
+// RevokeAPIKey
+// @Summary Отозвать ключ доступа.
+// @Description Метод отзывает ключ доступа автора запроса. Отозванный ключ остаётся в списке.
+// @Tags auth
+// @Param   id 			path 		string 			true  "Идентификатор ключа"
+// @Success 200
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse	"404 Not Found"
+// @Security Bearer
+// @Security ApiKey
+// @Security Cookies
+// @Router /apiKeys/{id} [delete]
+func (d *Delivery) RevokeAPIKey(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	result, ok := requirePrincipal(c, ctx)
+	if !ok {
+		return
+	}
+
+	var id jsonAuth.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := d.ucAuth.RevokeAPIKey(ctx, result.Subject, converter.StringToUUID(id.Value)); err != nil {
+		if errors.Is(err, useCase.ErrAPIKeyNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// ListAPIKey
+// @Summary Получить список ключей доступа.
+// @Description Метод позволяет получить ключи доступа автора запроса, включая отозванные. Сами ключи не возвращаются.
+// @Tags auth
+// @Produce json
+// @Param 	limit 		query 		int 					false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 					false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	sort 		query 		string 					false "Сортировка по полю" default(createdAt)
+// @Success 200			{object}  	jsonAuth.ListAPIKey 	true  "Список ключей"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse
+// @Security Bearer
+// @Security ApiKey
+// @Security Cookies
+// @Router /apiKeys/ [get]
+func (d *Delivery) ListAPIKey(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	result, ok := requirePrincipal(c, ctx)
+	if !ok {
+		return
+	}
+
+	params, err := query.ParseQuery(c, query.Options{
+		Sorts: mappingSortsAPIKey,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	keys, err := d.ucAuth.ListAPIKey(ctx, result.Subject, queryParameter.QueryParameter{
+		Sorts: params.Sorts,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucAuth.CountAPIKey(ctx, result.Subject)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var list = jsonAuth.ListAPIKey{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonAuth.APIKeyResponse{},
+	}
+	for _, value := range keys {
+		list.List = append(list.List, jsonAuth.ToAPIKeyResponse(value))
+	}
+
+	c.JSON(http.StatusOK, list)
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"time"
+
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/contact/internal/domain/apiKey"
+)
+
+func ToPrincipalResponse(response *principal.Principal) *PrincipalResponse {
+	var roles = response.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+
+	return &PrincipalResponse{
+		Subject:   response.Subject,
+		Name:      response.Name,
+		Method:    response.Method.String(),
+		Roles:     roles,
+		ExpiresAt: timePointer(response.ExpiresAt),
+	}
+}
+
+func ToAPIKeyResponse(response *apiKey.APIKey) *APIKeyResponse {
+	return &APIKeyResponse{
+		ID:         response.ID().String(),
+		CreatedAt:  response.CreatedAt(),
+		Name:       response.Name(),
+		Prefix:     response.Prefix(),
+		Roles:      response.Roles(),
+		ExpiresAt:  timePointer(response.ExpiresAt()),
+		LastUsedAt: timePointer(response.LastUsedAt()),
+		RevokedAt:  timePointer(response.RevokedAt()),
+	}
+}
+
+func ToCreatedAPIKeyResponse(response *apiKey.APIKey) *CreatedAPIKeyResponse {
+	return &CreatedAPIKeyResponse{
+		APIKeyResponse: *ToAPIKeyResponse(response),
+		Key:            response.Secret(),
+	}
+}
+
+func timePointer(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+	return &value
+}
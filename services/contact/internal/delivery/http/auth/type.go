@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"time"
+)
+
+type ID struct {
+	// Идентификатор ключа
+	Value string `json:"id" uri:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+}
+
+type PrincipalResponse struct {
+	// Идентификатор субъекта
+	Subject string `json:"subject" example:"user-42"`
+	// Отображаемое имя
+	Name string `json:"name,omitempty" example:"Иван Иванов"`
+	// Способ аутентификации
+	Method string `json:"method" enums:"token,apiKey,session" example:"token"`
+	// Роли
+	Roles []string `json:"roles" example:"editor"`
+	// Окончание действия учётных данных, не передаётся для бессрочных
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type SessionResponse struct {
+	// Окончание действия сессии
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type ShortAPIKey struct {
+	// Название ключа, чтобы отличать ключи в списке
+	Name string `json:"name" binding:"required,max=100" maxLength:"100" example:"Импорт из CRM"`
+	// Роли ключа, подмножество ролей автора запроса; пустой список -- все роли автора
+	Roles []string `json:"roles" example:"editor"`
+	// Окончание действия ключа, без значения -- бессрочный
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type APIKeyResponse struct {
+	// Идентификатор ключа
+	ID string `json:"id" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Дата создания ключа
+	CreatedAt time.Time `json:"createdAt"`
+	// Название ключа
+	Name string `json:"name" example:"Импорт из CRM"`
+	// Начало ключа, чтобы узнать его
+	Prefix string `json:"prefix" example:"ck_Zm9vYmF"`
+	// Роли ключа
+	Roles []string `json:"roles" example:"editor"`
+	// Окончание действия ключа
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Последнее использование ключа, с точностью до минуты
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	// Дата отзыва ключа
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// CreatedAPIKeyResponse ключ целиком возвращается только при создании
+type CreatedAPIKeyResponse struct {
+	APIKeyResponse
+	// Ключ доступа: передаётся в заголовке X-API-Key или Authorization: Bearer
+	Key string `json:"key" example:"ck_Zm9vYmFy..."`
+}
+
+type ListAPIKey struct {
+	// Всего
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*APIKeyResponse `json:"list"`
+}
@@ -0,0 +1,129 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	"architecture_go/services/contact/internal/domain/contact/age"
+	"architecture_go/services/contact/internal/domain/contact/birthday"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+const (
+	filterAge     = "age"
+	filterAgeFrom = "ageFrom"
+	filterAgeTo   = "ageTo"
+
+	// defaultBirthdayPeriod период по умолчанию для ближайших дней рождения
+	defaultBirthdayPeriod = 30
+)
+
+var ErrWrongAgeFilter = fmt.Errorf("age filter must be a number from 0 to %d", age.MaxLength)
+
+// checkAgeFilters возраст вычисляется в запросе, поэтому значения проверяются заранее
+func checkAgeFilters(filters filter.Filters) error {
+	for _, f := range filters {
+		if f.Key != filterAge && f.Key != filterAgeFrom && f.Key != filterAgeTo {
+			continue
+		}
+
+		if f.Key != filterAge && len(f.Values) != 1 {
+			return fmt.Errorf("%w: filter %s expects one value", ErrWrongAgeFilter, f.Key)
+		}
+
+		for _, value := range f.Values {
+			number, err := strconv.ParseUint(value, 10, 8)
+			if err != nil || uint8(number) > age.MaxLength {
+				return fmt.Errorf("%w: filter %s", ErrWrongAgeFilter, f.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// ListContactBirthday
+// @Summary Получить ближайшие дни рождения.
+// @Description Метод позволяет получить контакты, у которых день рождения приходится на период, в порядке наступления.
+// @Description Период может переходить через новый год. В невисокосный год дни рождения 29 февраля отмечаются 28 февраля.
+// @Tags contacts
+// @Accept  json
+// @Produce json
+// @Param 	from 		query 		string 					false "Начало периода, по умолчанию сегодня" format(date)
+// @Param 	to 			query 		string 					false "Конец периода включительно, по умолчанию через 30 дней" format(date)
+// @Param 	limit 		query 		int 					false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 					false "Смещение при получении записей" default(0) mininum(0)
+// @Success 200			{object}  	jsonContact.ListBirthday true  "Список контактов"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Router /contacts/birthdays [get]
+func (d *Delivery) ListContactBirthday(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	params, err := query.ParseQuery(c, query.Options{})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var period jsonContact.BirthdayPeriod
+	if err = c.ShouldBindQuery(&period); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var from = time.Now().UTC()
+	if period.From != "" {
+		from, _ = time.Parse(birthday.Layout, period.From)
+	}
+
+	var to = from.AddDate(0, 0, defaultBirthdayPeriod)
+	if period.To != "" {
+		to, _ = time.Parse(birthday.Layout, period.To)
+	}
+
+	contacts, err := d.ucContact.ListBirthday(ctx, from, to, queryParameter.QueryParameter{
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, useCase.ErrWrongPeriod) {
+			SetError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonContact.ListBirthday{
+		From:   from.Format(birthday.Layout),
+		To:     to.Format(birthday.Layout),
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonContact.BirthdayContact{},
+	}
+	for _, value := range contacts {
+		next := value.Birthday().Next(from)
+		result.List = append(result.List, &jsonContact.BirthdayContact{
+			ContactResponse: *jsonContact.ToContactResponse(value),
+			NextBirthday:    next.Format(birthday.Layout),
+			TurnsAge:        uint8(value.Birthday().AgeAt(next)),
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
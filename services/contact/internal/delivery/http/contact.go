@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"go.uber.org/zap"
 
 	"architecture_go/pkg/tools/converter"
@@ -16,22 +17,47 @@ import (
 	"architecture_go/pkg/type/query"
 	"architecture_go/pkg/type/queryParameter"
 	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	jsonVersion "architecture_go/services/contact/internal/delivery/http/version"
 	domainContact "architecture_go/services/contact/internal/domain/contact"
 	"architecture_go/services/contact/internal/domain/contact/age"
+	"architecture_go/services/contact/internal/domain/contact/birthday"
 	"architecture_go/services/contact/internal/domain/contact/name"
 	"architecture_go/services/contact/internal/domain/contact/patronymic"
 	"architecture_go/services/contact/internal/domain/contact/surname"
+	"architecture_go/services/contact/internal/domain/customField"
 	"architecture_go/services/contact/internal/useCase"
 )
 
 var mappingSortsContact = query.SortsOptions{
-	"name":        {},
-	"surname":     {},
-	"patronymic":  {},
-	"phoneNumber": {},
-	"email":       {},
-	"gender":      {},
-	"age":         {},
+	"name":         {},
+	"surname":      {},
+	"patronymic":   {},
+	"phoneNumber":  {},
+	"email":        {},
+	"gender":       {},
+	"age":          {},
+	"organization": {},
+
+	"customFields.*": {},
+}
+
+var mappingFiltersContact = query.FiltersOptions{
+	"customFields.*":     {},
+	filterTagsAny:        {},
+	filterTagsAll:        {},
+	filterAge:            {},
+	filterAgeFrom:        {},
+	filterAgeTo:          {},
+	filterCity:           {},
+	filterCountry:        {},
+	filterOrganization:   {},
+	filterOrganizationID: {},
+}
+
+func isCustomFieldError(err error) bool {
+	return errors.Is(err, customField.ErrUnknownField) ||
+		errors.Is(err, customField.ErrRequiredField) ||
+		errors.Is(err, customField.ErrWrongValue)
 }
 
 // CreateContact
@@ -81,6 +107,24 @@ func (d *Delivery) CreateContact(c *gin.Context) {
 		return
 	}
 
+	contactBirthday, err := birthday.Parse(contact.Birthday)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	contactAddresses, err := jsonContact.ToDomainAddresses(contact.Addresses)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	contactEmployment, err := jsonContact.ToDomainEmployment(contact)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
 	dContact, err := domainContact.New(
 		*phoneNumber.New(contact.PhoneNumber),
 		contact.Email,
@@ -94,9 +138,17 @@ func (d *Delivery) CreateContact(c *gin.Context) {
 		SetError(c, http.StatusBadRequest, err)
 		return
 	}
+	dContact.SetCustomFields(contact.CustomFields)
+	dContact.SetBirthday(contactBirthday)
+	dContact.SetAddresses(contactAddresses...)
+	dContact.SetEmployment(contactEmployment)
 
 	response, err := d.ucContact.Create(ctx, dContact)
 	if err != nil {
+		if isCustomFieldError(err) || errors.Is(err, useCase.ErrOrganizationNotFound) {
+			SetError(c, http.StatusBadRequest, err)
+			return
+		}
 
 		SetError(c, http.StatusInternalServerError, err)
 		return
@@ -109,6 +161,85 @@ func (d *Delivery) CreateContact(c *gin.Context) {
 	}
 }
 
+// CreateContactBatch
+// @Summary Метод позволяет создать пакет контактов.
+// @Description Метод позволяет создать пакет контактов одной транзакцией.
+// @Description В режиме atomic ошибка в любом контакте отклоняет весь пакет, в режиме bestEffort сохраняются только корректные контакты.
+// @Tags contacts
+// @Accept  json
+// @Produce json
+// @Param   contacts 	body 		jsonContact.BatchContact 		    true  "Пакет контактов"
+// @Success 201			{object}  	jsonContact.BatchContactResponse 	true  "Все контакты сохранены"
+// @Success 207			{object}  	jsonContact.BatchContactResponse 	true  "Сохранена часть контактов"
+// @Failure 400 		{object}    jsonContact.BatchContactResponse
+// @Failure 403	 		"Forbidden"
+// @Router /contacts/batch [post]
+func (d *Delivery) CreateContactBatch(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	batch := jsonContact.BatchContact{}
+	if err := c.ShouldBindJSON(&batch); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var mode = useCase.BatchModeAtomic
+	if batch.Mode == useCase.BatchModeBestEffort.String() {
+		mode = useCase.BatchModeBestEffort
+	}
+
+	var items = make([]*useCase.BatchItem, len(batch.List))
+	for i, contact := range batch.List {
+		if err := binding.Validator.ValidateStruct(contact); err != nil {
+			items[i] = &useCase.BatchItem{Err: err}
+			continue
+		}
+
+		dContact, err := jsonContact.ToDomainContact(contact)
+		items[i] = &useCase.BatchItem{Contact: dContact, Err: err}
+	}
+
+	items, err := d.ucContact.CreateBatch(ctx, mode, items...)
+	if err != nil && !errors.Is(err, useCase.ErrBatchRejected) {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonContact.BatchContactResponse{
+		Mode: mode.String(),
+		List: make([]*jsonContact.BatchContactResult, len(items)),
+	}
+	var lang = acceptLanguage(c)
+	for i, item := range items {
+		var value = &jsonContact.BatchContactResult{Index: i}
+		switch {
+		case item.Created:
+			value.Status = "created"
+			value.Contact = jsonContact.ToContactResponse(item.Contact)
+			result.Created++
+		case item.Err != nil:
+			value.Status = "failed"
+			value.Error = useCase.Message(lang, item.Err)
+			code, params := errorCode(lang, item.Err, http.StatusBadRequest)
+			value.Code, value.InvalidParams = code.String(), params
+			result.Failed++
+		default:
+			value.Status = "skipped"
+		}
+		result.List[i] = value
+	}
+
+	switch {
+	case result.Created == 0:
+		c.JSON(http.StatusBadRequest, result)
+	case result.Failed > 0:
+		c.JSON(http.StatusMultiStatus, result)
+	default:
+		c.JSON(http.StatusCreated, result)
+	}
+}
+
 // UpdateContact
 // @Summary Метод позволяет обновить данные контакта.
 // @Description Метод позволяет обновить данные контакта.
@@ -134,7 +265,7 @@ func (d *Delivery) UpdateContact(c *gin.Context) {
 
 	contact := jsonContact.ShortContact{}
 	if err := c.ShouldBindJSON(&contact); err != nil {
-		SetError(c, http.StatusInternalServerError, err)
+		SetError(c, http.StatusBadRequest, err)
 		return
 	}
 
@@ -162,6 +293,24 @@ func (d *Delivery) UpdateContact(c *gin.Context) {
 		return
 	}
 
+	contactBirthday, err := birthday.Parse(contact.Birthday)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	contactAddresses, err := jsonContact.ToDomainAddresses(contact.Addresses)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	contactEmployment, err := jsonContact.ToDomainEmployment(contact)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
 	var dContact, _ = domainContact.NewWithID(
 		converter.StringToUUID(id.Value),
 		time.Now().UTC(),
@@ -174,6 +323,10 @@ func (d *Delivery) UpdateContact(c *gin.Context) {
 		*contactAge,
 		contact.Gender,
 	)
+	dContact.SetCustomFields(contact.CustomFields)
+	dContact.SetBirthday(contactBirthday)
+	dContact.SetAddresses(contactAddresses...)
+	dContact.SetEmployment(contactEmployment)
 
 	response, err := d.ucContact.Update(ctx, *dContact)
 	if err != nil {
@@ -182,6 +335,11 @@ func (d *Delivery) UpdateContact(c *gin.Context) {
 			return
 		}
 
+		if isCustomFieldError(err) || errors.Is(err, useCase.ErrOrganizationNotFound) {
+			SetError(c, http.StatusBadRequest, err)
+			return
+		}
+
 		SetError(c, http.StatusInternalServerError, err)
 		return
 	}
@@ -212,12 +370,53 @@ func (d *Delivery) DeleteContact(c *gin.Context) {
 	}
 
 	if err := d.ucContact.Delete(ctx, converter.StringToUUID(id.Value)); err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
 		SetError(c, http.StatusInternalServerError, err)
 		return
 	}
 	c.Status(http.StatusOK)
 }
 
+// RestoreContact
+// @Summary Метод позволяет восстановить удалённый контакт.
+// @Description Метод позволяет вернуть контакт из архива вместе с его заметками.
+// @Tags contacts
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор контакта"
+// @Success 200			{object}  	jsonContact.ContactResponse true  "Структура контакта"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			  		  "Контакт не найден в архиве"
+// @Router /contacts/{id}/restore [post]
+func (d *Delivery) RestoreContact(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucContact.Restore(ctx, converter.StringToUUID(id.Value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonContact.ToContactResponse(response))
+}
+
 // ListContact
 // @Summary Получить список контактов.
 // @Description Метод позволяет получить список контактов.
@@ -226,7 +425,8 @@ func (d *Delivery) DeleteContact(c *gin.Context) {
 // @Produce json
 // @Param 	limit 		query 		int 					false "Количество записей" default(10) mininum(0) maxinum(100)
 // @Param 	offset 		query 		int 					false "Смещение при получении записей" default(0) mininum(0)
-// @Param 	sort 		query 		string 					false "Сортировка по полю" default(name)
+// @Param 	sort 		query 		string 					false "Сортировка по полю, для дополнительных полей customFields.<key>, по названию организации organization" default(name)
+// @Param 	filter 		query 		object 					false "Фильтр вида filter[customFields.<key>]=значение1,значение2, по тегам filter[tagsAny]=тег1,тег2 -- любой из тегов, filter[tagsAll]=тег1,тег2 -- все теги, по возрасту filter[age]=30,31, filter[ageFrom]=18, filter[ageTo]=65, по адресу filter[city]=Москва, filter[country]=RU,KZ, по организации filter[organization]=название, filter[organizationId]=идентификатор"
 // @Success 200			{object}  	jsonContact.ListContact true  "Список контактов"
 // @Failure 400 		{object}    ErrorResponse
 // @Failure 403	 		"Forbidden"
@@ -236,7 +436,8 @@ func (d *Delivery) ListContact(c *gin.Context) {
 
 	var ctx = context.New(c)
 	params, err := query.ParseQuery(c, query.Options{
-		Sorts: mappingSortsContact,
+		Sorts:   mappingSortsContact,
+		Filters: mappingFiltersContact,
 	})
 
 	if err != nil {
@@ -244,8 +445,29 @@ func (d *Delivery) ListContact(c *gin.Context) {
 		return
 	}
 
+	if err = normalizeTagFilters(params.Filters); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = checkAgeFilters(params.Filters); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = normalizeCountryFilters(params.Filters); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = checkOrganizationFilters(params.Filters); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
 	contacts, err := d.ucContact.List(ctx, queryParameter.QueryParameter{
-		Sorts: params.Sorts,
+		Sorts:   params.Sorts,
+		Filters: params.Filters,
 		Pagination: pagination.Pagination{
 			Limit:  params.Limit,
 			Offset: params.Offset,
@@ -256,7 +478,7 @@ func (d *Delivery) ListContact(c *gin.Context) {
 		return
 	}
 
-	count, err := d.ucContact.Count(ctx)
+	count, err := d.ucContact.Count(ctx, params.Filters)
 	if err != nil {
 		SetError(c, http.StatusInternalServerError, err)
 		return
@@ -281,7 +503,8 @@ func (d *Delivery) ListContact(c *gin.Context) {
 // @Tags contacts
 // @Accept  json
 // @Produce json
-// @Param   id 			path 		string 						true "Идентификатор контакта"
+// @Param   id 			path 		string 						true  "Идентификатор контакта"
+// @Param   asOf 		query 		string 						false "Состояние контакта на момент времени в формате RFC 3339" format(date-time)
 // @Success 200			{object}  	jsonContact.ContactResponse true "Структура контакта"
 // @Failure 400 		{object}    ErrorResponse
 // @Failure 403	 		"Forbidden"
@@ -297,7 +520,21 @@ func (d *Delivery) ReadContactByID(c *gin.Context) {
 		return
 	}
 
-	response, err := d.ucContact.ReadByID(ctx, converter.StringToUUID(id.Value))
+	var asOf jsonVersion.AsOf
+	if err := c.ShouldBindQuery(&asOf); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var (
+		response *domainContact.Contact
+		err      error
+	)
+	if asOf.Value.IsZero() {
+		response, err = d.ucContact.ReadByID(ctx, converter.StringToUUID(id.Value))
+	} else {
+		response, err = d.ucContact.ReadByIDAsOf(ctx, converter.StringToUUID(id.Value), asOf.Value)
+	}
 	if err != nil {
 		if errors.Is(err, useCase.ErrContactNotFound) {
 			SetError(c, http.StatusNotFound, err)
@@ -1,22 +1,158 @@
 package contact
 
 import (
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/phoneNumber"
 	domainContact "architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/contact/address"
+	"architecture_go/services/contact/internal/domain/contact/age"
+	"architecture_go/services/contact/internal/domain/contact/birthday"
+	"architecture_go/services/contact/internal/domain/contact/employment"
+	"architecture_go/services/contact/internal/domain/contact/name"
+	"architecture_go/services/contact/internal/domain/contact/patronymic"
+	"architecture_go/services/contact/internal/domain/contact/photo"
+	"architecture_go/services/contact/internal/domain/contact/surname"
 )
 
 func ToContactResponse(response *domainContact.Contact) *ContactResponse {
+	var tags = make([]string, len(response.Tags()))
+	for i, tag := range response.Tags() {
+		tags[i] = tag.String()
+	}
+
+	var organizationID string
+	if response.Employment().HasOrganization() {
+		organizationID = response.Employment().OrganizationID().String()
+	}
+
 	return &ContactResponse{
 		ID:         response.ID().String(),
 		CreatedAt:  response.CreatedAt(),
 		ModifiedAt: response.ModifiedAt(),
 		ShortContact: ShortContact{
-			PhoneNumber: response.PhoneNumber().String(),
-			Email:       response.Email(),
-			Gender:      response.Gender(),
-			Age:         uint8(response.Age()),
-			Name:        response.Name().String(),
-			Surname:     response.Surname().String(),
-			Patronymic:  response.Patronymic().String(),
+			PhoneNumber:    response.PhoneNumber().String(),
+			Email:          response.Email(),
+			Gender:         response.Gender(),
+			Age:            uint8(response.Age()),
+			Name:           response.Name().String(),
+			Surname:        response.Surname().String(),
+			Patronymic:     response.Patronymic().String(),
+			Birthday:       response.Birthday().String(),
+			OrganizationID: organizationID,
+			JobTitle:       response.Employment().JobTitle(),
+			Addresses:      ToAddresses(response.Addresses()),
+			CustomFields:   response.CustomFields(),
 		},
+		Photo:            ToPhoto(response.Photo()),
+		OrganizationName: response.Employment().OrganizationName(),
+		Tags:             tags,
+		Owner:            response.Owner(),
+	}
+}
+
+func ToDomainContact(contact ShortContact) (*domainContact.Contact, error) {
+	contactAge, err := age.New(contact.Age)
+	if err != nil {
+		return nil, err
+	}
+
+	contactName, err := name.New(contact.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	contactSurname, err := surname.New(contact.Surname)
+	if err != nil {
+		return nil, err
+	}
+
+	contactPatronymic, err := patronymic.New(contact.Patronymic)
+	if err != nil {
+		return nil, err
+	}
+
+	contactBirthday, err := birthday.Parse(contact.Birthday)
+	if err != nil {
+		return nil, err
+	}
+
+	contactAddresses, err := ToDomainAddresses(contact.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	contactEmployment, err := ToDomainEmployment(contact)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := domainContact.New(
+		*phoneNumber.New(contact.PhoneNumber),
+		contact.Email,
+		*contactName,
+		*contactSurname,
+		*contactPatronymic,
+		*contactAge,
+		contact.Gender,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result.SetBirthday(contactBirthday)
+	result.SetAddresses(contactAddresses...)
+	result.SetEmployment(contactEmployment)
+	result.SetCustomFields(contact.CustomFields)
+	return result, nil
+}
+
+func ToDomainEmployment(contact ShortContact) (employment.Employment, error) {
+	var organizationID = uuid.Nil
+	if contact.OrganizationID != "" {
+		organizationID = converter.StringToUUID(contact.OrganizationID)
+	}
+	return employment.New(organizationID, contact.JobTitle)
+}
+
+func ToAddresses(addresses []address.Address) []Address {
+	var result = make([]Address, len(addresses))
+	for i, value := range addresses {
+		result[i] = Address{
+			Label:    value.Label(),
+			Country:  value.Country(),
+			Region:   value.Region(),
+			City:     value.City(),
+			Street:   value.Street(),
+			Postcode: value.Postcode(),
+		}
+	}
+	return result
+}
+
+func ToDomainAddresses(addresses []Address) ([]address.Address, error) {
+	var result = make([]address.Address, len(addresses))
+	for i, value := range addresses {
+		domainAddress, err := address.New(value.Label, value.Country, value.Region, value.City, value.Street, value.Postcode)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = domainAddress
+	}
+	return result, nil
+}
+
+func ToPhoto(value photo.Photo) *Photo {
+	if value.IsEmpty() {
+		return nil
+	}
+
+	return &Photo{
+		ContentType: value.ContentType(),
+		Size:        value.Size(),
+		Width:       value.Width(),
+		Height:      value.Height(),
+		UploadedAt:  value.UploadedAt(),
 	}
 }
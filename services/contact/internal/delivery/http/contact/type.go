@@ -5,6 +5,7 @@ import (
 
 	"architecture_go/pkg/type/email"
 	"architecture_go/pkg/type/gender"
+	"architecture_go/pkg/type/problem"
 )
 
 type ID struct {
@@ -19,6 +20,14 @@ type ContactResponse struct {
 	// Дата последнего изменения контакта
 	ModifiedAt time.Time `json:"modifiedAt"  binding:"required"`
 	ShortContact
+	// Фотография контакта, загружается через /contacts/{id}/photo
+	Photo *Photo `json:"photo,omitempty"`
+	// Название организации
+	OrganizationName string `json:"organizationName,omitempty" example:"ООО Ромашка"`
+	// Теги контакта
+	Tags []string `json:"tags" example:"vip,partner"`
+	// Владелец: субъект или роль с префиксом "role:", пустая строка -- контакт общий
+	Owner string `json:"owner" example:"42"`
 }
 
 type ShortContact struct {
@@ -28,14 +37,52 @@ type ShortContact struct {
 	Email email.Email `json:"email" binding:"omitempty,max=250,email" maxLength:"250" example:"example@gmail.com" format:"email" swaggertype:"string"`
 	// Пол
 	Gender gender.Gender `json:"gender" example:"1" enums:"1,2" swaggertype:"integer"`
-	// Возраст
+	// Возраст. При указанной дате рождения вычисляется по ней, сохраняется только для контактов без даты рождения
 	Age uint8 `json:"age" binding:"min=0,max=200" minimum:"0" maximum:"200" default:"0" example:"42"`
+	// Дата рождения
+	Birthday string `json:"birthday,omitempty" binding:"omitempty,datetime=2006-01-02" format:"date" example:"1981-05-17"`
 	// Имя клиента
 	Name string `json:"name" binding:"max=50" maxLength:"50" example:"Иван"`
 	// Фамилия клиента
 	Surname string `json:"surname" binding:"max=100" maxLength:"100" example:"Иванов"`
 	// Отчество клиента
 	Patronymic string `json:"patronymic" binding:"max=100" maxLength:"100" example:"Иванович"`
+	// Идентификатор организации, в которой работает контакт
+	OrganizationID string `json:"organizationId,omitempty" binding:"omitempty,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Должность
+	JobTitle string `json:"jobTitle,omitempty" binding:"max=250" maxLength:"250" example:"Главный бухгалтер"`
+	// Почтовые адреса
+	Addresses []Address `json:"addresses,omitempty" binding:"omitempty,max=10,dive"`
+	// Дополнительные поля, описанные в реестре /customFields
+	CustomFields map[string]interface{} `json:"customFields,omitempty" swaggertype:"object"`
+}
+
+type Address struct {
+	// Метка адреса
+	Label string `json:"label,omitempty" binding:"max=50" maxLength:"50" example:"home"`
+	// Код страны ISO 3166-1 alpha-2
+	Country string `json:"country" binding:"required,len=2" minLength:"2" maxLength:"2" example:"RU"`
+	// Регион
+	Region string `json:"region,omitempty" binding:"max=100" maxLength:"100" example:"Московская область"`
+	// Город
+	City string `json:"city,omitempty" binding:"max=100" maxLength:"100" example:"Москва"`
+	// Улица, дом, квартира
+	Street string `json:"street,omitempty" binding:"max=250" maxLength:"250" example:"ул. Ленина, д. 1, кв. 2"`
+	// Почтовый индекс, формат проверяется по стране
+	Postcode string `json:"postcode,omitempty" binding:"max=20" maxLength:"20" example:"101000"`
+}
+
+type Photo struct {
+	// Тип изображения
+	ContentType string `json:"contentType" example:"image/jpeg"`
+	// Размер файла в байтах
+	Size int `json:"size" example:"102400"`
+	// Ширина в пикселях
+	Width int `json:"width" example:"800"`
+	// Высота в пикселях
+	Height int `json:"height" example:"600"`
+	// Дата загрузки
+	UploadedAt time.Time `json:"uploadedAt"`
 }
 
 type ListContact struct {
@@ -48,3 +95,69 @@ type ListContact struct {
 
 	List []*ContactResponse `json:"list"`
 }
+
+type BatchContact struct {
+	// Режим обработки пакета: atomic -- всё или ничего, bestEffort -- сохранить корректные записи
+	Mode string `json:"mode" binding:"omitempty,oneof=atomic bestEffort" enums:"atomic,bestEffort" default:"atomic" example:"atomic"`
+	// Список контактов
+	List []ShortContact `json:"list" binding:"required,min=1"`
+}
+
+type BatchContactResult struct {
+	// Порядковый номер контакта в запросе
+	Index int `json:"index" example:"0" minimum:"0"`
+	// Результат обработки: created -- сохранён, failed -- ошибка, skipped -- не сохранён из-за ошибок в пакете
+	Status string `json:"status" enums:"created,failed,skipped" example:"created"`
+	// Сохранённый контакт
+	Contact *ContactResponse `json:"contact,omitempty"`
+	// Текст ошибки
+	Error string `json:"error,omitempty"`
+	// Стабильный код ошибки, как в ответах application/problem+json
+	Code string `json:"code,omitempty" example:"contact_phone_number_required"`
+	// Поля контакта, которые не приняты
+	InvalidParams []problem.InvalidParam `json:"invalid-params,omitempty"`
+}
+
+type BatchContactResponse struct {
+	// Режим обработки пакета
+	Mode string `json:"mode" enums:"atomic,bestEffort" example:"atomic"`
+	// Количество сохранённых контактов
+	Created uint64 `json:"created" example:"10" minimum:"0"`
+	// Количество контактов с ошибкой
+	Failed uint64 `json:"failed" example:"0" minimum:"0"`
+	// Результаты по каждому контакту
+	List []*BatchContactResult `json:"list"`
+}
+
+type ContactTags struct {
+	// Теги, регистр не учитывается
+	Tags []string `json:"tags" binding:"required,min=1,dive,min=1,max=50" example:"vip,partner"`
+}
+
+type BirthdayContact struct {
+	ContactResponse
+	// Ближайший день рождения в периоде
+	NextBirthday string `json:"nextBirthday" format:"date" example:"2023-05-17"`
+	// Исполняется лет
+	TurnsAge uint8 `json:"turnsAge" example:"42"`
+}
+
+type ListBirthday struct {
+	// Начало периода
+	From string `json:"from" format:"date" example:"2023-05-01"`
+	// Конец периода
+	To string `json:"to" format:"date" example:"2023-05-31"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*BirthdayContact `json:"list"`
+}
+
+type BirthdayPeriod struct {
+	// Начало периода, по умолчанию сегодня
+	From string `form:"from" binding:"omitempty,datetime=2006-01-02"`
+	// Конец периода, по умолчанию через 30 дней после начала
+	To string `form:"to" binding:"omitempty,datetime=2006-01-02"`
+}
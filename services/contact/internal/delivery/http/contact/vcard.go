@@ -0,0 +1,66 @@
+package contact
+
+import (
+	"strings"
+
+	"architecture_go/pkg/tools/vcard"
+	domainContact "architecture_go/services/contact/internal/domain/contact"
+)
+
+// ToVCard карточка контакта в формате vCard 4.0
+func ToVCard(contact *domainContact.Contact) vcard.Card {
+	var card vcard.Card
+
+	card.AddRaw("UID", "urn:uuid:"+contact.ID().String())
+
+	fullName := strings.Join(strings.Fields(contact.FullName()), " ")
+	if fullName == "" {
+		fullName = contact.PhoneNumber().String()
+	}
+	card.Add("FN", fullName)
+	card.AddRaw("N", vcard.Structured(
+		contact.Surname().String(),
+		contact.Name().String(),
+		contact.Patronymic().String(),
+		"",
+		"",
+	))
+
+	switch {
+	case contact.Gender().IsMale():
+		card.AddRaw("GENDER", "M")
+	case contact.Gender().IsFemale():
+		card.AddRaw("GENDER", "F")
+	}
+
+	if !contact.Birthday().IsEmpty() {
+		card.AddRaw("BDAY", contact.Birthday().Value().Format("20060102"))
+	}
+
+	card.Add("TEL", contact.PhoneNumber().String(), vcard.Type("cell")...)
+	card.Add("EMAIL", contact.Email().String())
+
+	for _, address := range contact.Addresses() {
+		card.AddRaw("ADR", vcard.Structured(
+			"",
+			"",
+			address.Street(),
+			address.City(),
+			address.Region(),
+			address.Postcode(),
+			address.Country(),
+		), vcard.Type(address.Label())...)
+	}
+
+	if len(contact.Tags()) > 0 {
+		var tags = make([]string, len(contact.Tags()))
+		for i, tag := range contact.Tags() {
+			tags[i] = tag.String()
+		}
+		card.AddRaw("CATEGORIES", vcard.List(tags...))
+	}
+
+	card.AddRaw("REV", contact.ModifiedAt().UTC().Format("20060102T150405Z"))
+
+	return card
+}
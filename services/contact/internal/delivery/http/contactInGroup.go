@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -12,9 +13,11 @@ import (
 	jsonGroup "architecture_go/services/contact/internal/delivery/http/group"
 	domainContact "architecture_go/services/contact/internal/domain/contact"
 	"architecture_go/services/contact/internal/domain/contact/age"
+	"architecture_go/services/contact/internal/domain/contact/birthday"
 	"architecture_go/services/contact/internal/domain/contact/name"
 	"architecture_go/services/contact/internal/domain/contact/patronymic"
 	"architecture_go/services/contact/internal/domain/contact/surname"
+	"architecture_go/services/contact/internal/useCase"
 )
 
 // CreateContactIntoGroup
@@ -71,6 +74,24 @@ func (d *Delivery) CreateContactIntoGroup(c *gin.Context) {
 		return
 	}
 
+	contactBirthday, err := birthday.Parse(contact.Birthday)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	contactAddresses, err := jsonContact.ToDomainAddresses(contact.Addresses)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	contactEmployment, err := jsonContact.ToDomainEmployment(contact)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
 	dContact, err := domainContact.New(
 		*phoneNumber.New(contact.PhoneNumber),
 		contact.Email,
@@ -84,9 +105,18 @@ func (d *Delivery) CreateContactIntoGroup(c *gin.Context) {
 		SetError(c, http.StatusBadRequest, err)
 		return
 	}
+	dContact.SetCustomFields(contact.CustomFields)
+	dContact.SetBirthday(contactBirthday)
+	dContact.SetAddresses(contactAddresses...)
+	dContact.SetEmployment(contactEmployment)
 
 	contacts, err := d.ucGroup.CreateContactIntoGroup(ctx, converter.StringToUUID(id.Value), dContact)
 	if err != nil {
+		if isCustomFieldError(err) || errors.Is(err, useCase.ErrOrganizationNotFound) {
+			SetError(c, http.StatusBadRequest, err)
+			return
+		}
+
 		SetError(c, http.StatusInternalServerError, err)
 		return
 	}
@@ -0,0 +1,239 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonCustomField "architecture_go/services/contact/internal/delivery/http/customField"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+var mappingSortsCustomField = query.SortsOptions{
+	"id":    {},
+	"key":   {},
+	"label": {},
+	"type":  {},
+}
+
+// CreateCustomField
+// @Summary Метод позволяет описать дополнительное поле контакта.
+// @Description Метод позволяет описать дополнительное поле контакта. Значения поля передаются в customFields контакта по ключу key.
+// @Tags customFields
+// @Accept  json
+// @Produce json
+// @Param   field 		body 		jsonCustomField.ShortCustomField 		true  "Описание поля"
+// @Success 201			{object}  	jsonCustomField.CustomFieldResponse 	true  "Описание поля"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 409 	    {object} 	ErrorResponse			"Поле с таким ключом уже существует"
+// @Router /customFields/ [post]
+func (d *Delivery) CreateCustomField(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	field := jsonCustomField.ShortCustomField{}
+	if err := c.ShouldBindJSON(&field); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dField, err := jsonCustomField.ToDomainCustomField(uuid.Nil, field)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucCustomField.Create(ctx, dField)
+	if err != nil {
+		if errors.Is(err, useCase.ErrCustomFieldExists) {
+			SetError(c, http.StatusConflict, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, jsonCustomField.ToCustomFieldResponse(response))
+}
+
+// UpdateCustomField
+// @Summary Метод позволяет обновить описание дополнительного поля.
+// @Description Метод позволяет обновить название, обязательность и допустимые значения поля. Ключ и тип поля не изменяются.
+// @Tags customFields
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 									true  "Идентификатор поля"
+// @Param   field 		body 		jsonCustomField.ShortCustomField 		true  "Описание поля"
+// @Success 200			{object}  	jsonCustomField.CustomFieldResponse 	true  "Описание поля"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /customFields/{id} [put]
+func (d *Delivery) UpdateCustomField(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonCustomField.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	field := jsonCustomField.ShortCustomField{}
+	if err := c.ShouldBindJSON(&field); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dField, err := jsonCustomField.ToDomainCustomField(converter.StringToUUID(id.Value), field)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucCustomField.Update(ctx, dField)
+	if err != nil {
+		if errors.Is(err, useCase.ErrCustomFieldNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonCustomField.ToCustomFieldResponse(response))
+}
+
+// DeleteCustomField
+// @Summary Метод позволяет удалить дополнительное поле.
+// @Description Метод позволяет удалить дополнительное поле. Значения поля удаляются из всех контактов.
+// @Tags customFields
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 			true 	"Идентификатор поля"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /customFields/{id} [delete]
+func (d *Delivery) DeleteCustomField(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonCustomField.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := d.ucCustomField.Delete(ctx, converter.StringToUUID(id.Value)); err != nil {
+		if errors.Is(err, useCase.ErrCustomFieldNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// ListCustomField
+// @Summary Получить список дополнительных полей.
+// @Description Метод позволяет получить реестр дополнительных полей контакта.
+// @Tags customFields
+// @Accept  json
+// @Produce json
+// @Param 	limit 		query 		int 							false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 							false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	sort 		query 		string 							false "Сортировка по полю" default(key)
+// @Success 200			{object}  	jsonCustomField.ListCustomField true  "Список полей"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Router /customFields/ [get]
+func (d *Delivery) ListCustomField(c *gin.Context) {
+
+	var ctx = context.New(c)
+	params, err := query.ParseQuery(c, query.Options{
+		Sorts: mappingSortsCustomField,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	fields, err := d.ucCustomField.List(ctx, queryParameter.QueryParameter{
+		Sorts: params.Sorts,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucCustomField.Count(ctx)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonCustomField.ListCustomField{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonCustomField.CustomFieldResponse{},
+	}
+	for _, value := range fields {
+		result.List = append(result.List, jsonCustomField.ToCustomFieldResponse(value))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReadCustomFieldByID
+// @Summary Получить дополнительное поле.
+// @Description Метод позволяет получить описание дополнительного поля по идентификатору.
+// @Tags customFields
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 								true "Идентификатор поля"
+// @Success 200			{object}  	jsonCustomField.CustomFieldResponse true "Описание поля"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse					  "404 Not Found"
+// @Router /customFields/{id} [get]
+func (d *Delivery) ReadCustomFieldByID(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonCustomField.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucCustomField.ReadByID(ctx, converter.StringToUUID(id.Value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrCustomFieldNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonCustomField.ToCustomFieldResponse(response))
+}
@@ -0,0 +1,51 @@
+package customField
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	domainCustomField "architecture_go/services/contact/internal/domain/customField"
+	"architecture_go/services/contact/internal/domain/customField/fieldType"
+	"architecture_go/services/contact/internal/domain/customField/key"
+	"architecture_go/services/contact/internal/domain/customField/label"
+)
+
+func ToCustomFieldResponse(response *domainCustomField.CustomField) *CustomFieldResponse {
+	return &CustomFieldResponse{
+		ID:         response.ID().String(),
+		CreatedAt:  response.CreatedAt(),
+		ModifiedAt: response.ModifiedAt(),
+		ShortCustomField: ShortCustomField{
+			Key:      response.Key().String(),
+			Label:    response.Label().Value(),
+			Type:     response.Type().String(),
+			Required: response.Required(),
+			Options:  response.Options(),
+		},
+	}
+}
+
+func ToDomainCustomField(id uuid.UUID, field ShortCustomField) (*domainCustomField.CustomField, error) {
+	fieldKey, err := key.New(field.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldLabel, err := label.New(field.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	fType, err := fieldType.Parse(field.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == uuid.Nil {
+		return domainCustomField.New(fieldKey, fieldLabel, fType, field.Required, field.Options)
+	}
+
+	var timeNow = time.Now().UTC()
+	return domainCustomField.NewWithID(id, timeNow, timeNow, fieldKey, fieldLabel, fType, field.Required, field.Options)
+}
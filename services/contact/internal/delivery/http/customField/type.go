@@ -0,0 +1,42 @@
+package customField
+
+import "time"
+
+type ID struct {
+	// Идентификатор дополнительного поля
+	Value string `json:"id" uri:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+}
+
+type CustomFieldResponse struct {
+	// Идентификатор дополнительного поля
+	ID string `json:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Дата создания поля
+	CreatedAt time.Time `json:"createdAt"  binding:"required"`
+	// Дата последнего изменения поля
+	ModifiedAt time.Time `json:"modifiedAt"  binding:"required"`
+	ShortCustomField
+}
+
+type ShortCustomField struct {
+	// Ключ поля, используется в customFields контакта, сортировке и фильтрах. Не изменяется после создания
+	Key string `json:"key" binding:"required,max=50" maxLength:"50" example:"crmId"`
+	// Название поля
+	Label string `json:"label" binding:"max=250" maxLength:"250" example:"Идентификатор в CRM"`
+	// Тип значения. Не изменяется после создания
+	Type string `json:"type" binding:"required,oneof=string number date enum bool" enums:"string,number,date,enum,bool" example:"string"`
+	// Поле обязательно для заполнения
+	Required bool `json:"required" example:"false"`
+	// Допустимые значения для типа enum
+	Options []string `json:"options,omitempty" example:"ru,en"`
+}
+
+type ListCustomField struct {
+	// Всего
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*CustomFieldResponse `json:"list"`
+}
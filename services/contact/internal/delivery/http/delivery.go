@@ -6,12 +6,23 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/spf13/viper"
 
+	"architecture_go/pkg/metrics"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/contact/internal/domain/tenant"
 	"architecture_go/services/contact/internal/useCase"
 )
 
+const (
+	actorAnonymous = "anonymous"
+)
+
 // @title slurm contact service on clean architecture
 // @version 1.0
 // @description contact service on clean architecture
+// @description Ошибки -- application/problem+json (RFC 7807) со стабильным полем code.
+// @description Кроме статусов в описании методов, любой метод может ответить 409 при конфликте с параллельным изменением,
+// @description 412, если запись не в нужном состоянии, 429 при превышении лимита запросов, 503, если хранилище недоступно, и 504 по таймауту хранилища.
+// @description Тексты detail и reason -- на английском или русском по заголовку Accept-Language (по умолчанию en), язык ответа -- в Content-Language.
 // @license.name kolyadkons
 
 // @contact.name API Support
@@ -19,6 +30,20 @@ import (
 
 // @BasePath /
 
+// @securityDefinitions.apikey Bearer
+// @in header
+// @name Authorization
+// @description JWT или ключ доступа в виде "Bearer <token>"
+
+// @securityDefinitions.apikey ApiKey
+// @in header
+// @name X-API-Key
+
+// @securityDefinitions.apikey Cookies
+// @in header
+// @name Cookie
+// @description Cookie сессии, открытой через POST /auth/session
+
 func init() {
 	viper.SetConfigName(".env")
 	viper.SetConfigType("dotenv")
@@ -26,22 +51,62 @@ func init() {
 	viper.AutomaticEnv()
 
 	viper.SetDefault("HTTP_PORT", 80)
+	// адреса или сети прокси через запятую, которым можно верить в X-Forwarded-For; по умолчанию -- никому
+	viper.SetDefault("HTTP_TRUSTED_PROXIES", "")
+
+	viper.SetDefault("AUTH_METHODS", "token,apiKey,session")
+	viper.SetDefault("AUTH_ANONYMOUS", false)
+	viper.SetDefault("AUTH_SESSION_COOKIE", "session")
+	viper.SetDefault("AUTH_SESSION_SECURE", true)
+
+	viper.SetDefault("TENANT_DEFAULT", tenant.Default)
 }
 
 type Delivery struct {
-	ucContact useCase.Contact
-	ucGroup   useCase.Group
-	router    *gin.Engine
+	ucContact      useCase.Contact
+	ucGroup        useCase.Group
+	ucCustomField  useCase.CustomField
+	ucTag          useCase.Tag
+	ucOrganization useCase.Organization
+	ucNote         useCase.Note
+	ucPhoto        useCase.Photo
+	ucAudit        useCase.Audit
+	ucWebhook      useCase.Webhook
+	ucFeed         useCase.Feed
+	ucDelta        useCase.Delta
+	ucAuth         useCase.Auth
+	ucTenant       useCase.Tenant
+	ucShare        useCase.Share
+	ucRateLimit    useCase.RateLimit
+	router         *gin.Engine
+	authMethods    map[principal.Method]bool
 
 	options Options
 }
 
-type Options struct{}
+type Options struct {
+	// Metrics метрики запросов; если заданы, роутер считает запросы
+	Metrics *metrics.HTTP
+}
 
-func New(ucContact useCase.Contact, ucGroup useCase.Group, options Options) *Delivery {
+func New(ucContact useCase.Contact, ucGroup useCase.Group, ucCustomField useCase.CustomField, ucTag useCase.Tag, ucOrganization useCase.Organization, ucNote useCase.Note, ucPhoto useCase.Photo, ucAudit useCase.Audit, ucWebhook useCase.Webhook, ucFeed useCase.Feed, ucDelta useCase.Delta, ucAuth useCase.Auth, ucTenant useCase.Tenant, ucShare useCase.Share, ucRateLimit useCase.RateLimit, options Options) *Delivery {
 	var d = &Delivery{
-		ucContact: ucContact,
-		ucGroup:   ucGroup,
+		ucContact:      ucContact,
+		ucGroup:        ucGroup,
+		ucCustomField:  ucCustomField,
+		ucTag:          ucTag,
+		ucOrganization: ucOrganization,
+		ucNote:         ucNote,
+		ucPhoto:        ucPhoto,
+		ucAudit:        ucAudit,
+		ucWebhook:      ucWebhook,
+		ucFeed:         ucFeed,
+		ucDelta:        ucDelta,
+		ucAuth:         ucAuth,
+		ucTenant:       ucTenant,
+		ucShare:        ucShare,
+		ucRateLimit:    ucRateLimit,
+		authMethods:    authMethods(),
 	}
 
 	d.SetOptions(options)
@@ -59,7 +124,3 @@ func (d *Delivery) SetOptions(options Options) {
 func (d *Delivery) Run() error {
 	return d.router.Run(fmt.Sprintf(":%d", uint16(viper.GetUint("HTTP_PORT"))))
 }
-
-func checkAuth(c *gin.Context) {
-	c.Next()
-}
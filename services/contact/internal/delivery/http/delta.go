@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/type/context"
+	jsonDelta "architecture_go/services/contact/internal/delivery/http/delta"
+	"architecture_go/services/contact/internal/domain/delta"
+)
+
+// Sync
+// @Summary Инкрементальная синхронизация контактов и групп.
+// @Description Метод возвращает контакты и группы, созданные или изменённые после токена, в текущем состоянии,
+// @Description и надгробия записей, удалённых после токена. Без токена возвращаются все действующие записи.
+// @Description Токен из ответа передаётся в следующий запрос. Пока hasMore = true, следующую страницу нужно запросить сразу.
+// @Description Ответ 410 -- по токену продолжить нельзя, например изменился доступ к записям: клиент удаляет
+// @Description локальную копию и повторяет запрос без токена.
+// @Tags sync
+// @Accept  json
+// @Produce json
+// @Param 	since 		query 		string 					false "Токен из предыдущего ответа"
+// @Param 	limit 		query 		int 					false "Размер страницы" default(100) mininum(0) maxinum(1000)
+// @Success 200			{object}  	jsonDelta.SyncResponse 	true  "Изменения после токена"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 410 		{object}    ErrorResponse
+// @Router /sync/ [get]
+func (d *Delivery) Sync(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var params jsonDelta.SyncQuery
+	if err := c.ShouldBindQuery(&params); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	since, err := delta.ParseToken(params.Since)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucDelta.Changes(ctx, since, params.Limit)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonDelta.ToSyncResponse(response))
+}
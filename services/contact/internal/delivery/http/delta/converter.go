@@ -0,0 +1,35 @@
+package delta
+
+import (
+	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	jsonGroup "architecture_go/services/contact/internal/delivery/http/group"
+	"architecture_go/services/contact/internal/domain/delta"
+)
+
+func ToSyncResponse(value *delta.Delta) *SyncResponse {
+	var response = &SyncResponse{
+		Token:      value.Token().String(),
+		HasMore:    value.HasMore(),
+		Contacts:   make([]*jsonContact.ContactResponse, 0, len(value.Contacts())),
+		Groups:     make([]*jsonGroup.GroupResponse, 0, len(value.Groups())),
+		Tombstones: make([]*Tombstone, 0, len(value.Tombstones())),
+	}
+
+	for _, item := range value.Contacts() {
+		response.Contacts = append(response.Contacts, jsonContact.ToContactResponse(item))
+	}
+
+	for _, item := range value.Groups() {
+		response.Groups = append(response.Groups, jsonGroup.ProtoToGroupResponse(item))
+	}
+
+	for _, item := range value.Tombstones() {
+		response.Tombstones = append(response.Tombstones, &Tombstone{
+			Type:       item.Kind().String(),
+			ID:         item.ID().String(),
+			ArchivedAt: item.ArchivedAt(),
+		})
+	}
+
+	return response
+}
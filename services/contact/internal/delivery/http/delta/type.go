@@ -0,0 +1,38 @@
+package delta
+
+import (
+	"time"
+
+	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	jsonGroup "architecture_go/services/contact/internal/delivery/http/group"
+)
+
+type SyncQuery struct {
+	// Токен из предыдущего ответа, без токена -- полная синхронизация
+	Since string `json:"since" form:"since" example:"1024"`
+	// Размер страницы
+	Limit uint64 `json:"limit" form:"limit" binding:"min=0,max=1000" default:"100" minimum:"0" maximum:"1000" example:"100"`
+}
+
+// Tombstone запись, удалённая после токена клиента
+type Tombstone struct {
+	// Вид записи
+	Type string `json:"type" enums:"contact,group" example:"contact"`
+	// Идентификатор записи
+	ID string `json:"id" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Дата удаления
+	ArchivedAt time.Time `json:"archivedAt"`
+}
+
+type SyncResponse struct {
+	// Токен для следующего запроса
+	Token string `json:"token" example:"2048"`
+	// Изменения после токена не поместились в ответ, следующую страницу нужно запросить сразу
+	HasMore bool `json:"hasMore" example:"false"`
+	// Созданные и изменённые контакты в текущем состоянии
+	Contacts []*jsonContact.ContactResponse `json:"contacts"`
+	// Созданные и изменённые группы в текущем состоянии
+	Groups []*jsonGroup.GroupResponse `json:"groups"`
+	// Удалённые контакты и группы
+	Tombstones []*Tombstone `json:"tombstones"`
+}
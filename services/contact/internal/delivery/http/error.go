@@ -1,50 +1,235 @@
 package http
 
 import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 	"github.com/opentracing/opentracing-go"
 	"github.com/uber/jaeger-client-go"
 	"go.uber.org/zap"
 
 	"architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/problem"
+	"architecture_go/services/contact/internal/useCase"
 )
 
+func init() {
+	// в invalid-params поля называются так же, как в запросе, а не как в структуре Go
+	if engine, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		engine.RegisterTagNameFunc(fieldName)
+	}
+}
+
+// ErrorResponse ошибка в формате RFC 7807, Content-Type: application/problem+json
 type ErrorResponse struct {
-	ID     uuid.UUID   `json:"id"`
-	Error  string      `json:"message,omitempty"`
-	Errors []string    `json:"errors,omitempty"`
-	Info   interface{} `json:"info,omitempty"`
+	// URI типа ошибки, однозначно соответствует code
+	Type   string `json:"type" example:"urn:problem:contact_not_found"`
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	// Описание конкретного случая на языке из Accept-Language (en, ru); текст может меняться, сравнивать нужно code
+	Detail   string `json:"detail,omitempty" example:"contact not found"`
+	Instance string `json:"instance,omitempty" example:"/contacts/00000000-0000-0000-0000-000000000000"`
+	// Стабильный код ошибки
+	Code string `json:"code" example:"contact_not_found"`
+	// Поля запроса, которые не приняты
+	InvalidParams []problem.InvalidParam `json:"invalid-params,omitempty"`
+	TraceID       string                 `json:"traceId,omitempty"`
 }
 
+// SetError отвечает ошибкой errs[0]. Ошибка из каталога useCase.Problems получает свой код и статус,
+// для остальных статус выбирает обработчик. Следующие ошибки дополняют invalid-params.
+// Тексты detail и reason -- на языке из Accept-Language.
 func SetError(c *gin.Context, statusCode int, errs ...error) {
+	if len(errs) == 0 {
+		return
+	}
+
+	var (
+		lang     = acceptLanguage(c)
+		response = newErrorResponse(c, lang, statusCode, errs[0])
+	)
+	for _, err := range errs[1:] {
+		response.InvalidParams = append(response.InvalidParams, invalidParams(lang, err)...)
+	}
+
+	c.Header("Content-Type", problem.ContentType)
+	c.Header("Content-Language", lang.String())
+	c.JSON(response.Status, response)
+
+	fields := append(getContextFields(c), zap.String("code", response.Code))
+	if response.Status >= 400 && response.Status < 500 {
+		logger.Warn(errs[len(errs)-1].Error(), fields...)
+	} else if response.Status >= 500 {
+		logger.Error(errs[len(errs)-1], fields...)
+	}
+}
+
+func newErrorResponse(c *gin.Context, lang problem.Language, statusCode int, err error) ErrorResponse {
 	var response = ErrorResponse{
-		ID: uuid.New(),
+		Status:   statusCode,
+		Instance: c.Request.URL.Path,
+		TraceID:  traceID(c),
 	}
 
-	if len(errs) == 0 {
-		return
+	if entry, ok := useCase.Problems.Find(err); ok {
+		response.Status = entry.Kind.HTTPStatus()
 	}
 
-	if len(errs) > 0 {
-		response.Error = errs[0].Error()
+	var code problem.Code
+	code, response.InvalidParams = errorCode(lang, err, response.Status)
+	response.Detail = detail(lang, code, err)
+
+	response.Code = code.String()
+	response.Type = code.Type()
+	response.Title = http.StatusText(response.Status)
+	return response
+}
+
+// errorCode код и поля ошибки; statusCode задаёт код ошибки, которой нет в каталоге.
+// Нужен и там, где ошибка -- часть ответа, например у элемента пакета.
+func errorCode(lang problem.Language, err error, statusCode int) (problem.Code, []problem.InvalidParam) {
+	if entry, ok := useCase.Problems.Find(err); ok {
+		return entry.Code, invalidParams(lang, err)
+	}
+	if params := invalidParams(lang, err); len(params) > 0 {
+		return useCase.CodeValidation, params
+	}
+	return problem.CodeOf(statusCode), nil
+}
 
-		if len(errs) > 1 {
-			for _, err := range errs {
-				response.Errors = append(response.Errors, c.Error(err).Error())
-			}
+// detail текст ошибки: шаблон из каталога по коду с параметрами ошибки.
+// Без шаблона остаётся текст самой ошибки.
+func detail(lang problem.Language, code problem.Code, err error) string {
+	if _, ok := useCase.Problems.Find(err); ok {
+		return useCase.Message(lang, err)
+	}
+	if message, ok := useCase.Messages.Message(lang, code, nil); ok {
+		return message
+	}
+	return err.Error()
+}
+
+// invalidParams поля из ошибки разбора запроса: проверки binding или несовпадения типа в JSON
+func invalidParams(lang problem.Language, err error) []problem.InvalidParam {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		var result = make([]problem.InvalidParam, 0, len(validationErrors))
+		for _, fieldError := range validationErrors {
+			code, params := reason(fieldError)
+			result = append(result, problem.InvalidParam{Name: paramName(fieldError.Namespace()), Reason: message(lang, code, params)})
 		}
+		return result
 	}
-	c.JSON(statusCode, response)
 
-	fields := getContextFields(c)
-	if statusCode >= 400 && statusCode < 500 {
-		logger.Warn(errs[len(errs)-1].Error(), fields...)
-	} else if statusCode >= 500 {
-		logger.Error(errs[len(errs)-1], fields...)
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &typeError) && typeError.Field != "" {
+		return []problem.InvalidParam{{
+			Name:   typeError.Field,
+			Reason: message(lang, useCase.CodeFieldType, problem.Params{"type": typeError.Type.String()}),
+		}}
+	}
+
+	if entry, ok := useCase.Problems.Find(err); ok && entry.Param != "" {
+		return []problem.InvalidParam{{Name: entry.Param, Reason: useCase.Message(lang, err)}}
+	}
+
+	return nil
+}
+
+func message(lang problem.Language, code problem.Code, params problem.Params) string {
+	if text, ok := useCase.Messages.Message(lang, code, params); ok {
+		return text
+	}
+	return code.String()
+}
+
+// paramName путь к полю без имени структуры запроса: list[0].phoneNumber
+func paramName(namespace string) string {
+	if _, name, found := strings.Cut(namespace, "."); found {
+		return name
+	}
+	return namespace
+}
+
+// reason код причины, по которой поле не прошло проверку binding, и параметры для её текста
+func reason(fieldError validator.FieldError) (problem.Code, problem.Params) {
+	var (
+		param = fieldError.Param()
+		kind  = fieldError.Kind()
+	)
+
+	switch fieldError.Tag() {
+	case "required":
+		return useCase.CodeFieldRequired, nil
+	case "min":
+		return bound(kind, useCase.CodeFieldMinLength, useCase.CodeFieldMinItems, useCase.CodeFieldMin), problem.Params{"min": param}
+	case "max":
+		return bound(kind, useCase.CodeFieldMaxLength, useCase.CodeFieldMaxItems, useCase.CodeFieldMax), problem.Params{"max": param}
+	case "len":
+		if kind == reflect.String {
+			return useCase.CodeFieldLength, problem.Params{"len": param}
+		}
+	case "email":
+		return useCase.CodeFieldEmail, nil
+	case "uuid":
+		return useCase.CodeFieldUUID, nil
+	case "url":
+		return useCase.CodeFieldURL, nil
+	case "oneof":
+		return useCase.CodeFieldOneOf, problem.Params{"values": strings.ReplaceAll(param, " ", ", ")}
+	case "datetime":
+		return useCase.CodeFieldDatetime, problem.Params{"layout": param}
+	}
+	return useCase.CodeFieldInvalid, problem.Params{"rule": fieldError.Tag()}
+}
+
+// bound код границы min или max: для строки -- длина, для списка -- число элементов, иначе -- значение
+func bound(kind reflect.Kind, length, items, value problem.Code) problem.Code {
+	switch kind {
+	case reflect.String:
+		return length
+	case reflect.Slice, reflect.Map:
+		return items
+	default:
+		return value
 	}
 }
 
+func acceptLanguage(c *gin.Context) problem.Language {
+	return problem.ParseLanguage(c.GetHeader("Accept-Language"))
+}
+
+// fieldName имя поля в запросе: из тега json, form или uri
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form", "uri"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		switch name {
+		case "-":
+			return ""
+		case "":
+			continue
+		default:
+			return name
+		}
+	}
+	return field.Name
+}
+
+func traceID(c *gin.Context) string {
+	if span := opentracing.SpanFromContext(c.Request.Context()); span != nil {
+		if jaegerSpan, ok := span.Context().(jaeger.SpanContext); ok {
+			return jaegerSpan.TraceID().String()
+		}
+	}
+	return ""
+}
+
 func getContextFields(c *gin.Context) []zap.Field {
 	var fields = []zap.Field{zap.Int("status", c.Writer.Status()),
 		zap.String("method", c.Request.Method),
@@ -54,10 +239,8 @@ func getContextFields(c *gin.Context) []zap.Field {
 		zap.String("user-agent", c.Request.UserAgent()),
 	}
 
-	if span := opentracing.SpanFromContext(c.Request.Context()); span != nil {
-		if jaegerSpan, ok := span.Context().(jaeger.SpanContext); ok {
-			fields = append(fields, zap.Stringer("traceID", jaegerSpan.TraceID()))
-		}
+	if value := traceID(c); value != "" {
+		fields = append(fields, zap.String("traceID", value))
 	}
 
 	return fields
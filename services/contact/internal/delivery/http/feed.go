@@ -0,0 +1,234 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	jsonFeed "architecture_go/services/contact/internal/delivery/http/feed"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/outbox"
+)
+
+const (
+	headerLastEventID = "Last-Event-ID"
+
+	// changesWriteTimeout время на отправку одного сообщения WebSocket
+	changesWriteTimeout = time.Second * 10
+	// changesReadLimit клиент WebSocket ничего не присылает, кроме служебных кадров
+	changesReadLimit = 512
+)
+
+var changesUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// changesFilter события, которые клиент хочет получать; пустой фильтр пропускает все
+type changesFilter map[event.Name]struct{}
+
+func (f changesFilter) pass(message *outbox.Message) bool {
+	if len(f) == 0 {
+		return true
+	}
+	_, ok := f[message.Name()]
+	return ok
+}
+
+// parseChanges начало ленты и фильтр событий. Номер из заголовка Last-Event-ID
+// (его передаёт EventSource при переподключении) важнее параметра запроса.
+// Без номера клиент получает только события, появившиеся после подключения.
+func (d *Delivery) parseChanges(c *gin.Context, ctx context.Context) (int64, changesFilter, error) {
+	var params jsonFeed.ChangesQuery
+	if err := c.ShouldBindQuery(&params); err != nil {
+		return 0, nil, err
+	}
+
+	var filter = changesFilter{}
+	for _, value := range params.Events {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name == "" {
+				continue
+			}
+			if !event.Name(name).IsValid() {
+				return 0, nil, fmt.Errorf("unknown event %q", name)
+			}
+			filter[event.Name(name)] = struct{}{}
+		}
+	}
+
+	// номер последнего события читается всегда: заодно до начала потока проверяется доступ к ленте
+	last, err := d.ucFeed.LastSequence(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var after = last
+	if header := c.GetHeader(headerLastEventID); header != "" {
+		if after, err = strconv.ParseInt(header, 10, 64); err != nil || after < 0 {
+			return 0, nil, fmt.Errorf("header %s must be a non-negative number", headerLastEventID)
+		}
+	} else if params.LastEventID != nil {
+		after = *params.LastEventID
+	}
+
+	// номер клиента проверяется до начала потока: после него статус ответа уже не изменить
+	if after != last {
+		if err = d.ucFeed.Check(ctx, after); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return after, filter, nil
+}
+
+// ContactChanges
+// @Summary Поток изменений контактов и групп (Server-Sent Events).
+// @Description Метод держит соединение открытым и отправляет события создания, изменения и архивации
+// @Description контактов и групп по мере их фиксации. У каждого события есть номер (поле id), по нему
+// @Description клиент продолжает ленту после переподключения: EventSource передаёт его в заголовке Last-Event-ID.
+// @Description Без номера отправляются только события, появившиеся после подключения.
+// @Description В периоды без событий отправляется комментарий-heartbeat.
+// @Tags contacts
+// @Produce text/event-stream
+// @Param   Last-Event-ID	header 		int 		false "Номер последнего полученного события"
+// @Param 	lastEventId 	query 		int 		false "Номер последнего полученного события, если заголовок передать нельзя" mininum(0)
+// @Param 	events 			query 		[]string 	false "Фильтр по событиям" collectionFormat(csv)
+// @Success 200				{object}  	jsonFeed.Change "Поток событий: id -- номер, event -- название, data -- конверт события"
+// @Failure 400 			{object}    ErrorResponse
+// @Failure 403	 			"Forbidden"
+// @Failure 410 			{object}    ErrorResponse "Номер события неизвестен, подключитесь заново без него"
+// @Router /contacts/changes [get]
+func (d *Delivery) ContactChanges(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	after, filter, err := d.parseChanges(c, ctx)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var header = c.Writer.Header()
+	header.Set("Content-Type", sse.ContentType)
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	// nginx не должен буферизовать поток
+	header.Set("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	err = d.ucFeed.Follow(ctx, after, func(messages []*outbox.Message) error {
+		if len(messages) == 0 {
+			if _, err := io.WriteString(c.Writer, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+			c.Writer.Flush()
+			return nil
+		}
+
+		for _, message := range messages {
+			if !filter.pass(message) {
+				continue
+			}
+
+			if err := sse.Encode(c.Writer, sse.Event{
+				Id:    strconv.FormatInt(message.Sequence(), 10),
+				Event: message.Name().String(),
+				Data:  string(message.Payload()),
+			}); err != nil {
+				return err
+			}
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil {
+		// ответ уже начат, статус не изменить: клиент переподключится с Last-Event-ID
+		log.WarnWithContext(ctx, "contact changes stream closed", zap.Error(err))
+	}
+}
+
+// ContactChangesWebSocket
+// @Summary Поток изменений контактов и групп (WebSocket).
+// @Description Метод переключает соединение на WebSocket и отправляет те же события, что и /contacts/changes,
+// @Description JSON-сообщениями jsonFeed.Change. Продолжить ленту можно параметром lastEventId.
+// @Description В периоды без событий отправляется ping.
+// @Tags contacts
+// @Param 	lastEventId 	query 		int 		false "Номер последнего полученного события" mininum(0)
+// @Param 	events 			query 		[]string 	false "Фильтр по событиям" collectionFormat(csv)
+// @Success 101				{object}  	jsonFeed.Change "Переключение на WebSocket, далее сообщения с событиями"
+// @Failure 400 			{object}    ErrorResponse
+// @Failure 403	 			"Forbidden"
+// @Failure 410 			{object}    ErrorResponse "Номер события неизвестен, подключитесь заново без него"
+// @Router /contacts/changes/ws [get]
+func (d *Delivery) ContactChangesWebSocket(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	after, filter, err := d.parseChanges(c, ctx)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// при ошибке Upgrade ответ клиенту уже отправлен
+	conn, err := changesUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.WarnWithContext(ctx, "websocket upgrade failed", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	// поток останавливается, когда клиент закрыл соединение: это видно только при чтении
+	var streamCtx = ctx.CopyWithCancel()
+	defer streamCtx.Cancel()
+	conn.SetReadLimit(changesReadLimit)
+	go func() {
+		defer streamCtx.Cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = d.ucFeed.Follow(streamCtx, after, func(messages []*outbox.Message) error {
+		var deadline = time.Now().Add(changesWriteTimeout)
+
+		if len(messages) == 0 {
+			return conn.WriteControl(websocket.PingMessage, nil, deadline)
+		}
+
+		for _, message := range messages {
+			if !filter.pass(message) {
+				continue
+			}
+
+			if err := conn.SetWriteDeadline(deadline); err != nil {
+				return err
+			}
+			if err := conn.WriteJSON(jsonFeed.ToChange(message)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.WarnWithContext(ctx, "contact changes websocket closed", zap.Error(err))
+		_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, ""), time.Now().Add(changesWriteTimeout))
+		return
+	}
+
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(changesWriteTimeout))
+}
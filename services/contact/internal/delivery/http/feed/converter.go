@@ -0,0 +1,13 @@
+package feed
+
+import (
+	"architecture_go/services/contact/internal/domain/outbox"
+)
+
+func ToChange(message *outbox.Message) *Change {
+	return &Change{
+		ID:    message.Sequence(),
+		Event: message.Name().String(),
+		Data:  message.Payload(),
+	}
+}
@@ -0,0 +1,23 @@
+package feed
+
+import (
+	"encoding/json"
+)
+
+type ChangesQuery struct {
+	// Номер последнего полученного события, лента продолжится со следующего.
+	// Заголовок Last-Event-ID имеет приоритет
+	LastEventID *int64 `json:"lastEventId" form:"lastEventId" binding:"omitempty,min=0" minimum:"0" example:"42"`
+	// Фильтр по событиям, пустой список -- все события
+	Events []string `json:"events" form:"events" example:"contact.created,contact.updated"`
+}
+
+// Change сообщение WebSocket; в SSE те же поля передаются как id, event и data
+type Change struct {
+	// Номер события, курсор для продолжения ленты; события приходят в порядке фиксации, номера в нём не обязательно растут
+	ID int64 `json:"id" example:"42"`
+	// Название события
+	Event string `json:"event" example:"contact.updated"`
+	// Конверт события: id, name, aggregateId, occurredAt, data
+	Data json.RawMessage `json:"data" swaggertype:"object"`
+}
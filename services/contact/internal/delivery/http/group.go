@@ -13,6 +13,7 @@ import (
 	"architecture_go/pkg/type/query"
 	"architecture_go/pkg/type/queryParameter"
 	jsonGroup "architecture_go/services/contact/internal/delivery/http/group"
+	jsonVersion "architecture_go/services/contact/internal/delivery/http/version"
 	domainGroup "architecture_go/services/contact/internal/domain/group"
 	"architecture_go/services/contact/internal/domain/group/description"
 	"architecture_go/services/contact/internal/domain/group/name"
@@ -210,7 +211,7 @@ func (d *Delivery) ListGroup(c *gin.Context) {
 		return
 	}
 
-	count, err := d.ucContact.Count(ctx)
+	count, err := d.ucGroup.Count(ctx)
 	if err != nil {
 		SetError(c, http.StatusInternalServerError, err)
 		return
@@ -237,6 +238,7 @@ func (d *Delivery) ListGroup(c *gin.Context) {
 // @Accept  json
 // @Produce json
 // @Param   id 			path 		string 					true 	"Идентификатор группы контактов"
+// @Param   asOf 		query 		string 					false 	"Состояние группы на момент времени в формате RFC 3339" format(date-time)
 // @Success 200			{object}  	jsonGroup.GroupResponse
 // @Failure 400 		{object}    ErrorResponse
 // @Failure 403	 		"Forbidden"
@@ -252,7 +254,21 @@ func (d *Delivery) ReadGroupByID(c *gin.Context) {
 		return
 	}
 
-	response, err := d.ucGroup.ReadByID(ctx, converter.StringToUUID(id.Value))
+	var asOf jsonVersion.AsOf
+	if err := c.ShouldBindQuery(&asOf); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var (
+		response *domainGroup.Group
+		err      error
+	)
+	if asOf.Value.IsZero() {
+		response, err = d.ucGroup.ReadByID(ctx, converter.StringToUUID(id.Value))
+	} else {
+		response, err = d.ucGroup.ReadByIDAsOf(ctx, converter.StringToUUID(id.Value), asOf.Value)
+	}
 	if err != nil {
 		if errors.Is(err, useCase.ErrGroupNotFound) {
 			SetError(c, http.StatusNotFound, err)
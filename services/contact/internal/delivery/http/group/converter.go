@@ -2,6 +2,7 @@ package group
 
 import (
 	"architecture_go/services/contact/internal/domain/group"
+	"architecture_go/services/contact/internal/domain/group/acl"
 )
 
 func ProtoToGroupResponse(response *group.Group) *GroupResponse {
@@ -9,6 +10,7 @@ func ProtoToGroupResponse(response *group.Group) *GroupResponse {
 		ID:         response.ID().String(),
 		CreatedAt:  response.CreatedAt(),
 		ModifiedAt: response.ModifiedAt(),
+		Owner:      response.Owner(),
 		Group: Group{
 			ShortGroup: ShortGroup{
 				Name:        response.Name().Value(),
@@ -18,3 +20,14 @@ func ProtoToGroupResponse(response *group.Group) *GroupResponse {
 		},
 	}
 }
+
+func ToACLEntryResponse(response *acl.Entry) *ACLEntryResponse {
+	return &ACLEntryResponse{
+		GroupID:   response.GroupID().String(),
+		CreatedAt: response.CreatedAt(),
+		ShortACLEntry: ShortACLEntry{
+			Grantee: response.Grantee(),
+			Level:   response.Level().String(),
+		},
+	}
+}
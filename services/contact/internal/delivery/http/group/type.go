@@ -9,6 +9,8 @@ type GroupResponse struct {
 	CreatedAt time.Time `json:"createdAt"  binding:"required"`
 	// Дата последнего изменения группы
 	ModifiedAt time.Time `json:"modifiedAt"  binding:"required"`
+	// Владелец: субъект или роль с префиксом "role:", пустая строка -- группа общая
+	Owner string `json:"owner" example:"42"`
 	Group
 }
 
@@ -49,3 +51,31 @@ type ContactID struct {
 	// Идентификатор контакта
 	Value string `json:"id" uri:"contactId" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
 }
+
+// ShortACLEntry
+// Запись списка доступа группы.
+type ShortACLEntry struct {
+	// Субъект или роль с префиксом "role:"
+	Grantee string `json:"grantee" binding:"required,max=250" example:"role:sales" maxLength:"250"`
+	// Уровень доступа: editor -- изменение группы и состава, admin -- вдобавок удаление и управление списком
+	Level string `json:"level" binding:"required,oneof=editor admin" example:"editor" enums:"editor,admin"`
+}
+
+type ACLEntryResponse struct {
+	// Идентификатор группы
+	GroupID string `json:"groupId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Дата создания записи
+	CreatedAt time.Time `json:"createdAt"`
+	ShortACLEntry
+}
+
+// ACLList
+// Список доступа группы. Пустой список -- группой управляют по глобальным ролям.
+type ACLList struct {
+	List []*ACLEntryResponse `json:"list" binding:"min=0" minimum:"0"`
+}
+
+type Grantee struct {
+	// Субъект или роль с префиксом "role:"
+	Value string `json:"grantee" uri:"grantee" binding:"required,max=250" example:"role:sales"`
+}
@@ -0,0 +1,147 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	jsonGroup "architecture_go/services/contact/internal/delivery/http/group"
+	"architecture_go/services/contact/internal/domain/group/acl"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// ListGroupACL
+// @Summary Список доступа группы.
+// @Description Метод возвращает, кто может изменять группу. Пока список пуст, группой управляют
+// @Description по глобальным ролям: editor изменяет, admin удаляет. С первой записью изменять группу
+// @Description могут только перечисленные в списке и администраторы сервиса.
+// @Tags groups
+// @Produce json
+// @Param   id 			path 		string 				true  "Идентификатор группы"
+// @Success 200			{object}  	jsonGroup.ACLList 	true
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse		"404 Not Found"
+// @Router /groups/{id}/acl [get]
+func (d *Delivery) ListGroupACL(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonGroup.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	entries, err := d.ucGroup.ListACL(ctx, converter.StringToUUID(id.Value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrGroupNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var list = jsonGroup.ACLList{List: []*jsonGroup.ACLEntryResponse{}}
+	for _, value := range entries {
+		list.List = append(list.List, jsonGroup.ToACLEntryResponse(value))
+	}
+
+	c.JSON(http.StatusOK, list)
+}
+
+// SetGroupACL
+// @Summary Выдать доступ к группе.
+// @Description Метод добавляет запись в список доступа группы или меняет уровень у существующей.
+// @Description Доступно администратору сервиса и тем, у кого в группе уровень admin.
+// @Tags groups
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор группы"
+// @Param   entry 		body 		jsonGroup.ShortACLEntry 	true  "Запись списка доступа"
+// @Success 200			{object}  	jsonGroup.ACLEntryResponse 	true
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /groups/{id}/acl [put]
+func (d *Delivery) SetGroupACL(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonGroup.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var request jsonGroup.ShortACLEntry
+	if err := c.ShouldBindJSON(&request); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	entry, err := acl.New(converter.StringToUUID(id.Value), request.Grantee, acl.Level(request.Level))
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucGroup.SetACL(ctx, entry)
+	if err != nil {
+		if errors.Is(err, useCase.ErrGroupNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonGroup.ToACLEntryResponse(response))
+}
+
+// DeleteGroupACL
+// @Summary Отозвать доступ к группе.
+// @Description Метод удаляет запись из списка доступа группы. Доступно администратору сервиса
+// @Description и тем, у кого в группе уровень admin.
+// @Tags groups
+// @Param   id 			path 		string 			true  "Идентификатор группы"
+// @Param   grantee 	path 		string 			true  "Субъект или роль с префиксом role:"
+// @Success 200
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse	"404 Not Found"
+// @Router /groups/{id}/acl/{grantee} [delete]
+func (d *Delivery) DeleteGroupACL(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonGroup.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var grantee jsonGroup.Grantee
+	if err := c.ShouldBindUri(&grantee); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := d.ucGroup.DeleteACL(ctx, converter.StringToUUID(id.Value), grantee.Value); err != nil {
+		if errors.Is(err, useCase.ErrGroupACLNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,272 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	jsonNote "architecture_go/services/contact/internal/delivery/http/note"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// CreateContactNote
+// @Summary Метод позволяет добавить заметку к контакту.
+// @Description Метод позволяет записать звонок, письмо, встречу или произвольную заметку по контакту.
+// @Tags notes
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор контакта"
+// @Param   note 		body 		jsonNote.CreateNote 	true  "Данные заметки"
+// @Success 201			{object}  	jsonNote.NoteResponse 	true  "Структура заметки"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /contacts/{id}/notes [post]
+func (d *Delivery) CreateContactNote(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	note := jsonNote.CreateNote{}
+	if err := c.ShouldBindJSON(&note); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dNote, err := jsonNote.ToDomainNote(converter.StringToUUID(id.Value), note)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucNote.Create(ctx, dNote)
+	if err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, jsonNote.ToNoteResponse(response))
+}
+
+// UpdateContactNote
+// @Summary Метод позволяет изменить заметку контакта.
+// @Description Метод позволяет изменить тип, время и текст заметки. Автор заметки не изменяется.
+// @Tags notes
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор контакта"
+// @Param   noteId 		path 		string 					true  "Идентификатор заметки"
+// @Param   note 		body 		jsonNote.ShortNote 		true  "Данные заметки"
+// @Success 200			{object}  	jsonNote.NoteResponse 	true  "Структура заметки"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /contacts/{id}/notes/{noteId} [put]
+func (d *Delivery) UpdateContactNote(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var noteID jsonNote.NoteID
+	if err := c.ShouldBindUri(&noteID); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	note := jsonNote.ShortNote{}
+	if err := c.ShouldBindJSON(&note); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dNote, err := jsonNote.ToDomainNoteUpdate(converter.StringToUUID(id.Value), converter.StringToUUID(noteID.Value), note)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucNote.Update(ctx, dNote)
+	if err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) || errors.Is(err, useCase.ErrNoteNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonNote.ToNoteResponse(response))
+}
+
+// DeleteContactNote
+// @Summary Метод позволяет удалить заметку контакта.
+// @Description Метод позволяет удалить заметку контакта.
+// @Tags notes
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 			true 	"Идентификатор контакта"
+// @Param   noteId 		path 		string 			true 	"Идентификатор заметки"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /contacts/{id}/notes/{noteId} [delete]
+func (d *Delivery) DeleteContactNote(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var noteID jsonNote.NoteID
+	if err := c.ShouldBindUri(&noteID); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := d.ucNote.Delete(ctx, converter.StringToUUID(id.Value), converter.StringToUUID(noteID.Value)); err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) || errors.Is(err, useCase.ErrNoteNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// ListContactNote
+// @Summary Получить ленту заметок контакта.
+// @Description Метод позволяет получить заметки и историю взаимодействий с контактом, новые записи первыми.
+// @Tags notes
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 				true  "Идентификатор контакта"
+// @Param 	limit 		query 		int 				false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 				false "Смещение при получении записей" default(0) mininum(0)
+// @Success 200			{object}  	jsonNote.ListNote 	true  "Лента заметок"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse		"404 Not Found"
+// @Router /contacts/{id}/notes [get]
+func (d *Delivery) ListContactNote(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	params, err := query.ParseQuery(c, query.Options{})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var contactID = converter.StringToUUID(id.Value)
+
+	notes, err := d.ucNote.List(ctx, contactID, queryParameter.QueryParameter{
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucNote.Count(ctx, contactID)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonNote.ListNote{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonNote.NoteResponse{},
+	}
+	for _, value := range notes {
+		result.List = append(result.List, jsonNote.ToNoteResponse(value))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReadContactNoteByID
+// @Summary Получить заметку контакта.
+// @Description Метод позволяет получить заметку контакта по идентификатору.
+// @Tags notes
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true "Идентификатор контакта"
+// @Param   noteId 		path 		string 					true "Идентификатор заметки"
+// @Success 200			{object}  	jsonNote.NoteResponse 	true "Структура заметки"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /contacts/{id}/notes/{noteId} [get]
+func (d *Delivery) ReadContactNoteByID(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var noteID jsonNote.NoteID
+	if err := c.ShouldBindUri(&noteID); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucNote.ReadByID(ctx, converter.StringToUUID(id.Value), converter.StringToUUID(noteID.Value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) || errors.Is(err, useCase.ErrNoteNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonNote.ToNoteResponse(response))
+}
@@ -0,0 +1,62 @@
+package note
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	domainNote "architecture_go/services/contact/internal/domain/note"
+	"architecture_go/services/contact/internal/domain/note/author"
+	"architecture_go/services/contact/internal/domain/note/body"
+	"architecture_go/services/contact/internal/domain/note/noteType"
+)
+
+func ToNoteResponse(response *domainNote.Note) *NoteResponse {
+	return &NoteResponse{
+		ID:         response.ID().String(),
+		CreatedAt:  response.CreatedAt(),
+		ModifiedAt: response.ModifiedAt(),
+		ContactID:  response.ContactID().String(),
+		Author:     response.Author().Value(),
+		ShortNote: ShortNote{
+			Type:       response.Type().String(),
+			OccurredAt: response.OccurredAt(),
+			Body:       response.Body().Value(),
+		},
+	}
+}
+
+func ToDomainNote(contactID uuid.UUID, note CreateNote) (*domainNote.Note, error) {
+	noteAuthor, err := author.New(note.Author)
+	if err != nil {
+		return nil, err
+	}
+
+	nType, err := noteType.Parse(note.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	noteBody, err := body.New(note.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return domainNote.New(contactID, noteAuthor, nType, note.OccurredAt, noteBody)
+}
+
+// ToDomainNoteUpdate автор заметки при изменении не передаётся
+func ToDomainNoteUpdate(contactID, ID uuid.UUID, note ShortNote) (*domainNote.Note, error) {
+	nType, err := noteType.Parse(note.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	noteBody, err := body.New(note.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var timeNow = time.Now().UTC()
+	return domainNote.NewWithID(ID, timeNow, timeNow, contactID, author.Author{}, nType, note.OccurredAt, noteBody)
+}
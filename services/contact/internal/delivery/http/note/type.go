@@ -0,0 +1,48 @@
+package note
+
+import "time"
+
+type NoteID struct {
+	// Идентификатор заметки
+	Value string `json:"id" uri:"noteId" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+}
+
+type NoteResponse struct {
+	// Идентификатор заметки
+	ID string `json:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Дата создания заметки
+	CreatedAt time.Time `json:"createdAt"  binding:"required"`
+	// Дата последнего изменения заметки
+	ModifiedAt time.Time `json:"modifiedAt"  binding:"required"`
+	// Идентификатор контакта
+	ContactID string `json:"contactId" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Автор заметки
+	Author string `json:"author" example:"Петров П.П."`
+	ShortNote
+}
+
+type CreateNote struct {
+	// Автор заметки. Не изменяется после создания
+	Author string `json:"author" binding:"required,max=100" maxLength:"100" example:"Петров П.П."`
+	ShortNote
+}
+
+type ShortNote struct {
+	// Тип записи
+	Type string `json:"type" binding:"required,oneof=call email meeting note" enums:"call,email,meeting,note" example:"call"`
+	// Время взаимодействия, по умолчанию время создания заметки
+	OccurredAt time.Time `json:"occurredAt,omitempty" example:"2023-08-06T10:00:00Z"`
+	// Текст заметки
+	Body string `json:"body" binding:"required,max=10000" maxLength:"10000" example:"Обсудили условия продления договора"`
+}
+
+type ListNote struct {
+	// Всего
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*NoteResponse `json:"list"`
+}
@@ -0,0 +1,347 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	jsonOrganization "architecture_go/services/contact/internal/delivery/http/organization"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+const (
+	filterOrganization   = "organization"
+	filterOrganizationID = "organizationId"
+)
+
+var mappingSortsOrganization = query.SortsOptions{
+	"id":           {},
+	"name":         {},
+	"taxId":        {},
+	"createdAt":    {},
+	"contactCount": {},
+}
+
+// checkOrganizationFilters идентификаторы организаций должны быть UUID
+func checkOrganizationFilters(filters filter.Filters) error {
+	for _, f := range filters {
+		if f.Key != filterOrganizationID {
+			continue
+		}
+
+		for _, value := range f.Values {
+			if _, err := uuid.Parse(value); err != nil {
+				return fmt.Errorf("filter %s: wrong organization id %q", filterOrganizationID, value)
+			}
+		}
+	}
+	return nil
+}
+
+// CreateOrganization
+// @Summary Метод позволяет создать организацию.
+// @Description Метод позволяет создать организацию. ИНН должен быть уникальным среди организаций.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Param   organization 	body 		jsonOrganization.ShortOrganization 		true  "Данные организации"
+// @Success 201				{object}  	jsonOrganization.OrganizationResponse 	true  "Структура организации"
+// @Failure 400 			{object}    ErrorResponse
+// @Failure 403	 			"Forbidden"
+// @Failure 409 	    	{object} 	ErrorResponse			"Организация с таким ИНН уже существует"
+// @Router /organizations/ [post]
+func (d *Delivery) CreateOrganization(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	org := jsonOrganization.ShortOrganization{}
+	if err := c.ShouldBindJSON(&org); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dOrganization, err := jsonOrganization.ToDomainOrganization(uuid.Nil, org)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucOrganization.Create(ctx, dOrganization)
+	if err != nil {
+		if errors.Is(err, useCase.ErrOrganizationExists) {
+			SetError(c, http.StatusConflict, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, jsonOrganization.ToOrganizationResponse(response))
+}
+
+// UpdateOrganization
+// @Summary Метод позволяет обновить данные организации.
+// @Description Метод позволяет обновить название, ИНН и сайт организации.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Param   id 				path 		string 									true  "Идентификатор организации"
+// @Param   organization 	body 		jsonOrganization.ShortOrganization 		true  "Данные организации"
+// @Success 200				{object}  	jsonOrganization.OrganizationResponse 	true  "Структура организации"
+// @Failure 400 			{object}    ErrorResponse
+// @Failure 403	 			"Forbidden"
+// @Failure 404 	    	{object} 	ErrorResponse			"404 Not Found"
+// @Failure 409 	    	{object} 	ErrorResponse			"Организация с таким ИНН уже существует"
+// @Router /organizations/{id} [put]
+func (d *Delivery) UpdateOrganization(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonOrganization.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	org := jsonOrganization.ShortOrganization{}
+	if err := c.ShouldBindJSON(&org); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dOrganization, err := jsonOrganization.ToDomainOrganization(converter.StringToUUID(id.Value), org)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucOrganization.Update(ctx, dOrganization)
+	if err != nil {
+		if errors.Is(err, useCase.ErrOrganizationNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		if errors.Is(err, useCase.ErrOrganizationExists) {
+			SetError(c, http.StatusConflict, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonOrganization.ToOrganizationResponse(response))
+}
+
+// DeleteOrganization
+// @Summary Метод позволяет удалить организацию.
+// @Description Метод позволяет удалить организацию. Контакты организации сохраняются без привязки к ней.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 			true 	"Идентификатор организации"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /organizations/{id} [delete]
+func (d *Delivery) DeleteOrganization(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonOrganization.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := d.ucOrganization.Delete(ctx, converter.StringToUUID(id.Value)); err != nil {
+		if errors.Is(err, useCase.ErrOrganizationNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// ListOrganization
+// @Summary Получить список организаций.
+// @Description Метод позволяет получить список организаций с количеством контактов.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Param 	limit 		query 		int 								false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 								false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	sort 		query 		string 								false "Сортировка по полю" default(name)
+// @Success 200			{object}  	jsonOrganization.ListOrganization 	true  "Список организаций"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Router /organizations/ [get]
+func (d *Delivery) ListOrganization(c *gin.Context) {
+
+	var ctx = context.New(c)
+	params, err := query.ParseQuery(c, query.Options{
+		Sorts: mappingSortsOrganization,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	organizations, err := d.ucOrganization.List(ctx, queryParameter.QueryParameter{
+		Sorts: params.Sorts,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucOrganization.Count(ctx)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonOrganization.ListOrganization{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonOrganization.OrganizationResponse{},
+	}
+	for _, value := range organizations {
+		result.List = append(result.List, jsonOrganization.ToOrganizationResponse(value))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReadOrganizationByID
+// @Summary Получить организацию.
+// @Description Метод позволяет получить организацию по идентификатору.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 									true "Идентификатор организации"
+// @Success 200			{object}  	jsonOrganization.OrganizationResponse 	true "Структура организации"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse					  		"404 Not Found"
+// @Router /organizations/{id} [get]
+func (d *Delivery) ReadOrganizationByID(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonOrganization.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucOrganization.ReadByID(ctx, converter.StringToUUID(id.Value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrOrganizationNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonOrganization.ToOrganizationResponse(response))
+}
+
+// ListOrganizationContacts
+// @Summary Получить контакты организации.
+// @Description Метод позволяет получить список контактов, работающих в организации.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор организации"
+// @Param 	limit 		query 		int 					false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 					false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	sort 		query 		string 					false "Сортировка по полю" default(name)
+// @Success 200			{object}  	jsonContact.ListContact true  "Список контактов"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /organizations/{id}/contacts [get]
+func (d *Delivery) ListOrganizationContacts(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonOrganization.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	params, err := query.ParseQuery(c, query.Options{
+		Sorts: mappingSortsContact,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if _, err = d.ucOrganization.ReadByID(ctx, converter.StringToUUID(id.Value)); err != nil {
+		if errors.Is(err, useCase.ErrOrganizationNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var filters = filter.Filters{{Key: filterOrganizationID, Values: []string{id.Value}}}
+
+	contacts, err := d.ucContact.List(ctx, queryParameter.QueryParameter{
+		Sorts:   params.Sorts,
+		Filters: filters,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucContact.Count(ctx, filters)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonContact.ListContact{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonContact.ContactResponse{},
+	}
+	for _, value := range contacts {
+		result.List = append(result.List, jsonContact.ToContactResponse(value))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
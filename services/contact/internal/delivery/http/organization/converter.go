@@ -0,0 +1,50 @@
+package organization
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	domainOrganization "architecture_go/services/contact/internal/domain/organization"
+	"architecture_go/services/contact/internal/domain/organization/name"
+	"architecture_go/services/contact/internal/domain/organization/taxID"
+	"architecture_go/services/contact/internal/domain/organization/website"
+)
+
+func ToOrganizationResponse(response *domainOrganization.Organization) *OrganizationResponse {
+	return &OrganizationResponse{
+		ID:         response.ID().String(),
+		CreatedAt:  response.CreatedAt(),
+		ModifiedAt: response.ModifiedAt(),
+		ShortOrganization: ShortOrganization{
+			Name:    response.Name().Value(),
+			TaxID:   response.TaxID().String(),
+			Website: response.Website().String(),
+		},
+		ContactCount: response.ContactCount(),
+	}
+}
+
+func ToDomainOrganization(id uuid.UUID, org ShortOrganization) (*domainOrganization.Organization, error) {
+	orgName, err := name.New(org.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	orgTaxID, err := taxID.New(org.TaxID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgWebsite, err := website.New(org.Website)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == uuid.Nil {
+		return domainOrganization.New(orgName, orgTaxID, orgWebsite), nil
+	}
+
+	var timeNow = time.Now().UTC()
+	return domainOrganization.NewWithID(id, timeNow, timeNow, orgName, orgTaxID, orgWebsite, 0), nil
+}
@@ -0,0 +1,40 @@
+package organization
+
+import "time"
+
+type ID struct {
+	// Идентификатор организации
+	Value string `json:"id" uri:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+}
+
+type OrganizationResponse struct {
+	// Идентификатор организации
+	ID string `json:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Дата создания организации
+	CreatedAt time.Time `json:"createdAt"  binding:"required"`
+	// Дата последнего изменения организации
+	ModifiedAt time.Time `json:"modifiedAt"  binding:"required"`
+	ShortOrganization
+	// Количество контактов организации
+	ContactCount uint64 `json:"contactCount" example:"10" default:"0" minimum:"0"`
+}
+
+type ShortOrganization struct {
+	// Название организации
+	Name string `json:"name" binding:"required,max=250" maxLength:"250" example:"ООО Ромашка"`
+	// ИНН или иной налоговый идентификатор
+	TaxID string `json:"taxId,omitempty" binding:"max=20" maxLength:"20" example:"7707083893"`
+	// Сайт организации
+	Website string `json:"website,omitempty" binding:"omitempty,max=250,url" maxLength:"250" example:"https://example.com" format:"uri"`
+}
+
+type ListOrganization struct {
+	// Всего
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*OrganizationResponse `json:"list"`
+}
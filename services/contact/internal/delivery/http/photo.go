@@ -0,0 +1,168 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	"architecture_go/services/contact/internal/domain/contact/photo"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// maxPhotoRequestSize ограничение тела запроса, точный лимит размера фотографии проверяет useCase
+const maxPhotoRequestSize = 32 << 20
+
+type PhotoSize struct {
+	// Размер изображения: original -- исходный файл, thumbnail -- миниатюра в JPEG
+	Value string `form:"size" binding:"omitempty,oneof=original thumbnail"`
+}
+
+// UploadContactPhoto
+// @Summary Метод позволяет загрузить фотографию контакта.
+// @Description Метод позволяет загрузить фотографию контакта в формате JPEG, PNG, GIF или WebP. Тип определяется по содержимому файла, миниатюра строится на сервере.
+// @Tags contacts
+// @Accept  multipart/form-data
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор контакта"
+// @Param   photo 		formData 	file 						true  "Файл изображения"
+// @Success 200			{object}  	jsonContact.ContactResponse true  "Структура контакта"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			  		  "404 Not Found"
+// @Failure 413 	    {object} 	ErrorResponse			  		  "Файл слишком большой"
+// @Failure 415 	    {object} 	ErrorResponse			  		  "Неподдерживаемый тип изображения"
+// @Router /contacts/{id}/photo [put]
+func (d *Delivery) UploadContactPhoto(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxPhotoRequestSize)
+
+	header, err := c.FormFile("photo")
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucPhoto.Upload(ctx, converter.StringToUUID(id.Value), data)
+	if err != nil {
+		switch {
+		case errors.Is(err, useCase.ErrContactNotFound):
+			SetError(c, http.StatusNotFound, err)
+		case errors.Is(err, useCase.ErrPhotoTooLarge):
+			SetError(c, http.StatusRequestEntityTooLarge, err)
+		case errors.Is(err, photo.ErrUnsupportedType):
+			SetError(c, http.StatusUnsupportedMediaType, err)
+		case errors.Is(err, photo.ErrWrongDimensions):
+			SetError(c, http.StatusBadRequest, err)
+		default:
+			SetError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonContact.ToContactResponse(response))
+}
+
+// ReadContactPhoto
+// @Summary Получить фотографию контакта.
+// @Description Метод позволяет получить исходную фотографию контакта или её миниатюру.
+// @Tags contacts
+// @Produce image/jpeg,image/png,image/gif,image/webp
+// @Param   id 			path 		string 		true 	"Идентификатор контакта"
+// @Param   size 		query 		string 		false 	"Размер изображения" Enums(original, thumbnail) default(original)
+// @Success 200			{file}  	file 		"Изображение"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse	"404 Not Found"
+// @Router /contacts/{id}/photo [get]
+func (d *Delivery) ReadContactPhoto(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var size PhotoSize
+	if err := c.ShouldBindQuery(&size); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	data, contentType, err := d.ucPhoto.Read(ctx, converter.StringToUUID(id.Value), size.Value == "thumbnail")
+	if err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) || errors.Is(err, useCase.ErrPhotoNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Data(http.StatusOK, contentType, data)
+}
+
+// DeleteContactPhoto
+// @Summary Метод позволяет удалить фотографию контакта.
+// @Description Метод позволяет удалить фотографию контакта вместе с миниатюрой.
+// @Tags contacts
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор контакта"
+// @Success 200			{object}  	jsonContact.ContactResponse true  "Структура контакта"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			  		  "404 Not Found"
+// @Router /contacts/{id}/photo [delete]
+func (d *Delivery) DeleteContactPhoto(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucPhoto.Delete(ctx, converter.StringToUUID(id.Value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) || errors.Is(err, useCase.ErrPhotoNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonContact.ToContactResponse(response))
+}
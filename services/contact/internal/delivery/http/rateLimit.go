@@ -0,0 +1,86 @@
+package http
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// заголовки черновика IETF RateLimit header fields
+const (
+	headerRateLimitLimit     = "RateLimit-Limit"
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	headerRateLimitReset     = "RateLimit-Reset"
+	headerRateLimitPolicy    = "RateLimit-Policy"
+	headerRetryAfter         = "Retry-After"
+)
+
+// rateLimitAddress расходует запрос адреса клиента до аутентификации, чтобы перебор учётных данных
+// упирался в лимит так же, как и обычные запросы
+func (d *Delivery) rateLimitAddress(c *gin.Context) {
+	d.takeRateLimit(c, rateLimit.GroupAddress)
+}
+
+// rateLimit расходует запрос клиента в группе маршрута
+func (d *Delivery) rateLimit(c *gin.Context) {
+	d.takeRateLimit(c, routeGroup(c.FullPath()))
+}
+
+// takeRateLimit отвечает 429, когда ведро пусто. Адрес клиента берётся из X-Forwarded-For
+// только за доверенными прокси HTTP_TRUSTED_PROXIES, иначе -- адрес соединения
+func (d *Delivery) takeRateLimit(c *gin.Context, group rateLimit.Group) {
+	var ctx = context.New(c)
+
+	result, err := d.ucRateLimit.Take(ctx, group, c.ClientIP())
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		c.Abort()
+		return
+	}
+	if result == nil {
+		return
+	}
+
+	setRateLimitHeaders(c.Writer.Header(), result)
+
+	if !result.Allowed {
+		c.Header(headerRetryAfter, strconv.FormatInt(seconds(result.RetryAfter), 10))
+		SetError(c, http.StatusTooManyRequests, useCase.ErrRateLimited)
+		c.Abort()
+		return
+	}
+}
+
+// routeGroup группа лимита по шаблону маршрута; импорт и экспорт тяжелее обычных запросов
+func routeGroup(path string) rateLimit.Group {
+	switch {
+	case path == "/contacts/batch" || path == "/contacts/:id/vcard":
+		return rateLimit.GroupTransfer
+	case strings.HasPrefix(path, "/contacts"):
+		return rateLimit.GroupContacts
+	case strings.HasPrefix(path, "/groups"):
+		return rateLimit.GroupGroups
+	default:
+		return rateLimit.GroupDefault
+	}
+}
+
+func setRateLimitHeaders(header http.Header, result *rateLimit.Result) {
+	header.Set(headerRateLimitLimit, strconv.FormatUint(result.Limit.Burst, 10))
+	header.Set(headerRateLimitRemaining, strconv.FormatUint(result.Remaining, 10))
+	header.Set(headerRateLimitReset, strconv.FormatInt(seconds(result.Reset), 10))
+	header.Set(headerRateLimitPolicy, strconv.FormatUint(result.Limit.Burst, 10)+";w="+strconv.FormatInt(seconds(result.Limit.Period), 10))
+}
+
+// seconds округляет вверх: клиент, выждавший указанное время, не должен снова получить отказ
+func seconds(value time.Duration) int64 {
+	return int64(math.Ceil(value.Seconds()))
+}
@@ -28,29 +28,103 @@ func (d *Delivery) initRouter() *gin.Engine {
 
 	var router = gin.New()
 
+	// без доверенных прокси gin верит X-Forwarded-For от любого клиента, и лимит по адресу обходится подменой заголовка
+	if err := router.SetTrustedProxies(trustedProxies()); err != nil {
+		logger.Error(err)
+		_ = router.SetTrustedProxies(nil)
+	}
+
 	router.Use(Tracer())
 
+	// метрики учитывают и запросы, отклонённые аутентификацией и лимитом; отдаются они на METRICS_PORT
+	if d.options.Metrics != nil {
+		router.Use(d.options.Metrics.Middleware())
+	}
+
 	// Logs all panic to error log
 	//   - stack means whether output the stack info.
 	router.Use(ginzap.RecoveryWithZap(logger.GetLogger(), true))
 
 	d.routerDocs(router.Group("/docs"))
 
-	router.Use(checkAuth)
+	// лимит по адресу считается до аутентификации и ограничивает перебор учётных данных
+	router.Use(d.rateLimitAddress)
+
+	router.Use(d.authenticate)
+
+	// лимит группы считается после аутентификации: у ключа доступа и субъекта свои вёдра, у анонима -- по адресу
+	router.Use(d.rateLimit)
+
+	d.routerAuth(router.Group("/auth"))
+
+	d.routerAPIKeys(router.Group("/apiKeys"))
 
 	d.routerContacts(router.Group("/contacts"))
 
 	d.routerGroups(router.Group("/groups"))
 
+	d.routerCustomFields(router.Group("/customFields"))
+
+	d.routerTags(router.Group("/tags"))
+
+	d.routerOrganizations(router.Group("/organizations"))
+
+	d.routerAudit(router.Group("/audit"))
+
+	d.routerWebhooks(router.Group("/webhooks"))
+
+	d.routerSync(router.Group("/sync"))
+
+	d.routerTenants(router.Group("/tenants"))
+
 	return router
 }
 
+func (d *Delivery) routerAuth(router *gin.RouterGroup) {
+	router.GET("/me", d.ReadPrincipal)
+	router.POST("/session", d.CreateSession)
+	router.DELETE("/session", d.DeleteSession)
+}
+
+func (d *Delivery) routerAPIKeys(router *gin.RouterGroup) {
+	router.POST("/", d.CreateAPIKey)
+	router.GET("/", d.ListAPIKey)
+	router.DELETE("/:id", d.RevokeAPIKey)
+}
+
 func (d *Delivery) routerContacts(router *gin.RouterGroup) {
 	router.POST("/", d.CreateContact)
+	router.POST("/batch", d.CreateContactBatch)
 	router.PUT("/:id", d.UpdateContact)
 	router.DELETE("/:id", d.DeleteContact)
+	router.POST("/:id/restore", d.RestoreContact)
 	router.GET("/", d.ListContact)
+	router.GET("/birthdays", d.ListContactBirthday)
+	router.GET("/changes", d.ContactChanges)
+	router.GET("/changes/ws", d.ContactChangesWebSocket)
 	router.GET("/:id", d.ReadContactByID)
+	router.GET("/:id/vcard", d.ExportContactVCard)
+	router.GET("/:id/history", d.ContactHistory)
+	router.GET("/:id/versions", d.ListContactVersion)
+	router.POST("/:id/revert/:version", d.RevertContact)
+
+	router.PUT("/:id/photo", d.UploadContactPhoto)
+	router.GET("/:id/photo", d.ReadContactPhoto)
+	router.DELETE("/:id/photo", d.DeleteContactPhoto)
+
+	router.POST("/:id/tags", d.AddContactTags)
+	router.DELETE("/:id/tags", d.RemoveContactTags)
+
+	router.POST("/:id/notes", d.CreateContactNote)
+	router.GET("/:id/notes", d.ListContactNote)
+	router.GET("/:id/notes/:noteId", d.ReadContactNoteByID)
+	router.PUT("/:id/notes/:noteId", d.UpdateContactNote)
+	router.DELETE("/:id/notes/:noteId", d.DeleteContactNote)
+
+	router.POST("/:id/shares", d.ShareContact)
+	router.GET("/:id/shares", d.ListContactShare)
+	router.DELETE("/:id/shares/:grantee", d.UnshareContact)
+	router.PUT("/:id/owner", d.TransferContactOwner)
 }
 
 func (d *Delivery) routerGroups(router *gin.RouterGroup) {
@@ -63,9 +137,82 @@ func (d *Delivery) routerGroups(router *gin.RouterGroup) {
 	router.POST("/:id/contacts/", d.CreateContactIntoGroup)
 	router.POST("/:id/contacts/:contactId", d.AddContactToGroup)
 	router.DELETE("/:id/contacts/:contactId", d.DeleteContactFromGroup)
+
+	router.GET("/:id/acl", d.ListGroupACL)
+	router.PUT("/:id/acl", d.SetGroupACL)
+	router.DELETE("/:id/acl/:grantee", d.DeleteGroupACL)
+
+	router.POST("/:id/shares", d.ShareGroup)
+	router.GET("/:id/shares", d.ListGroupShare)
+	router.DELETE("/:id/shares/:grantee", d.UnshareGroup)
+	router.PUT("/:id/owner", d.TransferGroupOwner)
+}
+
+func (d *Delivery) routerCustomFields(router *gin.RouterGroup) {
+	router.POST("/", d.CreateCustomField)
+	router.PUT("/:id", d.UpdateCustomField)
+	router.DELETE("/:id", d.DeleteCustomField)
+	router.GET("/", d.ListCustomField)
+	router.GET("/:id", d.ReadCustomFieldByID)
+}
+
+func (d *Delivery) routerTags(router *gin.RouterGroup) {
+	router.PUT("/:id", d.RenameTag)
+	router.POST("/:id/merge", d.MergeTags)
+	router.GET("/", d.ListTag)
+	router.GET("/:id", d.ReadTagByID)
+}
+
+func (d *Delivery) routerOrganizations(router *gin.RouterGroup) {
+	router.POST("/", d.CreateOrganization)
+	router.PUT("/:id", d.UpdateOrganization)
+	router.DELETE("/:id", d.DeleteOrganization)
+	router.GET("/", d.ListOrganization)
+	router.GET("/:id", d.ReadOrganizationByID)
+	router.GET("/:id/contacts", d.ListOrganizationContacts)
+}
+
+func (d *Delivery) routerAudit(router *gin.RouterGroup) {
+	router.GET("/", d.ListAudit)
+}
+
+func (d *Delivery) routerWebhooks(router *gin.RouterGroup) {
+	router.POST("/", d.CreateWebhook)
+	router.PUT("/:id", d.UpdateWebhook)
+	router.DELETE("/:id", d.DeleteWebhook)
+	router.GET("/", d.ListWebhook)
+	router.GET("/:id", d.ReadWebhookByID)
+	router.GET("/:id/deliveries", d.ListWebhookDelivery)
+	router.POST("/:id/deliveries/:deliveryId/replay", d.ReplayWebhookDelivery)
+}
+
+func (d *Delivery) routerSync(router *gin.RouterGroup) {
+	router.GET("/", d.Sync)
+}
+
+func (d *Delivery) routerTenants(router *gin.RouterGroup) {
+	router.POST("/", d.CreateTenant)
+	router.PUT("/:id", d.UpdateTenant)
+	router.POST("/:id/disable", d.DisableTenant)
+	router.POST("/:id/enable", d.EnableTenant)
+	router.GET("/", d.ListTenant)
+	router.GET("/:id", d.ReadTenantByID)
+	router.GET("/:id/usage", d.ReadTenantUsage)
 }
+
 func (d *Delivery) routerDocs(router *gin.RouterGroup) {
 	docs.SwaggerInfo.BasePath = "/"
 
 	router.Any("/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
+
+// trustedProxies сети из HTTP_TRUSTED_PROXIES через запятую; пустой список -- не доверять никому
+func trustedProxies() []string {
+	var result []string
+	for _, value := range strings.Split(viper.GetString("HTTP_TRUSTED_PROXIES"), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
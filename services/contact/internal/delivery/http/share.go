@@ -0,0 +1,262 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	jsonShare "architecture_go/services/contact/internal/delivery/http/share"
+	"architecture_go/services/contact/internal/domain/share"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// ShareContact
+// @Summary Открыть доступ к контакту.
+// @Description Метод открывает контакт субъекту или команде (роли с префиксом role:) или меняет уровень
+// @Description существующей записи. Доступно владельцу контакта и администратору сервиса.
+// @Tags contacts
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор контакта"
+// @Param   share 		body 		jsonShare.ShortShare 	true  "Запись доступа"
+// @Success 200			{object}  	jsonShare.ShareResponse	true
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /contacts/{id}/shares [post]
+func (d *Delivery) ShareContact(c *gin.Context) {
+	d.share(c, share.ResourceContact)
+}
+
+// ListContactShare
+// @Summary Кому открыт контакт.
+// @Description Метод возвращает записи доступа к контакту. Контакт видят также его владелец
+// @Description и те, кому открыта группа с этим контактом.
+// @Tags contacts
+// @Produce json
+// @Param   id 			path 		string 				true  "Идентификатор контакта"
+// @Success 200			{object}  	jsonShare.ShareList	true
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse		"404 Not Found"
+// @Router /contacts/{id}/shares [get]
+func (d *Delivery) ListContactShare(c *gin.Context) {
+	d.listShare(c, share.ResourceContact)
+}
+
+// UnshareContact
+// @Summary Закрыть доступ к контакту.
+// @Description Метод удаляет запись доступа к контакту. Доступно владельцу контакта и администратору сервиса.
+// @Tags contacts
+// @Param   id 			path 		string 			true  "Идентификатор контакта"
+// @Param   grantee 	path 		string 			true  "Субъект или роль с префиксом role:"
+// @Success 200
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse	"404 Not Found"
+// @Router /contacts/{id}/shares/{grantee} [delete]
+func (d *Delivery) UnshareContact(c *gin.Context) {
+	d.unshare(c, share.ResourceContact)
+}
+
+// TransferContactOwner
+// @Summary Передать контакт.
+// @Description Метод меняет владельца контакта. Доступно владельцу контакта и администратору сервиса,
+// @Description общий контакт без владельца передаёт только администратор.
+// @Tags contacts
+// @Accept  json
+// @Param   id 			path 		string 				true  "Идентификатор контакта"
+// @Param   owner 		body 		jsonShare.Owner 	true  "Новый владелец"
+// @Success 200
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse		"404 Not Found"
+// @Router /contacts/{id}/owner [put]
+func (d *Delivery) TransferContactOwner(c *gin.Context) {
+	d.transferOwner(c, share.ResourceContact)
+}
+
+// ShareGroup
+// @Summary Открыть доступ к группе.
+// @Description Метод открывает группу субъекту или команде или меняет уровень существующей записи.
+// @Description Доступ к группе открывает на чтение и всех её участников. Доступно владельцу группы
+// @Description и администратору сервиса.
+// @Tags groups
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор группы"
+// @Param   share 		body 		jsonShare.ShortShare 	true  "Запись доступа"
+// @Success 200			{object}  	jsonShare.ShareResponse	true
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /groups/{id}/shares [post]
+func (d *Delivery) ShareGroup(c *gin.Context) {
+	d.share(c, share.ResourceGroup)
+}
+
+// ListGroupShare
+// @Summary Кому открыта группа.
+// @Description Метод возвращает записи доступа к группе.
+// @Tags groups
+// @Produce json
+// @Param   id 			path 		string 				true  "Идентификатор группы"
+// @Success 200			{object}  	jsonShare.ShareList	true
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse		"404 Not Found"
+// @Router /groups/{id}/shares [get]
+func (d *Delivery) ListGroupShare(c *gin.Context) {
+	d.listShare(c, share.ResourceGroup)
+}
+
+// UnshareGroup
+// @Summary Закрыть доступ к группе.
+// @Description Метод удаляет запись доступа к группе. Доступно владельцу группы и администратору сервиса.
+// @Tags groups
+// @Param   id 			path 		string 			true  "Идентификатор группы"
+// @Param   grantee 	path 		string 			true  "Субъект или роль с префиксом role:"
+// @Success 200
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse	"404 Not Found"
+// @Router /groups/{id}/shares/{grantee} [delete]
+func (d *Delivery) UnshareGroup(c *gin.Context) {
+	d.unshare(c, share.ResourceGroup)
+}
+
+// TransferGroupOwner
+// @Summary Передать группу.
+// @Description Метод меняет владельца группы. Доступно владельцу группы и администратору сервиса,
+// @Description общую группу без владельца передаёт только администратор.
+// @Tags groups
+// @Accept  json
+// @Param   id 			path 		string 				true  "Идентификатор группы"
+// @Param   owner 		body 		jsonShare.Owner 	true  "Новый владелец"
+// @Success 200
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 404 	    {object} 	ErrorResponse		"404 Not Found"
+// @Router /groups/{id}/owner [put]
+func (d *Delivery) TransferGroupOwner(c *gin.Context) {
+	d.transferOwner(c, share.ResourceGroup)
+}
+
+func (d *Delivery) share(c *gin.Context, resource share.Resource) {
+
+	var ctx = context.New(c)
+
+	var id jsonShare.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var request jsonShare.ShortShare
+	if err := c.ShouldBindJSON(&request); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	value, err := share.New(resource, converter.StringToUUID(id.Value), request.Grantee, share.Level(request.Level))
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucShare.Share(ctx, value)
+	if err != nil {
+		setShareError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonShare.ToShareResponse(response))
+}
+
+func (d *Delivery) listShare(c *gin.Context, resource share.Resource) {
+
+	var ctx = context.New(c)
+
+	var id jsonShare.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	shares, err := d.ucShare.List(ctx, resource, converter.StringToUUID(id.Value))
+	if err != nil {
+		setShareError(c, err)
+		return
+	}
+
+	var list = jsonShare.ShareList{List: []*jsonShare.ShareResponse{}}
+	for _, value := range shares {
+		list.List = append(list.List, jsonShare.ToShareResponse(value))
+	}
+
+	c.JSON(http.StatusOK, list)
+}
+
+func (d *Delivery) unshare(c *gin.Context, resource share.Resource) {
+
+	var ctx = context.New(c)
+
+	var id jsonShare.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var grantee jsonShare.Grantee
+	if err := c.ShouldBindUri(&grantee); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := d.ucShare.Unshare(ctx, resource, converter.StringToUUID(id.Value), grantee.Value); err != nil {
+		setShareError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (d *Delivery) transferOwner(c *gin.Context, resource share.Resource) {
+
+	var ctx = context.New(c)
+
+	var id jsonShare.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var request jsonShare.Owner
+	if err := c.ShouldBindJSON(&request); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := d.ucShare.TransferOwner(ctx, resource, converter.StringToUUID(id.Value), request.Owner); err != nil {
+		setShareError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func setShareError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, useCase.ErrContactNotFound),
+		errors.Is(err, useCase.ErrGroupNotFound),
+		errors.Is(err, useCase.ErrShareNotFound):
+		SetError(c, http.StatusNotFound, err)
+	case errors.Is(err, share.ErrWrongOwner):
+		SetError(c, http.StatusBadRequest, err)
+	default:
+		SetError(c, http.StatusInternalServerError, err)
+	}
+}
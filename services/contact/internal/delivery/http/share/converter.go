@@ -0,0 +1,17 @@
+package share
+
+import (
+	"architecture_go/services/contact/internal/domain/share"
+)
+
+func ToShareResponse(response *share.Share) *ShareResponse {
+	return &ShareResponse{
+		Resource:   response.Resource().String(),
+		ResourceID: response.ResourceID().String(),
+		CreatedAt:  response.CreatedAt(),
+		ShortShare: ShortShare{
+			Grantee: response.Grantee(),
+			Level:   response.Level().String(),
+		},
+	}
+}
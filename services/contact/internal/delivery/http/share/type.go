@@ -0,0 +1,45 @@
+package share
+
+import "time"
+
+type ID struct {
+	// Идентификатор контакта или группы
+	Value string `json:"id" uri:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+}
+
+// ShortShare
+// Запись доступа к контакту или группе.
+type ShortShare struct {
+	// Субъект или команда -- роль с префиксом "role:"
+	Grantee string `json:"grantee" binding:"required,max=250" example:"role:sales" maxLength:"250"`
+	// Уровень доступа: read -- чтение, write -- вдобавок изменение. Доступ к группе открывает её участников только на чтение
+	Level string `json:"level" binding:"required,oneof=read write" example:"read" enums:"read,write"`
+}
+
+type ShareResponse struct {
+	// Вид ресурса
+	Resource string `json:"resource" example:"contact" enums:"contact,group"`
+	// Идентификатор контакта или группы
+	ResourceID string `json:"resourceId" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Дата создания записи
+	CreatedAt time.Time `json:"createdAt"`
+	ShortShare
+}
+
+// ShareList
+// Кому открыт контакт или группа, кроме владельца.
+type ShareList struct {
+	List []*ShareResponse `json:"list" binding:"min=0" minimum:"0"`
+}
+
+type Grantee struct {
+	// Субъект или роль с префиксом "role:"
+	Value string `json:"grantee" uri:"grantee" binding:"required,max=250" example:"role:sales"`
+}
+
+// Owner
+// Новый владелец контакта или группы.
+type Owner struct {
+	// Субъект или команда -- роль с префиксом "role:"
+	Owner string `json:"owner" binding:"required,max=250" example:"role:sales" maxLength:"250"`
+}
@@ -22,19 +22,27 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/contacts/": {
+        "/apiKeys/": {
             "get": {
-                "description": "Метод позволяет получить список контактов.",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "Bearer": []
+                    },
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "Cookies": []
+                    }
                 ],
+                "description": "Метод позволяет получить ключи доступа автора запроса, включая отозванные. Сами ключи не возвращаются.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "contacts"
+                    "auth"
                 ],
-                "summary": "Получить список контактов.",
+                "summary": "Получить список ключей доступа.",
                 "parameters": [
                     {
                         "type": "integer",
@@ -52,7 +60,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "default": "name",
+                        "default": "createdAt",
                         "description": "Сортировка по полю",
                         "name": "sort",
                         "in": "query"
@@ -60,9 +68,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Список контактов",
+                        "description": "Список ключей",
                         "schema": {
-                            "$ref": "#/definitions/contact.ListContact"
+                            "$ref": "#/definitions/auth.ListAPIKey"
                         }
                     },
                     "400": {
@@ -71,11 +79,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "404 Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/http.ErrorResponse"
                         }
@@ -83,7 +88,18 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Метод позволяет создать контакт.",
+                "security": [
+                    {
+                        "Bearer": []
+                    },
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "Cookies": []
+                    }
+                ],
+                "description": "Метод выпускает ключ доступа для автора запроса. Ключ возвращается только в ответе на создание,\nв сервисе хранится его хеш. Ключ передаётся в заголовке X-API-Key или Authorization: Bearer.",
                 "consumes": [
                     "application/json"
                 ],
@@ -91,28 +107,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "contacts"
+                    "auth"
                 ],
-                "summary": "Метод позволяет создать контакт.",
+                "summary": "Выпустить ключ доступа.",
                 "parameters": [
                     {
-                        "description": "Данные по контакту",
-                        "name": "contact",
+                        "description": "Данные ключа",
+                        "name": "apiKey",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/contact.ShortContact"
+                            "$ref": "#/definitions/auth.ShortAPIKey"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": ""
-                    },
                     "201": {
-                        "description": "Структура контакта",
+                        "description": "Ключ доступа",
                         "schema": {
-                            "$ref": "#/definitions/contact.ContactResponse"
+                            "$ref": "#/definitions/auth.CreatedAPIKeyResponse"
                         }
                     },
                     "400": {
@@ -121,11 +134,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
                     },
-                    "404": {
-                        "description": "404 Not Found",
+                    "403": {
+                        "description": "Запрошена роль, которой нет у автора запроса",
                         "schema": {
                             "$ref": "#/definitions/http.ErrorResponse"
                         }
@@ -133,23 +149,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/contacts/{id}": {
-            "get": {
-                "description": "Метод позволяет получить контакт по мдентификатору контакта.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+        "/apiKeys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    },
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "Cookies": []
+                    }
                 ],
+                "description": "Метод отзывает ключ доступа автора запроса. Отозванный ключ остаётся в списке.",
                 "tags": [
-                    "contacts"
+                    "auth"
                 ],
-                "summary": "Получить контакт.",
+                "summary": "Отозвать ключ доступа.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Идентификатор контакта",
+                        "description": "Идентификатор ключа",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -157,10 +178,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Структура контакта",
-                        "schema": {
-                            "$ref": "#/definitions/contact.ContactResponse"
-                        }
+                        "description": ""
                     },
                     "400": {
                         "description": "Bad Request",
@@ -168,8 +186,11 @@ const docTemplate = `{
                             "$ref": "#/definitions/http.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
                     },
                     "404": {
                         "description": "404 Not Found",
@@ -178,9 +199,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Метод позволяет обновить данные контакта.",
+            }
+        },
+        "/audit/": {
+            "get": {
+                "description": "Метод позволяет получить журнал изменений контактов, групп и тегов. Новые записи первыми.",
                 "consumes": [
                     "application/json"
                 ],
@@ -188,32 +211,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "contacts"
+                    "audit"
                 ],
-                "summary": "Метод позволяет обновить данные контакта.",
+                "summary": "Получить журнал изменений.",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Идентификатор контакта",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
-                        "description": "Данные по контакту",
-                        "name": "contact",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/contact.ShortContact"
-                        }
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "object",
+                        "description": "Фильтр вида filter[entity]=contact,group,tag, filter[entityId]=идентификатор, filter[actor]=автор, filter[action]=create,update,delete,restore,addToGroup,removeFromGroup,merge, filter[requestId]=идентификатор запроса, filter[traceId]=идентификатор трассировки, по времени filter[createdFrom]=2023-08-08T00:00:00Z, filter[createdTo]=2023-08-09T00:00:00Z",
+                        "name": "filter",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Структура контакта",
+                        "description": "Журнал изменений",
                         "schema": {
-                            "$ref": "#/definitions/contact.ContactResponse"
+                            "$ref": "#/definitions/audit.ListEntry"
                         }
                     },
                     "400": {
@@ -224,48 +251,103 @@ const docTemplate = `{
                     },
                     "403": {
                         "description": "Forbidden"
+                    }
+                }
+            }
+        },
+        "/auth/me": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
                     },
-                    "404": {
-                        "description": "404 Not Found",
+                    {
+                        "ApiKey": []
+                    },
+                    {
+                        "Cookies": []
+                    }
+                ],
+                "description": "Метод возвращает субъекта и роли, с которыми выполняются запросы.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Автор запроса.",
+                "responses": {
+                    "200": {
+                        "description": "Автор запроса",
+                        "schema": {
+                            "$ref": "#/definitions/auth.PrincipalResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/http.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Метод позволяет удалить контакт.",
-                "consumes": [
-                    "application/json"
+            }
+        },
+        "/auth/session": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    },
+                    {
+                        "ApiKey": []
+                    }
                 ],
+                "description": "Метод обменивает bearer-токен или ключ доступа на cookie сессии с флагами HttpOnly и SameSite=Lax.\nСессия получает субъекта и роли автора запроса и не переживает его учётные данные.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "contacts"
-                ],
-                "summary": "Метод позволяет удалить контакт.",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Идентификатор контакта",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "auth"
                 ],
+                "summary": "Открыть сессию браузера.",
                 "responses": {
-                    "400": {
-                        "description": "Bad Request",
+                    "201": {
+                        "description": "Сессия открыта",
                         "schema": {
-                            "$ref": "#/definitions/http.ErrorResponse"
+                            "$ref": "#/definitions/auth.SessionResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
                     },
                     "404": {
-                        "description": "404 Not Found",
+                        "description": "Вход по сессиям выключен",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Cookies": []
+                    }
+                ],
+                "description": "Метод закрывает сессию из cookie и удаляет cookie.",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Закрыть сессию браузера.",
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/http.ErrorResponse"
                         }
@@ -273,9 +355,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/": {
+        "/contacts/": {
             "get": {
-                "description": "Метод позволяет получить список групп.",
+                "description": "Метод позволяет получить список контактов.",
                 "consumes": [
                     "application/json"
                 ],
@@ -283,9 +365,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "contacts"
                 ],
-                "summary": "Метод позволяет получить список групп.",
+                "summary": "Получить список контактов.",
                 "parameters": [
                     {
                         "type": "integer",
@@ -304,16 +386,22 @@ const docTemplate = `{
                     {
                         "type": "string",
                         "default": "name",
-                        "description": "Сортировка по полю",
+                        "description": "Сортировка по полю, для дополнительных полей customFields.\u003ckey\u003e, по названию организации organization",
                         "name": "sort",
                         "in": "query"
+                    },
+                    {
+                        "type": "object",
+                        "description": "Фильтр вида filter[customFields.\u003ckey\u003e]=значение1,значение2, по тегам filter[tagsAny]=тег1,тег2 -- любой из тегов, filter[tagsAll]=тег1,тег2 -- все теги, по возрасту filter[age]=30,31, filter[ageFrom]=18, filter[ageTo]=65, по адресу filter[city]=Москва, filter[country]=RU,KZ, по организации filter[organization]=название, filter[organizationId]=идентификатор",
+                        "name": "filter",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Список контактов",
                         "schema": {
-                            "$ref": "#/definitions/group.GroupList"
+                            "$ref": "#/definitions/contact.ListContact"
                         }
                     },
                     "400": {
@@ -334,7 +422,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Метод позволяет создать группу контактов.",
+                "description": "Метод позволяет создать контакт.",
                 "consumes": [
                     "application/json"
                 ],
@@ -342,25 +430,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "contacts"
                 ],
-                "summary": "Метод позволяет создать группу контактов.",
+                "summary": "Метод позволяет создать контакт.",
                 "parameters": [
                     {
-                        "description": "Данные по группе",
-                        "name": "group",
+                        "description": "Данные по контакту",
+                        "name": "contact",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/group.ShortGroup"
+                            "$ref": "#/definitions/contact.ShortContact"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": ""
+                    },
+                    "201": {
+                        "description": "Структура контакта",
                         "schema": {
-                            "$ref": "#/definitions/group.GroupResponse"
+                            "$ref": "#/definitions/contact.ContactResponse"
                         }
                     },
                     "400": {
@@ -381,9 +472,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/groups/{id}": {
-            "get": {
-                "description": "Метод позволяет получить данные по группе.",
+        "/contacts/batch": {
+            "post": {
+                "description": "Метод позволяет создать пакет контактов одной транзакцией.\nВ режиме atomic ошибка в любом контакте отклоняет весь пакет, в режиме bestEffort сохраняются только корректные контакты.",
                 "consumes": [
                     "application/json"
                 ],
@@ -391,44 +482,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "contacts"
                 ],
-                "summary": "Метод позволяет получить данные по группе.",
+                "summary": "Метод позволяет создать пакет контактов.",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Идентификатор группы контактов",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Пакет контактов",
+                        "name": "contacts",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contact.BatchContact"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Все контакты сохранены",
                         "schema": {
-                            "$ref": "#/definitions/group.GroupResponse"
+                            "$ref": "#/definitions/contact.BatchContactResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Сохранена часть контактов",
+                        "schema": {
+                            "$ref": "#/definitions/contact.BatchContactResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/http.ErrorResponse"
+                            "$ref": "#/definitions/contact.BatchContactResponse"
                         }
                     },
                     "403": {
                         "description": "Forbidden"
-                    },
-                    "404": {
-                        "description": "404 Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/http.ErrorResponse"
-                        }
                     }
                 }
-            },
-            "put": {
-                "description": "Метод позволяет обновить данные группы.",
+            }
+        },
+        "/contacts/birthdays": {
+            "get": {
+                "description": "Метод позволяет получить контакты, у которых день рождения приходится на период, в порядке наступления.\nПериод может переходить через новый год. В невисокосный год дни рождения 29 февраля отмечаются 28 февраля.",
                 "consumes": [
                     "application/json"
                 ],
@@ -436,32 +531,97 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "contacts"
                 ],
-                "summary": "Метод позволяет обновить данные группы.",
+                "summary": "Получить ближайшие дни рождения.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Идентификатор группы",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "format": "date",
+                        "description": "Начало периода, по умолчанию сегодня",
+                        "name": "from",
+                        "in": "query"
                     },
                     {
-                        "description": "Данные по группе",
-                        "name": "group",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "format": "date",
+                        "description": "Конец периода включительно, по умолчанию через 30 дней",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список контактов",
                         "schema": {
-                            "$ref": "#/definitions/group.ShortGroup"
+                            "$ref": "#/definitions/contact.ListBirthday"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    }
+                }
+            }
+        },
+        "/contacts/changes": {
+            "get": {
+                "description": "Метод держит соединение открытым и отправляет события создания, изменения и архивации\nконтактов и групп по мере их фиксации. У каждого события есть номер (поле id), по нему\nклиент продолжает ленту после переподключения: EventSource передаёт его в заголовке Last-Event-ID.\nБез номера отправляются только события, появившиеся после подключения.\nВ периоды без событий отправляется комментарий-heartbeat.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Поток изменений контактов и групп (Server-Sent Events).",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Номер последнего полученного события",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер последнего полученного события, если заголовок передать нельзя",
+                        "name": "lastEventId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Фильтр по событиям",
+                        "name": "events",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Поток событий: id -- номер, event -- название, data -- конверт события",
                         "schema": {
-                            "$ref": "#/definitions/group.GroupResponse"
+                            "$ref": "#/definitions/feed.Change"
                         }
                     },
                     "400": {
@@ -473,16 +633,68 @@ const docTemplate = `{
                     "403": {
                         "description": "Forbidden"
                     },
-                    "404": {
-                        "description": "404 Not Found",
+                    "410": {
+                        "description": "Номер события неизвестен, подключитесь заново без него",
                         "schema": {
                             "$ref": "#/definitions/http.ErrorResponse"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Метод позволяет удалить группу.",
+            }
+        },
+        "/contacts/changes/ws": {
+            "get": {
+                "description": "Метод переключает соединение на WebSocket и отправляет те же события, что и /contacts/changes,\nJSON-сообщениями jsonFeed.Change. Продолжить ленту можно параметром lastEventId.\nВ периоды без событий отправляется ping.",
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Поток изменений контактов и групп (WebSocket).",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Номер последнего полученного события",
+                        "name": "lastEventId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Фильтр по событиям",
+                        "name": "events",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Переключение на WebSocket, далее сообщения с событиями",
+                        "schema": {
+                            "$ref": "#/definitions/feed.Change"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "410": {
+                        "description": "Номер события неизвестен, подключитесь заново без него",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}": {
+            "get": {
+                "description": "Метод позволяет получить контакт по мдентификатору контакта.",
                 "consumes": [
                     "application/json"
                 ],
@@ -490,23 +702,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "contacts"
                 ],
-                "summary": "Метод позволяет удалить группу.",
+                "summary": "Получить контакт.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Идентификатор группы",
+                        "description": "Идентификатор контакта",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Состояние контакта на момент времени в формате RFC 3339",
+                        "name": "asOf",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Структура контакта",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/contact.ContactResponse"
                         }
                     },
                     "400": {
@@ -525,16 +744,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/groups/{id}/contacts/": {
-            "post": {
-                "security": [
-                    {
-                        "Cookies": []
-                    }
-                ],
-                "description": "Создание контакта и добавление его в существующую группу.",
+            },
+            "put": {
+                "description": "Метод позволяет обновить данные контакта.",
                 "consumes": [
                     "application/json"
                 ],
@@ -542,13 +754,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "contacts"
                 ],
-                "summary": "Создание контакта и добавление его в существующую группу.",
+                "summary": "Метод позволяет обновить данные контакта.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Идентификатор группы контактов",
+                        "description": "Идентификатор контакта",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -565,7 +777,10 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": ""
+                        "description": "Структура контакта",
+                        "schema": {
+                            "$ref": "#/definitions/contact.ContactResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -583,11 +798,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/groups/{id}/contacts/{contactId}": {
-            "post": {
-                "description": "Метод позволяет добавить контакты в группу.",
+            },
+            "delete": {
+                "description": "Метод позволяет удалить контакт.",
                 "consumes": [
                     "application/json"
                 ],
@@ -595,29 +808,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "contacts"
                 ],
-                "summary": "Метод позволяет добавить контакты в группу.",
+                "summary": "Метод позволяет удалить контакт.",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Идентификатор группы",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "Идентификатор контакта",
-                        "name": "contactId",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": ""
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -634,9 +837,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Метод позволяет удалить контакт из группы.",
+            }
+        },
+        "/contacts/{id}/history": {
+            "get": {
+                "description": "Метод позволяет получить историю изменений контакта, включая добавление в группы и исключение из групп. История доступна и для удалённых контактов.",
                 "consumes": [
                     "application/json"
                 ],
@@ -644,28 +849,99 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "contacts"
                 ],
-                "summary": "Метод позволяет удалить контакт из группы.",
+                "summary": "Получить историю изменений контакта.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Идентификатор группы",
+                        "description": "Идентификатор контакта",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "object",
+                        "description": "Фильтр вида filter[actor]=автор, filter[action]=update,addToGroup, filter[requestId]=идентификатор запроса, filter[traceId]=идентификатор трассировки, по времени filter[createdFrom]=2023-08-08T00:00:00Z, filter[createdTo]=2023-08-09T00:00:00Z",
+                        "name": "filter",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "История изменений",
+                        "schema": {
+                            "$ref": "#/definitions/audit.ListEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/notes": {
+            "get": {
+                "description": "Метод позволяет получить заметки и историю взаимодействий с контактом, новые записи первыми.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "Получить ленту заметок контакта.",
+                "parameters": [
                     {
                         "type": "string",
                         "description": "Идентификатор контакта",
-                        "name": "contactId",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": ""
+                        "description": "Лента заметок",
+                        "schema": {
+                            "$ref": "#/definitions/note.ListNote"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -683,84 +959,5220 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
+            },
+            "post": {
+                "description": "Метод позволяет записать звонок, письмо, встречу или произвольную заметку по контакту.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "Метод позволяет добавить заметку к контакту.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные заметки",
+                        "name": "note",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/note.CreateNote"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Структура заметки",
+                        "schema": {
+                            "$ref": "#/definitions/note.NoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/notes/{noteId}": {
+            "get": {
+                "description": "Метод позволяет получить заметку контакта по идентификатору.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "Получить заметку контакта.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заметки",
+                        "name": "noteId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Структура заметки",
+                        "schema": {
+                            "$ref": "#/definitions/note.NoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Метод позволяет изменить тип, время и текст заметки. Автор заметки не изменяется.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "Метод позволяет изменить заметку контакта.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заметки",
+                        "name": "noteId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные заметки",
+                        "name": "note",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/note.ShortNote"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Структура заметки",
+                        "schema": {
+                            "$ref": "#/definitions/note.NoteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Метод позволяет удалить заметку контакта.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "Метод позволяет удалить заметку контакта.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заметки",
+                        "name": "noteId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/owner": {
+            "put": {
+                "description": "Метод меняет владельца контакта. Доступно владельцу контакта и администратору сервиса,\nобщий контакт без владельца передаёт только администратор.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Передать контакт.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новый владелец",
+                        "name": "owner",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/share.Owner"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/photo": {
+            "get": {
+                "description": "Метод позволяет получить исходную фотографию контакта или её миниатюру.",
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif",
+                    "image/webp"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Получить фотографию контакта.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "original",
+                            "thumbnail"
+                        ],
+                        "type": "string",
+                        "default": "original",
+                        "description": "Размер изображения",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Изображение",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Метод позволяет загрузить фотографию контакта в формате JPEG, PNG, GIF или WebP. Тип определяется по содержимому файла, миниатюра строится на сервере.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Метод позволяет загрузить фотографию контакта.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Файл изображения",
+                        "name": "photo",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Структура контакта",
+                        "schema": {
+                            "$ref": "#/definitions/contact.ContactResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Файл слишком большой",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Неподдерживаемый тип изображения",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Метод позволяет удалить фотографию контакта вместе с миниатюрой.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Метод позволяет удалить фотографию контакта.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Структура контакта",
+                        "schema": {
+                            "$ref": "#/definitions/contact.ContactResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/restore": {
+            "post": {
+                "description": "Метод позволяет вернуть контакт из архива вместе с его заметками.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Метод позволяет восстановить удалённый контакт.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Структура контакта",
+                        "schema": {
+                            "$ref": "#/definitions/contact.ContactResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Контакт не найден в архиве",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/revert/{version}": {
+            "post": {
+                "description": "Метод позволяет вернуть поля контакта к сохранённой версии. Возврат сохраняется как новая версия, теги и фотография не изменяются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Метод позволяет вернуть контакт к версии.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер версии",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Структура контакта",
+                        "schema": {
+                            "$ref": "#/definitions/contact.ContactResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Контакт или версия не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/shares": {
+            "get": {
+                "description": "Метод возвращает записи доступа к контакту. Контакт видят также его владелец\nи те, кому открыта группа с этим контактом.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Кому открыт контакт.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/share.ShareList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Метод открывает контакт субъекту или команде (роли с префиксом role:) или меняет уровень\nсуществующей записи. Доступно владельцу контакта и администратору сервиса.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Открыть доступ к контакту.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запись доступа",
+                        "name": "share",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/share.ShortShare"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/share.ShareResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/shares/{grantee}": {
+            "delete": {
+                "description": "Метод удаляет запись доступа к контакту. Доступно владельцу контакта и администратору сервиса.",
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Закрыть доступ к контакту.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Субъект или роль с префиксом role:",
+                        "name": "grantee",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/tags": {
+            "post": {
+                "description": "Метод позволяет добавить теги контакту. Несуществующие теги создаются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Метод позволяет добавить теги контакту.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Теги",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contact.ContactTags"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Структура контакта",
+                        "schema": {
+                            "$ref": "#/definitions/contact.ContactResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Метод позволяет удалить теги контакта. Теги остаются в справочнике.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Метод позволяет удалить теги контакта.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Теги",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contact.ContactTags"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Структура контакта",
+                        "schema": {
+                            "$ref": "#/definitions/contact.ContactResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/vcard": {
+            "get": {
+                "description": "Метод позволяет получить карточку контакта в формате vCard 4.0. Миниатюра фотографии встраивается в поле PHOTO.",
+                "produces": [
+                    "text/vcard"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Выгрузить контакт в формате vCard.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Карточка vCard",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/contacts/{id}/versions": {
+            "get": {
+                "description": "Метод позволяет получить список сохранённых версий контакта, новые первыми. Версия сохраняется после каждого изменения контакта.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contacts"
+                ],
+                "summary": "Получить версии контакта.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Версии контакта",
+                        "schema": {
+                            "$ref": "#/definitions/version.ListVersion"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    }
+                }
+            }
+        },
+        "/customFields/": {
+            "get": {
+                "description": "Метод позволяет получить реестр дополнительных полей контакта.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customFields"
+                ],
+                "summary": "Получить список дополнительных полей.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "key",
+                        "description": "Сортировка по полю",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список полей",
+                        "schema": {
+                            "$ref": "#/definitions/customField.ListCustomField"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    }
+                }
+            },
+            "post": {
+                "description": "Метод позволяет описать дополнительное поле контакта. Значения поля передаются в customFields контакта по ключу key.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customFields"
+                ],
+                "summary": "Метод позволяет описать дополнительное поле контакта.",
+                "parameters": [
+                    {
+                        "description": "Описание поля",
+                        "name": "field",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/customField.ShortCustomField"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Описание поля",
+                        "schema": {
+                            "$ref": "#/definitions/customField.CustomFieldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "409": {
+                        "description": "Поле с таким ключом уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/customFields/{id}": {
+            "get": {
+                "description": "Метод позволяет получить описание дополнительного поля по идентификатору.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customFields"
+                ],
+                "summary": "Получить дополнительное поле.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор поля",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Описание поля",
+                        "schema": {
+                            "$ref": "#/definitions/customField.CustomFieldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Метод позволяет обновить название, обязательность и допустимые значения поля. Ключ и тип поля не изменяются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customFields"
+                ],
+                "summary": "Метод позволяет обновить описание дополнительного поля.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор поля",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Описание поля",
+                        "name": "field",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/customField.ShortCustomField"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Описание поля",
+                        "schema": {
+                            "$ref": "#/definitions/customField.CustomFieldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Метод позволяет удалить дополнительное поле. Значения поля удаляются из всех контактов.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "customFields"
+                ],
+                "summary": "Метод позволяет удалить дополнительное поле.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор поля",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/": {
+            "get": {
+                "description": "Метод позволяет получить список групп.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Метод позволяет получить список групп.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "name",
+                        "description": "Сортировка по полю",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/group.GroupList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Метод позволяет создать группу контактов.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Метод позволяет создать группу контактов.",
+                "parameters": [
+                    {
+                        "description": "Данные по группе",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/group.ShortGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/group.GroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}": {
+            "get": {
+                "description": "Метод позволяет получить данные по группе.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Метод позволяет получить данные по группе.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы контактов",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "description": "Состояние группы на момент времени в формате RFC 3339",
+                        "name": "asOf",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/group.GroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Метод позволяет обновить данные группы.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Метод позволяет обновить данные группы.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные по группе",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/group.ShortGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/group.GroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Метод позволяет удалить группу.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Метод позволяет удалить группу.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/acl": {
+            "get": {
+                "description": "Метод возвращает, кто может изменять группу. Пока список пуст, группой управляют\nпо глобальным ролям: editor изменяет, admin удаляет. С первой записью изменять группу\nмогут только перечисленные в списке и администраторы сервиса.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Список доступа группы.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/group.ACLList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Метод добавляет запись в список доступа группы или меняет уровень у существующей.\nДоступно администратору сервиса и тем, у кого в группе уровень admin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Выдать доступ к группе.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запись списка доступа",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/group.ShortACLEntry"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/group.ACLEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/acl/{grantee}": {
+            "delete": {
+                "description": "Метод удаляет запись из списка доступа группы. Доступно администратору сервиса\nи тем, у кого в группе уровень admin.",
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Отозвать доступ к группе.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Субъект или роль с префиксом role:",
+                        "name": "grantee",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/contacts/": {
+            "post": {
+                "security": [
+                    {
+                        "Cookies": []
+                    }
+                ],
+                "description": "Создание контакта и добавление его в существующую группу.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Создание контакта и добавление его в существующую группу.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы контактов",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные по контакту",
+                        "name": "contact",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/contact.ShortContact"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/contacts/{contactId}": {
+            "post": {
+                "description": "Метод позволяет добавить контакты в группу.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Метод позволяет добавить контакты в группу.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Метод позволяет удалить контакт из группы.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Метод позволяет удалить контакт из группы.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор контакта",
+                        "name": "contactId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/owner": {
+            "put": {
+                "description": "Метод меняет владельца группы. Доступно владельцу группы и администратору сервиса,\nобщую группу без владельца передаёт только администратор.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Передать группу.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новый владелец",
+                        "name": "owner",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/share.Owner"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/shares": {
+            "get": {
+                "description": "Метод возвращает записи доступа к группе.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Кому открыта группа.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/share.ShareList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Метод открывает группу субъекту или команде или меняет уровень существующей записи.\nДоступ к группе открывает на чтение и всех её участников. Доступно владельцу группы\nи администратору сервиса.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Открыть доступ к группе.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Запись доступа",
+                        "name": "share",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/share.ShortShare"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/share.ShareResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/groups/{id}/shares/{grantee}": {
+            "delete": {
+                "description": "Метод удаляет запись доступа к группе. Доступно владельцу группы и администратору сервиса.",
+                "tags": [
+                    "groups"
+                ],
+                "summary": "Закрыть доступ к группе.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор группы",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Субъект или роль с префиксом role:",
+                        "name": "grantee",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": ""
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/": {
+            "get": {
+                "description": "Метод позволяет получить список организаций с количеством контактов.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Получить список организаций.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "name",
+                        "description": "Сортировка по полю",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список организаций",
+                        "schema": {
+                            "$ref": "#/definitions/organization.ListOrganization"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    }
+                }
+            },
+            "post": {
+                "description": "Метод позволяет создать организацию. ИНН должен быть уникальным среди организаций.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Метод позволяет создать организацию.",
+                "parameters": [
+                    {
+                        "description": "Данные организации",
+                        "name": "organization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/organization.ShortOrganization"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Структура организации",
+                        "schema": {
+                            "$ref": "#/definitions/organization.OrganizationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "409": {
+                        "description": "Организация с таким ИНН уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/{id}": {
+            "get": {
+                "description": "Метод позволяет получить организацию по идентификатору.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Получить организацию.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор организации",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Структура организации",
+                        "schema": {
+                            "$ref": "#/definitions/organization.OrganizationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Метод позволяет обновить название, ИНН и сайт организации.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Метод позволяет обновить данные организации.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор организации",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные организации",
+                        "name": "organization",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/organization.ShortOrganization"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Структура организации",
+                        "schema": {
+                            "$ref": "#/definitions/organization.OrganizationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Организация с таким ИНН уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Метод позволяет удалить организацию. Контакты организации сохраняются без привязки к ней.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Метод позволяет удалить организацию.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор организации",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/organizations/{id}/contacts": {
+            "get": {
+                "description": "Метод позволяет получить список контактов, работающих в организации.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organizations"
+                ],
+                "summary": "Получить контакты организации.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор организации",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "name",
+                        "description": "Сортировка по полю",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список контактов",
+                        "schema": {
+                            "$ref": "#/definitions/contact.ListContact"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sync/": {
+            "get": {
+                "description": "Метод возвращает контакты и группы, созданные или изменённые после токена, в текущем состоянии,\nи надгробия записей, удалённых после токена. Без токена возвращаются все действующие записи.\nТокен из ответа передаётся в следующий запрос. Пока hasMore = true, следующую страницу нужно запросить сразу.\nОтвет 410 -- по токену продолжить нельзя, например изменился доступ к записям: клиент удаляет\nлокальную копию и повторяет запрос без токена.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sync"
+                ],
+                "summary": "Инкрементальная синхронизация контактов и групп.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Токен из предыдущего ответа",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Размер страницы",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Изменения после токена",
+                        "schema": {
+                            "$ref": "#/definitions/delta.SyncResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/": {
+            "get": {
+                "description": "Метод позволяет получить список тегов с количеством контактов. По умолчанию сначала самые используемые.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Получить список тегов.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "-contactCount",
+                        "description": "Сортировка по полю",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список тегов",
+                        "schema": {
+                            "$ref": "#/definitions/tag.ListTag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    }
+                }
+            }
+        },
+        "/tags/{id}": {
+            "get": {
+                "description": "Метод позволяет получить тег с количеством контактов.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Получить тег.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор тега",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Тег",
+                        "schema": {
+                            "$ref": "#/definitions/tag.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Метод позволяет переименовать тег. Если тег с новым названием уже есть, теги нужно объединить методом merge.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Метод позволяет переименовать тег.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор тега",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новое название",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tag.RenameTag"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Тег",
+                        "schema": {
+                            "$ref": "#/definitions/tag.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Тег с таким названием уже существует",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}/merge": {
+            "post": {
+                "description": "Метод переносит контакты с перечисленных тегов на тег id, перечисленные теги удаляются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Метод позволяет объединить теги.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор тега, в который объединяются теги",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Объединяемые теги",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tag.MergeTag"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Тег",
+                        "schema": {
+                            "$ref": "#/definitions/tag.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/": {
+            "get": {
+                "description": "Метод позволяет получить список арендаторов, включая отключённых.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Получить список арендаторов.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "id",
+                        "description": "Сортировка по полю",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список арендаторов",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.ListTenant"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    }
+                }
+            },
+            "post": {
+                "description": "Метод позволяет создать арендатора с квотами. Доступен только оператору сервиса.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Метод позволяет создать арендатора.",
+                "parameters": [
+                    {
+                        "description": "Данные арендатора",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tenant.CreateTenant"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Арендатор",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.TenantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "409": {
+                        "description": "Арендатор с таким идентификатором уже есть",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/{id}": {
+            "get": {
+                "description": "Метод позволяет получить арендатора по идентификатору.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Получить арендатора.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор арендатора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Арендатор",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.TenantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Метод позволяет изменить название и квоты арендатора. Уменьшение квоты не удаляет данные, но запрещает создание.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Метод позволяет изменить арендатора.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор арендатора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные арендатора",
+                        "name": "tenant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tenant.ShortTenant"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Арендатор",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.TenantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/{id}/disable": {
+            "post": {
+                "description": "Метод закрывает доступ к данным арендатора, данные сохраняются. На других экземплярах сервиса отключение вступает в силу с задержкой кэша.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Метод позволяет отключить арендатора.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор арендатора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Арендатор",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.TenantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/{id}/enable": {
+            "post": {
+                "description": "Метод возвращает доступ к данным отключённого арендатора.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Метод позволяет включить арендатора.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор арендатора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Арендатор",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.TenantResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tenants/{id}/usage": {
+            "get": {
+                "description": "Метод возвращает количество неархивных контактов и групп арендатора вместе с его квотами.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tenants"
+                ],
+                "summary": "Получить потребление арендатора.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор арендатора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Потребление",
+                        "schema": {
+                            "$ref": "#/definitions/tenant.UsageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/": {
+            "get": {
+                "description": "Метод позволяет получить список подписок на события.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Получить список подписок.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "createdAt",
+                        "description": "Сортировка по полю",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список подписок",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ListWebhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    }
+                }
+            },
+            "post": {
+                "description": "Метод позволяет зарегистрировать адрес, на который POST-запросом отправляются события.\nТело запроса подписывается HMAC-SHA256, подпись передаётся в заголовке X-Webhook-Signature.\nКлюч подписи возвращается только в ответе на создание.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Метод позволяет подписаться на события контактов и групп.",
+                "parameters": [
+                    {
+                        "description": "Данные подписки",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ShortWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Подписка с ключом подписи",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.CreatedWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "description": "Метод позволяет получить подписку по идентификатору. Ключ подписи не возвращается.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Получить подписку.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор подписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Подписка",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Метод позволяет изменить адрес, фильтр событий, ключ подписи и включить или выключить подписку.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Метод позволяет изменить подписку.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор подписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные подписки",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ShortWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Подписка",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Метод позволяет удалить подписку. Журнал доставок сохраняется, неотправленные доставки больше не отправляются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Метод позволяет удалить подписку.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор подписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "description": "Метод позволяет получить доставки событий подписчику с результатом последней попытки. Новые доставки первыми.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Получить журнал доставок подписки.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор подписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Количество записей",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Смещение при получении записей",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "object",
+                        "description": "Фильтр вида filter[status]=pending,delivered,dead, filter[event]=contact.created",
+                        "name": "filter",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Журнал доставок",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.ListDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries/{deliveryId}/replay": {
+            "post": {
+                "description": "Метод позволяет поставить доставку в очередь заново со сброшенным счётчиком попыток,\nв том числе доставку из dead-letter или уже доставленную.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Метод позволяет повторить доставку.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор подписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор доставки",
+                        "name": "deliveryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Доставка",
+                        "schema": {
+                            "$ref": "#/definitions/webhook.DeliveryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "404 Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/http.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
     },
     "definitions": {
+        "audit.Change": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "description": "Значение после изменения",
+                    "type": "string",
+                    "example": "Пётр"
+                },
+                "before": {
+                    "description": "Значение до изменения",
+                    "type": "string",
+                    "example": "Иван"
+                },
+                "field": {
+                    "description": "Название поля",
+                    "type": "string",
+                    "example": "name"
+                }
+            }
+        },
+        "audit.EntryResponse": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "entityId",
+                "id"
+            ],
+            "properties": {
+                "action": {
+                    "description": "Вид изменения",
+                    "type": "string",
+                    "enum": [
+                        "create",
+                        "update",
+                        "delete",
+                        "restore",
+                        "addToGroup",
+                        "removeFromGroup",
+                        "merge"
+                    ],
+                    "example": "update"
+                },
+                "actor": {
+                    "description": "Автор изменения, system -- изменение выполнено не по запросу пользователя",
+                    "type": "string",
+                    "example": "anonymous"
+                },
+                "changes": {
+                    "description": "Изменённые поля",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/audit.Change"
+                    }
+                },
+                "createdAt": {
+                    "description": "Время изменения",
+                    "type": "string"
+                },
+                "entity": {
+                    "description": "Тип сущности",
+                    "type": "string",
+                    "enum": [
+                        "contact",
+                        "group",
+                        "tag"
+                    ],
+                    "example": "contact"
+                },
+                "entityId": {
+                    "description": "Идентификатор сущности",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "id": {
+                    "description": "Идентификатор записи журнала",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "requestId": {
+                    "description": "Идентификатор запроса",
+                    "type": "string",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "traceId": {
+                    "description": "Идентификатор трассировки",
+                    "type": "string",
+                    "example": "3a5e2c1b9f0d4e7a"
+                }
+            }
+        },
+        "audit.ListEntry": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Количество записей",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/audit.EntryResponse"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "total": {
+                    "description": "Всего",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "auth.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "description": "Дата создания ключа",
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "description": "Окончание действия ключа",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор ключа",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "lastUsedAt": {
+                    "description": "Последнее использование ключа, с точностью до минуты",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название ключа",
+                    "type": "string",
+                    "example": "Импорт из CRM"
+                },
+                "prefix": {
+                    "description": "Начало ключа, чтобы узнать его",
+                    "type": "string",
+                    "example": "ck_Zm9vYmF"
+                },
+                "revokedAt": {
+                    "description": "Дата отзыва ключа",
+                    "type": "string"
+                },
+                "roles": {
+                    "description": "Роли ключа",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "editor"
+                    ]
+                }
+            }
+        },
+        "auth.CreatedAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "description": "Дата создания ключа",
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "description": "Окончание действия ключа",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор ключа",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "key": {
+                    "description": "Ключ доступа: передаётся в заголовке X-API-Key или Authorization: Bearer",
+                    "type": "string",
+                    "example": "ck_Zm9vYmFy..."
+                },
+                "lastUsedAt": {
+                    "description": "Последнее использование ключа, с точностью до минуты",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название ключа",
+                    "type": "string",
+                    "example": "Импорт из CRM"
+                },
+                "prefix": {
+                    "description": "Начало ключа, чтобы узнать его",
+                    "type": "string",
+                    "example": "ck_Zm9vYmF"
+                },
+                "revokedAt": {
+                    "description": "Дата отзыва ключа",
+                    "type": "string"
+                },
+                "roles": {
+                    "description": "Роли ключа",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "editor"
+                    ]
+                }
+            }
+        },
+        "auth.ListAPIKey": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Количество записей",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/auth.APIKeyResponse"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "total": {
+                    "description": "Всего",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "auth.PrincipalResponse": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "description": "Окончание действия учётных данных, не передаётся для бессрочных",
+                    "type": "string"
+                },
+                "method": {
+                    "description": "Способ аутентификации",
+                    "type": "string",
+                    "enum": [
+                        "token",
+                        "apiKey",
+                        "session"
+                    ],
+                    "example": "token"
+                },
+                "name": {
+                    "description": "Отображаемое имя",
+                    "type": "string",
+                    "example": "Иван Иванов"
+                },
+                "roles": {
+                    "description": "Роли",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "editor"
+                    ]
+                },
+                "subject": {
+                    "description": "Идентификатор субъекта",
+                    "type": "string",
+                    "example": "user-42"
+                }
+            }
+        },
+        "auth.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "description": "Окончание действия сессии",
+                    "type": "string"
+                }
+            }
+        },
+        "auth.ShortAPIKey": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "expiresAt": {
+                    "description": "Окончание действия ключа, без значения -- бессрочный",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название ключа, чтобы отличать ключи в списке",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Импорт из CRM"
+                },
+                "roles": {
+                    "description": "Роли ключа, подмножество ролей автора запроса; пустой список -- все роли автора",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "editor"
+                    ]
+                }
+            }
+        },
+        "contact.Address": {
+            "type": "object",
+            "required": [
+                "country"
+            ],
+            "properties": {
+                "city": {
+                    "description": "Город",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Москва"
+                },
+                "country": {
+                    "description": "Код страны ISO 3166-1 alpha-2",
+                    "type": "string",
+                    "maxLength": 2,
+                    "minLength": 2,
+                    "example": "RU"
+                },
+                "label": {
+                    "description": "Метка адреса",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "home"
+                },
+                "postcode": {
+                    "description": "Почтовый индекс, формат проверяется по стране",
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "101000"
+                },
+                "region": {
+                    "description": "Регион",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Московская область"
+                },
+                "street": {
+                    "description": "Улица, дом, квартира",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "ул. Ленина, д. 1, кв. 2"
+                }
+            }
+        },
+        "contact.BatchContact": {
+            "type": "object",
+            "required": [
+                "list"
+            ],
+            "properties": {
+                "list": {
+                    "description": "Список контактов",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/contact.ShortContact"
+                    }
+                },
+                "mode": {
+                    "description": "Режим обработки пакета: atomic -- всё или ничего, bestEffort -- сохранить корректные записи",
+                    "type": "string",
+                    "default": "atomic",
+                    "enum": [
+                        "atomic",
+                        "bestEffort"
+                    ],
+                    "example": "atomic"
+                }
+            }
+        },
+        "contact.BatchContactResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "description": "Количество сохранённых контактов",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10
+                },
+                "failed": {
+                    "description": "Количество контактов с ошибкой",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "list": {
+                    "description": "Результаты по каждому контакту",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contact.BatchContactResult"
+                    }
+                },
+                "mode": {
+                    "description": "Режим обработки пакета",
+                    "type": "string",
+                    "enum": [
+                        "atomic",
+                        "bestEffort"
+                    ],
+                    "example": "atomic"
+                }
+            }
+        },
+        "contact.BatchContactResult": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Стабильный код ошибки, как в ответах application/problem+json",
+                    "type": "string",
+                    "example": "contact_phone_number_required"
+                },
+                "contact": {
+                    "description": "Сохранённый контакт",
+                    "$ref": "#/definitions/contact.ContactResponse"
+                },
+                "error": {
+                    "description": "Текст ошибки",
+                    "type": "string"
+                },
+                "index": {
+                    "description": "Порядковый номер контакта в запросе",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "invalid-params": {
+                    "description": "Поля контакта, которые не приняты",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/problem.InvalidParam"
+                    }
+                },
+                "status": {
+                    "description": "Результат обработки: created -- сохранён, failed -- ошибка, skipped -- не сохранён из-за ошибок в пакете",
+                    "type": "string",
+                    "enum": [
+                        "created",
+                        "failed",
+                        "skipped"
+                    ],
+                    "example": "created"
+                }
+            }
+        },
+        "contact.BirthdayContact": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "modifiedAt",
+                "phoneNumber"
+            ],
+            "properties": {
+                "addresses": {
+                    "description": "Почтовые адреса",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/contact.Address"
+                    }
+                },
+                "age": {
+                    "description": "Возраст. При указанной дате рождения вычисляется по ней, сохраняется только для контактов без даты рождения",
+                    "type": "integer",
+                    "default": 0,
+                    "maximum": 200,
+                    "minimum": 0,
+                    "example": 42
+                },
+                "birthday": {
+                    "description": "Дата рождения",
+                    "type": "string",
+                    "format": "date",
+                    "example": "1981-05-17"
+                },
+                "createdAt": {
+                    "description": "Дата создания контакта",
+                    "type": "string"
+                },
+                "customFields": {
+                    "description": "Дополнительные поля, описанные в реестре /customFields",
+                    "type": "object"
+                },
+                "email": {
+                    "description": "Электронная почта",
+                    "type": "string",
+                    "format": "email",
+                    "maxLength": 250,
+                    "example": "example@gmail.com"
+                },
+                "gender": {
+                    "description": "Пол",
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2
+                    ],
+                    "example": 1
+                },
+                "id": {
+                    "description": "Идетификатор записи",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "jobTitle": {
+                    "description": "Должность",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "Главный бухгалтер"
+                },
+                "modifiedAt": {
+                    "description": "Дата последнего изменения контакта",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Имя клиента",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Иван"
+                },
+                "nextBirthday": {
+                    "description": "Ближайший день рождения в периоде",
+                    "type": "string",
+                    "format": "date",
+                    "example": "2023-05-17"
+                },
+                "organizationId": {
+                    "description": "Идентификатор организации, в которой работает контакт",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "organizationName": {
+                    "description": "Название организации",
+                    "type": "string",
+                    "example": "ООО Ромашка"
+                },
+                "owner": {
+                    "description": "Владелец: субъект или роль с префиксом \"role:\", пустая строка -- контакт общий",
+                    "type": "string",
+                    "example": "42"
+                },
+                "patronymic": {
+                    "description": "Отчество клиента",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Иванович"
+                },
+                "phoneNumber": {
+                    "description": "Мобильный телефон",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "78002002020"
+                },
+                "photo": {
+                    "description": "Фотография контакта, загружается через /contacts/{id}/photo",
+                    "$ref": "#/definitions/contact.Photo"
+                },
+                "surname": {
+                    "description": "Фамилия клиента",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Иванов"
+                },
+                "tags": {
+                    "description": "Теги контакта",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vip",
+                        "partner"
+                    ]
+                },
+                "turnsAge": {
+                    "description": "Исполняется лет",
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
         "contact.ContactResponse": {
             "type": "object",
             "required": [
-                "createdAt",
-                "id",
-                "modifiedAt",
-                "phoneNumber"
+                "createdAt",
+                "id",
+                "modifiedAt",
+                "phoneNumber"
+            ],
+            "properties": {
+                "addresses": {
+                    "description": "Почтовые адреса",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/contact.Address"
+                    }
+                },
+                "age": {
+                    "description": "Возраст. При указанной дате рождения вычисляется по ней, сохраняется только для контактов без даты рождения",
+                    "type": "integer",
+                    "default": 0,
+                    "maximum": 200,
+                    "minimum": 0,
+                    "example": 42
+                },
+                "birthday": {
+                    "description": "Дата рождения",
+                    "type": "string",
+                    "format": "date",
+                    "example": "1981-05-17"
+                },
+                "createdAt": {
+                    "description": "Дата создания контакта",
+                    "type": "string"
+                },
+                "customFields": {
+                    "description": "Дополнительные поля, описанные в реестре /customFields",
+                    "type": "object"
+                },
+                "email": {
+                    "description": "Электронная почта",
+                    "type": "string",
+                    "format": "email",
+                    "maxLength": 250,
+                    "example": "example@gmail.com"
+                },
+                "gender": {
+                    "description": "Пол",
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2
+                    ],
+                    "example": 1
+                },
+                "id": {
+                    "description": "Идетификатор записи",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "jobTitle": {
+                    "description": "Должность",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "Главный бухгалтер"
+                },
+                "modifiedAt": {
+                    "description": "Дата последнего изменения контакта",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Имя клиента",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Иван"
+                },
+                "organizationId": {
+                    "description": "Идентификатор организации, в которой работает контакт",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "organizationName": {
+                    "description": "Название организации",
+                    "type": "string",
+                    "example": "ООО Ромашка"
+                },
+                "owner": {
+                    "description": "Владелец: субъект или роль с префиксом \"role:\", пустая строка -- контакт общий",
+                    "type": "string",
+                    "example": "42"
+                },
+                "patronymic": {
+                    "description": "Отчество клиента",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Иванович"
+                },
+                "phoneNumber": {
+                    "description": "Мобильный телефон",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "78002002020"
+                },
+                "photo": {
+                    "description": "Фотография контакта, загружается через /contacts/{id}/photo",
+                    "$ref": "#/definitions/contact.Photo"
+                },
+                "surname": {
+                    "description": "Фамилия клиента",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Иванов"
+                },
+                "tags": {
+                    "description": "Теги контакта",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vip",
+                        "partner"
+                    ]
+                }
+            }
+        },
+        "contact.ContactTags": {
+            "type": "object",
+            "required": [
+                "tags"
+            ],
+            "properties": {
+                "tags": {
+                    "description": "Теги, регистр не учитывается",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vip",
+                        "partner"
+                    ]
+                }
+            }
+        },
+        "contact.ListBirthday": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "Начало периода",
+                    "type": "string",
+                    "format": "date",
+                    "example": "2023-05-01"
+                },
+                "limit": {
+                    "description": "Количество записей",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contact.BirthdayContact"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "to": {
+                    "description": "Конец периода",
+                    "type": "string",
+                    "format": "date",
+                    "example": "2023-05-31"
+                }
+            }
+        },
+        "contact.ListContact": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Количество записей",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contact.ContactResponse"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "total": {
+                    "description": "Всего",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "contact.Photo": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "description": "Тип изображения",
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "height": {
+                    "description": "Высота в пикселях",
+                    "type": "integer",
+                    "example": 600
+                },
+                "size": {
+                    "description": "Размер файла в байтах",
+                    "type": "integer",
+                    "example": 102400
+                },
+                "uploadedAt": {
+                    "description": "Дата загрузки",
+                    "type": "string"
+                },
+                "width": {
+                    "description": "Ширина в пикселях",
+                    "type": "integer",
+                    "example": 800
+                }
+            }
+        },
+        "contact.ShortContact": {
+            "type": "object",
+            "required": [
+                "phoneNumber"
+            ],
+            "properties": {
+                "addresses": {
+                    "description": "Почтовые адреса",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/contact.Address"
+                    }
+                },
+                "age": {
+                    "description": "Возраст. При указанной дате рождения вычисляется по ней, сохраняется только для контактов без даты рождения",
+                    "type": "integer",
+                    "default": 0,
+                    "maximum": 200,
+                    "minimum": 0,
+                    "example": 42
+                },
+                "birthday": {
+                    "description": "Дата рождения",
+                    "type": "string",
+                    "format": "date",
+                    "example": "1981-05-17"
+                },
+                "customFields": {
+                    "description": "Дополнительные поля, описанные в реестре /customFields",
+                    "type": "object"
+                },
+                "email": {
+                    "description": "Электронная почта",
+                    "type": "string",
+                    "format": "email",
+                    "maxLength": 250,
+                    "example": "example@gmail.com"
+                },
+                "gender": {
+                    "description": "Пол",
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2
+                    ],
+                    "example": 1
+                },
+                "jobTitle": {
+                    "description": "Должность",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "Главный бухгалтер"
+                },
+                "name": {
+                    "description": "Имя клиента",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "Иван"
+                },
+                "organizationId": {
+                    "description": "Идентификатор организации, в которой работает контакт",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "patronymic": {
+                    "description": "Отчество клиента",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Иванович"
+                },
+                "phoneNumber": {
+                    "description": "Мобильный телефон",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "78002002020"
+                },
+                "surname": {
+                    "description": "Фамилия клиента",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Иванов"
+                }
+            }
+        },
+        "customField.CustomFieldResponse": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "key",
+                "modifiedAt",
+                "type"
+            ],
+            "properties": {
+                "createdAt": {
+                    "description": "Дата создания поля",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор дополнительного поля",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "key": {
+                    "description": "Ключ поля, используется в customFields контакта, сортировке и фильтрах. Не изменяется после создания",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "crmId"
+                },
+                "label": {
+                    "description": "Название поля",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "Идентификатор в CRM"
+                },
+                "modifiedAt": {
+                    "description": "Дата последнего изменения поля",
+                    "type": "string"
+                },
+                "options": {
+                    "description": "Допустимые значения для типа enum",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ru",
+                        "en"
+                    ]
+                },
+                "required": {
+                    "description": "Поле обязательно для заполнения",
+                    "type": "boolean",
+                    "example": false
+                },
+                "type": {
+                    "description": "Тип значения. Не изменяется после создания",
+                    "type": "string",
+                    "enum": [
+                        "string",
+                        "number",
+                        "date",
+                        "enum",
+                        "bool"
+                    ],
+                    "example": "string"
+                }
+            }
+        },
+        "customField.ListCustomField": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Количество записей",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/customField.CustomFieldResponse"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "total": {
+                    "description": "Всего",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "customField.ShortCustomField": {
+            "type": "object",
+            "required": [
+                "key",
+                "type"
+            ],
+            "properties": {
+                "key": {
+                    "description": "Ключ поля, используется в customFields контакта, сортировке и фильтрах. Не изменяется после создания",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "crmId"
+                },
+                "label": {
+                    "description": "Название поля",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "Идентификатор в CRM"
+                },
+                "options": {
+                    "description": "Допустимые значения для типа enum",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ru",
+                        "en"
+                    ]
+                },
+                "required": {
+                    "description": "Поле обязательно для заполнения",
+                    "type": "boolean",
+                    "example": false
+                },
+                "type": {
+                    "description": "Тип значения. Не изменяется после создания",
+                    "type": "string",
+                    "enum": [
+                        "string",
+                        "number",
+                        "date",
+                        "enum",
+                        "bool"
+                    ],
+                    "example": "string"
+                }
+            }
+        },
+        "delta.SyncResponse": {
+            "type": "object",
+            "properties": {
+                "contacts": {
+                    "description": "Созданные и изменённые контакты в текущем состоянии",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/contact.ContactResponse"
+                    }
+                },
+                "groups": {
+                    "description": "Созданные и изменённые группы в текущем состоянии",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/group.GroupResponse"
+                    }
+                },
+                "hasMore": {
+                    "description": "Изменения после токена не поместились в ответ, следующую страницу нужно запросить сразу",
+                    "type": "boolean",
+                    "example": false
+                },
+                "token": {
+                    "description": "Токен для следующего запроса",
+                    "type": "string",
+                    "example": "2048"
+                },
+                "tombstones": {
+                    "description": "Удалённые контакты и группы",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delta.Tombstone"
+                    }
+                }
+            }
+        },
+        "delta.Tombstone": {
+            "type": "object",
+            "properties": {
+                "archivedAt": {
+                    "description": "Дата удаления",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор записи",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "type": {
+                    "description": "Вид записи",
+                    "type": "string",
+                    "enum": [
+                        "contact",
+                        "group"
+                    ],
+                    "example": "contact"
+                }
+            }
+        },
+        "feed.Change": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Конверт события: id, name, aggregateId, occurredAt, data",
+                    "type": "object"
+                },
+                "event": {
+                    "description": "Название события",
+                    "type": "string",
+                    "example": "contact.updated"
+                },
+                "id": {
+                    "description": "Номер события, курсор для продолжения ленты; события приходят в порядке фиксации, номера в нём не обязательно растут",
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "group.ACLEntryResponse": {
+            "type": "object",
+            "required": [
+                "grantee",
+                "level"
+            ],
+            "properties": {
+                "createdAt": {
+                    "description": "Дата создания записи",
+                    "type": "string"
+                },
+                "grantee": {
+                    "description": "Субъект или роль с префиксом \"role:\"",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "role:sales"
+                },
+                "groupId": {
+                    "description": "Идентификатор группы",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "level": {
+                    "description": "Уровень доступа: editor -- изменение группы и состава, admin -- вдобавок удаление и управление списком",
+                    "type": "string",
+                    "enum": [
+                        "editor",
+                        "admin"
+                    ],
+                    "example": "editor"
+                }
+            }
+        },
+        "group.ACLList": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "minItems": 0,
+                    "items": {
+                        "$ref": "#/definitions/group.ACLEntryResponse"
+                    }
+                }
+            }
+        },
+        "group.GroupList": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Количество записей",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "list": {
+                    "description": "Список групп",
+                    "type": "array",
+                    "minItems": 0,
+                    "items": {
+                        "$ref": "#/definitions/group.GroupResponse"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "total": {
+                    "description": "Всего",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "group.GroupResponse": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "modifiedAt",
+                "name"
+            ],
+            "properties": {
+                "contactsAmount": {
+                    "description": "Кол-во контактов в группе",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0
+                },
+                "createdAt": {
+                    "description": "Дата создания группы",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Описание",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Описание группы"
+                },
+                "id": {
+                    "description": "Идентификатор группы",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "modifiedAt": {
+                    "description": "Дата последнего изменения группы",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название группы",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Название группы"
+                },
+                "owner": {
+                    "description": "Владелец: субъект или роль с префиксом \"role:\", пустая строка -- группа общая",
+                    "type": "string",
+                    "example": "42"
+                }
+            }
+        },
+        "group.ShortACLEntry": {
+            "type": "object",
+            "required": [
+                "grantee",
+                "level"
+            ],
+            "properties": {
+                "grantee": {
+                    "description": "Субъект или роль с префиксом \"role:\"",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "role:sales"
+                },
+                "level": {
+                    "description": "Уровень доступа: editor -- изменение группы и состава, admin -- вдобавок удаление и управление списком",
+                    "type": "string",
+                    "enum": [
+                        "editor",
+                        "admin"
+                    ],
+                    "example": "editor"
+                }
+            }
+        },
+        "group.ShortGroup": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "description": "Описание",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Описание группы"
+                },
+                "name": {
+                    "description": "Название группы",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Название группы"
+                }
+            }
+        },
+        "http.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Стабильный код ошибки",
+                    "type": "string",
+                    "example": "contact_not_found"
+                },
+                "detail": {
+                    "description": "Описание конкретного случая на языке из Accept-Language (en, ru); текст может меняться, сравнивать нужно code",
+                    "type": "string",
+                    "example": "contact not found"
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/contacts/00000000-0000-0000-0000-000000000000"
+                },
+                "invalid-params": {
+                    "description": "Поля запроса, которые не приняты",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/problem.InvalidParam"
+                    }
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                },
+                "traceId": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "URI типа ошибки, однозначно соответствует code",
+                    "type": "string",
+                    "example": "urn:problem:contact_not_found"
+                }
+            }
+        },
+        "note.CreateNote": {
+            "type": "object",
+            "required": [
+                "author",
+                "body",
+                "type"
+            ],
+            "properties": {
+                "author": {
+                    "description": "Автор заметки. Не изменяется после создания",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Петров П.П."
+                },
+                "body": {
+                    "description": "Текст заметки",
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "Обсудили условия продления договора"
+                },
+                "occurredAt": {
+                    "description": "Время взаимодействия, по умолчанию время создания заметки",
+                    "type": "string",
+                    "example": "2023-08-06T10:00:00Z"
+                },
+                "type": {
+                    "description": "Тип записи",
+                    "type": "string",
+                    "enum": [
+                        "call",
+                        "email",
+                        "meeting",
+                        "note"
+                    ],
+                    "example": "call"
+                }
+            }
+        },
+        "note.ListNote": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Количество записей",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/note.NoteResponse"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "total": {
+                    "description": "Всего",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "note.NoteResponse": {
+            "type": "object",
+            "required": [
+                "body",
+                "contactId",
+                "createdAt",
+                "id",
+                "modifiedAt",
+                "type"
+            ],
+            "properties": {
+                "author": {
+                    "description": "Автор заметки",
+                    "type": "string",
+                    "example": "Петров П.П."
+                },
+                "body": {
+                    "description": "Текст заметки",
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "Обсудили условия продления договора"
+                },
+                "contactId": {
+                    "description": "Идентификатор контакта",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "createdAt": {
+                    "description": "Дата создания заметки",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор заметки",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "modifiedAt": {
+                    "description": "Дата последнего изменения заметки",
+                    "type": "string"
+                },
+                "occurredAt": {
+                    "description": "Время взаимодействия, по умолчанию время создания заметки",
+                    "type": "string",
+                    "example": "2023-08-06T10:00:00Z"
+                },
+                "type": {
+                    "description": "Тип записи",
+                    "type": "string",
+                    "enum": [
+                        "call",
+                        "email",
+                        "meeting",
+                        "note"
+                    ],
+                    "example": "call"
+                }
+            }
+        },
+        "note.ShortNote": {
+            "type": "object",
+            "required": [
+                "body",
+                "type"
+            ],
+            "properties": {
+                "body": {
+                    "description": "Текст заметки",
+                    "type": "string",
+                    "maxLength": 10000,
+                    "example": "Обсудили условия продления договора"
+                },
+                "occurredAt": {
+                    "description": "Время взаимодействия, по умолчанию время создания заметки",
+                    "type": "string",
+                    "example": "2023-08-06T10:00:00Z"
+                },
+                "type": {
+                    "description": "Тип записи",
+                    "type": "string",
+                    "enum": [
+                        "call",
+                        "email",
+                        "meeting",
+                        "note"
+                    ],
+                    "example": "call"
+                }
+            }
+        },
+        "organization.ListOrganization": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Количество записей",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/organization.OrganizationResponse"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "total": {
+                    "description": "Всего",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "organization.OrganizationResponse": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "modifiedAt",
+                "name"
+            ],
+            "properties": {
+                "contactCount": {
+                    "description": "Количество контактов организации",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "createdAt": {
+                    "description": "Дата создания организации",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор организации",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "modifiedAt": {
+                    "description": "Дата последнего изменения организации",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название организации",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "ООО Ромашка"
+                },
+                "taxId": {
+                    "description": "ИНН или иной налоговый идентификатор",
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "7707083893"
+                },
+                "website": {
+                    "description": "Сайт организации",
+                    "type": "string",
+                    "format": "uri",
+                    "maxLength": 250,
+                    "example": "https://example.com"
+                }
+            }
+        },
+        "organization.ShortOrganization": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Название организации",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "ООО Ромашка"
+                },
+                "taxId": {
+                    "description": "ИНН или иной налоговый идентификатор",
+                    "type": "string",
+                    "maxLength": 20,
+                    "example": "7707083893"
+                },
+                "website": {
+                    "description": "Сайт организации",
+                    "type": "string",
+                    "format": "uri",
+                    "maxLength": 250,
+                    "example": "https://example.com"
+                }
+            }
+        },
+        "problem.InvalidParam": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "phoneNumber"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "is required"
+                }
+            }
+        },
+        "share.Owner": {
+            "type": "object",
+            "required": [
+                "owner"
+            ],
+            "properties": {
+                "owner": {
+                    "description": "Субъект или команда -- роль с префиксом \"role:\"",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "role:sales"
+                }
+            }
+        },
+        "share.ShareList": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "minItems": 0,
+                    "items": {
+                        "$ref": "#/definitions/share.ShareResponse"
+                    }
+                }
+            }
+        },
+        "share.ShareResponse": {
+            "type": "object",
+            "required": [
+                "grantee",
+                "level"
+            ],
+            "properties": {
+                "createdAt": {
+                    "description": "Дата создания записи",
+                    "type": "string"
+                },
+                "grantee": {
+                    "description": "Субъект или команда -- роль с префиксом \"role:\"",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "role:sales"
+                },
+                "level": {
+                    "description": "Уровень доступа: read -- чтение, write -- вдобавок изменение. Доступ к группе открывает её участников только на чтение",
+                    "type": "string",
+                    "enum": [
+                        "read",
+                        "write"
+                    ],
+                    "example": "read"
+                },
+                "resource": {
+                    "description": "Вид ресурса",
+                    "type": "string",
+                    "enum": [
+                        "contact",
+                        "group"
+                    ],
+                    "example": "contact"
+                },
+                "resourceId": {
+                    "description": "Идентификатор контакта или группы",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                }
+            }
+        },
+        "share.ShortShare": {
+            "type": "object",
+            "required": [
+                "grantee",
+                "level"
+            ],
+            "properties": {
+                "grantee": {
+                    "description": "Субъект или команда -- роль с префиксом \"role:\"",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "role:sales"
+                },
+                "level": {
+                    "description": "Уровень доступа: read -- чтение, write -- вдобавок изменение. Доступ к группе открывает её участников только на чтение",
+                    "type": "string",
+                    "enum": [
+                        "read",
+                        "write"
+                    ],
+                    "example": "read"
+                }
+            }
+        },
+        "tag.ListTag": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Количество записей",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tag.TagResponse"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "total": {
+                    "description": "Всего",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "tag.MergeTag": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "description": "Идентификаторы тегов, контакты которых переносятся на тег. Эти теги удаляются",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "00000000-0000-0000-0000-000000000000"
+                    ]
+                }
+            }
+        },
+        "tag.RenameTag": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "description": "Новое название тега",
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "vip"
+                }
+            }
+        },
+        "tag.TagResponse": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "modifiedAt"
+            ],
+            "properties": {
+                "contactCount": {
+                    "description": "Количество контактов с тегом",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10
+                },
+                "createdAt": {
+                    "description": "Дата создания тега",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор тега",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
+                },
+                "modifiedAt": {
+                    "description": "Дата последнего изменения тега",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Название тега",
+                    "type": "string",
+                    "example": "vip"
+                }
+            }
+        },
+        "tenant.CreateTenant": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "id": {
+                    "description": "Идентификатор арендатора: строчные латинские буквы, цифры, '-' и '_'",
+                    "type": "string",
+                    "maxLength": 63,
+                    "example": "acme"
+                },
+                "name": {
+                    "description": "Название арендатора",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "ООО Ромашка"
+                },
+                "quota": {
+                    "description": "Квоты арендатора",
+                    "$ref": "#/definitions/tenant.Quota"
+                }
+            }
+        },
+        "tenant.ListTenant": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "description": "Количество записей",
+                    "type": "integer",
+                    "default": 10,
+                    "minimum": 0,
+                    "example": 10
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tenant.TenantResponse"
+                    }
+                },
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
+                },
+                "total": {
+                    "description": "Всего",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
+                }
+            }
+        },
+        "tenant.Quota": {
+            "type": "object",
+            "properties": {
+                "maxContacts": {
+                    "description": "Максимальное количество контактов, 0 -- без ограничения",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10000
+                },
+                "maxGroups": {
+                    "description": "Максимальное количество групп, 0 -- без ограничения",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 100
+                }
+            }
+        },
+        "tenant.ShortTenant": {
+            "type": "object",
+            "required": [
+                "name"
             ],
             "properties": {
-                "age": {
-                    "description": "Возраст",
-                    "type": "integer",
-                    "default": 0,
-                    "maximum": 200,
-                    "minimum": 0,
-                    "example": 42
+                "name": {
+                    "description": "Название арендатора",
+                    "type": "string",
+                    "maxLength": 250,
+                    "example": "ООО Ромашка"
                 },
+                "quota": {
+                    "description": "Квоты арендатора",
+                    "$ref": "#/definitions/tenant.Quota"
+                }
+            }
+        },
+        "tenant.TenantResponse": {
+            "type": "object",
+            "properties": {
                 "createdAt": {
-                    "description": "Дата создания контакта",
+                    "description": "Дата создания арендатора",
                     "type": "string"
                 },
-                "email": {
-                    "description": "Электронная почта",
-                    "type": "string",
-                    "format": "email",
-                    "maxLength": 250,
-                    "example": "example@gmail.com"
-                },
-                "gender": {
-                    "description": "Пол",
-                    "type": "integer",
-                    "enum": [
-                        1,
-                        2
-                    ],
-                    "example": 1
+                "disabledAt": {
+                    "description": "Дата отключения, отсутствует у активного арендатора",
+                    "type": "string"
                 },
                 "id": {
-                    "description": "Идетификатор записи",
+                    "description": "Идентификатор арендатора",
                     "type": "string",
-                    "format": "uuid",
-                    "example": "00000000-0000-0000-0000-000000000000"
+                    "example": "acme"
                 },
                 "modifiedAt": {
-                    "description": "Дата последнего изменения контакта",
+                    "description": "Дата последнего изменения арендатора",
                     "type": "string"
                 },
                 "name": {
-                    "description": "Имя клиента",
+                    "description": "Название арендатора",
                     "type": "string",
-                    "maxLength": 50,
-                    "example": "Иван"
+                    "example": "ООО Ромашка"
                 },
-                "patronymic": {
-                    "description": "Отчество клиента",
-                    "type": "string",
-                    "maxLength": 100,
-                    "example": "Иванович"
+                "quota": {
+                    "description": "Квоты арендатора",
+                    "$ref": "#/definitions/tenant.Quota"
+                }
+            }
+        },
+        "tenant.UsageResponse": {
+            "type": "object",
+            "properties": {
+                "contacts": {
+                    "description": "Неархивных контактов",
+                    "type": "integer",
+                    "example": 1200
                 },
-                "phoneNumber": {
-                    "description": "Мобильный телефон",
-                    "type": "string",
-                    "maxLength": 50,
-                    "example": "78002002020"
+                "groups": {
+                    "description": "Неархивных групп",
+                    "type": "integer",
+                    "example": 12
                 },
-                "surname": {
-                    "description": "Фамилия клиента",
-                    "type": "string",
-                    "maxLength": 100,
-                    "example": "Иванов"
+                "quota": {
+                    "description": "Квоты арендатора",
+                    "$ref": "#/definitions/tenant.Quota"
                 }
             }
         },
-        "contact.ListContact": {
+        "version.ListVersion": {
             "type": "object",
             "properties": {
                 "limit": {
@@ -773,7 +6185,7 @@ const docTemplate = `{
                 "list": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/contact.ContactResponse"
+                        "$ref": "#/definitions/version.VersionResponse"
                     }
                 },
                 "offset": {
@@ -792,63 +6204,148 @@ const docTemplate = `{
                 }
             }
         },
-        "contact.ShortContact": {
+        "version.VersionResponse": {
             "type": "object",
             "required": [
-                "phoneNumber"
+                "createdAt"
             ],
             "properties": {
-                "age": {
-                    "description": "Возраст",
+                "createdAt": {
+                    "description": "Время сохранения версии",
+                    "type": "string"
+                },
+                "deleted": {
+                    "description": "Версия сохранена при удалении",
+                    "type": "boolean",
+                    "example": false
+                },
+                "version": {
+                    "description": "Номер версии",
                     "type": "integer",
-                    "default": 0,
-                    "maximum": 200,
-                    "minimum": 0,
-                    "example": 42
+                    "example": 3
+                }
+            }
+        },
+        "webhook.CreatedWebhookResponse": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "modifiedAt"
+            ],
+            "properties": {
+                "active": {
+                    "description": "Включена ли подписка",
+                    "type": "boolean",
+                    "example": true
                 },
-                "email": {
-                    "description": "Электронная почта",
+                "createdAt": {
+                    "description": "Дата создания подписки",
+                    "type": "string"
+                },
+                "events": {
+                    "description": "Фильтр по событиям, пустой список -- все события",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "contact.created",
+                        "contact.updated"
+                    ]
+                },
+                "id": {
+                    "description": "Идентификатор подписки",
                     "type": "string",
-                    "format": "email",
-                    "maxLength": 250,
-                    "example": "example@gmail.com"
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "gender": {
-                    "description": "Пол",
+                "modifiedAt": {
+                    "description": "Дата последнего изменения подписки",
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "Ключ подписи HMAC-SHA256: заголовок X-Webhook-Signature = \"sha256=\" + hex(HMAC(secret, X-Webhook-Timestamp + \".\" + тело))",
+                    "type": "string",
+                    "example": "5f1d0c4a..."
+                },
+                "url": {
+                    "description": "Адрес, на который отправляются события",
+                    "type": "string",
+                    "format": "uri",
+                    "example": "https://example.com/hooks/contacts"
+                }
+            }
+        },
+        "webhook.DeliveryResponse": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "eventId",
+                "id"
+            ],
+            "properties": {
+                "attempts": {
+                    "description": "Количество неудачных попыток",
                     "type": "integer",
-                    "enum": [
-                        1,
-                        2
-                    ],
-                    "example": 1
+                    "example": 0
                 },
-                "name": {
-                    "description": "Имя клиента",
+                "createdAt": {
+                    "description": "Время события",
+                    "type": "string"
+                },
+                "deliveredAt": {
+                    "description": "Время успешной доставки",
+                    "type": "string"
+                },
+                "event": {
+                    "description": "Название события",
                     "type": "string",
-                    "maxLength": 50,
-                    "example": "Иван"
+                    "example": "contact.created"
                 },
-                "patronymic": {
-                    "description": "Отчество клиента",
+                "eventId": {
+                    "description": "Идентификатор события",
                     "type": "string",
-                    "maxLength": 100,
-                    "example": "Иванович"
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "phoneNumber": {
-                    "description": "Мобильный телефон",
+                "id": {
+                    "description": "Идентификатор доставки, передаётся в заголовке X-Webhook-Id",
                     "type": "string",
-                    "maxLength": 50,
-                    "example": "78002002020"
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "surname": {
-                    "description": "Фамилия клиента",
+                "lastError": {
+                    "description": "Ошибка последней попытки",
                     "type": "string",
-                    "maxLength": 100,
-                    "example": "Иванов"
+                    "example": "connection refused"
+                },
+                "lastStatusCode": {
+                    "description": "Код ответа подписчика на последнюю попытку, 0 -- ответа не было",
+                    "type": "integer",
+                    "example": 204
+                },
+                "nextAttemptAt": {
+                    "description": "Время следующей попытки для доставок в очереди",
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "Тело запроса",
+                    "type": "object"
+                },
+                "status": {
+                    "description": "Состояние доставки",
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "delivered",
+                        "dead"
+                    ],
+                    "example": "delivered"
                 }
             }
         },
-        "group.GroupList": {
+        "webhook.ListDelivery": {
             "type": "object",
             "properties": {
                 "limit": {
@@ -859,11 +6356,9 @@ const docTemplate = `{
                     "example": 10
                 },
                 "list": {
-                    "description": "Список групп",
                     "type": "array",
-                    "minItems": 0,
                     "items": {
-                        "$ref": "#/definitions/group.GroupResponse"
+                        "$ref": "#/definitions/webhook.DeliveryResponse"
                     }
                 },
                 "offset": {
@@ -882,87 +6377,140 @@ const docTemplate = `{
                 }
             }
         },
-        "group.GroupResponse": {
+        "webhook.ListWebhook": {
             "type": "object",
-            "required": [
-                "createdAt",
-                "id",
-                "modifiedAt",
-                "name"
-            ],
             "properties": {
-                "contactsAmount": {
-                    "description": "Кол-во контактов в группе",
+                "limit": {
+                    "description": "Количество записей",
                     "type": "integer",
                     "default": 10,
-                    "minimum": 0
-                },
-                "createdAt": {
-                    "description": "Дата создания группы",
-                    "type": "string"
-                },
-                "description": {
-                    "description": "Описание",
-                    "type": "string",
-                    "maxLength": 1000,
-                    "example": "Описание группы"
+                    "minimum": 0,
+                    "example": 10
                 },
-                "id": {
-                    "description": "Идентификатор группы",
-                    "type": "string",
-                    "format": "uuid",
-                    "example": "00000000-0000-0000-0000-000000000000"
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/webhook.WebhookResponse"
+                    }
                 },
-                "modifiedAt": {
-                    "description": "Дата последнего изменения группы",
-                    "type": "string"
+                "offset": {
+                    "description": "Смещение при получении записей",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 20
                 },
-                "name": {
-                    "description": "Название группы",
-                    "type": "string",
-                    "maxLength": 100,
-                    "example": "Название группы"
+                "total": {
+                    "description": "Всего",
+                    "type": "integer",
+                    "default": 0,
+                    "minimum": 0,
+                    "example": 10
                 }
             }
         },
-        "group.ShortGroup": {
+        "webhook.ShortWebhook": {
             "type": "object",
             "required": [
-                "name"
+                "url"
             ],
             "properties": {
-                "description": {
-                    "description": "Описание",
+                "active": {
+                    "description": "Включена ли подписка, выключенная подписка копит доставки и отправит их после включения",
+                    "type": "boolean",
+                    "example": true
+                },
+                "events": {
+                    "description": "Фильтр по событиям, пустой список -- все события",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "contact.created",
+                        "contact.updated"
+                    ]
+                },
+                "secret": {
+                    "description": "Ключ подписи HMAC-SHA256. При создании генерируется, если не передан; при изменении пустое значение оставляет прежний ключ",
                     "type": "string",
-                    "maxLength": 1000,
-                    "example": "Описание группы"
+                    "maxLength": 250,
+                    "minLength": 16
                 },
-                "name": {
-                    "description": "Название группы",
+                "url": {
+                    "description": "Адрес, на который отправляются события",
                     "type": "string",
-                    "maxLength": 100,
-                    "example": "Название группы"
+                    "format": "uri",
+                    "maxLength": 2000,
+                    "example": "https://example.com/hooks/contacts"
                 }
             }
         },
-        "http.ErrorResponse": {
+        "webhook.WebhookResponse": {
             "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "modifiedAt"
+            ],
             "properties": {
-                "errors": {
+                "active": {
+                    "description": "Включена ли подписка",
+                    "type": "boolean",
+                    "example": true
+                },
+                "createdAt": {
+                    "description": "Дата создания подписки",
+                    "type": "string"
+                },
+                "events": {
+                    "description": "Фильтр по событиям, пустой список -- все события",
                     "type": "array",
                     "items": {
                         "type": "string"
-                    }
+                    },
+                    "example": [
+                        "contact.created",
+                        "contact.updated"
+                    ]
                 },
                 "id": {
-                    "type": "string"
+                    "description": "Идентификатор подписки",
+                    "type": "string",
+                    "format": "uuid",
+                    "example": "00000000-0000-0000-0000-000000000000"
                 },
-                "info": {},
-                "message": {
+                "modifiedAt": {
+                    "description": "Дата последнего изменения подписки",
                     "type": "string"
+                },
+                "url": {
+                    "description": "Адрес, на который отправляются события",
+                    "type": "string",
+                    "format": "uri",
+                    "example": "https://example.com/hooks/contacts"
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "ApiKey": {
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
+        "Bearer": {
+            "description": "JWT или ключ доступа в виде \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        },
+        "Cookies": {
+            "description": "Cookie сессии, открытой через POST /auth/session",
+            "type": "apiKey",
+            "name": "Cookie",
+            "in": "header"
+        }
     }
 }`
 
@@ -973,7 +6521,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/",
 	Schemes:          []string{},
 	Title:            "slurm contact service on clean architecture",
-	Description:      "contact service on clean architecture",
+	Description:      "contact service on clean architecture\nОшибки -- application/problem+json (RFC 7807) со стабильным полем code.\nКроме статусов в описании методов, любой метод может ответить 409 при конфликте с параллельным изменением,\n412, если запись не в нужном состоянии, 429 при превышении лимита запросов, 503, если хранилище недоступно, и 504 по таймауту хранилища.\nТексты detail и reason -- на английском или русском по заголовку Accept-Language (по умолчанию en), язык ответа -- в Content-Language.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 }
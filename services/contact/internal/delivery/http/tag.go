@@ -0,0 +1,316 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	jsonTag "architecture_go/services/contact/internal/delivery/http/tag"
+	domainContact "architecture_go/services/contact/internal/domain/contact"
+	domainTag "architecture_go/services/contact/internal/domain/tag"
+	tagName "architecture_go/services/contact/internal/domain/tag/name"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+const (
+	filterTagsAny = "tagsAny"
+	filterTagsAll = "tagsAll"
+)
+
+var mappingSortsTag = query.SortsOptions{
+	"id":           {},
+	"name":         {},
+	"contactCount": {},
+}
+
+// normalizeTagFilters приводит названия тегов в фильтрах к виду, в котором они хранятся
+func normalizeTagFilters(filters filter.Filters) error {
+	for _, f := range filters {
+		if f.Key != filterTagsAny && f.Key != filterTagsAll {
+			continue
+		}
+
+		tags, err := tagName.NewList(f.Values...)
+		if err != nil {
+			return err
+		}
+
+		f.Values = make([]string, len(tags))
+		for i, tag := range tags {
+			f.Values[i] = tag.String()
+		}
+	}
+	return nil
+}
+
+// AddContactTags
+// @Summary Метод позволяет добавить теги контакту.
+// @Description Метод позволяет добавить теги контакту. Несуществующие теги создаются.
+// @Tags contacts
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор контакта"
+// @Param   tags 		body 		jsonContact.ContactTags 	true  "Теги"
+// @Success 200			{object}  	jsonContact.ContactResponse true  "Структура контакта"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /contacts/{id}/tags [post]
+func (d *Delivery) AddContactTags(c *gin.Context) {
+	d.changeContactTags(c, d.ucContact.AddTags)
+}
+
+// RemoveContactTags
+// @Summary Метод позволяет удалить теги контакта.
+// @Description Метод позволяет удалить теги контакта. Теги остаются в справочнике.
+// @Tags contacts
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор контакта"
+// @Param   tags 		body 		jsonContact.ContactTags 	true  "Теги"
+// @Success 200			{object}  	jsonContact.ContactResponse true  "Структура контакта"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /contacts/{id}/tags [delete]
+func (d *Delivery) RemoveContactTags(c *gin.Context) {
+	d.changeContactTags(c, d.ucContact.RemoveTags)
+}
+
+func (d *Delivery) changeContactTags(c *gin.Context, changeFn func(c context.Context, contactID uuid.UUID, tags ...tagName.Name) (*domainContact.Contact, error)) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	body := jsonContact.ContactTags{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	tags, err := tagName.NewList(body.Tags...)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := changeFn(ctx, converter.StringToUUID(id.Value), tags...)
+	if err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonContact.ToContactResponse(response))
+}
+
+// RenameTag
+// @Summary Метод позволяет переименовать тег.
+// @Description Метод позволяет переименовать тег. Если тег с новым названием уже есть, теги нужно объединить методом merge.
+// @Tags tags
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор тега"
+// @Param   tag 		body 		jsonTag.RenameTag 		true  "Новое название"
+// @Success 200			{object}  	jsonTag.TagResponse 	true  "Тег"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Failure 409 	    {object} 	ErrorResponse			"Тег с таким названием уже существует"
+// @Router /tags/{id} [put]
+func (d *Delivery) RenameTag(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonTag.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	body := jsonTag.RenameTag{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	name, err := tagName.New(body.Name)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var timeNow = time.Now().UTC()
+	response, err := d.ucTag.Update(ctx, domainTag.NewWithID(converter.StringToUUID(id.Value), timeNow, timeNow, name, 0))
+	if err != nil {
+		switch {
+		case errors.Is(err, useCase.ErrTagNotFound):
+			SetError(c, http.StatusNotFound, err)
+		case errors.Is(err, useCase.ErrTagExists):
+			SetError(c, http.StatusConflict, err)
+		default:
+			SetError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonTag.ToTagResponse(response))
+}
+
+// MergeTags
+// @Summary Метод позволяет объединить теги.
+// @Description Метод переносит контакты с перечисленных тегов на тег id, перечисленные теги удаляются.
+// @Tags tags
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор тега, в который объединяются теги"
+// @Param   tags 		body 		jsonTag.MergeTag 		true  "Объединяемые теги"
+// @Success 200			{object}  	jsonTag.TagResponse 	true  "Тег"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /tags/{id}/merge [post]
+func (d *Delivery) MergeTags(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonTag.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	body := jsonTag.MergeTag{}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var sourceIDs = make([]uuid.UUID, len(body.IDs))
+	for i, sourceID := range body.IDs {
+		sourceIDs[i] = converter.StringToUUID(sourceID)
+	}
+
+	response, err := d.ucTag.Merge(ctx, converter.StringToUUID(id.Value), sourceIDs...)
+	if err != nil {
+		if errors.Is(err, useCase.ErrTagNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonTag.ToTagResponse(response))
+}
+
+// ListTag
+// @Summary Получить список тегов.
+// @Description Метод позволяет получить список тегов с количеством контактов. По умолчанию сначала самые используемые.
+// @Tags tags
+// @Accept  json
+// @Produce json
+// @Param 	limit 		query 		int 				false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 				false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	sort 		query 		string 				false "Сортировка по полю" default(-contactCount)
+// @Success 200			{object}  	jsonTag.ListTag 	true  "Список тегов"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Router /tags/ [get]
+func (d *Delivery) ListTag(c *gin.Context) {
+
+	var ctx = context.New(c)
+	params, err := query.ParseQuery(c, query.Options{
+		Sorts: mappingSortsTag,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	tags, err := d.ucTag.List(ctx, queryParameter.QueryParameter{
+		Sorts: params.Sorts,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucTag.Count(ctx)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonTag.ListTag{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonTag.TagResponse{},
+	}
+	for _, value := range tags {
+		result.List = append(result.List, jsonTag.ToTagResponse(value))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReadTagByID
+// @Summary Получить тег.
+// @Description Метод позволяет получить тег с количеством контактов.
+// @Tags tags
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 				true "Идентификатор тега"
+// @Success 200			{object}  	jsonTag.TagResponse true "Тег"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse		"404 Not Found"
+// @Router /tags/{id} [get]
+func (d *Delivery) ReadTagByID(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonTag.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucTag.ReadByID(ctx, converter.StringToUUID(id.Value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrTagNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonTag.ToTagResponse(response))
+}
@@ -0,0 +1,15 @@
+package tag
+
+import (
+	domainTag "architecture_go/services/contact/internal/domain/tag"
+)
+
+func ToTagResponse(response *domainTag.Tag) *TagResponse {
+	return &TagResponse{
+		ID:           response.ID().String(),
+		CreatedAt:    response.CreatedAt(),
+		ModifiedAt:   response.ModifiedAt(),
+		Name:         response.Name().String(),
+		ContactCount: response.ContactCount(),
+	}
+}
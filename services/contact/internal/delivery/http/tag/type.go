@@ -0,0 +1,42 @@
+package tag
+
+import "time"
+
+type ID struct {
+	// Идентификатор тега
+	Value string `json:"id" uri:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+}
+
+type TagResponse struct {
+	// Идентификатор тега
+	ID string `json:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Дата создания тега
+	CreatedAt time.Time `json:"createdAt"  binding:"required"`
+	// Дата последнего изменения тега
+	ModifiedAt time.Time `json:"modifiedAt"  binding:"required"`
+	// Название тега
+	Name string `json:"name" example:"vip"`
+	// Количество контактов с тегом
+	ContactCount uint64 `json:"contactCount" example:"10" minimum:"0"`
+}
+
+type RenameTag struct {
+	// Новое название тега
+	Name string `json:"name" binding:"required,max=50" maxLength:"50" example:"vip"`
+}
+
+type MergeTag struct {
+	// Идентификаторы тегов, контакты которых переносятся на тег. Эти теги удаляются
+	IDs []string `json:"ids" binding:"required,min=1,dive,uuid" example:"00000000-0000-0000-0000-000000000000"`
+}
+
+type ListTag struct {
+	// Всего
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*TagResponse `json:"list"`
+}
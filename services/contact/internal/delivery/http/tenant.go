@@ -0,0 +1,304 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonTenant "architecture_go/services/contact/internal/delivery/http/tenant"
+	"architecture_go/services/contact/internal/domain/tenant"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+const headerTenant = "X-Tenant-ID"
+
+var mappingSortsTenant = query.SortsOptions{
+	"id":        {},
+	"name":      {},
+	"createdAt": {},
+}
+
+// CreateTenant
+// @Summary Метод позволяет создать арендатора.
+// @Description Метод позволяет создать арендатора с квотами. Доступен только оператору сервиса.
+// @Tags tenants
+// @Accept  json
+// @Produce json
+// @Param   tenant 		body 		jsonTenant.CreateTenant 	true  "Данные арендатора"
+// @Success 201			{object}  	jsonTenant.TenantResponse 	true  "Арендатор"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 409 		{object}    ErrorResponse				"Арендатор с таким идентификатором уже есть"
+// @Router /tenants/ [post]
+func (d *Delivery) CreateTenant(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	value := jsonTenant.CreateTenant{}
+	if err := c.ShouldBindJSON(&value); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dTenant, err := jsonTenant.ToDomainTenant(value.ID, value.ShortTenant)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucTenant.Create(ctx, dTenant)
+	if err != nil {
+		if errors.Is(err, useCase.ErrTenantExists) {
+			SetError(c, http.StatusConflict, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, jsonTenant.ToTenantResponse(response))
+}
+
+// UpdateTenant
+// @Summary Метод позволяет изменить арендатора.
+// @Description Метод позволяет изменить название и квоты арендатора. Уменьшение квоты не удаляет данные, но запрещает создание.
+// @Tags tenants
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор арендатора"
+// @Param   tenant 		body 		jsonTenant.ShortTenant 		true  "Данные арендатора"
+// @Success 200			{object}  	jsonTenant.TenantResponse 	true  "Арендатор"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /tenants/{id} [put]
+func (d *Delivery) UpdateTenant(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonTenant.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	value := jsonTenant.ShortTenant{}
+	if err := c.ShouldBindJSON(&value); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dTenant, err := jsonTenant.ToDomainTenant(id.Value, value)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucTenant.Update(ctx, dTenant)
+	if err != nil {
+		if errors.Is(err, useCase.ErrTenantNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonTenant.ToTenantResponse(response))
+}
+
+// DisableTenant
+// @Summary Метод позволяет отключить арендатора.
+// @Description Метод закрывает доступ к данным арендатора, данные сохраняются. На других экземплярах сервиса отключение вступает в силу с задержкой кэша.
+// @Tags tenants
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор арендатора"
+// @Success 200			{object}  	jsonTenant.TenantResponse 	true  "Арендатор"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /tenants/{id}/disable [post]
+func (d *Delivery) DisableTenant(c *gin.Context) {
+	d.switchTenant(c, d.ucTenant.Disable)
+}
+
+// EnableTenant
+// @Summary Метод позволяет включить арендатора.
+// @Description Метод возвращает доступ к данным отключённого арендатора.
+// @Tags tenants
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор арендатора"
+// @Success 200			{object}  	jsonTenant.TenantResponse 	true  "Арендатор"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /tenants/{id}/enable [post]
+func (d *Delivery) EnableTenant(c *gin.Context) {
+	d.switchTenant(c, d.ucTenant.Enable)
+}
+
+func (d *Delivery) switchTenant(c *gin.Context, switchFn func(ctx context.Context, ID string) (*tenant.Tenant, error)) {
+
+	var ctx = context.New(c)
+
+	var id jsonTenant.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := switchFn(ctx, id.Value)
+	if err != nil {
+		if errors.Is(err, useCase.ErrTenantNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonTenant.ToTenantResponse(response))
+}
+
+// ListTenant
+// @Summary Получить список арендаторов.
+// @Description Метод позволяет получить список арендаторов, включая отключённых.
+// @Tags tenants
+// @Accept  json
+// @Produce json
+// @Param 	limit 		query 		int 					false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 					false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	sort 		query 		string 					false "Сортировка по полю" default(id)
+// @Success 200			{object}  	jsonTenant.ListTenant 	true  "Список арендаторов"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Router /tenants/ [get]
+func (d *Delivery) ListTenant(c *gin.Context) {
+
+	var ctx = context.New(c)
+	params, err := query.ParseQuery(c, query.Options{
+		Sorts: mappingSortsTenant,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	tenants, err := d.ucTenant.List(ctx, queryParameter.QueryParameter{
+		Sorts: params.Sorts,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucTenant.Count(ctx)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonTenant.ListTenant{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonTenant.TenantResponse{},
+	}
+	for _, value := range tenants {
+		result.List = append(result.List, jsonTenant.ToTenantResponse(value))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReadTenantByID
+// @Summary Получить арендатора.
+// @Description Метод позволяет получить арендатора по идентификатору.
+// @Tags tenants
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true "Идентификатор арендатора"
+// @Success 200			{object}  	jsonTenant.TenantResponse 	true "Арендатор"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /tenants/{id} [get]
+func (d *Delivery) ReadTenantByID(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonTenant.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucTenant.ReadByID(ctx, id.Value)
+	if err != nil {
+		if errors.Is(err, useCase.ErrTenantNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonTenant.ToTenantResponse(response))
+}
+
+// ReadTenantUsage
+// @Summary Получить потребление арендатора.
+// @Description Метод возвращает количество неархивных контактов и групп арендатора вместе с его квотами.
+// @Tags tenants
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true "Идентификатор арендатора"
+// @Success 200			{object}  	jsonTenant.UsageResponse 	true "Потребление"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /tenants/{id}/usage [get]
+func (d *Delivery) ReadTenantUsage(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonTenant.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	value, err := d.ucTenant.ReadByID(ctx, id.Value)
+	if err != nil {
+		if errors.Is(err, useCase.ErrTenantNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	usage, err := d.ucTenant.Usage(ctx, id.Value)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonTenant.ToUsageResponse(usage, value.Quota()))
+}
@@ -0,0 +1,41 @@
+package tenant
+
+import (
+	domainTenant "architecture_go/services/contact/internal/domain/tenant"
+)
+
+func ToTenantResponse(response *domainTenant.Tenant) *TenantResponse {
+	var result = &TenantResponse{
+		ID:         response.ID(),
+		CreatedAt:  response.CreatedAt(),
+		ModifiedAt: response.ModifiedAt(),
+		Name:       response.Name(),
+		Quota:      toQuota(response.Quota()),
+	}
+
+	if response.Disabled() {
+		var disabledAt = response.DisabledAt()
+		result.DisabledAt = &disabledAt
+	}
+
+	return result
+}
+
+func ToUsageResponse(usage *domainTenant.Usage, quota domainTenant.Quota) *UsageResponse {
+	return &UsageResponse{
+		Contacts: usage.Contacts,
+		Groups:   usage.Groups,
+		Quota:    toQuota(quota),
+	}
+}
+
+func ToDomainTenant(id string, value ShortTenant) (*domainTenant.Tenant, error) {
+	return domainTenant.New(id, value.Name, domainTenant.Quota{
+		MaxContacts: value.Quota.MaxContacts,
+		MaxGroups:   value.Quota.MaxGroups,
+	})
+}
+
+func toQuota(value domainTenant.Quota) Quota {
+	return Quota{MaxContacts: value.MaxContacts, MaxGroups: value.MaxGroups}
+}
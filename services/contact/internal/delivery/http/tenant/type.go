@@ -0,0 +1,64 @@
+package tenant
+
+import "time"
+
+type ID struct {
+	// Идентификатор арендатора
+	Value string `json:"id" uri:"id" binding:"required,max=63" maxLength:"63" example:"acme"`
+}
+
+type Quota struct {
+	// Максимальное количество контактов, 0 -- без ограничения
+	MaxContacts uint64 `json:"maxContacts" binding:"min=0" minimum:"0" example:"10000"`
+	// Максимальное количество групп, 0 -- без ограничения
+	MaxGroups uint64 `json:"maxGroups" binding:"min=0" minimum:"0" example:"100"`
+}
+
+type ShortTenant struct {
+	// Название арендатора
+	Name string `json:"name" binding:"required,max=250" maxLength:"250" example:"ООО Ромашка"`
+	// Квоты арендатора
+	Quota Quota `json:"quota"`
+}
+
+type CreateTenant struct {
+	// Идентификатор арендатора: строчные латинские буквы, цифры, '-' и '_'
+	ID string `json:"id" binding:"required,max=63" maxLength:"63" example:"acme"`
+
+	ShortTenant
+}
+
+type TenantResponse struct {
+	// Идентификатор арендатора
+	ID string `json:"id" example:"acme"`
+	// Дата создания арендатора
+	CreatedAt time.Time `json:"createdAt"`
+	// Дата последнего изменения арендатора
+	ModifiedAt time.Time `json:"modifiedAt"`
+	// Название арендатора
+	Name string `json:"name" example:"ООО Ромашка"`
+	// Квоты арендатора
+	Quota Quota `json:"quota"`
+	// Дата отключения, отсутствует у активного арендатора
+	DisabledAt *time.Time `json:"disabledAt,omitempty"`
+}
+
+type UsageResponse struct {
+	// Неархивных контактов
+	Contacts uint64 `json:"contacts" example:"1200"`
+	// Неархивных групп
+	Groups uint64 `json:"groups" example:"12"`
+	// Квоты арендатора
+	Quota Quota `json:"quota"`
+}
+
+type ListTenant struct {
+	// Всего
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*TenantResponse `json:"list"`
+}
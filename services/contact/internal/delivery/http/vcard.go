@@ -0,0 +1,69 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/tools/vcard"
+	"architecture_go/pkg/type/context"
+	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// ExportContactVCard
+// @Summary Выгрузить контакт в формате vCard.
+// @Description Метод позволяет получить карточку контакта в формате vCard 4.0. Миниатюра фотографии встраивается в поле PHOTO.
+// @Tags contacts
+// @Produce text/vcard
+// @Param   id 			path 		string 		true 	"Идентификатор контакта"
+// @Success 200			{string}  	string 		"Карточка vCard"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse	"404 Not Found"
+// @Router /contacts/{id}/vcard [get]
+func (d *Delivery) ExportContactVCard(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucContact.ReadByID(ctx, converter.StringToUUID(id.Value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var card = jsonContact.ToVCard(response)
+	if !response.Photo().IsEmpty() {
+		data, contentType, err := d.ucPhoto.Read(ctx, response.ID(), true)
+		switch {
+		case err == nil:
+			card.AddRaw("PHOTO", vcard.DataURI(contentType, data))
+		case !errors.Is(err, useCase.ErrPhotoNotFound):
+			SetError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err = vcard.Encode(&buffer, card); err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="`+response.ID().String()+`.vcf"`)
+	c.Data(http.StatusOK, vcard.ContentType, buffer.Bytes())
+}
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonContact "architecture_go/services/contact/internal/delivery/http/contact"
+	jsonVersion "architecture_go/services/contact/internal/delivery/http/version"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// ListContactVersion
+// @Summary Получить версии контакта.
+// @Description Метод позволяет получить список сохранённых версий контакта, новые первыми. Версия сохраняется после каждого изменения контакта.
+// @Tags contacts
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор контакта"
+// @Param 	limit 		query 		int 					false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 					false "Смещение при получении записей" default(0) mininum(0)
+// @Success 200			{object}  	jsonVersion.ListVersion true  "Версии контакта"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Router /contacts/{id}/versions [get]
+func (d *Delivery) ListContactVersion(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	params, err := query.ParseQuery(c, query.Options{})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var contactID = converter.StringToUUID(id.Value)
+
+	versions, err := d.ucContact.ListVersion(ctx, contactID, queryParameter.QueryParameter{
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucContact.CountVersion(ctx, contactID)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonVersion.ListVersion{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonVersion.VersionResponse{},
+	}
+	for _, value := range versions {
+		result.List = append(result.List, jsonVersion.ToVersionResponse(value))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// RevertContact
+// @Summary Метод позволяет вернуть контакт к версии.
+// @Description Метод позволяет вернуть поля контакта к сохранённой версии. Возврат сохраняется как новая версия, теги и фотография не изменяются.
+// @Tags contacts
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор контакта"
+// @Param   version 	path 		int 						true  "Номер версии"
+// @Success 200			{object}  	jsonContact.ContactResponse true  "Структура контакта"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			  		  "Контакт или версия не найдены"
+// @Router /contacts/{id}/revert/{version} [post]
+func (d *Delivery) RevertContact(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonContact.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var number jsonVersion.Number
+	if err := c.ShouldBindUri(&number); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucContact.Revert(ctx, converter.StringToUUID(id.Value), number.Value)
+	if err != nil {
+		if errors.Is(err, useCase.ErrContactNotFound) || errors.Is(err, useCase.ErrVersionNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		if isCustomFieldError(err) || errors.Is(err, useCase.ErrOrganizationNotFound) {
+			SetError(c, http.StatusBadRequest, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonContact.ToContactResponse(response))
+}
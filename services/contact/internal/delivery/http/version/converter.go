@@ -0,0 +1,13 @@
+package version
+
+import (
+	domainVersion "architecture_go/services/contact/internal/domain/version"
+)
+
+func ToVersionResponse(response *domainVersion.Version) *VersionResponse {
+	return &VersionResponse{
+		Version:   response.Number(),
+		CreatedAt: response.CreatedAt(),
+		Deleted:   response.Deleted(),
+	}
+}
@@ -0,0 +1,33 @@
+package version
+
+import "time"
+
+type Number struct {
+	// Номер версии
+	Value uint64 `json:"version" uri:"version" binding:"required,min=1" minimum:"1" example:"3"`
+}
+
+type AsOf struct {
+	// Момент времени в формате RFC 3339, на который нужно получить состояние
+	Value time.Time `form:"asOf" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+type VersionResponse struct {
+	// Номер версии
+	Version uint64 `json:"version" example:"3"`
+	// Время сохранения версии
+	CreatedAt time.Time `json:"createdAt" binding:"required"`
+	// Версия сохранена при удалении
+	Deleted bool `json:"deleted" example:"false"`
+}
+
+type ListVersion struct {
+	// Всего
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*VersionResponse `json:"list"`
+}
@@ -0,0 +1,388 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/tools/converter"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonWebhook "architecture_go/services/contact/internal/delivery/http/webhook"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/webhook"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+const (
+	filterWebhookDeliveryStatus = "status"
+	filterWebhookDeliveryEvent  = "event"
+)
+
+var mappingSortsWebhook = query.SortsOptions{
+	"id":         {},
+	"url":        {},
+	"createdAt":  {},
+	"modifiedAt": {},
+}
+
+var mappingFiltersWebhookDelivery = query.FiltersOptions{
+	filterWebhookDeliveryStatus: {},
+	filterWebhookDeliveryEvent:  {},
+}
+
+// checkWebhookDeliveryFilters состояния и названия событий -- из справочника
+func checkWebhookDeliveryFilters(filters filter.Filters) error {
+	for _, f := range filters {
+		for _, value := range f.Values {
+			switch f.Key {
+			case filterWebhookDeliveryStatus:
+				if !webhook.Status(value).IsValid() {
+					return fmt.Errorf("filter %s: unknown status %q", filterWebhookDeliveryStatus, value)
+				}
+			case filterWebhookDeliveryEvent:
+				if !event.Name(value).IsValid() {
+					return fmt.Errorf("filter %s: unknown event %q", filterWebhookDeliveryEvent, value)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// CreateWebhook
+// @Summary Метод позволяет подписаться на события контактов и групп.
+// @Description Метод позволяет зарегистрировать адрес, на который POST-запросом отправляются события.
+// @Description Тело запроса подписывается HMAC-SHA256, подпись передаётся в заголовке X-Webhook-Signature.
+// @Description Ключ подписи возвращается только в ответе на создание.
+// @Tags webhooks
+// @Accept  json
+// @Produce json
+// @Param   webhook 	body 		jsonWebhook.ShortWebhook 			true  "Данные подписки"
+// @Success 201			{object}  	jsonWebhook.CreatedWebhookResponse 	true  "Подписка с ключом подписи"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Router /webhooks/ [post]
+func (d *Delivery) CreateWebhook(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	hook := jsonWebhook.ShortWebhook{}
+	if err := c.ShouldBindJSON(&hook); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dWebhook, err := jsonWebhook.ToDomainWebhook(uuid.Nil, hook)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucWebhook.Create(ctx, dWebhook)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, jsonWebhook.ToCreatedWebhookResponse(response))
+}
+
+// UpdateWebhook
+// @Summary Метод позволяет изменить подписку.
+// @Description Метод позволяет изменить адрес, фильтр событий, ключ подписи и включить или выключить подписку.
+// @Tags webhooks
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор подписки"
+// @Param   webhook 	body 		jsonWebhook.ShortWebhook 	true  "Данные подписки"
+// @Success 200			{object}  	jsonWebhook.WebhookResponse true  "Подписка"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /webhooks/{id} [put]
+func (d *Delivery) UpdateWebhook(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonWebhook.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	hook := jsonWebhook.ShortWebhook{}
+	if err := c.ShouldBindJSON(&hook); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	dWebhook, err := jsonWebhook.ToDomainWebhook(converter.StringToUUID(id.Value), hook)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucWebhook.Update(ctx, dWebhook)
+	if err != nil {
+		if errors.Is(err, useCase.ErrWebhookNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonWebhook.ToWebhookResponse(response))
+}
+
+// DeleteWebhook
+// @Summary Метод позволяет удалить подписку.
+// @Description Метод позволяет удалить подписку. Журнал доставок сохраняется, неотправленные доставки больше не отправляются.
+// @Tags webhooks
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 			true 	"Идентификатор подписки"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Router /webhooks/{id} [delete]
+func (d *Delivery) DeleteWebhook(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonWebhook.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := d.ucWebhook.Delete(ctx, converter.StringToUUID(id.Value)); err != nil {
+		if errors.Is(err, useCase.ErrWebhookNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+// ListWebhook
+// @Summary Получить список подписок.
+// @Description Метод позволяет получить список подписок на события.
+// @Tags webhooks
+// @Accept  json
+// @Produce json
+// @Param 	limit 		query 		int 						false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 						false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	sort 		query 		string 						false "Сортировка по полю" default(createdAt)
+// @Success 200			{object}  	jsonWebhook.ListWebhook 	true  "Список подписок"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Router /webhooks/ [get]
+func (d *Delivery) ListWebhook(c *gin.Context) {
+
+	var ctx = context.New(c)
+	params, err := query.ParseQuery(c, query.Options{
+		Sorts: mappingSortsWebhook,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	webhooks, err := d.ucWebhook.List(ctx, queryParameter.QueryParameter{
+		Sorts: params.Sorts,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucWebhook.Count(ctx)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonWebhook.ListWebhook{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonWebhook.WebhookResponse{},
+	}
+	for _, value := range webhooks {
+		result.List = append(result.List, jsonWebhook.ToWebhookResponse(value))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReadWebhookByID
+// @Summary Получить подписку.
+// @Description Метод позволяет получить подписку по идентификатору. Ключ подписи не возвращается.
+// @Tags webhooks
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true "Идентификатор подписки"
+// @Success 200			{object}  	jsonWebhook.WebhookResponse true "Подписка"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /webhooks/{id} [get]
+func (d *Delivery) ReadWebhookByID(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonWebhook.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucWebhook.ReadByID(ctx, converter.StringToUUID(id.Value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrWebhookNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonWebhook.ToWebhookResponse(response))
+}
+
+// ListWebhookDelivery
+// @Summary Получить журнал доставок подписки.
+// @Description Метод позволяет получить доставки событий подписчику с результатом последней попытки. Новые доставки первыми.
+// @Tags webhooks
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 						true  "Идентификатор подписки"
+// @Param 	limit 		query 		int 						false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 						false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	filter 		query 		object 						false "Фильтр вида filter[status]=pending,delivered,dead, filter[event]=contact.created"
+// @Success 200			{object}  	jsonWebhook.ListDelivery 	true  "Журнал доставок"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse				"404 Not Found"
+// @Router /webhooks/{id}/deliveries [get]
+func (d *Delivery) ListWebhookDelivery(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonWebhook.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	params, err := query.ParseQuery(c, query.Options{
+		Filters: mappingFiltersWebhookDelivery,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = checkWebhookDeliveryFilters(params.Filters); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var webhookID = converter.StringToUUID(id.Value)
+
+	deliveries, err := d.ucWebhook.ListDelivery(ctx, webhookID, queryParameter.QueryParameter{
+		Filters: params.Filters,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, useCase.ErrWebhookNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucWebhook.CountDelivery(ctx, webhookID, params.Filters)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonWebhook.ListDelivery{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonWebhook.DeliveryResponse{},
+	}
+	for _, value := range deliveries {
+		result.List = append(result.List, jsonWebhook.ToDeliveryResponse(value))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReplayWebhookDelivery
+// @Summary Метод позволяет повторить доставку.
+// @Description Метод позволяет поставить доставку в очередь заново со сброшенным счётчиком попыток,
+// @Description в том числе доставку из dead-letter или уже доставленную.
+// @Tags webhooks
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 							true  "Идентификатор подписки"
+// @Param   deliveryId 	path 		string 							true  "Идентификатор доставки"
+// @Success 200			{object}  	jsonWebhook.DeliveryResponse 	true  "Доставка"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse					"404 Not Found"
+// @Router /webhooks/{id}/deliveries/{deliveryId}/replay [post]
+func (d *Delivery) ReplayWebhookDelivery(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var id jsonWebhook.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var deliveryID jsonWebhook.DeliveryID
+	if err := c.ShouldBindUri(&deliveryID); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucWebhook.Replay(ctx, converter.StringToUUID(id.Value), converter.StringToUUID(deliveryID.Value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrWebhookNotFound) || errors.Is(err, useCase.ErrWebhookDeliveryNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonWebhook.ToDeliveryResponse(response))
+}
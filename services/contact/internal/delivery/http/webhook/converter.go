@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/event"
+	domainWebhook "architecture_go/services/contact/internal/domain/webhook"
+)
+
+func ToWebhookResponse(response *domainWebhook.Webhook) *WebhookResponse {
+	var events = make([]string, len(response.Events()))
+	for i, value := range response.Events() {
+		events[i] = value.String()
+	}
+
+	return &WebhookResponse{
+		ID:         response.ID().String(),
+		CreatedAt:  response.CreatedAt(),
+		ModifiedAt: response.ModifiedAt(),
+		URL:        response.URL(),
+		Events:     events,
+		Active:     response.Active(),
+	}
+}
+
+func ToCreatedWebhookResponse(response *domainWebhook.Webhook) *CreatedWebhookResponse {
+	return &CreatedWebhookResponse{
+		WebhookResponse: *ToWebhookResponse(response),
+		Secret:          response.Secret(),
+	}
+}
+
+func ToDomainWebhook(id uuid.UUID, hook ShortWebhook) (*domainWebhook.Webhook, error) {
+	var events = make([]event.Name, len(hook.Events))
+	for i, value := range hook.Events {
+		events[i] = event.Name(value)
+	}
+
+	result, err := domainWebhook.New(hook.URL, events, hook.Secret, hook.Active)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == uuid.Nil {
+		return result, nil
+	}
+
+	return domainWebhook.NewWithID(id, result.CreatedAt(), result.ModifiedAt(), result.URL(), result.Events(), result.Secret(), result.Active()), nil
+}
+
+func ToDeliveryResponse(response *domainWebhook.Delivery) *DeliveryResponse {
+	var result = &DeliveryResponse{
+		ID:             response.ID().String(),
+		EventID:        response.EventID().String(),
+		Event:          response.EventName().String(),
+		Payload:        response.Payload(),
+		CreatedAt:      response.CreatedAt(),
+		Status:         response.Status().String(),
+		Attempts:       response.Attempts(),
+		LastStatusCode: response.LastStatusCode(),
+		LastError:      response.LastError(),
+	}
+
+	if response.Status() == domainWebhook.StatusPending {
+		result.NextAttemptAt = timePointer(response.NextAttemptAt())
+	}
+
+	if !response.DeliveredAt().IsZero() {
+		result.DeliveredAt = timePointer(response.DeliveredAt())
+	}
+
+	return result
+}
+
+func timePointer(value time.Time) *time.Time {
+	return &value
+}
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ID struct {
+	// Идентификатор подписки
+	Value string `json:"id" uri:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+}
+
+type DeliveryID struct {
+	// Идентификатор доставки
+	Value string `json:"deliveryId" uri:"deliveryId" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+}
+
+type ShortWebhook struct {
+	// Адрес, на который отправляются события
+	URL string `json:"url" binding:"required,max=2000,url" maxLength:"2000" example:"https://example.com/hooks/contacts" format:"uri"`
+	// Фильтр по событиям, пустой список -- все события
+	Events []string `json:"events" example:"contact.created,contact.updated"`
+	// Ключ подписи HMAC-SHA256. При создании генерируется, если не передан; при изменении пустое значение оставляет прежний ключ
+	Secret string `json:"secret,omitempty" binding:"omitempty,min=16,max=250" minLength:"16" maxLength:"250"`
+	// Включена ли подписка, выключенная подписка копит доставки и отправит их после включения
+	Active bool `json:"active" example:"true"`
+}
+
+type WebhookResponse struct {
+	// Идентификатор подписки
+	ID string `json:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Дата создания подписки
+	CreatedAt time.Time `json:"createdAt"  binding:"required"`
+	// Дата последнего изменения подписки
+	ModifiedAt time.Time `json:"modifiedAt"  binding:"required"`
+	// Адрес, на который отправляются события
+	URL string `json:"url" example:"https://example.com/hooks/contacts" format:"uri"`
+	// Фильтр по событиям, пустой список -- все события
+	Events []string `json:"events" example:"contact.created,contact.updated"`
+	// Включена ли подписка
+	Active bool `json:"active" example:"true"`
+}
+
+// CreatedWebhookResponse ключ подписи возвращается только при создании
+type CreatedWebhookResponse struct {
+	WebhookResponse
+	// Ключ подписи HMAC-SHA256: заголовок X-Webhook-Signature = "sha256=" + hex(HMAC(secret, X-Webhook-Timestamp + "." + тело))
+	Secret string `json:"secret" example:"5f1d0c4a..."`
+}
+
+type ListWebhook struct {
+	// Всего
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*WebhookResponse `json:"list"`
+}
+
+type DeliveryResponse struct {
+	// Идентификатор доставки, передаётся в заголовке X-Webhook-Id
+	ID string `json:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Идентификатор события
+	EventID string `json:"eventId" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Название события
+	Event string `json:"event" example:"contact.created"`
+	// Тело запроса
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+	// Время события
+	CreatedAt time.Time `json:"createdAt" binding:"required"`
+	// Состояние доставки
+	Status string `json:"status" enums:"pending,delivered,dead" example:"delivered"`
+	// Количество неудачных попыток
+	Attempts uint32 `json:"attempts" example:"0"`
+	// Время следующей попытки для доставок в очереди
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+	// Код ответа подписчика на последнюю попытку, 0 -- ответа не было
+	LastStatusCode int `json:"lastStatusCode" example:"204"`
+	// Ошибка последней попытки
+	LastError string `json:"lastError,omitempty" example:"connection refused"`
+	// Время успешной доставки
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+}
+
+type ListDelivery struct {
+	// Всего
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit"  example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*DeliveryResponse `json:"list"`
+}
@@ -0,0 +1,192 @@
+package apiKey
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"architecture_go/pkg/tools/secret"
+	"architecture_go/pkg/type/principal"
+)
+
+const (
+	// Prefix начало каждого ключа
+	Prefix = "ck_"
+	// PrefixLength сколько первых символов ключа хранится открыто, чтобы ключ можно было узнать в списке
+	PrefixLength = 11
+
+	secretSize = 32
+)
+
+var (
+	MaxNameLength = 100
+
+	ErrWrongName    = errors.Errorf("api key name must be from 1 to %d characters", MaxNameLength)
+	ErrWrongSubject = errors.New("api key subject is required")
+	ErrWrongTenant  = errors.New("api key tenant is required")
+	ErrWrongExpires = errors.New("api key expiration must be in the future")
+)
+
+// APIKey непрозрачный ключ доступа. Сам ключ не хранится, только его хеш,
+// поэтому secret есть лишь у только что созданного ключа
+type APIKey struct {
+	id        uuid.UUID
+	createdAt time.Time
+
+	name    string
+	prefix  string
+	hash    []byte
+	subject string
+	tenant  string
+	roles   []string
+
+	// expiresAt нулевое значение -- ключ бессрочный
+	expiresAt  time.Time
+	lastUsedAt time.Time
+	revokedAt  time.Time
+
+	secret string
+}
+
+func NewWithID(
+	id uuid.UUID,
+	createdAt time.Time,
+	name string,
+	prefix string,
+	hash []byte,
+	subject string,
+	tenant string,
+	roles []string,
+	expiresAt time.Time,
+	lastUsedAt time.Time,
+	revokedAt time.Time,
+) *APIKey {
+	return &APIKey{
+		id:         id,
+		createdAt:  createdAt.UTC(),
+		name:       name,
+		prefix:     prefix,
+		hash:       hash,
+		subject:    subject,
+		tenant:     tenant,
+		roles:      roles,
+		expiresAt:  expiresAt.UTC(),
+		lastUsedAt: lastUsedAt.UTC(),
+		revokedAt:  revokedAt.UTC(),
+	}
+}
+
+// New выпускает ключ для субъекта subject арендатора tenant с ролями roles
+func New(name, subject, tenant string, roles []string, expiresAt time.Time) (*APIKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return nil, ErrWrongName
+	}
+
+	if strings.TrimSpace(subject) == "" {
+		return nil, ErrWrongSubject
+	}
+
+	if tenant == "" {
+		return nil, ErrWrongTenant
+	}
+
+	var now = time.Now().UTC()
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return nil, ErrWrongExpires
+	}
+
+	value, err := secret.Generate(Prefix, secretSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if roles == nil {
+		roles = []string{}
+	}
+
+	return &APIKey{
+		id:        uuid.New(),
+		createdAt: now,
+		name:      name,
+		prefix:    value[:PrefixLength],
+		hash:      secret.Hash(value),
+		subject:   subject,
+		tenant:    tenant,
+		roles:     roles,
+		expiresAt: expiresAt.UTC(),
+		secret:    value,
+	}, nil
+}
+
+func (k APIKey) ID() uuid.UUID {
+	return k.id
+}
+
+func (k APIKey) CreatedAt() time.Time {
+	return k.createdAt
+}
+
+func (k APIKey) Name() string {
+	return k.name
+}
+
+func (k APIKey) Prefix() string {
+	return k.prefix
+}
+
+func (k APIKey) Hash() []byte {
+	return k.hash
+}
+
+func (k APIKey) Subject() string {
+	return k.subject
+}
+
+func (k APIKey) Tenant() string {
+	return k.tenant
+}
+
+func (k APIKey) Roles() []string {
+	return k.roles
+}
+
+func (k APIKey) ExpiresAt() time.Time {
+	return k.expiresAt
+}
+
+func (k APIKey) LastUsedAt() time.Time {
+	return k.lastUsedAt
+}
+
+func (k APIKey) RevokedAt() time.Time {
+	return k.revokedAt
+}
+
+// Secret ключ целиком, пустая строка у ключей, прочитанных из хранилища
+func (k APIKey) Secret() string {
+	return k.secret
+}
+
+// Active ключ не отозван и не истёк к моменту now
+func (k APIKey) Active(now time.Time) bool {
+	if !k.revokedAt.IsZero() {
+		return false
+	}
+	return k.expiresAt.IsZero() || now.Before(k.expiresAt)
+}
+
+func (k APIKey) Principal() *principal.Principal {
+	return &principal.Principal{
+		Subject:      k.subject,
+		Name:         k.name,
+		Method:       principal.MethodAPIKey,
+		CredentialID: k.id.String(),
+		Tenant:       k.tenant,
+		Roles:        k.roles,
+		ExpiresAt:    k.expiresAt,
+	}
+}
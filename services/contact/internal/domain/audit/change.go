@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// Snapshot состояние сущности в виде плоского набора полей
+type Snapshot map[string]interface{}
+
+// Change изменение одного поля сущности
+type Change struct {
+	field  string
+	before interface{}
+	after  interface{}
+}
+
+func NewChange(field string, before, after interface{}) Change {
+	return Change{
+		field:  field,
+		before: before,
+		after:  after,
+	}
+}
+
+func (c Change) Field() string {
+	return c.field
+}
+
+func (c Change) Before() interface{} {
+	return c.before
+}
+
+func (c Change) After() interface{} {
+	return c.after
+}
+
+// Diff список изменённых полей, упорядоченный по имени поля.
+// Значения сравниваются после приведения к JSON, поэтому, например,
+// время и uuid сравниваются так же, как они будут сохранены в журнал.
+func Diff(before, after Snapshot) []Change {
+	var fields = make(map[string]struct{}, len(before)+len(after))
+	for field := range before {
+		fields[field] = struct{}{}
+	}
+	for field := range after {
+		fields[field] = struct{}{}
+	}
+
+	var result []Change
+	for field := range fields {
+		valueBefore, valueAfter := normalize(before[field]), normalize(after[field])
+		if reflect.DeepEqual(valueBefore, valueAfter) {
+			continue
+		}
+		result = append(result, NewChange(field, valueBefore, valueAfter))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].field < result[j].field
+	})
+
+	return result
+}
+
+func normalize(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var result interface{}
+	if err = json.Unmarshal(data, &result); err != nil {
+		return value
+	}
+	return result
+}
@@ -0,0 +1,67 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestDiff(t *testing.T) {
+	var (
+		id        = uuid.New()
+		createdAt = time.Date(2023, 8, 8, 10, 0, 0, 0, time.UTC)
+	)
+
+	before := Snapshot{
+		"id":        id,
+		"createdAt": createdAt,
+		"name":      "Иван",
+		"email":     "ivan@example.com",
+		"tags":      []string{"a", "b"},
+	}
+	after := Snapshot{
+		"id":        id.String(),
+		"createdAt": createdAt,
+		"name":      "Пётр",
+		"tags":      []string{"a", "c"},
+		"jobTitle":  "инженер",
+	}
+
+	changes := Diff(before, after)
+
+	var expected = []Change{
+		NewChange("email", "ivan@example.com", nil),
+		NewChange("jobTitle", nil, "инженер"),
+		NewChange("name", "Иван", "Пётр"),
+		NewChange("tags", []interface{}{"a", "b"}, []interface{}{"a", "c"}),
+	}
+
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %d: %v", len(expected), len(changes), changes)
+	}
+
+	for i := range expected {
+		if changes[i].Field() != expected[i].Field() {
+			t.Errorf("change %d: expected field %q, got %q", i, expected[i].Field(), changes[i].Field())
+		}
+	}
+}
+
+func TestDiffEmpty(t *testing.T) {
+	if changes := Diff(nil, nil); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+
+	snapshot := Snapshot{"name": "Иван"}
+	if changes := Diff(snapshot, snapshot); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestNewDefaultActor(t *testing.T) {
+	entry := New("", "request", "", EntityContact, uuid.New(), ActionCreate, nil)
+	if entry.Actor() != actorSystem {
+		t.Errorf("expected actor %q, got %q", actorSystem, entry.Actor())
+	}
+}
@@ -0,0 +1,135 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entity тип сущности, изменение которой записано в журнал
+type Entity string
+
+const (
+	EntityContact Entity = "contact"
+	EntityGroup   Entity = "group"
+	EntityTag     Entity = "tag"
+)
+
+func (e Entity) String() string {
+	return string(e)
+}
+
+// Action вид изменения сущности
+type Action string
+
+const (
+	ActionCreate          Action = "create"
+	ActionUpdate          Action = "update"
+	ActionDelete          Action = "delete"
+	ActionRestore         Action = "restore"
+	ActionAddToGroup      Action = "addToGroup"
+	ActionRemoveFromGroup Action = "removeFromGroup"
+	ActionMerge           Action = "merge"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// actorSystem автор изменений, выполненных не по запросу пользователя
+const actorSystem = "system"
+
+// Entry запись журнала аудита
+type Entry struct {
+	id        uuid.UUID
+	createdAt time.Time
+
+	actor     string
+	requestID string
+	traceID   string
+
+	entity   Entity
+	entityID uuid.UUID
+	action   Action
+	changes  []Change
+}
+
+func NewWithID(
+	id uuid.UUID,
+	createdAt time.Time,
+	actor string,
+	requestID string,
+	traceID string,
+	entity Entity,
+	entityID uuid.UUID,
+	action Action,
+	changes []Change,
+) *Entry {
+	if id == uuid.Nil {
+		id = uuid.New()
+	}
+
+	if len(actor) == 0 {
+		actor = actorSystem
+	}
+
+	return &Entry{
+		id:        id,
+		createdAt: createdAt.UTC(),
+		actor:     actor,
+		requestID: requestID,
+		traceID:   traceID,
+		entity:    entity,
+		entityID:  entityID,
+		action:    action,
+		changes:   changes,
+	}
+}
+
+func New(
+	actor string,
+	requestID string,
+	traceID string,
+	entity Entity,
+	entityID uuid.UUID,
+	action Action,
+	changes []Change,
+) *Entry {
+	return NewWithID(uuid.New(), time.Now().UTC(), actor, requestID, traceID, entity, entityID, action, changes)
+}
+
+func (e Entry) ID() uuid.UUID {
+	return e.id
+}
+
+func (e Entry) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+func (e Entry) Actor() string {
+	return e.actor
+}
+
+func (e Entry) RequestID() string {
+	return e.requestID
+}
+
+func (e Entry) TraceID() string {
+	return e.traceID
+}
+
+func (e Entry) Entity() Entity {
+	return e.entity
+}
+
+func (e Entry) EntityID() uuid.UUID {
+	return e.entityID
+}
+
+func (e Entry) Action() Action {
+	return e.action
+}
+
+func (e Entry) Changes() []Change {
+	return e.changes
+}
@@ -0,0 +1,33 @@
+package address
+
+import "regexp"
+
+// postcodeFormats форматы почтовых индексов по коду страны. Для стран без формата проверяется только длина
+var postcodeFormats = map[string]*regexp.Regexp{
+	"RU": regexp.MustCompile(`^\d{6}$`),
+	"BY": regexp.MustCompile(`^\d{6}$`),
+	"KZ": regexp.MustCompile(`^(\d{6}|[A-Z]\d{2}[A-Z]\d[A-Z]\d)$`),
+	"UA": regexp.MustCompile(`^\d{5}$`),
+	"DE": regexp.MustCompile(`^\d{5}$`),
+	"FR": regexp.MustCompile(`^\d{5}$`),
+	"IT": regexp.MustCompile(`^\d{5}$`),
+	"ES": regexp.MustCompile(`^\d{5}$`),
+	"FI": regexp.MustCompile(`^\d{5}$`),
+	"PL": regexp.MustCompile(`^\d{2}-\d{3}$`),
+	"NL": regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`),
+	"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+	"CA": regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`),
+	"GB": regexp.MustCompile(`^([A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}|GIR ?0AA)$`),
+	"JP": regexp.MustCompile(`^\d{3}-?\d{4}$`),
+	"CN": regexp.MustCompile(`^\d{6}$`),
+	"IN": regexp.MustCompile(`^\d{6}$`),
+}
+
+// CheckPostcode проверяет индекс по формату страны
+func CheckPostcode(country, postcode string) bool {
+	format, ok := postcodeFormats[country]
+	if !ok {
+		return len([]rune(postcode)) <= MaxPostcodeLength
+	}
+	return format.MatchString(postcode)
+}
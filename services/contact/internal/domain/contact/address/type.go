@@ -0,0 +1,104 @@
+package address
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxLabelLength    = 50
+	MaxRegionLength   = 100
+	MaxCityLength     = 100
+	MaxStreetLength   = 250
+	MaxPostcodeLength = 20
+
+	ErrWrongCountry  = errors.New("country must be an ISO 3166-1 alpha-2 code")
+	ErrWrongLength   = errors.New("address field is too long")
+	ErrWrongPostcode = errors.New("postcode does not match the country format")
+
+	regexpCountry = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// Address почтовый адрес контакта. Метка (home, work) отличает адреса одного контакта
+type Address struct {
+	label    string
+	country  string
+	region   string
+	city     string
+	street   string
+	postcode string
+}
+
+func New(label, country, region, city, street, postcode string) (Address, error) {
+	var result = Address{
+		label:    strings.TrimSpace(label),
+		region:   strings.TrimSpace(region),
+		city:     strings.TrimSpace(city),
+		street:   strings.TrimSpace(street),
+		postcode: strings.ToUpper(strings.TrimSpace(postcode)),
+	}
+
+	countryCode, err := NewCountry(country)
+	if err != nil {
+		return Address{}, err
+	}
+	result.country = countryCode
+
+	for _, field := range []struct {
+		name      string
+		value     string
+		maxLength int
+	}{
+		{"label", result.label, MaxLabelLength},
+		{"region", result.region, MaxRegionLength},
+		{"city", result.city, MaxCityLength},
+		{"street", result.street, MaxStreetLength},
+		{"postcode", result.postcode, MaxPostcodeLength},
+	} {
+		if len([]rune(field.value)) > field.maxLength {
+			return Address{}, errors.WithMessagef(ErrWrongLength, "%s must be less than or equal to %d characters", field.name, field.maxLength)
+		}
+	}
+
+	if result.postcode != "" && !CheckPostcode(result.country, result.postcode) {
+		return Address{}, errors.WithMessagef(ErrWrongPostcode, "postcode %q, country %s", result.postcode, result.country)
+	}
+
+	return result, nil
+}
+
+// NewCountry приводит код страны к верхнему регистру и проверяет его
+func NewCountry(country string) (string, error) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if !regexpCountry.MatchString(country) {
+		return "", ErrWrongCountry
+	}
+	return country, nil
+}
+
+func (a Address) Label() string {
+	return a.label
+}
+
+// Country код страны ISO 3166-1 alpha-2
+func (a Address) Country() string {
+	return a.country
+}
+
+func (a Address) Region() string {
+	return a.region
+}
+
+func (a Address) City() string {
+	return a.city
+}
+
+func (a Address) Street() string {
+	return a.street
+}
+
+func (a Address) Postcode() string {
+	return a.postcode
+}
@@ -0,0 +1,31 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	assertion := assert.New(t)
+
+	value, err := New("home", "ru", "Московская область", "Москва", "ул. Ленина, 1", "101000")
+	assertion.NoError(err)
+	assertion.Equal("RU", value.Country())
+
+	value, err = New("work", "GB", "", "London", "10 Downing Street", "sw1a 2aa")
+	assertion.NoError(err)
+	assertion.Equal("SW1A 2AA", value.Postcode())
+
+	_, err = New("", "US", "", "", "", "90210-12")
+	assertion.ErrorIs(err, ErrWrongPostcode)
+
+	_, err = New("", "RU", "", "", "", "1010")
+	assertion.ErrorIs(err, ErrWrongPostcode)
+
+	_, err = New("", "XX", "", "", "", "anything-goes")
+	assertion.NoError(err)
+
+	_, err = New("", "Russia", "", "Москва", "", "")
+	assertion.ErrorIs(err, ErrWrongCountry)
+}
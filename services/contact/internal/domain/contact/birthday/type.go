@@ -0,0 +1,113 @@
+package birthday
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"architecture_go/services/contact/internal/domain/contact/age"
+)
+
+var (
+	Layout = "2006-01-02"
+
+	ErrWrongFormat = errors.Errorf("birthday must be a date in format %s", Layout)
+	ErrWrongDate   = errors.Errorf("birthday must not be in the future or more than %d years ago", age.MaxLength)
+)
+
+// Birthday дата рождения без времени. Пустое значение означает, что дата рождения не указана
+type Birthday struct {
+	value time.Time
+}
+
+func New(date time.Time) (Birthday, error) {
+	if date.IsZero() {
+		return Birthday{}, nil
+	}
+
+	var (
+		value = truncate(date)
+		today = truncate(time.Now())
+	)
+	if value.After(today) || value.Before(today.AddDate(-int(age.MaxLength), 0, 0)) {
+		return Birthday{}, ErrWrongDate
+	}
+
+	return Birthday{value: value}, nil
+}
+
+// Parse пустая строка означает, что дата рождения не указана
+func Parse(date string) (Birthday, error) {
+	if date == "" {
+		return Birthday{}, nil
+	}
+
+	value, err := time.Parse(Layout, date)
+	if err != nil {
+		return Birthday{}, ErrWrongFormat
+	}
+
+	return New(value)
+}
+
+func (b Birthday) Value() time.Time {
+	return b.value
+}
+
+func (b Birthday) String() string {
+	if b.IsEmpty() {
+		return ""
+	}
+	return b.value.Format(Layout)
+}
+
+func (b Birthday) IsEmpty() bool {
+	return b.value.IsZero()
+}
+
+// In день рождения в указанном году. В невисокосный год день рождения 29 февраля отмечается 28 февраля
+func (b Birthday) In(year int) time.Time {
+	var (
+		month = b.value.Month()
+		day   = b.value.Day()
+	)
+	if month == time.February && day == 29 && !IsLeap(year) {
+		day = 28
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Next ближайший день рождения начиная с даты from включительно
+func (b Birthday) Next(from time.Time) time.Time {
+	from = truncate(from)
+	if next := b.In(from.Year()); !next.Before(from) {
+		return next
+	}
+	return b.In(from.Year() + 1)
+}
+
+// AgeAt полных лет на дату date
+func (b Birthday) AgeAt(date time.Time) age.Age {
+	date = truncate(date)
+
+	years := date.Year() - b.value.Year()
+	if date.Before(b.In(date.Year())) {
+		years--
+	}
+
+	switch {
+	case years < 0:
+		return 0
+	case years > int(age.MaxLength):
+		return age.Age(age.MaxLength)
+	}
+	return age.Age(years)
+}
+
+func IsLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func truncate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,39 @@
+package birthday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"architecture_go/services/contact/internal/domain/contact/age"
+)
+
+func TestBirthday(t *testing.T) {
+	assertion := assert.New(t)
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	leapDay, err := Parse("2000-02-29")
+	assertion.NoError(err)
+
+	assertion.Equal(age.Age(22), leapDay.AgeAt(date(2023, time.February, 27)))
+	assertion.Equal(age.Age(23), leapDay.AgeAt(date(2023, time.February, 28)))
+	assertion.Equal(age.Age(23), leapDay.AgeAt(date(2024, time.February, 28)))
+	assertion.Equal(age.Age(24), leapDay.AgeAt(date(2024, time.February, 29)))
+
+	assertion.Equal(date(2023, time.February, 28), leapDay.Next(date(2023, time.January, 1)))
+	assertion.Equal(date(2024, time.February, 29), leapDay.Next(date(2023, time.March, 1)))
+
+	_, err = Parse("17.05.1990")
+	assertion.ErrorIs(err, ErrWrongFormat)
+
+	_, err = New(time.Now().AddDate(0, 0, 2))
+	assertion.ErrorIs(err, ErrWrongDate)
+
+	empty, err := Parse("")
+	assertion.NoError(err)
+	assertion.True(empty.IsEmpty())
+}
@@ -0,0 +1,50 @@
+package employment
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxJobTitleLength = 250
+	ErrWrongLength    = errors.Errorf("job title must be less than or equal to %d characters", MaxJobTitleLength)
+)
+
+// Employment место работы контакта: организация и должность. Пустое значение -- место работы не указано
+type Employment struct {
+	organizationID   uuid.UUID
+	organizationName string
+	jobTitle         string
+}
+
+func New(organizationID uuid.UUID, jobTitle string) (Employment, error) {
+	jobTitle = strings.TrimSpace(jobTitle)
+	if len([]rune(jobTitle)) > MaxJobTitleLength {
+		return Employment{}, ErrWrongLength
+	}
+	return Employment{organizationID: organizationID, jobTitle: jobTitle}, nil
+}
+
+// WithOrganizationName название организации заполняется при чтении и не сохраняется вместе с контактом
+func (e Employment) WithOrganizationName(name string) Employment {
+	e.organizationName = name
+	return e
+}
+
+func (e Employment) OrganizationID() uuid.UUID {
+	return e.organizationID
+}
+
+func (e Employment) OrganizationName() string {
+	return e.organizationName
+}
+
+func (e Employment) JobTitle() string {
+	return e.jobTitle
+}
+
+func (e Employment) HasOrganization() bool {
+	return e.organizationID != uuid.Nil
+}
@@ -0,0 +1,79 @@
+package photo
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxWidth  = 8000
+	MaxHeight = 8000
+
+	ErrUnsupportedType = errors.New("photo must be a JPEG, PNG, GIF or WebP image")
+	ErrWrongDimensions = errors.Errorf("photo must not be larger than %dx%d pixels", MaxWidth, MaxHeight)
+)
+
+// supportedTypes типы изображений, для которых строится миниатюра
+var supportedTypes = map[string]struct{}{
+	"image/jpeg": {},
+	"image/png":  {},
+	"image/gif":  {},
+	"image/webp": {},
+}
+
+// Photo описание фотографии контакта, само изображение хранится в хранилище файлов
+type Photo struct {
+	contentType string
+	size        int
+	width       int
+	height      int
+	uploadedAt  time.Time
+}
+
+func New(contentType string, size, width, height int, uploadedAt time.Time) (Photo, error) {
+	if !IsSupported(contentType) {
+		return Photo{}, ErrUnsupportedType
+	}
+
+	if width <= 0 || height <= 0 || width > MaxWidth || height > MaxHeight {
+		return Photo{}, ErrWrongDimensions
+	}
+
+	return Photo{
+		contentType: contentType,
+		size:        size,
+		width:       width,
+		height:      height,
+		uploadedAt:  uploadedAt.UTC(),
+	}, nil
+}
+
+func IsSupported(contentType string) bool {
+	_, ok := supportedTypes[contentType]
+	return ok
+}
+
+func (p Photo) ContentType() string {
+	return p.contentType
+}
+
+func (p Photo) Size() int {
+	return p.size
+}
+
+func (p Photo) Width() int {
+	return p.width
+}
+
+func (p Photo) Height() int {
+	return p.height
+}
+
+func (p Photo) UploadedAt() time.Time {
+	return p.uploadedAt
+}
+
+func (p Photo) IsEmpty() bool {
+	return p.contentType == ""
+}
@@ -10,10 +10,16 @@ import (
 	"architecture_go/pkg/type/email"
 	"architecture_go/pkg/type/gender"
 	"architecture_go/pkg/type/phoneNumber"
+	"architecture_go/services/contact/internal/domain/contact/address"
 	"architecture_go/services/contact/internal/domain/contact/age"
+	"architecture_go/services/contact/internal/domain/contact/birthday"
+	"architecture_go/services/contact/internal/domain/contact/employment"
 	"architecture_go/services/contact/internal/domain/contact/name"
 	"architecture_go/services/contact/internal/domain/contact/patronymic"
+	"architecture_go/services/contact/internal/domain/contact/photo"
 	"architecture_go/services/contact/internal/domain/contact/surname"
+	"architecture_go/services/contact/internal/domain/customField"
+	tagName "architecture_go/services/contact/internal/domain/tag/name"
 )
 
 var (
@@ -32,9 +38,23 @@ type Contact struct {
 	surname    surname.Surname
 	patronymic patronymic.Patronymic
 
-	age age.Age
+	age      age.Age
+	birthday birthday.Birthday
 
 	gender gender.Gender
+
+	addresses []address.Address
+
+	employment employment.Employment
+
+	photo photo.Photo
+
+	customFields customField.Values
+
+	tags []tagName.Name
+
+	// owner субъект или команда, пустая строка -- контакт общий
+	owner string
 }
 
 func NewWithID(
@@ -137,14 +157,88 @@ func (c Contact) FullName() string {
 	return fmt.Sprintf("%s %s %s", c.surname, c.name, c.patronymic)
 }
 
+// Age возраст вычисляется по дате рождения, сохранённый возраст используется, если дата рождения не указана
 func (c Contact) Age() age.Age {
+	if !c.birthday.IsEmpty() {
+		return c.birthday.AgeAt(time.Now())
+	}
 	return c.age
 }
 
+func (c Contact) Birthday() birthday.Birthday {
+	return c.birthday
+}
+
+func (c *Contact) SetBirthday(birthday birthday.Birthday) {
+	c.birthday = birthday
+}
+
 func (c Contact) Gender() gender.Gender {
 	return c.gender
 }
 
+func (c Contact) Addresses() []address.Address {
+	return c.addresses
+}
+
+func (c *Contact) SetAddresses(addresses ...address.Address) {
+	c.addresses = addresses
+}
+
+func (c Contact) Employment() employment.Employment {
+	return c.employment
+}
+
+func (c *Contact) SetEmployment(employment employment.Employment) {
+	c.employment = employment
+}
+
+// Photo фотография контакта, изменяется отдельно от контакта
+func (c Contact) Photo() photo.Photo {
+	return c.photo
+}
+
+func (c *Contact) SetPhoto(photo photo.Photo) {
+	c.photo = photo
+}
+
+func (c Contact) CustomFields() customField.Values {
+	return c.customFields
+}
+
+// SetCustomFields значения проверяются методом CheckCustomFields перед сохранением
+func (c *Contact) SetCustomFields(values customField.Values) {
+	c.customFields = values
+}
+
+// CheckCustomFields проверяет значения дополнительных полей по реестру и приводит их к каноничному виду
+func (c *Contact) CheckCustomFields(registry customField.Registry) error {
+	values, err := registry.Validate(c.customFields)
+	if err != nil {
+		return err
+	}
+	c.customFields = values
+	return nil
+}
+
+// Tags теги контакта, изменяются отдельно от контакта
+func (c Contact) Tags() []tagName.Name {
+	return c.tags
+}
+
+func (c *Contact) SetTags(tags ...tagName.Name) {
+	c.tags = tags
+}
+
+// Owner владелец контакта, меняется отдельно от контакта
+func (c Contact) Owner() string {
+	return c.owner
+}
+
+func (c *Contact) SetOwner(owner string) {
+	c.owner = owner
+}
+
 func (c Contact) Equal(contact Contact) bool {
 	return c.id == contact.id
 }
@@ -0,0 +1,69 @@
+package fieldType
+
+import "github.com/pkg/errors"
+
+var (
+	ErrUnknown = errors.New("type must be one of: string, number, date, enum, bool")
+)
+
+type FieldType uint8
+
+const (
+	UNKNOWN FieldType = 0
+	STRING  FieldType = 1
+	NUMBER  FieldType = 2
+	DATE    FieldType = 3
+	ENUM    FieldType = 4
+	BOOL    FieldType = 5
+)
+
+func New(fieldType uint8) FieldType {
+	switch FieldType(fieldType) {
+	case STRING, NUMBER, DATE, ENUM, BOOL:
+		return FieldType(fieldType)
+	default:
+		return UNKNOWN
+	}
+}
+
+func Parse(fieldType string) (FieldType, error) {
+	switch fieldType {
+	case "string":
+		return STRING, nil
+	case "number":
+		return NUMBER, nil
+	case "date":
+		return DATE, nil
+	case "enum":
+		return ENUM, nil
+	case "bool":
+		return BOOL, nil
+	default:
+		return UNKNOWN, ErrUnknown
+	}
+}
+
+func (f FieldType) String() string {
+	switch f {
+	case STRING:
+		return "string"
+	case NUMBER:
+		return "number"
+	case DATE:
+		return "date"
+	case ENUM:
+		return "enum"
+	case BOOL:
+		return "bool"
+	default:
+		return "unknown"
+	}
+}
+
+func (f FieldType) Number() uint8 {
+	return uint8(f)
+}
+
+func (f FieldType) IsEmpty() bool {
+	return f == UNKNOWN
+}
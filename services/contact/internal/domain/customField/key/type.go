@@ -0,0 +1,27 @@
+package key
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxLength      = 50
+	ErrWrongFormat = errors.Errorf("key must start with a lowercase latin letter, contain only latin letters, digits or '_' and be less than or equal to %d characters", MaxLength)
+
+	regexpKey = regexp.MustCompile(`^[a-z][a-zA-Z0-9_]*$`)
+)
+
+type Key string
+
+func (k Key) String() string {
+	return string(k)
+}
+
+func New(key string) (Key, error) {
+	if len(key) > MaxLength || !regexpKey.MatchString(key) {
+		return "", ErrWrongFormat
+	}
+	return Key(key), nil
+}
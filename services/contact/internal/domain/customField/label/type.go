@@ -0,0 +1,23 @@
+package label
+
+import "github.com/pkg/errors"
+
+var (
+	MaxLength      = 250
+	ErrWrongLength = errors.Errorf("label must be less than or equal to %d characters", MaxLength)
+)
+
+type Label struct {
+	value string
+}
+
+func New(label string) (Label, error) {
+	if len([]rune(label)) > MaxLength {
+		return Label{}, ErrWrongLength
+	}
+	return Label{value: label}, nil
+}
+
+func (l Label) Value() string {
+	return l.value
+}
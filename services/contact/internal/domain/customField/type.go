@@ -0,0 +1,132 @@
+package customField
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"architecture_go/services/contact/internal/domain/customField/fieldType"
+	"architecture_go/services/contact/internal/domain/customField/key"
+	"architecture_go/services/contact/internal/domain/customField/label"
+)
+
+var (
+	ErrOptionsRequired   = errors.New("options are required for enum field")
+	ErrOptionsNotAllowed = errors.New("options are allowed only for enum field")
+)
+
+// CustomField
+// Описание дополнительного поля контакта, заданного администратором.
+type CustomField struct {
+	id         uuid.UUID
+	createdAt  time.Time
+	modifiedAt time.Time
+
+	key       key.Key
+	label     label.Label
+	fieldType fieldType.FieldType
+	required  bool
+	options   []string
+}
+
+func NewWithID(
+	id uuid.UUID,
+	createdAt time.Time,
+	modifiedAt time.Time,
+	key key.Key,
+	label label.Label,
+	fieldType fieldType.FieldType,
+	required bool,
+	options []string,
+) (*CustomField, error) {
+
+	if err := checkOptions(fieldType, options); err != nil {
+		return nil, err
+	}
+
+	if id == uuid.Nil {
+		id = uuid.New()
+	}
+
+	return &CustomField{
+		id:         id,
+		createdAt:  createdAt.UTC(),
+		modifiedAt: modifiedAt.UTC(),
+		key:        key,
+		label:      label,
+		fieldType:  fieldType,
+		required:   required,
+		options:    options,
+	}, nil
+}
+
+func New(
+	key key.Key,
+	label label.Label,
+	fieldType fieldType.FieldType,
+	required bool,
+	options []string,
+) (*CustomField, error) {
+
+	if err := checkOptions(fieldType, options); err != nil {
+		return nil, err
+	}
+
+	var timeNow = time.Now().UTC()
+	return &CustomField{
+		id:         uuid.New(),
+		createdAt:  timeNow,
+		modifiedAt: timeNow,
+		key:        key,
+		label:      label,
+		fieldType:  fieldType,
+		required:   required,
+		options:    options,
+	}, nil
+}
+
+func checkOptions(ft fieldType.FieldType, options []string) error {
+	if ft.IsEmpty() {
+		return fieldType.ErrUnknown
+	}
+	if ft == fieldType.ENUM && len(options) == 0 {
+		return ErrOptionsRequired
+	}
+	if ft != fieldType.ENUM && len(options) > 0 {
+		return ErrOptionsNotAllowed
+	}
+	return nil
+}
+
+func (f CustomField) ID() uuid.UUID {
+	return f.id
+}
+
+func (f CustomField) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+func (f CustomField) ModifiedAt() time.Time {
+	return f.modifiedAt
+}
+
+func (f CustomField) Key() key.Key {
+	return f.key
+}
+
+func (f CustomField) Label() label.Label {
+	return f.label
+}
+
+func (f CustomField) Type() fieldType.FieldType {
+	return f.fieldType
+}
+
+func (f CustomField) Required() bool {
+	return f.required
+}
+
+func (f CustomField) Options() []string {
+	return f.options
+}
@@ -0,0 +1,121 @@
+package customField
+
+import (
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"architecture_go/services/contact/internal/domain/customField/fieldType"
+)
+
+var (
+	MaxStringLength = 1000
+	DateLayout      = "2006-01-02"
+
+	ErrUnknownField  = errors.New("unknown custom field")
+	ErrRequiredField = errors.New("custom field is required")
+	ErrWrongValue    = errors.New("wrong custom field value")
+)
+
+// Values значения дополнительных полей контакта map[key]value
+type Values map[string]interface{}
+
+// Validate проверяет значение по описанию поля и возвращает его в каноничном виде:
+// string -- строка, number -- float64, date -- строка в формате DateLayout, enum -- строка из options, bool -- bool.
+func (f CustomField) Validate(value interface{}) (interface{}, error) {
+	switch f.fieldType {
+	case fieldType.STRING:
+		if str, ok := value.(string); ok && len([]rune(str)) <= MaxStringLength {
+			return str, nil
+		}
+	case fieldType.NUMBER:
+		if number, ok := toNumber(value); ok {
+			return number, nil
+		}
+	case fieldType.DATE:
+		if str, ok := value.(string); ok {
+			if date, err := time.Parse(DateLayout, str); err == nil {
+				return date.Format(DateLayout), nil
+			}
+		}
+	case fieldType.ENUM:
+		if str, ok := value.(string); ok {
+			for _, option := range f.options {
+				if option == str {
+					return str, nil
+				}
+			}
+		}
+	case fieldType.BOOL:
+		if flag, ok := value.(bool); ok {
+			return flag, nil
+		}
+	}
+	return nil, errors.WithMessagef(ErrWrongValue, "field %q expects %s", f.key, f.fieldType)
+}
+
+func toNumber(value interface{}) (float64, bool) {
+	var number float64
+	switch v := value.(type) {
+	case float64:
+		number = v
+	case float32:
+		number = float64(v)
+	case int:
+		number = float64(v)
+	case int64:
+		number = float64(v)
+	case uint64:
+		number = float64(v)
+	default:
+		return 0, false
+	}
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, false
+	}
+	return number, true
+}
+
+// Registry реестр действующих описаний дополнительных полей
+type Registry []*CustomField
+
+func (r Registry) Get(key string) (*CustomField, bool) {
+	for _, field := range r {
+		if field.key.String() == key {
+			return field, true
+		}
+	}
+	return nil, false
+}
+
+// Validate проверяет значения по реестру: неизвестные поля и отсутствующие обязательные поля
+// считаются ошибкой, значения null удаляются.
+func (r Registry) Validate(values Values) (Values, error) {
+	var result = make(Values, len(values))
+
+	for name, value := range values {
+		if value == nil {
+			continue
+		}
+
+		field, ok := r.Get(name)
+		if !ok {
+			return nil, errors.WithMessagef(ErrUnknownField, "field %q", name)
+		}
+
+		normalized, err := field.Validate(value)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = normalized
+	}
+
+	for _, field := range r {
+		if _, ok := result[field.key.String()]; field.required && !ok {
+			return nil, errors.WithMessagef(ErrRequiredField, "field %q", field.key)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package customField
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"architecture_go/services/contact/internal/domain/customField/fieldType"
+	"architecture_go/services/contact/internal/domain/customField/key"
+	"architecture_go/services/contact/internal/domain/customField/label"
+)
+
+func newTestField(t *testing.T, name string, ft fieldType.FieldType, required bool, options ...string) *CustomField {
+	fieldKey, err := key.New(name)
+	assert.NoError(t, err)
+	fieldLabel, err := label.New(name)
+	assert.NoError(t, err)
+	field, err := New(fieldKey, fieldLabel, ft, required, options)
+	assert.NoError(t, err)
+	return field
+}
+
+func TestRegistryValidate(t *testing.T) {
+	assertion := assert.New(t)
+
+	registry := Registry{
+		newTestField(t, "crmId", fieldType.STRING, true),
+		newTestField(t, "score", fieldType.NUMBER, false),
+		newTestField(t, "since", fieldType.DATE, false),
+		newTestField(t, "lang", fieldType.ENUM, false, "ru", "en"),
+		newTestField(t, "vip", fieldType.BOOL, false),
+	}
+
+	t.Run("normalize", func(t *testing.T) {
+		values, err := registry.Validate(Values{
+			"crmId": "A-1",
+			"score": 10,
+			"since": "2023-08-01",
+			"lang":  "ru",
+			"vip":   true,
+			"extra": nil,
+		})
+		assertion.NoError(err)
+		assertion.Equal(Values{
+			"crmId": "A-1",
+			"score": float64(10),
+			"since": "2023-08-01",
+			"lang":  "ru",
+			"vip":   true,
+		}, values)
+	})
+
+	t.Run("required", func(t *testing.T) {
+		_, err := registry.Validate(Values{"score": 1.5})
+		assertion.ErrorIs(err, ErrRequiredField)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := registry.Validate(Values{"crmId": "A-1", "other": "x"})
+		assertion.ErrorIs(err, ErrUnknownField)
+	})
+
+	t.Run("wrong value", func(t *testing.T) {
+		for _, values := range []Values{
+			{"crmId": 1},
+			{"crmId": "A-1", "since": "01.08.2023"},
+			{"crmId": "A-1", "lang": "de"},
+			{"crmId": "A-1", "vip": "yes"},
+		} {
+			_, err := registry.Validate(values)
+			assertion.ErrorIs(err, ErrWrongValue)
+		}
+	})
+}
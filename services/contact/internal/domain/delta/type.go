@@ -0,0 +1,120 @@
+package delta
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/group"
+)
+
+var (
+	ErrWrongToken = errors.New("sync token is not valid")
+	// ErrTokenExpired по токену нельзя продолжить: клиенту нужна полная синхронизация
+	ErrTokenExpired = errors.New("sync token expired, start over with a full sync")
+)
+
+// Token позиция клиента в последовательности изменений. Для клиента значение непрозрачно,
+// нулевой токен -- полная синхронизация
+type Token int64
+
+func ParseToken(value string) (Token, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number < 0 {
+		return 0, ErrWrongToken
+	}
+
+	return Token(number), nil
+}
+
+func (t Token) String() string {
+	return strconv.FormatInt(int64(t), 10)
+}
+
+// Kind вид удалённой записи
+type Kind string
+
+const (
+	KindContact Kind = "contact"
+	KindGroup   Kind = "group"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// Tombstone запись, отправленная в архив после токена клиента
+type Tombstone struct {
+	kind       Kind
+	id         uuid.UUID
+	archivedAt time.Time
+}
+
+func NewTombstone(kind Kind, id uuid.UUID, archivedAt time.Time) *Tombstone {
+	return &Tombstone{
+		kind:       kind,
+		id:         id,
+		archivedAt: archivedAt.UTC(),
+	}
+}
+
+func (t Tombstone) Kind() Kind {
+	return t.kind
+}
+
+func (t Tombstone) ID() uuid.UUID {
+	return t.id
+}
+
+func (t Tombstone) ArchivedAt() time.Time {
+	return t.archivedAt
+}
+
+// Delta страница изменений: текущее состояние созданных и изменённых записей и
+// надгробия удалённых. Каждая запись попадает в страницу один раз, в последнем состоянии.
+type Delta struct {
+	contacts   []*contact.Contact
+	groups     []*group.Group
+	tombstones []*Tombstone
+	token      Token
+	hasMore    bool
+}
+
+func New(contacts []*contact.Contact, groups []*group.Group, tombstones []*Tombstone, token Token, hasMore bool) *Delta {
+	return &Delta{
+		contacts:   contacts,
+		groups:     groups,
+		tombstones: tombstones,
+		token:      token,
+		hasMore:    hasMore,
+	}
+}
+
+func (d Delta) Contacts() []*contact.Contact {
+	return d.contacts
+}
+
+func (d Delta) Groups() []*group.Group {
+	return d.groups
+}
+
+func (d Delta) Tombstones() []*Tombstone {
+	return d.tombstones
+}
+
+// Token токен для следующего запроса
+func (d Delta) Token() Token {
+	return d.token
+}
+
+// HasMore изменения после токена не поместились в страницу, следующую нужно запросить сразу
+func (d Delta) HasMore() bool {
+	return d.hasMore
+}
@@ -0,0 +1,25 @@
+package delta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseToken(t *testing.T) {
+	assertion := assert.New(t)
+
+	token, err := ParseToken("")
+	assertion.NoError(err)
+	assertion.Equal(Token(0), token)
+
+	token, err = ParseToken("1024")
+	assertion.NoError(err)
+	assertion.Equal(Token(1024), token)
+	assertion.Equal("1024", token.String())
+
+	for _, value := range []string{"-1", "abc", "1.5"} {
+		_, err = ParseToken(value)
+		assertion.ErrorIs(err, ErrWrongToken, value)
+	}
+}
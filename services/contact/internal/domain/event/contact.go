@@ -0,0 +1,78 @@
+package event
+
+import (
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/contact"
+)
+
+type ContactCreated struct {
+	base
+	contact *contact.Contact
+}
+
+func NewContactCreated(value *contact.Contact) *ContactCreated {
+	return &ContactCreated{
+		base:    newBase(NameContactCreated, value.ID()),
+		contact: value,
+	}
+}
+
+func (e ContactCreated) Contact() *contact.Contact {
+	return e.contact
+}
+
+// ContactUpdated изменение полей, тегов или возврат контакта к версии
+type ContactUpdated struct {
+	base
+	contact *contact.Contact
+}
+
+func NewContactUpdated(value *contact.Contact) *ContactUpdated {
+	return &ContactUpdated{
+		base:    newBase(NameContactUpdated, value.ID()),
+		contact: value,
+	}
+}
+
+func (e ContactUpdated) Contact() *contact.Contact {
+	return e.contact
+}
+
+// ContactArchived контакт удалён, данные остаются в архиве
+type ContactArchived struct {
+	base
+}
+
+func NewContactArchived(contactID uuid.UUID) *ContactArchived {
+	return &ContactArchived{
+		base: newBase(NameContactArchived, contactID),
+	}
+}
+
+type ContactRestored struct {
+	base
+	contact *contact.Contact
+}
+
+func NewContactRestored(value *contact.Contact) *ContactRestored {
+	return &ContactRestored{
+		base:    newBase(NameContactRestored, value.ID()),
+		contact: value,
+	}
+}
+
+func (e ContactRestored) Contact() *contact.Contact {
+	return e.contact
+}
+
+// ContactAccessChanged изменились владелец контакта или записи доступа к нему
+type ContactAccessChanged struct {
+	base
+}
+
+func NewContactAccessChanged(contactID uuid.UUID) *ContactAccessChanged {
+	return &ContactAccessChanged{
+		base: newBase(NameContactAccessChanged, contactID),
+	}
+}
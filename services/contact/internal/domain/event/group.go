@@ -0,0 +1,104 @@
+package event
+
+import (
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/group"
+)
+
+type GroupCreated struct {
+	base
+	group *group.Group
+}
+
+func NewGroupCreated(value *group.Group) *GroupCreated {
+	return &GroupCreated{
+		base:  newBase(NameGroupCreated, value.ID()),
+		group: value,
+	}
+}
+
+func (e GroupCreated) Group() *group.Group {
+	return e.group
+}
+
+type GroupUpdated struct {
+	base
+	group *group.Group
+}
+
+func NewGroupUpdated(value *group.Group) *GroupUpdated {
+	return &GroupUpdated{
+		base:  newBase(NameGroupUpdated, value.ID()),
+		group: value,
+	}
+}
+
+func (e GroupUpdated) Group() *group.Group {
+	return e.group
+}
+
+// GroupArchived группа удалена, контакты исключены из неё
+type GroupArchived struct {
+	base
+}
+
+func NewGroupArchived(groupID uuid.UUID) *GroupArchived {
+	return &GroupArchived{
+		base: newBase(NameGroupArchived, groupID),
+	}
+}
+
+// ContactAddedToGroup событие группы, AggregateID -- идентификатор группы
+type ContactAddedToGroup struct {
+	base
+	contactID uuid.UUID
+}
+
+func NewContactAddedToGroup(groupID, contactID uuid.UUID) *ContactAddedToGroup {
+	return &ContactAddedToGroup{
+		base:      newBase(NameContactAddedToGroup, groupID),
+		contactID: contactID,
+	}
+}
+
+func (e ContactAddedToGroup) GroupID() uuid.UUID {
+	return e.aggregateID
+}
+
+func (e ContactAddedToGroup) ContactID() uuid.UUID {
+	return e.contactID
+}
+
+// ContactRemovedFromGroup событие группы, AggregateID -- идентификатор группы
+type ContactRemovedFromGroup struct {
+	base
+	contactID uuid.UUID
+}
+
+func NewContactRemovedFromGroup(groupID, contactID uuid.UUID) *ContactRemovedFromGroup {
+	return &ContactRemovedFromGroup{
+		base:      newBase(NameContactRemovedFromGroup, groupID),
+		contactID: contactID,
+	}
+}
+
+func (e ContactRemovedFromGroup) GroupID() uuid.UUID {
+	return e.aggregateID
+}
+
+func (e ContactRemovedFromGroup) ContactID() uuid.UUID {
+	return e.contactID
+}
+
+// GroupAccessChanged изменились владелец группы, записи доступа к ней или её список доступа.
+// Вместе с группой меняется видимость её контактов
+type GroupAccessChanged struct {
+	base
+}
+
+func NewGroupAccessChanged(groupID uuid.UUID) *GroupAccessChanged {
+	return &GroupAccessChanged{
+		base: newBase(NameGroupAccessChanged, groupID),
+	}
+}
@@ -0,0 +1,93 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Name название события, по нему подписчики выбирают интересующие их события
+type Name string
+
+const (
+	NameContactCreated          Name = "contact.created"
+	NameContactUpdated          Name = "contact.updated"
+	NameContactArchived         Name = "contact.archived"
+	NameContactRestored         Name = "contact.restored"
+	NameContactAccessChanged    Name = "contact.accessChanged"
+	NameGroupCreated            Name = "group.created"
+	NameGroupUpdated            Name = "group.updated"
+	NameGroupArchived           Name = "group.archived"
+	NameContactAddedToGroup     Name = "group.contactAdded"
+	NameContactRemovedFromGroup Name = "group.contactRemoved"
+	NameGroupAccessChanged      Name = "group.accessChanged"
+)
+
+// Names все события, на которые можно подписаться
+var Names = []Name{
+	NameContactCreated,
+	NameContactUpdated,
+	NameContactArchived,
+	NameContactRestored,
+	NameContactAccessChanged,
+	NameGroupCreated,
+	NameGroupUpdated,
+	NameGroupArchived,
+	NameContactAddedToGroup,
+	NameContactRemovedFromGroup,
+	NameGroupAccessChanged,
+}
+
+func (n Name) String() string {
+	return string(n)
+}
+
+func (n Name) IsValid() bool {
+	for _, name := range Names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Event доменное событие, возникающее после успешного изменения агрегата
+type Event interface {
+	ID() uuid.UUID
+	Name() Name
+	// AggregateID идентификатор контакта или группы, с которыми произошло событие
+	AggregateID() uuid.UUID
+	OccurredAt() time.Time
+}
+
+type base struct {
+	id          uuid.UUID
+	name        Name
+	aggregateID uuid.UUID
+	occurredAt  time.Time
+}
+
+func newBase(name Name, aggregateID uuid.UUID) base {
+	return base{
+		id:          uuid.New(),
+		name:        name,
+		aggregateID: aggregateID,
+		occurredAt:  time.Now().UTC(),
+	}
+}
+
+func (b base) ID() uuid.UUID {
+	return b.id
+}
+
+func (b base) Name() Name {
+	return b.name
+}
+
+func (b base) AggregateID() uuid.UUID {
+	return b.aggregateID
+}
+
+func (b base) OccurredAt() time.Time {
+	return b.occurredAt
+}
@@ -0,0 +1,101 @@
+package acl
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"architecture_go/pkg/type/principal"
+)
+
+// Level уровень доступа к группе
+type Level string
+
+const (
+	// LevelEditor изменение группы и её состава
+	LevelEditor Level = "editor"
+	// LevelAdmin вдобавок удаление группы и управление её списком доступа
+	LevelAdmin Level = "admin"
+)
+
+// RolePrefix отличает в записи роль от субъекта: "role:sales" -- все, у кого есть роль sales
+const RolePrefix = "role:"
+
+var (
+	MaxGranteeLength = 250
+
+	ErrWrongGrantee = errors.Errorf("grantee must be from 1 to %d characters", MaxGranteeLength)
+	ErrWrongLevel   = errors.Errorf("level must be %s or %s", LevelEditor, LevelAdmin)
+)
+
+func (l Level) String() string {
+	return string(l)
+}
+
+func (l Level) IsValid() bool {
+	return l == LevelEditor || l == LevelAdmin
+}
+
+// Allows уровень l не ниже required
+func (l Level) Allows(required Level) bool {
+	return l == LevelAdmin || l == required
+}
+
+// Entry запись списка доступа группы. Пока у группы нет записей, ею управляют по глобальным ролям;
+// с первой записью изменять группу могут только перечисленные в списке и администраторы сервиса.
+type Entry struct {
+	groupID uuid.UUID
+	// grantee субъект или роль с префиксом RolePrefix
+	grantee   string
+	level     Level
+	createdAt time.Time
+}
+
+func NewWithID(groupID uuid.UUID, grantee string, level Level, createdAt time.Time) *Entry {
+	return &Entry{
+		groupID:   groupID,
+		grantee:   grantee,
+		level:     level,
+		createdAt: createdAt.UTC(),
+	}
+}
+
+func New(groupID uuid.UUID, grantee string, level Level) (*Entry, error) {
+	grantee = strings.TrimSpace(grantee)
+	if grantee == "" || grantee == RolePrefix || utf8.RuneCountInString(grantee) > MaxGranteeLength {
+		return nil, ErrWrongGrantee
+	}
+
+	if !level.IsValid() {
+		return nil, ErrWrongLevel
+	}
+
+	return NewWithID(groupID, grantee, level, time.Now()), nil
+}
+
+func (e Entry) GroupID() uuid.UUID {
+	return e.groupID
+}
+
+func (e Entry) Grantee() string {
+	return e.grantee
+}
+
+func (e Entry) Level() Level {
+	return e.level
+}
+
+func (e Entry) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// Matches запись относится к субъекту p или к одной из его ролей
+func (e Entry) Matches(p principal.Principal) bool {
+	if role := strings.TrimPrefix(e.grantee, RolePrefix); role != e.grantee {
+		return p.HasRole(role)
+	}
+	return e.grantee == p.Subject
+}
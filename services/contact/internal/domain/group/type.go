@@ -16,6 +16,8 @@ type Group struct {
 	name         name.Name
 	description  description.Description
 	contactCount uint64
+	// owner субъект или команда, пустая строка -- группа общая
+	owner string
 }
 
 func NewWithID(id uuid.UUID, createdAt time.Time, modifiedAt time.Time, name name.Name, description description.Description, contactCount uint64) *Group {
@@ -44,6 +46,15 @@ func (g Group) ContactCount() uint64 {
 	return g.contactCount
 }
 
+// Owner владелец группы, меняется отдельно от группы
+func (g Group) Owner() string {
+	return g.owner
+}
+
+func (g *Group) SetOwner(owner string) {
+	g.owner = owner
+}
+
 func (g Group) ID() uuid.UUID {
 	return g.id
 }
@@ -0,0 +1,28 @@
+package author
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxLength      = 100
+	ErrWrongLength = errors.Errorf("author must not be empty and must be less than or equal to %d characters", MaxLength)
+)
+
+type Author struct {
+	value string
+}
+
+func New(author string) (Author, error) {
+	author = strings.TrimSpace(author)
+	if length := len([]rune(author)); length == 0 || length > MaxLength {
+		return Author{}, ErrWrongLength
+	}
+	return Author{value: author}, nil
+}
+
+func (a Author) Value() string {
+	return a.value
+}
@@ -0,0 +1,27 @@
+package body
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxLength      = 10000
+	ErrWrongLength = errors.Errorf("body must not be empty and must be less than or equal to %d characters", MaxLength)
+)
+
+type Body struct {
+	value string
+}
+
+func New(body string) (Body, error) {
+	if length := len([]rune(strings.TrimSpace(body))); length == 0 || length > MaxLength {
+		return Body{}, ErrWrongLength
+	}
+	return Body{value: body}, nil
+}
+
+func (b Body) Value() string {
+	return b.value
+}
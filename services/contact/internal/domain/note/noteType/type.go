@@ -0,0 +1,64 @@
+package noteType
+
+import "github.com/pkg/errors"
+
+var (
+	ErrUnknown = errors.New("type must be one of: call, email, meeting, note")
+)
+
+type NoteType uint8
+
+const (
+	UNKNOWN NoteType = 0
+	CALL    NoteType = 1
+	EMAIL   NoteType = 2
+	MEETING NoteType = 3
+	NOTE    NoteType = 4
+)
+
+func New(noteType uint8) NoteType {
+	switch NoteType(noteType) {
+	case CALL, EMAIL, MEETING, NOTE:
+		return NoteType(noteType)
+	default:
+		return UNKNOWN
+	}
+}
+
+func Parse(noteType string) (NoteType, error) {
+	switch noteType {
+	case "call":
+		return CALL, nil
+	case "email":
+		return EMAIL, nil
+	case "meeting":
+		return MEETING, nil
+	case "note":
+		return NOTE, nil
+	default:
+		return UNKNOWN, ErrUnknown
+	}
+}
+
+func (n NoteType) String() string {
+	switch n {
+	case CALL:
+		return "call"
+	case EMAIL:
+		return "email"
+	case MEETING:
+		return "meeting"
+	case NOTE:
+		return "note"
+	default:
+		return "unknown"
+	}
+}
+
+func (n NoteType) Number() uint8 {
+	return uint8(n)
+}
+
+func (n NoteType) IsEmpty() bool {
+	return n == UNKNOWN
+}
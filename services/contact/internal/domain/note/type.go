@@ -0,0 +1,107 @@
+package note
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"architecture_go/services/contact/internal/domain/note/author"
+	"architecture_go/services/contact/internal/domain/note/body"
+	"architecture_go/services/contact/internal/domain/note/noteType"
+)
+
+var (
+	ErrTypeRequired = errors.New("note type is required")
+)
+
+// Note заметка или запись о взаимодействии с контактом
+type Note struct {
+	id         uuid.UUID
+	createdAt  time.Time
+	modifiedAt time.Time
+
+	contactID uuid.UUID
+	author    author.Author
+	noteType  noteType.NoteType
+	// occurredAt время взаимодействия, по нему строится лента контакта
+	occurredAt time.Time
+	body       body.Body
+}
+
+func NewWithID(
+	id uuid.UUID,
+	createdAt time.Time,
+	modifiedAt time.Time,
+	contactID uuid.UUID,
+	author author.Author,
+	noteType noteType.NoteType,
+	occurredAt time.Time,
+	body body.Body,
+) (*Note, error) {
+	if noteType.IsEmpty() {
+		return nil, ErrTypeRequired
+	}
+
+	if id == uuid.Nil {
+		id = uuid.New()
+	}
+
+	return &Note{
+		id:         id,
+		createdAt:  createdAt.UTC(),
+		modifiedAt: modifiedAt.UTC(),
+		contactID:  contactID,
+		author:     author,
+		noteType:   noteType,
+		occurredAt: occurredAt.UTC(),
+		body:       body,
+	}, nil
+}
+
+// New время взаимодействия по умолчанию совпадает со временем создания
+func New(
+	contactID uuid.UUID,
+	author author.Author,
+	noteType noteType.NoteType,
+	occurredAt time.Time,
+	body body.Body,
+) (*Note, error) {
+	var timeNow = time.Now().UTC()
+	if occurredAt.IsZero() {
+		occurredAt = timeNow
+	}
+	return NewWithID(uuid.New(), timeNow, timeNow, contactID, author, noteType, occurredAt, body)
+}
+
+func (n Note) ID() uuid.UUID {
+	return n.id
+}
+
+func (n Note) CreatedAt() time.Time {
+	return n.createdAt
+}
+
+func (n Note) ModifiedAt() time.Time {
+	return n.modifiedAt
+}
+
+func (n Note) ContactID() uuid.UUID {
+	return n.contactID
+}
+
+func (n Note) Author() author.Author {
+	return n.author
+}
+
+func (n Note) Type() noteType.NoteType {
+	return n.noteType
+}
+
+func (n Note) OccurredAt() time.Time {
+	return n.occurredAt
+}
+
+func (n Note) Body() body.Body {
+	return n.body
+}
@@ -0,0 +1,28 @@
+package name
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxLength      = 250
+	ErrWrongLength = errors.Errorf("name must not be empty and must be less than or equal to %d characters", MaxLength)
+)
+
+type Name struct {
+	value string
+}
+
+func New(name string) (Name, error) {
+	name = strings.TrimSpace(name)
+	if length := len([]rune(name)); length == 0 || length > MaxLength {
+		return Name{}, ErrWrongLength
+	}
+	return Name{value: name}, nil
+}
+
+func (n Name) Value() string {
+	return n.value
+}
@@ -0,0 +1,75 @@
+package taxID
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxLength      = 20
+	ErrWrongFormat = errors.Errorf("tax id must contain only latin letters, digits or '-' and be from 5 to %d characters", MaxLength)
+	ErrWrongINN    = errors.New("INN must contain 10 or 12 digits with a valid checksum")
+
+	regexpDigits = regexp.MustCompile(`^\d+$`)
+	regexpTaxID  = regexp.MustCompile(`^[A-Z0-9-]{5,20}$`)
+)
+
+// TaxID налоговый номер организации. Номер только из цифр проверяется как ИНН, остальные -- по общему формату
+type TaxID string
+
+func (t TaxID) String() string {
+	return string(t)
+}
+
+func (t TaxID) IsEmpty() bool {
+	return t == ""
+}
+
+// New пустое значение означает, что номер не указан
+func New(value string) (TaxID, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" {
+		return "", nil
+	}
+
+	if regexpDigits.MatchString(value) {
+		if !checkINN(value) {
+			return "", ErrWrongINN
+		}
+		return TaxID(value), nil
+	}
+
+	if !regexpTaxID.MatchString(value) {
+		return "", ErrWrongFormat
+	}
+	return TaxID(value), nil
+}
+
+var (
+	weightsINN10   = []int{2, 4, 10, 3, 5, 9, 4, 6, 8}
+	weightsINN12n1 = []int{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+	weightsINN12n2 = []int{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+)
+
+// checkINN контрольные разряды ИНН юридического (10 цифр) или физического (12 цифр) лица
+func checkINN(value string) bool {
+	switch len(value) {
+	case 10:
+		return checkDigit(value, weightsINN10) == int(value[9]-'0')
+	case 12:
+		return checkDigit(value, weightsINN12n1) == int(value[10]-'0') &&
+			checkDigit(value, weightsINN12n2) == int(value[11]-'0')
+	default:
+		return false
+	}
+}
+
+func checkDigit(value string, weights []int) int {
+	var sum int
+	for i, weight := range weights {
+		sum += int(value[i]-'0') * weight
+	}
+	return sum % 11 % 10
+}
@@ -0,0 +1,24 @@
+package taxID
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	assertion := assert.New(t)
+
+	for _, value := range []string{"7707083893", "500100732259", "de-123456789", ""} {
+		_, err := New(value)
+		assertion.NoError(err, value)
+	}
+
+	for _, value := range []string{"7707083894", "500100732250", "12345678901"} {
+		_, err := New(value)
+		assertion.ErrorIs(err, ErrWrongINN, value)
+	}
+
+	_, err := New("ИНН 123")
+	assertion.ErrorIs(err, ErrWrongFormat)
+}
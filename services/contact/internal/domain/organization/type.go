@@ -0,0 +1,84 @@
+package organization
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/organization/name"
+	"architecture_go/services/contact/internal/domain/organization/taxID"
+	"architecture_go/services/contact/internal/domain/organization/website"
+)
+
+type Organization struct {
+	id         uuid.UUID
+	createdAt  time.Time
+	modifiedAt time.Time
+
+	name    name.Name
+	taxID   taxID.TaxID
+	website website.Website
+
+	contactCount uint64
+}
+
+func NewWithID(
+	id uuid.UUID,
+	createdAt time.Time,
+	modifiedAt time.Time,
+	name name.Name,
+	taxID taxID.TaxID,
+	website website.Website,
+	contactCount uint64,
+) *Organization {
+	return &Organization{
+		id:           id,
+		createdAt:    createdAt.UTC(),
+		modifiedAt:   modifiedAt.UTC(),
+		name:         name,
+		taxID:        taxID,
+		website:      website,
+		contactCount: contactCount,
+	}
+}
+
+func New(name name.Name, taxID taxID.TaxID, website website.Website) *Organization {
+	var timeNow = time.Now().UTC()
+	return &Organization{
+		id:         uuid.New(),
+		createdAt:  timeNow,
+		modifiedAt: timeNow,
+		name:       name,
+		taxID:      taxID,
+		website:    website,
+	}
+}
+
+func (o Organization) ID() uuid.UUID {
+	return o.id
+}
+
+func (o Organization) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+func (o Organization) ModifiedAt() time.Time {
+	return o.modifiedAt
+}
+
+func (o Organization) Name() name.Name {
+	return o.name
+}
+
+func (o Organization) TaxID() taxID.TaxID {
+	return o.taxID
+}
+
+func (o Organization) Website() website.Website {
+	return o.website
+}
+
+// ContactCount количество действующих контактов организации
+func (o Organization) ContactCount() uint64 {
+	return o.contactCount
+}
@@ -0,0 +1,40 @@
+package website
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxLength      = 250
+	ErrWrongFormat = errors.Errorf("website must be an absolute http or https URL less than or equal to %d characters", MaxLength)
+)
+
+type Website struct {
+	value string
+}
+
+// New пустое значение означает, что сайт не указан
+func New(value string) (Website, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Website{}, nil
+	}
+
+	if len(value) > MaxLength {
+		return Website{}, ErrWrongFormat
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return Website{}, ErrWrongFormat
+	}
+
+	return Website{value: value}, nil
+}
+
+func (w Website) String() string {
+	return w.value
+}
@@ -0,0 +1,139 @@
+package outbox
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/event"
+)
+
+var (
+	// ErrEventExpired ленту нельзя продолжить с события: такого номера нет, клиенту нужно подключиться заново без него
+	ErrEventExpired = errors.New("last event id is unknown, reconnect without it")
+)
+
+// Message доменное событие, сохранённое для доставки брокеру
+type Message struct {
+	id          uuid.UUID
+	sequence    int64
+	createdAt   time.Time
+	aggregateID uuid.UUID
+	// tenant арендатор, в данных которого произошло событие
+	tenant string
+	name   event.Name
+	// payload конверт события в JSON, брокеру уходит без изменений
+	payload []byte
+
+	attempts      uint32
+	nextAttemptAt time.Time
+	lastError     string
+	publishedAt   time.Time
+	// parkedAt когда сообщение отложено после последней неудачной попытки
+	parkedAt time.Time
+}
+
+func NewWithID(
+	id uuid.UUID,
+	sequence int64,
+	createdAt time.Time,
+	aggregateID uuid.UUID,
+	tenant string,
+	name event.Name,
+	payload []byte,
+	attempts uint32,
+	nextAttemptAt time.Time,
+	lastError string,
+) *Message {
+	return &Message{
+		id:            id,
+		sequence:      sequence,
+		createdAt:     createdAt.UTC(),
+		aggregateID:   aggregateID,
+		tenant:        tenant,
+		name:          name,
+		payload:       payload,
+		attempts:      attempts,
+		nextAttemptAt: nextAttemptAt.UTC(),
+		lastError:     lastError,
+	}
+}
+
+func (m Message) ID() uuid.UUID {
+	return m.id
+}
+
+// Sequence порядковый номер сообщения, задаёт порядок доставки в пределах агрегата
+func (m Message) Sequence() int64 {
+	return m.sequence
+}
+
+func (m Message) CreatedAt() time.Time {
+	return m.createdAt
+}
+
+func (m Message) AggregateID() uuid.UUID {
+	return m.aggregateID
+}
+
+func (m Message) Tenant() string {
+	return m.tenant
+}
+
+func (m Message) Name() event.Name {
+	return m.name
+}
+
+func (m Message) Payload() []byte {
+	return m.payload
+}
+
+// Attempts количество неудачных попыток доставки
+func (m Message) Attempts() uint32 {
+	return m.attempts
+}
+
+func (m Message) NextAttemptAt() time.Time {
+	return m.nextAttemptAt
+}
+
+func (m Message) LastError() string {
+	return m.lastError
+}
+
+func (m Message) PublishedAt() time.Time {
+	return m.publishedAt
+}
+
+func (m Message) Published() bool {
+	return !m.publishedAt.IsZero()
+}
+
+func (m *Message) MarkPublished(publishedAt time.Time) {
+	m.publishedAt = publishedAt.UTC()
+	m.lastError = ""
+}
+
+func (m Message) ParkedAt() time.Time {
+	return m.parkedAt
+}
+
+// Parked сообщение больше не отправляется и не задерживает следующие события агрегата
+func (m Message) Parked() bool {
+	return !m.parkedAt.IsZero()
+}
+
+// MarkFailed увеличивает счётчик попыток и откладывает следующую попытку до nextAttemptAt;
+// после maxAttempts неудачных попыток сообщение откладывается в сторону
+func (m *Message) MarkFailed(err error, nextAttemptAt time.Time, maxAttempts uint32) {
+	m.attempts++
+	m.nextAttemptAt = nextAttemptAt.UTC()
+	if err != nil {
+		m.lastError = err.Error()
+	}
+
+	if m.attempts >= maxAttempts {
+		m.parkedAt = m.nextAttemptAt
+	}
+}
@@ -0,0 +1,132 @@
+package rateLimit
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Group группа маршрутов со своим лимитом; у клиента в каждой группе своё ведро
+type Group string
+
+const (
+	GroupDefault  Group = "default"
+	GroupContacts Group = "contacts"
+	GroupGroups   Group = "groups"
+	// GroupTransfer импорт и экспорт: пакетное создание контактов и выгрузка vCard
+	GroupTransfer Group = "transfer"
+	// GroupAddress все запросы с одного адреса, считаются до аутентификации: подбор учётных данных
+	// упирается в этот лимит, сколько бы ключей или токенов ни перебирал клиент
+	GroupAddress Group = "address"
+)
+
+var (
+	ErrWrongLimit = errors.New(`limit must look like "100/1m": requests per period`)
+)
+
+func (g Group) String() string {
+	return string(g)
+}
+
+// Limit ведро на Burst маркеров, которое заполняется целиком за Period. Запрос забирает маркер,
+// поэтому клиент может сделать Burst запросов подряд, а дальше -- Burst запросов за Period
+type Limit struct {
+	Burst  uint64
+	Period time.Duration
+}
+
+// Parse лимит вида "100/1m"; пустая строка или "0" -- без ограничения
+func Parse(value string) (Limit, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "0" {
+		return Limit{}, nil
+	}
+
+	burst, period, found := strings.Cut(value, "/")
+	if !found {
+		return Limit{}, ErrWrongLimit
+	}
+
+	count, err := strconv.ParseUint(strings.TrimSpace(burst), 10, 64)
+	if err != nil {
+		return Limit{}, ErrWrongLimit
+	}
+
+	duration, err := time.ParseDuration(strings.TrimSpace(period))
+	if err != nil || duration <= 0 {
+		return Limit{}, ErrWrongLimit
+	}
+
+	return Limit{Burst: count, Period: duration}, nil
+}
+
+// Disabled лимит не задан
+func (l Limit) Disabled() bool {
+	return l.Burst == 0 || l.Period <= 0
+}
+
+// rate маркеров в наносекунду
+func (l Limit) rate() float64 {
+	return float64(l.Burst) / float64(l.Period)
+}
+
+func (l Limit) String() string {
+	if l.Disabled() {
+		return "0"
+	}
+	return strconv.FormatUint(l.Burst, 10) + "/" + l.Period.String()
+}
+
+// Bucket состояние ведра клиента
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Result решение по запросу и состояние ведра после него
+type Result struct {
+	Allowed bool
+	Limit   Limit
+	// Remaining сколько запросов ещё можно сделать сразу
+	Remaining uint64
+	// Reset через сколько ведро снова будет полным
+	Reset time.Duration
+	// RetryAfter через сколько запрос пройдёт; ноль, если он уже прошёл
+	RetryAfter time.Duration
+}
+
+// Take забирает cost маркеров из ведра на момент now. Пустое ведро -- новый клиент, оно полное.
+func Take(bucket Bucket, limit Limit, now time.Time, cost uint64) (Bucket, Result) {
+	var tokens = float64(limit.Burst)
+	if !bucket.UpdatedAt.IsZero() {
+		var elapsed = now.Sub(bucket.UpdatedAt)
+		if elapsed < 0 {
+			elapsed = 0
+		}
+		tokens = math.Min(tokens, bucket.Tokens+float64(elapsed)*limit.rate())
+	}
+
+	var allowed = tokens >= float64(cost)
+	if allowed {
+		tokens -= float64(cost)
+	}
+
+	return Bucket{Tokens: tokens, UpdatedAt: now}, NewResult(limit, tokens, allowed, cost)
+}
+
+// NewResult решение по числу маркеров, оставшихся в ведре после запроса
+func NewResult(limit Limit, tokens float64, allowed bool, cost uint64) Result {
+	var result = Result{
+		Allowed:   allowed,
+		Limit:     limit,
+		Remaining: uint64(math.Max(0, math.Floor(tokens))),
+		Reset:     time.Duration(math.Ceil((float64(limit.Burst) - tokens) / limit.rate())),
+	}
+	if !allowed {
+		result.RetryAfter = time.Duration(math.Ceil((float64(cost) - tokens) / limit.rate()))
+	}
+	return result
+}
@@ -0,0 +1,55 @@
+package rateLimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTake(t *testing.T) {
+	var (
+		limit  = Limit{Burst: 2, Period: time.Second * 10}
+		now    = time.Now()
+		bucket Bucket
+		result Result
+	)
+
+	for i := 0; i < 2; i++ {
+		bucket, result = Take(bucket, limit, now, 1)
+		if !result.Allowed {
+			t.Fatalf("request %d: expected to be allowed", i)
+		}
+	}
+	if result.Remaining != 0 || result.Reset != time.Second*10 {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	bucket, result = Take(bucket, limit, now.Add(time.Second), 1)
+	if result.Allowed {
+		t.Fatal("expected to be limited")
+	}
+	if result.RetryAfter != time.Second*4 {
+		t.Errorf("unexpected retry after %s", result.RetryAfter)
+	}
+
+	// за пять секунд ведро пополняется на один маркер
+	if _, result = Take(bucket, limit, now.Add(time.Second*5), 1); !result.Allowed {
+		t.Errorf("expected to be allowed after refill, got %+v", result)
+	}
+}
+
+func TestParse(t *testing.T) {
+	limit, err := Parse("100/1m")
+	if err != nil || limit != (Limit{Burst: 100, Period: time.Minute}) {
+		t.Errorf("unexpected limit %+v, %v", limit, err)
+	}
+
+	if limit, err = Parse(""); err != nil || !limit.Disabled() {
+		t.Errorf("expected disabled limit, got %+v, %v", limit, err)
+	}
+
+	for _, value := range []string{"100", "x/1m", "100/0s", "100/m"} {
+		if _, err = Parse(value); err != ErrWrongLimit {
+			t.Errorf("%q: expected ErrWrongLimit, got %v", value, err)
+		}
+	}
+}
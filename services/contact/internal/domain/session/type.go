@@ -0,0 +1,140 @@
+package session
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"architecture_go/pkg/tools/secret"
+	"architecture_go/pkg/type/principal"
+)
+
+const (
+	// Prefix начало каждого токена сессии
+	Prefix = "cs_"
+
+	tokenSize = 32
+)
+
+var ErrWrongTTL = errors.New("session lifetime must be positive")
+
+// Session сессия браузера, открытая по токену или ключу. Хранится хеш токена,
+// сам токен есть только у только что созданной сессии и уходит клиенту в cookie
+type Session struct {
+	id        uuid.UUID
+	createdAt time.Time
+	expiresAt time.Time
+	hash      []byte
+
+	// субъект, арендатор и роли копируются из учётных данных, которыми открыта сессия
+	subject string
+	name    string
+	tenant  string
+	roles   []string
+
+	token string
+}
+
+func NewWithID(id uuid.UUID, createdAt, expiresAt time.Time, hash []byte, subject, name, tenant string, roles []string) *Session {
+	return &Session{
+		id:        id,
+		createdAt: createdAt.UTC(),
+		expiresAt: expiresAt.UTC(),
+		hash:      hash,
+		subject:   subject,
+		name:      name,
+		tenant:    tenant,
+		roles:     roles,
+	}
+}
+
+// New сессия для principal на ttl, но не дольше срока учётных данных principal
+func New(p principal.Principal, ttl time.Duration) (*Session, error) {
+	if ttl <= 0 {
+		return nil, ErrWrongTTL
+	}
+
+	token, err := secret.Generate(Prefix, tokenSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		now       = time.Now().UTC()
+		expiresAt = now.Add(ttl)
+	)
+	if !p.ExpiresAt.IsZero() && p.ExpiresAt.Before(expiresAt) {
+		expiresAt = p.ExpiresAt.UTC()
+	}
+
+	var roles = p.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+
+	return &Session{
+		id:        uuid.New(),
+		createdAt: now,
+		expiresAt: expiresAt,
+		hash:      secret.Hash(token),
+		subject:   p.Subject,
+		name:      p.Name,
+		tenant:    p.Tenant,
+		roles:     roles,
+		token:     token,
+	}, nil
+}
+
+func (s Session) ID() uuid.UUID {
+	return s.id
+}
+
+func (s Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+func (s Session) ExpiresAt() time.Time {
+	return s.expiresAt
+}
+
+func (s Session) Hash() []byte {
+	return s.hash
+}
+
+func (s Session) Subject() string {
+	return s.subject
+}
+
+func (s Session) Name() string {
+	return s.name
+}
+
+func (s Session) Tenant() string {
+	return s.tenant
+}
+
+func (s Session) Roles() []string {
+	return s.roles
+}
+
+// Token токен для cookie, пустая строка у сессий, прочитанных из хранилища
+func (s Session) Token() string {
+	return s.token
+}
+
+func (s Session) Expired(now time.Time) bool {
+	return !now.Before(s.expiresAt)
+}
+
+func (s Session) Principal() *principal.Principal {
+	return &principal.Principal{
+		Subject:      s.subject,
+		Name:         s.name,
+		Method:       principal.MethodSession,
+		CredentialID: s.id.String(),
+		Tenant:       s.tenant,
+		Roles:        s.roles,
+		ExpiresAt:    s.expiresAt,
+	}
+}
@@ -0,0 +1,158 @@
+package share
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/contact/internal/domain/group/acl"
+)
+
+// Resource вид ресурса, которым можно поделиться
+type Resource string
+
+const (
+	ResourceContact Resource = "contact"
+	// ResourceGroup доступ к группе даёт видимость её участников
+	ResourceGroup Resource = "group"
+)
+
+// Level уровень доступа к ресурсу
+type Level string
+
+const (
+	// LevelRead чтение
+	LevelRead Level = "read"
+	// LevelWrite чтение и изменение
+	LevelWrite Level = "write"
+)
+
+var (
+	MaxGranteeLength = acl.MaxGranteeLength
+	MaxOwnerLength   = acl.MaxGranteeLength
+
+	ErrWrongGrantee = errors.Errorf("grantee must be from 1 to %d characters", MaxGranteeLength)
+	ErrWrongOwner   = errors.Errorf("owner must be from 1 to %d characters", MaxOwnerLength)
+	ErrWrongLevel   = errors.Errorf("level must be %s or %s", LevelRead, LevelWrite)
+
+	ErrWrongResource = errors.Errorf("resource must be %s or %s", ResourceContact, ResourceGroup)
+)
+
+func (r Resource) String() string {
+	return string(r)
+}
+
+func (l Level) String() string {
+	return string(l)
+}
+
+func (l Level) IsValid() bool {
+	return l == LevelRead || l == LevelWrite
+}
+
+// Allows уровень l не ниже required
+func (l Level) Allows(required Level) bool {
+	return l == LevelWrite || (l == LevelRead && required == LevelRead)
+}
+
+// Share доступ grantee к ресурсу. Grantee, как и владелец, -- субъект или команда,
+// то есть роль с префиксом acl.RolePrefix: "role:sales" -- все, у кого есть роль sales
+type Share struct {
+	resource   Resource
+	resourceID uuid.UUID
+	grantee    string
+	level      Level
+	createdAt  time.Time
+}
+
+func NewWithID(resource Resource, resourceID uuid.UUID, grantee string, level Level, createdAt time.Time) *Share {
+	return &Share{
+		resource:   resource,
+		resourceID: resourceID,
+		grantee:    grantee,
+		level:      level,
+		createdAt:  createdAt.UTC(),
+	}
+}
+
+func New(resource Resource, resourceID uuid.UUID, grantee string, level Level) (*Share, error) {
+	grantee, err := NewGrantee(grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if !level.IsValid() {
+		return nil, ErrWrongLevel
+	}
+
+	return NewWithID(resource, resourceID, grantee, level, time.Now()), nil
+}
+
+// NewGrantee субъект или команда без пробелов по краям
+func NewGrantee(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || value == acl.RolePrefix || utf8.RuneCountInString(value) > MaxGranteeLength {
+		return "", ErrWrongGrantee
+	}
+	return value, nil
+}
+
+func (s Share) Resource() Resource {
+	return s.resource
+}
+
+func (s Share) ResourceID() uuid.UUID {
+	return s.resourceID
+}
+
+func (s Share) Grantee() string {
+	return s.grantee
+}
+
+func (s Share) Level() Level {
+	return s.level
+}
+
+func (s Share) CreatedAt() time.Time {
+	return s.createdAt
+}
+
+// Grantees кем выступает p в записях доступа и в полях владельца: сам субъект и его команды
+func Grantees(p principal.Principal) []string {
+	var result = make([]string, 0, len(p.Roles)+1)
+	if p.Subject != "" {
+		result = append(result, p.Subject)
+	}
+	for _, role := range p.Roles {
+		result = append(result, acl.RolePrefix+role)
+	}
+	return result
+}
+
+// Access доступ автора запроса к ресурсу, собранный хранилищем по его Grantees
+type Access struct {
+	// Owner владелец ресурса, пустая строка -- ресурс создан до появления владельцев и общий
+	Owner string
+	// Owned владелец ресурса -- сам автор запроса или его команда
+	Owned bool
+	// Level лучший уровень из записей доступа к самому ресурсу, пустая строка -- записей нет
+	Level Level
+	// ViaGroup контакт входит в группу, которой автор владеет или к которой ему дан доступ.
+	// Членство в группе даёт только чтение: иначе, добавив чужой контакт в свою группу, можно было бы его изменить
+	ViaGroup bool
+}
+
+// Allows разрешён ли доступ уровня level к ресурсу с владельцем
+func (a Access) Allows(level Level) bool {
+	if a.Owned {
+		return true
+	}
+	if a.Level != "" && a.Level.Allows(level) {
+		return true
+	}
+	return a.ViaGroup && level == LevelRead
+}
@@ -0,0 +1,57 @@
+package share
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/principal"
+)
+
+func TestAccessAllows(t *testing.T) {
+	var cases = []struct {
+		name   string
+		access Access
+		read   bool
+		write  bool
+	}{
+		{"owner", Access{Owner: "42", Owned: true}, true, true},
+		{"write share", Access{Owner: "7", Level: LevelWrite}, true, true},
+		{"read share", Access{Owner: "7", Level: LevelRead}, true, false},
+		{"via group", Access{Owner: "7", ViaGroup: true}, true, false},
+		{"stranger", Access{Owner: "7"}, false, false},
+	}
+
+	for _, c := range cases {
+		if got := c.access.Allows(LevelRead); got != c.read {
+			t.Errorf("%s: read = %v, want %v", c.name, got, c.read)
+		}
+		if got := c.access.Allows(LevelWrite); got != c.write {
+			t.Errorf("%s: write = %v, want %v", c.name, got, c.write)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	var ID = uuid.New()
+
+	value, err := New(ResourceContact, ID, " role:sales ", LevelRead)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.Grantee() != "role:sales" {
+		t.Errorf("unexpected grantee %q", value.Grantee())
+	}
+
+	if _, err = New(ResourceContact, ID, "role:", LevelRead); err != ErrWrongGrantee {
+		t.Errorf("expected ErrWrongGrantee, got %v", err)
+	}
+	if _, err = New(ResourceContact, ID, "42", "admin"); err != ErrWrongLevel {
+		t.Errorf("expected ErrWrongLevel, got %v", err)
+	}
+
+	var grantees = Grantees(principal.Principal{Subject: "42", Roles: []string{"sales"}})
+	if len(grantees) != 2 || grantees[0] != "42" || grantees[1] != "role:sales" {
+		t.Errorf("unexpected grantees %v", grantees)
+	}
+}
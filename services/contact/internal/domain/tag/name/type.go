@@ -0,0 +1,58 @@
+package name
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	MaxLength      = 50
+	ErrWrongLength = errors.Errorf("tag must not be empty and must be less than or equal to %d characters", MaxLength)
+	ErrWrongFormat = errors.New("tag must not contain ',' or '/'")
+)
+
+// Name название тега. Теги нечувствительны к регистру, поэтому название хранится в нижнем регистре без крайних пробелов
+type Name string
+
+func (n Name) String() string {
+	return string(n)
+}
+
+func New(name string) (Name, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if length := len([]rune(name)); length == 0 || length > MaxLength {
+		return "", ErrWrongLength
+	}
+
+	// запятая разделяет значения фильтра, слеш ломает путь запроса
+	if strings.ContainsAny(name, ",/") {
+		return "", ErrWrongFormat
+	}
+
+	return Name(name), nil
+}
+
+// NewList проверяет названия и убирает повторы, порядок сохраняется
+func NewList(names ...string) ([]Name, error) {
+	var (
+		result = make([]Name, 0, len(names))
+		exists = make(map[Name]struct{}, len(names))
+	)
+
+	for _, value := range names {
+		tagName, err := New(value)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "tag %q", value)
+		}
+
+		if _, ok := exists[tagName]; ok {
+			continue
+		}
+		exists[tagName] = struct{}{}
+		result = append(result, tagName)
+	}
+
+	return result, nil
+}
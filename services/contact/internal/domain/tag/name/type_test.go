@@ -0,0 +1,21 @@
+package name
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewList(t *testing.T) {
+	assertion := assert.New(t)
+
+	tags, err := NewList(" VIP ", "vip", "Partner")
+	assertion.NoError(err)
+	assertion.Equal([]Name{"vip", "partner"}, tags)
+
+	_, err = NewList("vip", "  ")
+	assertion.ErrorIs(err, ErrWrongLength)
+
+	_, err = NewList("a,b")
+	assertion.ErrorIs(err, ErrWrongFormat)
+}
@@ -0,0 +1,66 @@
+package tag
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/tag/name"
+)
+
+type Tag struct {
+	id         uuid.UUID
+	createdAt  time.Time
+	modifiedAt time.Time
+
+	name name.Name
+
+	contactCount uint64
+}
+
+func NewWithID(
+	id uuid.UUID,
+	createdAt time.Time,
+	modifiedAt time.Time,
+	name name.Name,
+	contactCount uint64,
+) *Tag {
+	return &Tag{
+		id:           id,
+		createdAt:    createdAt.UTC(),
+		modifiedAt:   modifiedAt.UTC(),
+		name:         name,
+		contactCount: contactCount,
+	}
+}
+
+func New(name name.Name) *Tag {
+	var timeNow = time.Now().UTC()
+	return &Tag{
+		id:         uuid.New(),
+		createdAt:  timeNow,
+		modifiedAt: timeNow,
+		name:       name,
+	}
+}
+
+func (t Tag) ID() uuid.UUID {
+	return t.id
+}
+
+func (t Tag) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t Tag) ModifiedAt() time.Time {
+	return t.modifiedAt
+}
+
+func (t Tag) Name() name.Name {
+	return t.name
+}
+
+// ContactCount количество действующих контактов с тегом
+func (t Tag) ContactCount() uint64 {
+	return t.contactCount
+}
@@ -0,0 +1,123 @@
+package tenant
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// Default арендатор запросов без явного арендатора и данных, созданных до разделения
+const Default = "default"
+
+var (
+	MaxNameLength = 250
+
+	ErrWrongID   = errors.New("tenant id must be from 1 to 63 lowercase latin letters, digits, '-' or '_' and start with a letter or digit")
+	ErrWrongName = errors.Errorf("tenant name must be from 1 to %d characters", MaxNameLength)
+
+	patternID = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+)
+
+// Quota ограничения арендатора, нулевое значение -- без ограничения
+type Quota struct {
+	MaxContacts uint64
+	MaxGroups   uint64
+}
+
+// Usage сколько ресурсов арендатор уже занял, архивные записи не учитываются
+type Usage struct {
+	Contacts uint64
+	Groups   uint64
+}
+
+// Tenant владелец изолированного набора контактов, групп и справочников
+type Tenant struct {
+	id         string
+	createdAt  time.Time
+	modifiedAt time.Time
+
+	name  string
+	quota Quota
+	// disabledAt нулевое значение -- арендатор активен
+	disabledAt time.Time
+}
+
+func NewWithID(
+	id string,
+	createdAt time.Time,
+	modifiedAt time.Time,
+	name string,
+	quota Quota,
+	disabledAt time.Time,
+) *Tenant {
+	return &Tenant{
+		id:         id,
+		createdAt:  createdAt.UTC(),
+		modifiedAt: modifiedAt.UTC(),
+		name:       name,
+		quota:      quota,
+		disabledAt: disabledAt.UTC(),
+	}
+}
+
+// New идентификатор арендатора попадает в заголовки и токены, поэтому набор символов ограничен
+func New(tenantID, name string, quota Quota) (*Tenant, error) {
+	if !ValidID(tenantID) {
+		return nil, ErrWrongID
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return nil, ErrWrongName
+	}
+
+	var timeNow = time.Now().UTC()
+	return &Tenant{
+		id:         tenantID,
+		createdAt:  timeNow,
+		modifiedAt: timeNow,
+		name:       name,
+		quota:      quota,
+	}, nil
+}
+
+// ValidID подходит ли value в качестве идентификатора арендатора
+func ValidID(value string) bool {
+	return patternID.MatchString(value)
+}
+
+func (t Tenant) ID() string {
+	return t.id
+}
+
+func (t Tenant) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t Tenant) ModifiedAt() time.Time {
+	return t.modifiedAt
+}
+
+func (t Tenant) Name() string {
+	return t.name
+}
+
+func (t Tenant) Quota() Quota {
+	return t.quota
+}
+
+func (t Tenant) DisabledAt() time.Time {
+	return t.disabledAt
+}
+
+func (t Tenant) Disabled() bool {
+	return !t.disabledAt.IsZero()
+}
+
+// Allows хватит ли квоты limit, чтобы к used добавить ещё count
+func Allows(limit, used, count uint64) bool {
+	return limit == 0 || used+count <= limit
+}
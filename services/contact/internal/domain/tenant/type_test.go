@@ -0,0 +1,36 @@
+package tenant
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidID(t *testing.T) {
+	for value, expected := range map[string]bool{
+		"default":               true,
+		"acme-1":                true,
+		"a_b":                   true,
+		"":                      false,
+		"*":                     false,
+		"-acme":                 false,
+		"Acme":                  false,
+		"acme.com":              false,
+		strings.Repeat("a", 64): false,
+	} {
+		if ValidID(value) != expected {
+			t.Errorf("ValidID(%q) must be %v", value, expected)
+		}
+	}
+}
+
+func TestAllows(t *testing.T) {
+	if !Allows(0, 1000, 1) {
+		t.Error("zero limit must not restrict")
+	}
+	if !Allows(10, 9, 1) {
+		t.Error("limit must be reachable")
+	}
+	if Allows(10, 9, 2) {
+		t.Error("limit must not be exceeded")
+	}
+}
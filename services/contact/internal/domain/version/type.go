@@ -0,0 +1,32 @@
+package version
+
+import "time"
+
+// Version сохранённое состояние контакта или группы после очередного изменения
+type Version struct {
+	number    uint64
+	createdAt time.Time
+	// deleted сущность была удалена этим изменением
+	deleted bool
+}
+
+func New(number uint64, createdAt time.Time, deleted bool) *Version {
+	return &Version{
+		number:    number,
+		createdAt: createdAt.UTC(),
+		deleted:   deleted,
+	}
+}
+
+// Number номер версии, нумерация начинается с 1 для каждой сущности
+func (v Version) Number() uint64 {
+	return v.number
+}
+
+func (v Version) CreatedAt() time.Time {
+	return v.createdAt
+}
+
+func (v Version) Deleted() bool {
+	return v.deleted
+}
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"net"
+	"strings"
+)
+
+// nonPublicNetworks сети, которых нет в интернете, сверх тех, что распознаёт net.IP:
+// общий адрес провайдера, служебные, тестовые и зарезервированные диапазоны, NAT64
+var nonPublicNetworks = parseNetworks(
+	"0.0.0.0/8",
+	"100.64.0.0/10",
+	"192.0.0.0/24",
+	"192.0.2.0/24",
+	"198.18.0.0/15",
+	"198.51.100.0/24",
+	"203.0.113.0/24",
+	"240.0.0.0/4",
+	"64:ff9b::/96",
+	"64:ff9b:1::/48",
+	"2001:db8::/32",
+)
+
+func parseNetworks(values ...string) []*net.IPNet {
+	var result = make([]*net.IPNet, len(values))
+	for i, value := range values {
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			panic(err)
+		}
+		result[i] = network
+	}
+	return result
+}
+
+// PublicIP адрес доступен из интернета. Вебхук с адресом внутренней сети позволил бы автору подписки
+// обращаться от имени сервиса к базе, метаданным облака и другим внутренним системам
+func PublicIP(ip net.IP) bool {
+	if ip == nil || ip.IsUnspecified() || ip.IsLoopback() || ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// publicHost host не указывает на внутреннюю сеть явно: адрес из внутренней сети или localhost.
+// Имя, которое разрешается во внутренний адрес, проверяется при соединении, см. repository/webhook/http
+func publicHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return PublicIP(ip)
+	}
+	return true
+}
@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/event"
+)
+
+// Status состояние доставки события подписчику
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusDelivered Status = "delivered"
+	// StatusDead попытки исчерпаны, доставку можно только повторить вручную
+	StatusDead Status = "dead"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+func (s Status) IsValid() bool {
+	return s == StatusPending || s == StatusDelivered || s == StatusDead
+}
+
+// Delivery доставка одного события одной подписке, она же запись журнала доставок
+type Delivery struct {
+	id        uuid.UUID
+	webhookID uuid.UUID
+	eventID   uuid.UUID
+	eventName event.Name
+	// payload конверт события в JSON, подписывается и отправляется без изменений
+	payload   []byte
+	createdAt time.Time
+
+	status         Status
+	attempts       uint32
+	nextAttemptAt  time.Time
+	lastStatusCode int
+	lastError      string
+	deliveredAt    time.Time
+}
+
+func NewDeliveryWithID(
+	id uuid.UUID,
+	webhookID uuid.UUID,
+	eventID uuid.UUID,
+	eventName event.Name,
+	payload []byte,
+	createdAt time.Time,
+	status Status,
+	attempts uint32,
+	nextAttemptAt time.Time,
+	lastStatusCode int,
+	lastError string,
+	deliveredAt time.Time,
+) *Delivery {
+	return &Delivery{
+		id:             id,
+		webhookID:      webhookID,
+		eventID:        eventID,
+		eventName:      eventName,
+		payload:        payload,
+		createdAt:      createdAt.UTC(),
+		status:         status,
+		attempts:       attempts,
+		nextAttemptAt:  nextAttemptAt.UTC(),
+		lastStatusCode: lastStatusCode,
+		lastError:      lastError,
+		deliveredAt:    deliveredAt.UTC(),
+	}
+}
+
+func (d Delivery) ID() uuid.UUID {
+	return d.id
+}
+
+func (d Delivery) WebhookID() uuid.UUID {
+	return d.webhookID
+}
+
+func (d Delivery) EventID() uuid.UUID {
+	return d.eventID
+}
+
+func (d Delivery) EventName() event.Name {
+	return d.eventName
+}
+
+func (d Delivery) Payload() []byte {
+	return d.payload
+}
+
+func (d Delivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d Delivery) Status() Status {
+	return d.status
+}
+
+// Attempts количество неудачных попыток с момента создания или последнего повтора
+func (d Delivery) Attempts() uint32 {
+	return d.attempts
+}
+
+func (d Delivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+// LastStatusCode код ответа подписчика на последнюю попытку, 0 -- ответа не было
+func (d Delivery) LastStatusCode() int {
+	return d.lastStatusCode
+}
+
+func (d Delivery) LastError() string {
+	return d.lastError
+}
+
+func (d Delivery) DeliveredAt() time.Time {
+	return d.deliveredAt
+}
+
+func (d *Delivery) MarkDelivered(statusCode int, deliveredAt time.Time) {
+	d.status = StatusDelivered
+	d.lastStatusCode = statusCode
+	d.lastError = ""
+	d.deliveredAt = deliveredAt.UTC()
+}
+
+// MarkFailed после maxAttempts неудачных попыток доставка уходит в dead-letter
+func (d *Delivery) MarkFailed(statusCode int, err error, nextAttemptAt time.Time, maxAttempts uint32) {
+	d.attempts++
+	d.lastStatusCode = statusCode
+	d.nextAttemptAt = nextAttemptAt.UTC()
+	if err != nil {
+		d.lastError = err.Error()
+	}
+
+	if d.attempts >= maxAttempts {
+		d.status = StatusDead
+	}
+}
+
+// Replay ставит доставку в очередь заново со сброшенным счётчиком попыток,
+// повторить можно и доставленное событие
+func (d *Delivery) Replay(now time.Time) {
+	d.status = StatusPending
+	d.attempts = 0
+	d.nextAttemptAt = now.UTC()
+	d.deliveredAt = time.Time{}
+}
@@ -0,0 +1,26 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+const signaturePrefix = "sha256="
+
+// Sign подпись запроса: HMAC-SHA256 от "<unix timestamp>.<тело>" ключом подписки.
+// Метка времени входит в подпись, чтобы получатель мог отбросить повтор старого запроса.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	var mac = hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверка подписи на стороне получателя, сравнение за постоянное время
+func Verify(secret string, timestamp time.Time, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"architecture_go/services/contact/internal/domain/event"
+)
+
+var (
+	MaxURLLength    = 2000
+	MinSecretLength = 16
+	MaxSecretLength = 250
+
+	ErrWrongURL    = errors.Errorf("webhook url must be an absolute http or https URL less than or equal to %d characters", MaxURLLength)
+	ErrPrivateURL  = errors.New("webhook url must point to a public internet address")
+	ErrWrongEvent  = errors.New("unknown event name in webhook filter")
+	ErrWrongSecret = errors.Errorf("webhook secret must be from %d to %d characters", MinSecretLength, MaxSecretLength)
+)
+
+// Webhook подписка внешней системы на события контактов и групп
+type Webhook struct {
+	id         uuid.UUID
+	createdAt  time.Time
+	modifiedAt time.Time
+
+	url string
+	// events фильтр событий, пустой список -- все события
+	events []event.Name
+	// secret ключ подписи HMAC-SHA256, известен только подписчику и сервису
+	secret string
+	active bool
+}
+
+func NewWithID(
+	id uuid.UUID,
+	createdAt time.Time,
+	modifiedAt time.Time,
+	url string,
+	events []event.Name,
+	secret string,
+	active bool,
+) *Webhook {
+	return &Webhook{
+		id:         id,
+		createdAt:  createdAt.UTC(),
+		modifiedAt: modifiedAt.UTC(),
+		url:        url,
+		events:     events,
+		secret:     secret,
+		active:     active,
+	}
+}
+
+// New пустой secret допустим: при создании сервис сгенерирует его сам, при изменении оставит прежний
+func New(rawURL string, events []event.Name, secret string, active bool) (*Webhook, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if len(rawURL) > MaxURLLength {
+		return nil, ErrWrongURL
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, ErrWrongURL
+	}
+
+	if !publicHost(parsed.Hostname()) {
+		return nil, ErrPrivateURL
+	}
+
+	for _, name := range events {
+		if !name.IsValid() {
+			return nil, errors.Wrapf(ErrWrongEvent, "%q", name)
+		}
+	}
+
+	if secret != "" && (len(secret) < MinSecretLength || len(secret) > MaxSecretLength) {
+		return nil, ErrWrongSecret
+	}
+
+	var timeNow = time.Now().UTC()
+	return &Webhook{
+		id:         uuid.New(),
+		createdAt:  timeNow,
+		modifiedAt: timeNow,
+		url:        rawURL,
+		events:     events,
+		secret:     secret,
+		active:     active,
+	}, nil
+}
+
+// GenerateSecret случайный ключ подписи из 32 байт в hex
+func GenerateSecret() (string, error) {
+	var value = make([]byte, 32)
+	if _, err := rand.Read(value); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(value), nil
+}
+
+func (w Webhook) ID() uuid.UUID {
+	return w.id
+}
+
+func (w Webhook) CreatedAt() time.Time {
+	return w.createdAt
+}
+
+func (w Webhook) ModifiedAt() time.Time {
+	return w.modifiedAt
+}
+
+func (w Webhook) URL() string {
+	return w.url
+}
+
+func (w Webhook) Events() []event.Name {
+	return w.events
+}
+
+func (w Webhook) Secret() string {
+	return w.secret
+}
+
+func (w Webhook) Active() bool {
+	return w.active
+}
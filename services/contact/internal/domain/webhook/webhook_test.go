@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/event"
+)
+
+func TestSign(t *testing.T) {
+	var (
+		secret    = "0123456789abcdef"
+		timestamp = time.Unix(1700000000, 0)
+		body      = []byte(`{}`)
+	)
+
+	var signature = Sign(secret, timestamp, body)
+	if signature != "sha256=e4f8e2ecae2295b2ddb2f0b5584c8275e226c0ebe9b3b819e70156bb67122e3e" {
+		t.Fatalf("unexpected signature %s", signature)
+	}
+
+	if !Verify(secret, timestamp, body, signature) {
+		t.Fatal("signature must be valid")
+	}
+
+	if Verify(secret, timestamp.Add(time.Second), body, signature) {
+		t.Fatal("signature must depend on timestamp")
+	}
+
+	if Verify("another secret value", timestamp, body, signature) {
+		t.Fatal("signature must depend on secret")
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New("https://example.com/hook", []event.Name{event.NameContactCreated}, "", true); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if _, err := New("ftp://example.com/hook", nil, "", true); !errors.Is(err, ErrWrongURL) {
+		t.Fatalf("expected ErrWrongURL, got %v", err)
+	}
+
+	for _, value := range []string{"http://127.0.0.1/hook", "http://localhost:8080/hook", "http://169.254.169.254/latest", "http://[::1]/hook", "http://10.1.2.3/hook", "http://[::ffff:192.168.0.1]/hook"} {
+		if _, err := New(value, nil, "", true); !errors.Is(err, ErrPrivateURL) {
+			t.Fatalf("expected ErrPrivateURL for %s, got %v", value, err)
+		}
+	}
+
+	if _, err := New("https://example.com/hook", []event.Name{"contact.unknown"}, "", true); !errors.Is(err, ErrWrongEvent) {
+		t.Fatalf("expected ErrWrongEvent, got %v", err)
+	}
+
+	if _, err := New("https://example.com/hook", nil, "short", true); !errors.Is(err, ErrWrongSecret) {
+		t.Fatalf("expected ErrWrongSecret, got %v", err)
+	}
+}
+
+func TestDeliveryDeadLetter(t *testing.T) {
+	var now = time.Now()
+	var delivery = NewDeliveryWithID(uuid.New(), uuid.New(), uuid.New(), event.NameContactCreated, []byte(`{}`), now,
+		StatusPending, 0, now, 0, "", time.Time{})
+
+	var errFailed = errors.New("connection refused")
+	delivery.MarkFailed(0, errFailed, now.Add(time.Second), 2)
+	if delivery.Status() != StatusPending || delivery.Attempts() != 1 {
+		t.Fatalf("unexpected state %s/%d after first failure", delivery.Status(), delivery.Attempts())
+	}
+
+	delivery.MarkFailed(500, errFailed, now.Add(time.Minute), 2)
+	if delivery.Status() != StatusDead || delivery.LastStatusCode() != 500 || delivery.LastError() != errFailed.Error() {
+		t.Fatalf("unexpected state %s/%d/%s after last failure", delivery.Status(), delivery.LastStatusCode(), delivery.LastError())
+	}
+
+	delivery.Replay(now)
+	if delivery.Status() != StatusPending || delivery.Attempts() != 0 {
+		t.Fatalf("unexpected state %s/%d after replay", delivery.Status(), delivery.Attempts())
+	}
+}
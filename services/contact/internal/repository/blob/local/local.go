@@ -0,0 +1,124 @@
+package local
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func init() {
+	viper.SetDefault("BLOB_LOCAL_ROOT", "./data/blob")
+}
+
+var ErrWrongKey = errors.New("blob key must be a relative path inside the storage root")
+
+// Repository хранит файлы в локальной файловой системе
+type Repository struct {
+	options Options
+}
+
+type Options struct {
+	Root string
+}
+
+func New(o Options) (*Repository, error) {
+	var r = &Repository{}
+	r.SetOptions(o)
+
+	if err := os.MkdirAll(r.options.Root, 0o755); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Repository) SetOptions(options Options) {
+	if options.Root == "" {
+		options.Root = viper.GetString("BLOB_LOCAL_ROOT")
+		log.Debug("set default options.Root", zap.Any("root", options.Root))
+	}
+
+	if r.options != options {
+		r.options = options
+		log.Info("set new options", zap.Any("options", r.options))
+	}
+}
+
+// Put пишет файл во временный и переименовывает его, чтобы читатели не видели частично записанный файл
+func (r *Repository) Put(ctx context.Context, key, _ string, data []byte) error {
+	path, err := r.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return log.ErrorWithContext(ctx, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return log.ErrorWithContext(ctx, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return log.ErrorWithContext(ctx, err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return log.ErrorWithContext(ctx, err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return log.ErrorWithContext(ctx, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := r.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, useCase.ErrBlobNotFound
+		}
+		return nil, log.ErrorWithContext(ctx, err)
+	}
+
+	return data, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, key string) error {
+	path, err := r.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return log.ErrorWithContext(ctx, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) path(key string) (string, error) {
+	var clean = filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", ErrWrongKey
+	}
+	return filepath.Join(r.options.Root, clean), nil
+}
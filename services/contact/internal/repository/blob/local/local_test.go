@@ -0,0 +1,36 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func TestRepository(t *testing.T) {
+	assertion := assert.New(t)
+
+	r, err := New(Options{Root: t.TempDir()})
+	assertion.NoError(err)
+
+	var ctx = context.Empty()
+
+	assertion.NoError(r.Put(ctx, "contacts/1/photo", "image/png", []byte("data")))
+
+	data, err := r.Get(ctx, "contacts/1/photo")
+	assertion.NoError(err)
+	assertion.Equal([]byte("data"), data)
+
+	assertion.NoError(r.Delete(ctx, "contacts/1/photo"))
+	assertion.NoError(r.Delete(ctx, "contacts/1/photo"))
+
+	_, err = r.Get(ctx, "contacts/1/photo")
+	assertion.ErrorIs(err, useCase.ErrBlobNotFound)
+
+	for _, key := range []string{"", "../photo", "/etc/passwd", "contacts/../../photo"} {
+		_, err = r.Get(ctx, key)
+		assertion.ErrorIs(err, ErrWrongKey, key)
+	}
+}
@@ -0,0 +1,133 @@
+package s3
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func init() {
+	viper.SetDefault("S3_ENDPOINT", "minio:9000")
+	viper.SetDefault("S3_BUCKET", "contact")
+	viper.SetDefault("S3_REGION", "us-east-1")
+}
+
+const codeNoSuchKey = "NoSuchKey"
+
+// Repository хранит файлы в S3-совместимом хранилище, для локальной разработки подходит MinIO
+type Repository struct {
+	client  *minio.Client
+	options Options
+}
+
+type Options struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	UseSSL    bool
+}
+
+// New создаёт бакет, если его ещё нет
+func New(ctx context.Context, o Options) (*Repository, error) {
+	var r = &Repository{}
+	r.SetOptions(o)
+
+	client, err := minio.New(r.options.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(r.options.AccessKey, r.options.SecretKey, ""),
+		Secure: r.options.UseSSL,
+		Region: r.options.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.client = client
+
+	exists, err := client.BucketExists(ctx, r.options.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		if err = client.MakeBucket(ctx, r.options.Bucket, minio.MakeBucketOptions{Region: r.options.Region}); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+func (r *Repository) SetOptions(options Options) {
+	if options.Endpoint == "" {
+		options.Endpoint = viper.GetString("S3_ENDPOINT")
+		options.AccessKey = viper.GetString("S3_ACCESS_KEY")
+		options.SecretKey = viper.GetString("S3_SECRET_KEY")
+		options.UseSSL = viper.GetBool("S3_USE_SSL")
+		log.Debug("set default options.Endpoint", zap.Any("endpoint", options.Endpoint))
+	}
+
+	if options.Bucket == "" {
+		options.Bucket = viper.GetString("S3_BUCKET")
+		log.Debug("set default options.Bucket", zap.Any("bucket", options.Bucket))
+	}
+
+	if options.Region == "" {
+		options.Region = viper.GetString("S3_REGION")
+	}
+
+	if r.options != options {
+		r.options = options
+		log.Info("set new options", zap.Any("endpoint", r.options.Endpoint), zap.Any("bucket", r.options.Bucket))
+	}
+}
+
+func (r *Repository) Put(ctx context.Context, key, contentType string, data []byte) error {
+	_, err := r.client.PutObject(ctx, r.options.Bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return log.ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, key string) ([]byte, error) {
+	object, err := r.client.GetObject(ctx, r.options.Bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, r.error(ctx, err)
+	}
+	defer object.Close()
+
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return nil, r.error(ctx, err)
+	}
+
+	return data, nil
+}
+
+func (r *Repository) Delete(ctx context.Context, key string) error {
+	if err := r.client.RemoveObject(ctx, r.options.Bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		if minio.ToErrorResponse(err).Code == codeNoSuchKey {
+			return nil
+		}
+		return log.ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func (r *Repository) error(ctx context.Context, err error) error {
+	if minio.ToErrorResponse(err).Code == codeNoSuchKey {
+		return useCase.ErrBlobNotFound
+	}
+	return log.ErrorWithContext(ctx, err)
+}
@@ -0,0 +1,27 @@
+package log
+
+import (
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/outbox"
+)
+
+// Repository брокер для локальной разработки: сообщения только пишутся в лог и считаются доставленными
+type Repository struct{}
+
+func New() *Repository {
+	return &Repository{}
+}
+
+func (r *Repository) Publish(ctx context.Context, message *outbox.Message) error {
+	logger.InfoWithContext(ctx, "outbox message published",
+		zap.String("id", message.ID().String()),
+		zap.String("name", message.Name().String()),
+		zap.String("aggregateId", message.AggregateID().String()),
+		zap.Int64("sequence", message.Sequence()),
+		zap.ByteString("payload", message.Payload()),
+	)
+	return nil
+}
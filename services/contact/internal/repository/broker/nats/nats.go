@@ -0,0 +1,101 @@
+package nats
+
+import (
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/outbox"
+)
+
+func init() {
+	viper.SetDefault("NATS_URL", nats.DefaultURL)
+	viper.SetDefault("NATS_SUBJECT_PREFIX", "slurm")
+}
+
+const (
+	headerAggregateID = "Aggregate-Id"
+	headerTenantID    = "Tenant-Id"
+)
+
+// Repository публикует сообщения outbox в NATS, тема -- "<prefix>.<название события>",
+// например slurm.contact.created. Для локальной разработки подходит nats-server без настроек.
+type Repository struct {
+	conn    *nats.Conn
+	options Options
+}
+
+type Options struct {
+	URL           string
+	SubjectPrefix string
+	// Timeout сколько ждать подтверждения, что сервер получил сообщение
+	Timeout time.Duration
+}
+
+func New(o Options) (*Repository, error) {
+	var r = &Repository{}
+	r.SetOptions(o)
+
+	conn, err := nats.Connect(r.options.URL, nats.Name(viper.GetString("SERVICE_NAME")), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, err
+	}
+	r.conn = conn
+
+	return r, nil
+}
+
+func (r *Repository) SetOptions(options Options) {
+	if options.URL == "" {
+		options.URL = viper.GetString("NATS_URL")
+		log.Debug("set default options.URL", zap.Any("url", options.URL))
+	}
+
+	if options.SubjectPrefix == "" {
+		options.SubjectPrefix = viper.GetString("NATS_SUBJECT_PREFIX")
+		log.Debug("set default options.SubjectPrefix", zap.Any("subjectPrefix", options.SubjectPrefix))
+	}
+
+	if options.Timeout == 0 {
+		options.Timeout = time.Second * 5
+		log.Debug("set default options.Timeout", zap.Any("timeout", options.Timeout))
+	}
+
+	if r.options != options {
+		r.options = options
+		log.Info("set new options", zap.Any("url", r.options.URL), zap.Any("subjectPrefix", r.options.SubjectPrefix))
+	}
+}
+
+// Publish считает сообщение доставленным, когда сервер подтвердил его получение.
+// ID сообщения передаётся в заголовке Nats-Msg-Id, JetStream по нему отбрасывает повторы.
+func (r *Repository) Publish(c context.Context, message *outbox.Message) error {
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	var msg = nats.NewMsg(r.options.SubjectPrefix + "." + message.Name().String())
+	msg.Data = message.Payload()
+	msg.Header.Set(nats.MsgIdHdr, message.ID().String())
+	msg.Header.Set(headerAggregateID, message.AggregateID().String())
+	// подписчики делят события по арендаторам, не разбирая тело
+	msg.Header.Set(headerTenantID, message.Tenant())
+
+	if err := r.conn.PublishMsg(msg); err != nil {
+		return log.ErrorWithContext(ctx, err)
+	}
+
+	if err := r.conn.FlushWithContext(ctx); err != nil {
+		return log.ErrorWithContext(ctx, err)
+	}
+
+	return nil
+}
+
+// Close отправляет накопленные сообщения и закрывает соединение
+func (r *Repository) Close() error {
+	return r.conn.Drain()
+}
@@ -0,0 +1,77 @@
+package bus
+
+import (
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/event"
+)
+
+// Handler обработчик события, выполняется в том же потоке, что и публикация
+type Handler func(ctx context.Context, e event.Event) error
+
+// Bus синхронная шина событий внутри процесса
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[event.Name][]Handler
+	all      []Handler
+}
+
+func New() *Bus {
+	return &Bus{
+		handlers: make(map[event.Name][]Handler),
+	}
+}
+
+// Subscribe подписывает обработчик на события с указанными названиями, без названий -- на все события
+func (b *Bus) Subscribe(handler Handler, names ...event.Name) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(names) == 0 {
+		b.all = append(b.all, handler)
+		return
+	}
+
+	for _, name := range names {
+		b.handlers[name] = append(b.handlers[name], handler)
+	}
+}
+
+// Publish вызывает обработчики по порядку подписки. Ошибка обработчика не прерывает
+// доставку остальным, возвращается первая ошибка.
+func (b *Bus) Publish(ctx context.Context, events ...event.Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var result error
+	for _, e := range events {
+		for _, handler := range b.handlers[e.Name()] {
+			if err := handler(ctx, e); err != nil && result == nil {
+				result = fmt.Errorf("event %s: %w", e.Name(), err)
+			}
+		}
+
+		for _, handler := range b.all {
+			if err := handler(ctx, e); err != nil && result == nil {
+				result = fmt.Errorf("event %s: %w", e.Name(), err)
+			}
+		}
+	}
+
+	return result
+}
+
+// LogHandler записывает события в журнал на уровне debug
+func LogHandler(ctx context.Context, e event.Event) error {
+	log.DebugWithContext(ctx, "domain event",
+		zap.String("event", e.Name().String()),
+		zap.String("eventId", e.ID().String()),
+		zap.String("aggregateId", e.AggregateID().String()),
+	)
+	return nil
+}
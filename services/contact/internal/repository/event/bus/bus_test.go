@@ -0,0 +1,44 @@
+package bus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/event"
+)
+
+func TestBus(t *testing.T) {
+	assertion := assert.New(t)
+
+	var (
+		b        = New()
+		groupID  = uuid.New()
+		received []event.Name
+		all      int
+		errTest  = errors.New("handler failed")
+	)
+
+	b.Subscribe(func(ctx context.Context, e event.Event) error {
+		received = append(received, e.Name())
+		return errTest
+	}, event.NameContactAddedToGroup, event.NameContactRemovedFromGroup)
+
+	b.Subscribe(func(ctx context.Context, e event.Event) error {
+		all++
+		return nil
+	})
+
+	err := b.Publish(context.Empty(),
+		event.NewGroupArchived(groupID),
+		event.NewContactAddedToGroup(groupID, uuid.New()),
+		event.NewContactRemovedFromGroup(groupID, uuid.New()),
+	)
+
+	assertion.ErrorIs(err, errTest)
+	assertion.Equal([]event.Name{event.NameContactAddedToGroup, event.NameContactRemovedFromGroup}, received)
+	assertion.Equal(3, all)
+}
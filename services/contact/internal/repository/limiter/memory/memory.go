@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+)
+
+// Repository держит вёдра в памяти процесса: у каждой реплики сервиса свой лимит
+type Repository struct {
+	mu        sync.Mutex
+	buckets   map[string]bucket
+	sweptAt   time.Time
+	timeNowFn func() time.Time
+
+	options Options
+}
+
+type bucket struct {
+	rateLimit.Bucket
+	// fullAt когда ведро заполнится и его можно забыть: новое ведро тоже полное
+	fullAt time.Time
+}
+
+type Options struct {
+	// SweepInterval как часто удалять полные вёдра ушедших клиентов
+	SweepInterval time.Duration
+}
+
+func New(o Options) *Repository {
+	var r = &Repository{
+		buckets:   make(map[string]bucket),
+		timeNowFn: time.Now,
+	}
+	r.SetOptions(o)
+	return r
+}
+
+func (r *Repository) SetOptions(options Options) {
+	if options.SweepInterval == 0 {
+		options.SweepInterval = time.Minute
+		log.Debug("set default options.SweepInterval", zap.Any("sweepInterval", options.SweepInterval))
+	}
+
+	if r.options != options {
+		r.options = options
+		log.Info("set new options", zap.Any("options", r.options))
+	}
+}
+
+func (r *Repository) Take(_ context.Context, key string, limit rateLimit.Limit, cost uint64) (*rateLimit.Result, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var now = r.timeNowFn()
+	r.sweep(now)
+
+	value, result := rateLimit.Take(r.buckets[key].Bucket, limit, now, cost)
+	r.buckets[key] = bucket{Bucket: value, fullAt: now.Add(result.Reset)}
+
+	return &result, nil
+}
+
+// sweep вызывается под блокировкой не чаще SweepInterval
+func (r *Repository) sweep(now time.Time) {
+	if now.Sub(r.sweptAt) < r.options.SweepInterval {
+		return
+	}
+	r.sweptAt = now
+
+	for key, value := range r.buckets {
+		if !now.Before(value.fullAt) {
+			delete(r.buckets, key)
+		}
+	}
+}
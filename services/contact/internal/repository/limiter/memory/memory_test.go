@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+)
+
+func TestTake(t *testing.T) {
+	var (
+		now   = time.Now()
+		r     = New(Options{SweepInterval: time.Second})
+		limit = rateLimit.Limit{Burst: 1, Period: time.Second * 2}
+		ctx   = context.Empty()
+	)
+	r.timeNowFn = func() time.Time { return now }
+
+	if result, _ := r.Take(ctx, "a", limit, 1); !result.Allowed {
+		t.Fatal("first request must be allowed")
+	}
+	if result, _ := r.Take(ctx, "a", limit, 1); result.Allowed || result.RetryAfter != time.Second*2 {
+		t.Fatalf("second request must be limited, got %+v", result)
+	}
+	if result, _ := r.Take(ctx, "b", limit, 1); !result.Allowed {
+		t.Fatal("other client must have its own bucket")
+	}
+
+	// через три секунды оба ведра полные и удаляются при очистке
+	now = now.Add(time.Second * 3)
+	if result, _ := r.Take(ctx, "a", limit, 1); !result.Allowed {
+		t.Fatal("bucket must refill")
+	}
+	if _, found := r.buckets["b"]; found {
+		t.Error("full bucket must be swept")
+	}
+}
@@ -0,0 +1,175 @@
+package redis
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+)
+
+func init() {
+	viper.SetDefault("REDIS_ADDR", "localhost:6379")
+	viper.SetDefault("REDIS_DB", 0)
+	viper.SetDefault("RATE_LIMIT_REDIS_PREFIX", "slurm:rateLimit:")
+}
+
+// script то же ведро, что и rateLimit.Take, но время берётся с сервера Redis, чтобы реплики сервиса
+// с расходящимися часами считали одинаково. Время и период -- в микросекундах. Ведро живёт,
+// пока не заполнится: новое ведро тоже полное.
+const script = `
+local burst = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local cost = tonumber(ARGV[3])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+if tokens == nil then
+	tokens = burst
+else
+	tokens = math.min(burst, tokens + math.max(0, now - tonumber(state[2])) * burst / period)
+end
+local allowed = 0
+if tokens >= cost then
+	tokens = tokens - cost
+	allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) * period / burst / 1000) + 1)
+return {allowed, tostring(tokens)}
+`
+
+var takeScript = goredis.NewScript(script)
+
+// Repository держит вёдра в Redis: реплики сервиса с общим Redis делят лимит.
+// Для локальной разработки подходит redis-server без настроек.
+type Repository struct {
+	client  *goredis.Client
+	options Options
+}
+
+type Options struct {
+	Addr     string
+	Password string
+	DB       int
+	// Prefix префикс ключей вёдер
+	Prefix string
+	// PoolSize размер пула соединений
+	PoolSize int
+	Timeout  time.Duration
+}
+
+func New(o Options) (*Repository, error) {
+	var r = &Repository{}
+	r.SetOptions(o)
+
+	r.client = goredis.NewClient(&goredis.Options{
+		Addr:         r.options.Addr,
+		Password:     r.options.Password,
+		DB:           r.options.DB,
+		PoolSize:     r.options.PoolSize,
+		DialTimeout:  r.options.Timeout,
+		ReadTimeout:  r.options.Timeout,
+		WriteTimeout: r.options.Timeout,
+	})
+
+	// соединение проверяется сразу, чтобы ошибка настройки была видна при запуске
+	if err := r.client.Ping(context.Empty()).Err(); err != nil {
+		_ = r.client.Close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Repository) SetOptions(options Options) {
+	if options.Addr == "" {
+		options.Addr = viper.GetString("REDIS_ADDR")
+		log.Debug("set default options.Addr", zap.Any("addr", options.Addr))
+	}
+
+	if options.Password == "" {
+		options.Password = viper.GetString("REDIS_PASSWORD")
+	}
+
+	if options.DB == 0 {
+		options.DB = viper.GetInt("REDIS_DB")
+	}
+
+	if options.Prefix == "" {
+		options.Prefix = viper.GetString("RATE_LIMIT_REDIS_PREFIX")
+		log.Debug("set default options.Prefix", zap.Any("prefix", options.Prefix))
+	}
+
+	if options.PoolSize == 0 {
+		options.PoolSize = 10
+		log.Debug("set default options.PoolSize", zap.Any("poolSize", options.PoolSize))
+	}
+
+	if options.Timeout == 0 {
+		options.Timeout = time.Millisecond * 500
+		log.Debug("set default options.Timeout", zap.Any("timeout", options.Timeout))
+	}
+
+	if r.options != options {
+		r.options = options
+		log.Info("set new options", zap.Any("addr", r.options.Addr), zap.Any("db", r.options.DB), zap.Any("prefix", r.options.Prefix))
+	}
+}
+
+func (r *Repository) Close() error {
+	return r.client.Close()
+}
+
+// Take сначала пробует EVALSHA и загружает скрипт через EVAL, только если сервер его ещё не знает
+func (r *Repository) Take(ctx context.Context, key string, limit rateLimit.Limit, cost uint64) (*rateLimit.Result, error) {
+	reply, err := takeScript.Run(ctx, r.client, []string{r.options.Prefix + key},
+		limit.Burst,
+		limit.Period.Microseconds(),
+		cost,
+	).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, tokens, err := parseReply(reply)
+	if err != nil {
+		return nil, log.ErrorWithContext(ctx, err)
+	}
+
+	var value = rateLimit.NewResult(limit, tokens, allowed, cost)
+	return &value, nil
+}
+
+var errReply = errors.New("redis: unexpected script reply")
+
+// parseReply ответ скрипта {allowed, tokens}: число и строка
+func parseReply(reply []interface{}) (bool, float64, error) {
+	if len(reply) != 2 {
+		return false, 0, errReply
+	}
+
+	allowed, ok := reply[0].(int64)
+	if !ok {
+		return false, 0, errReply
+	}
+
+	data, ok := reply[1].(string)
+	if !ok {
+		return false, 0, errReply
+	}
+
+	tokens, err := strconv.ParseFloat(data, 64)
+	if err != nil {
+		return false, 0, errReply
+	}
+
+	return allowed == 1, tokens, nil
+}
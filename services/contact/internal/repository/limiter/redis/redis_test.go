@@ -0,0 +1,27 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReply(t *testing.T) {
+	var req = require.New(t)
+
+	allowed, tokens, err := parseReply([]interface{}{int64(1), "2.5"})
+	req.NoError(err)
+	req.True(allowed)
+	req.Equal(2.5, tokens)
+
+	allowed, tokens, err = parseReply([]interface{}{int64(0), "0"})
+	req.NoError(err)
+	req.False(allowed)
+	req.Zero(tokens)
+
+	_, _, err = parseReply([]interface{}{int64(1)})
+	req.ErrorIs(err, errReply)
+
+	_, _, err = parseReply([]interface{}{"1", "2.5"})
+	req.ErrorIs(err, errReply)
+}
@@ -4,13 +4,19 @@ package mockStorage
 
 import (
 	context "architecture_go/pkg/type/context"
+	filter "architecture_go/pkg/type/filter"
+	queryParameter "architecture_go/pkg/type/queryParameter"
 	contact "architecture_go/services/contact/internal/domain/contact"
+	photo "architecture_go/services/contact/internal/domain/contact/photo"
+	customField "architecture_go/services/contact/internal/domain/customField"
+	organization "architecture_go/services/contact/internal/domain/organization"
+	name "architecture_go/services/contact/internal/domain/tag/name"
 
 	mock "github.com/stretchr/testify/mock"
 
-	queryParameter "architecture_go/pkg/type/queryParameter"
-
+	version "architecture_go/services/contact/internal/domain/version"
 	testing "testing"
+	time "time"
 
 	uuid "github.com/google/uuid"
 )
@@ -20,8 +26,101 @@ type Contact struct {
 	mock.Mock
 }
 
-// CountContact provides a mock function with given fields: ctx
-func (_m *Contact) CountContact(ctx context.Context) (uint64, error) {
+// AddContactTags provides a mock function with given fields: ctx, contactID, tags
+func (_m *Contact) AddContactTags(ctx context.Context, contactID uuid.UUID, tags ...name.Name) (*contact.Contact, error) {
+	_va := make([]interface{}, len(tags))
+	for _i := range tags {
+		_va[_i] = tags[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, contactID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...name.Name) *contact.Contact); ok {
+		r0 = rf(ctx, contactID, tags...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...name.Name) error); ok {
+		r1 = rf(ctx, contactID, tags...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountContact provides a mock function with given fields: ctx, filters
+func (_m *Contact) CountContact(ctx context.Context, filters filter.Filters) (uint64, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, filter.Filters) uint64); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, filter.Filters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountContactVersion provides a mock function with given fields: ctx, ID
+func (_m *Contact) CountContactVersion(ctx context.Context, ID uuid.UUID) (uint64, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) uint64); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountCustomField provides a mock function with given fields: ctx
+func (_m *Contact) CountCustomField(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountOrganization provides a mock function with given fields: ctx
+func (_m *Contact) CountOrganization(ctx context.Context) (uint64, error) {
 	ret := _m.Called(ctx)
 
 	var r0 uint64
@@ -108,6 +207,121 @@ func (_m *Contact) ListContact(ctx context.Context, parameter queryParameter.Que
 	return r0, r1
 }
 
+// ListContactBirthday provides a mock function with given fields: ctx, from, to, parameter
+func (_m *Contact) ListContactBirthday(ctx context.Context, from time.Time, to time.Time, parameter queryParameter.QueryParameter) ([]*contact.Contact, error) {
+	ret := _m.Called(ctx, from, to, parameter)
+
+	var r0 []*contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, queryParameter.QueryParameter) []*contact.Contact); ok {
+		r0 = rf(ctx, from, to, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, from, to, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContactVersion provides a mock function with given fields: ctx, ID, parameter
+func (_m *Contact) ListContactVersion(ctx context.Context, ID uuid.UUID, parameter queryParameter.QueryParameter) ([]*version.Version, error) {
+	ret := _m.Called(ctx, ID, parameter)
+
+	var r0 []*version.Version
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, queryParameter.QueryParameter) []*version.Version); ok {
+		r0 = rf(ctx, ID, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*version.Version)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, ID, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCustomField provides a mock function with given fields: ctx, parameter
+func (_m *Contact) ListCustomField(ctx context.Context, parameter queryParameter.QueryParameter) ([]*customField.CustomField, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*customField.CustomField
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*customField.CustomField); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*customField.CustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganization provides a mock function with given fields: ctx, parameter
+func (_m *Contact) ListOrganization(ctx context.Context, parameter queryParameter.QueryParameter) ([]*organization.Organization, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*organization.Organization
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*organization.Organization); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*organization.Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadContactAsOf provides a mock function with given fields: ctx, ID, asOf
+func (_m *Contact) ReadContactAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*contact.Contact, error) {
+	ret := _m.Called(ctx, ID, asOf)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *contact.Contact); ok {
+		r0 = rf(ctx, ID, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, ID, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReadContactByID provides a mock function with given fields: ctx, ID
 func (_m *Contact) ReadContactByID(ctx context.Context, ID uuid.UUID) (*contact.Contact, error) {
 	ret := _m.Called(ctx, ID)
@@ -131,6 +345,151 @@ func (_m *Contact) ReadContactByID(ctx context.Context, ID uuid.UUID) (*contact.
 	return r0, r1
 }
 
+// ReadContactVersion provides a mock function with given fields: ctx, ID, number
+func (_m *Contact) ReadContactVersion(ctx context.Context, ID uuid.UUID, number uint64) (*contact.Contact, error) {
+	ret := _m.Called(ctx, ID, number)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint64) *contact.Contact); ok {
+		r0 = rf(ctx, ID, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uint64) error); ok {
+		r1 = rf(ctx, ID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadCustomFieldByID provides a mock function with given fields: ctx, ID
+func (_m *Contact) ReadCustomFieldByID(ctx context.Context, ID uuid.UUID) (*customField.CustomField, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *customField.CustomField
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *customField.CustomField); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*customField.CustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadCustomFieldRegistry provides a mock function with given fields: ctx
+func (_m *Contact) ReadCustomFieldRegistry(ctx context.Context) (customField.Registry, error) {
+	ret := _m.Called(ctx)
+
+	var r0 customField.Registry
+	if rf, ok := ret.Get(0).(func(context.Context) customField.Registry); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(customField.Registry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadOrganizationByID provides a mock function with given fields: ctx, ID
+func (_m *Contact) ReadOrganizationByID(ctx context.Context, ID uuid.UUID) (*organization.Organization, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *organization.Organization
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *organization.Organization); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveContactTags provides a mock function with given fields: ctx, contactID, tags
+func (_m *Contact) RemoveContactTags(ctx context.Context, contactID uuid.UUID, tags ...name.Name) (*contact.Contact, error) {
+	_va := make([]interface{}, len(tags))
+	for _i := range tags {
+		_va[_i] = tags[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, contactID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...name.Name) *contact.Contact); ok {
+		r0 = rf(ctx, contactID, tags...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...name.Name) error); ok {
+		r1 = rf(ctx, contactID, tags...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreContact provides a mock function with given fields: ctx, ID
+func (_m *Contact) RestoreContact(ctx context.Context, ID uuid.UUID) (*contact.Contact, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *contact.Contact); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateContact provides a mock function with given fields: ctx, ID, updateFn
 func (_m *Contact) UpdateContact(ctx context.Context, ID uuid.UUID, updateFn func(*contact.Contact) (*contact.Contact, error)) (*contact.Contact, error) {
 	ret := _m.Called(ctx, ID, updateFn)
@@ -154,6 +513,29 @@ func (_m *Contact) UpdateContact(ctx context.Context, ID uuid.UUID, updateFn fun
 	return r0, r1
 }
 
+// UpdateContactPhoto provides a mock function with given fields: ctx, contactID, value
+func (_m *Contact) UpdateContactPhoto(ctx context.Context, contactID uuid.UUID, value photo.Photo) (*contact.Contact, error) {
+	ret := _m.Called(ctx, contactID, value)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, photo.Photo) *contact.Contact); ok {
+		r0 = rf(ctx, contactID, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, photo.Photo) error); ok {
+		r1 = rf(ctx, contactID, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewContact creates a new instance of Contact. It also registers a cleanup function to assert the mocks expectations.
 func NewContact(t testing.TB) *Contact {
 	mock := &Contact{}
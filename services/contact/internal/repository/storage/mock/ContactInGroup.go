@@ -4,7 +4,10 @@ package mockStorage
 
 import (
 	context "architecture_go/pkg/type/context"
+	queryParameter "architecture_go/pkg/type/queryParameter"
 	contact "architecture_go/services/contact/internal/domain/contact"
+	customField "architecture_go/services/contact/internal/domain/customField"
+	organization "architecture_go/services/contact/internal/domain/organization"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -39,6 +42,48 @@ func (_m *ContactInGroup) AddContactsToGroup(ctx context.Context, groupID uuid.U
 	return r0
 }
 
+// CountCustomField provides a mock function with given fields: ctx
+func (_m *ContactInGroup) CountCustomField(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountOrganization provides a mock function with given fields: ctx
+func (_m *ContactInGroup) CountOrganization(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateContactIntoGroup provides a mock function with given fields: ctx, groupID, contacts
 func (_m *ContactInGroup) CreateContactIntoGroup(ctx context.Context, groupID uuid.UUID, contacts ...*contact.Contact) ([]*contact.Contact, error) {
 	_va := make([]interface{}, len(contacts))
@@ -83,6 +128,121 @@ func (_m *ContactInGroup) DeleteContactFromGroup(ctx context.Context, groupID uu
 	return r0
 }
 
+// ListCustomField provides a mock function with given fields: ctx, parameter
+func (_m *ContactInGroup) ListCustomField(ctx context.Context, parameter queryParameter.QueryParameter) ([]*customField.CustomField, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*customField.CustomField
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*customField.CustomField); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*customField.CustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganization provides a mock function with given fields: ctx, parameter
+func (_m *ContactInGroup) ListOrganization(ctx context.Context, parameter queryParameter.QueryParameter) ([]*organization.Organization, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*organization.Organization
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*organization.Organization); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*organization.Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadCustomFieldByID provides a mock function with given fields: ctx, ID
+func (_m *ContactInGroup) ReadCustomFieldByID(ctx context.Context, ID uuid.UUID) (*customField.CustomField, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *customField.CustomField
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *customField.CustomField); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*customField.CustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadCustomFieldRegistry provides a mock function with given fields: ctx
+func (_m *ContactInGroup) ReadCustomFieldRegistry(ctx context.Context) (customField.Registry, error) {
+	ret := _m.Called(ctx)
+
+	var r0 customField.Registry
+	if rf, ok := ret.Get(0).(func(context.Context) customField.Registry); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(customField.Registry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadOrganizationByID provides a mock function with given fields: ctx, ID
+func (_m *ContactInGroup) ReadOrganizationByID(ctx context.Context, ID uuid.UUID) (*organization.Organization, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *organization.Organization
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *organization.Organization); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewContactInGroup creates a new instance of ContactInGroup. It also registers a cleanup function to assert the mocks expectations.
 func NewContactInGroup(t testing.TB) *ContactInGroup {
 	mock := &ContactInGroup{}
@@ -4,13 +4,15 @@ package mockStorage
 
 import (
 	context "architecture_go/pkg/type/context"
+	filter "architecture_go/pkg/type/filter"
+	queryParameter "architecture_go/pkg/type/queryParameter"
 	contact "architecture_go/services/contact/internal/domain/contact"
 
 	mock "github.com/stretchr/testify/mock"
 
-	queryParameter "architecture_go/pkg/type/queryParameter"
-
+	version "architecture_go/services/contact/internal/domain/version"
 	testing "testing"
+	time "time"
 
 	uuid "github.com/google/uuid"
 )
@@ -20,20 +22,41 @@ type ContactReader struct {
 	mock.Mock
 }
 
-// CountContact provides a mock function with given fields: ctx
-func (_m *ContactReader) CountContact(ctx context.Context) (uint64, error) {
-	ret := _m.Called(ctx)
+// CountContact provides a mock function with given fields: ctx, filters
+func (_m *ContactReader) CountContact(ctx context.Context, filters filter.Filters) (uint64, error) {
+	ret := _m.Called(ctx, filters)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, filter.Filters) uint64); ok {
+		r0 = rf(ctx, filters)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, filter.Filters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountContactVersion provides a mock function with given fields: ctx, ID
+func (_m *ContactReader) CountContactVersion(ctx context.Context, ID uuid.UUID) (uint64, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) uint64); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,6 +87,75 @@ func (_m *ContactReader) ListContact(ctx context.Context, parameter queryParamet
 	return r0, r1
 }
 
+// ListContactBirthday provides a mock function with given fields: ctx, from, to, parameter
+func (_m *ContactReader) ListContactBirthday(ctx context.Context, from time.Time, to time.Time, parameter queryParameter.QueryParameter) ([]*contact.Contact, error) {
+	ret := _m.Called(ctx, from, to, parameter)
+
+	var r0 []*contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, queryParameter.QueryParameter) []*contact.Contact); ok {
+		r0 = rf(ctx, from, to, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, from, to, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContactVersion provides a mock function with given fields: ctx, ID, parameter
+func (_m *ContactReader) ListContactVersion(ctx context.Context, ID uuid.UUID, parameter queryParameter.QueryParameter) ([]*version.Version, error) {
+	ret := _m.Called(ctx, ID, parameter)
+
+	var r0 []*version.Version
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, queryParameter.QueryParameter) []*version.Version); ok {
+		r0 = rf(ctx, ID, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*version.Version)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, ID, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadContactAsOf provides a mock function with given fields: ctx, ID, asOf
+func (_m *ContactReader) ReadContactAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*contact.Contact, error) {
+	ret := _m.Called(ctx, ID, asOf)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *contact.Contact); ok {
+		r0 = rf(ctx, ID, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, ID, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReadContactByID provides a mock function with given fields: ctx, ID
 func (_m *ContactReader) ReadContactByID(ctx context.Context, ID uuid.UUID) (*contact.Contact, error) {
 	ret := _m.Called(ctx, ID)
@@ -87,6 +179,29 @@ func (_m *ContactReader) ReadContactByID(ctx context.Context, ID uuid.UUID) (*co
 	return r0, r1
 }
 
+// ReadContactVersion provides a mock function with given fields: ctx, ID, number
+func (_m *ContactReader) ReadContactVersion(ctx context.Context, ID uuid.UUID, number uint64) (*contact.Contact, error) {
+	ret := _m.Called(ctx, ID, number)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint64) *contact.Contact); ok {
+		r0 = rf(ctx, ID, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uint64) error); ok {
+		r1 = rf(ctx, ID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewContactReader creates a new instance of ContactReader. It also registers a cleanup function to assert the mocks expectations.
 func NewContactReader(t testing.TB) *ContactReader {
 	mock := &ContactReader{}
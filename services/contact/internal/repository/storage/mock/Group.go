@@ -4,15 +4,17 @@ package mockStorage
 
 import (
 	context "architecture_go/pkg/type/context"
+	queryParameter "architecture_go/pkg/type/queryParameter"
 	contact "architecture_go/services/contact/internal/domain/contact"
-
+	customField "architecture_go/services/contact/internal/domain/customField"
 	group "architecture_go/services/contact/internal/domain/group"
+	acl "architecture_go/services/contact/internal/domain/group/acl"
+	organization "architecture_go/services/contact/internal/domain/organization"
 
 	mock "github.com/stretchr/testify/mock"
 
-	queryParameter "architecture_go/pkg/type/queryParameter"
-
 	testing "testing"
+	time "time"
 
 	uuid "github.com/google/uuid"
 )
@@ -43,6 +45,27 @@ func (_m *Group) AddContactsToGroup(ctx context.Context, groupID uuid.UUID, cont
 	return r0
 }
 
+// CountCustomField provides a mock function with given fields: ctx
+func (_m *Group) CountCustomField(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CountGroup provides a mock function with given fields: ctx
 func (_m *Group) CountGroup(ctx context.Context) (uint64, error) {
 	ret := _m.Called(ctx)
@@ -64,6 +87,27 @@ func (_m *Group) CountGroup(ctx context.Context) (uint64, error) {
 	return r0, r1
 }
 
+// CountOrganization provides a mock function with given fields: ctx
+func (_m *Group) CountOrganization(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateContactIntoGroup provides a mock function with given fields: ctx, groupID, contacts
 func (_m *Group) CreateContactIntoGroup(ctx context.Context, groupID uuid.UUID, contacts ...*contact.Contact) ([]*contact.Contact, error) {
 	_va := make([]interface{}, len(contacts))
@@ -145,6 +189,43 @@ func (_m *Group) DeleteGroup(ctx context.Context, ID uuid.UUID) error {
 	return r0
 }
 
+// DeleteGroupACL provides a mock function with given fields: ctx, groupID, grantee
+func (_m *Group) DeleteGroupACL(ctx context.Context, groupID uuid.UUID, grantee string) error {
+	ret := _m.Called(ctx, groupID, grantee)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, groupID, grantee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListCustomField provides a mock function with given fields: ctx, parameter
+func (_m *Group) ListCustomField(ctx context.Context, parameter queryParameter.QueryParameter) ([]*customField.CustomField, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*customField.CustomField
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*customField.CustomField); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*customField.CustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListGroup provides a mock function with given fields: ctx, parameter
 func (_m *Group) ListGroup(ctx context.Context, parameter queryParameter.QueryParameter) ([]*group.Group, error) {
 	ret := _m.Called(ctx, parameter)
@@ -168,6 +249,121 @@ func (_m *Group) ListGroup(ctx context.Context, parameter queryParameter.QueryPa
 	return r0, r1
 }
 
+// ListGroupACL provides a mock function with given fields: ctx, groupID
+func (_m *Group) ListGroupACL(ctx context.Context, groupID uuid.UUID) ([]*acl.Entry, error) {
+	ret := _m.Called(ctx, groupID)
+
+	var r0 []*acl.Entry
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*acl.Entry); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*acl.Entry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganization provides a mock function with given fields: ctx, parameter
+func (_m *Group) ListOrganization(ctx context.Context, parameter queryParameter.QueryParameter) ([]*organization.Organization, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*organization.Organization
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*organization.Organization); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*organization.Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadCustomFieldByID provides a mock function with given fields: ctx, ID
+func (_m *Group) ReadCustomFieldByID(ctx context.Context, ID uuid.UUID) (*customField.CustomField, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *customField.CustomField
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *customField.CustomField); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*customField.CustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadCustomFieldRegistry provides a mock function with given fields: ctx
+func (_m *Group) ReadCustomFieldRegistry(ctx context.Context) (customField.Registry, error) {
+	ret := _m.Called(ctx)
+
+	var r0 customField.Registry
+	if rf, ok := ret.Get(0).(func(context.Context) customField.Registry); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(customField.Registry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadGroupAsOf provides a mock function with given fields: ctx, ID, asOf
+func (_m *Group) ReadGroupAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*group.Group, error) {
+	ret := _m.Called(ctx, ID, asOf)
+
+	var r0 *group.Group
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *group.Group); ok {
+		r0 = rf(ctx, ID, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*group.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, ID, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReadGroupByID provides a mock function with given fields: ctx, ID
 func (_m *Group) ReadGroupByID(ctx context.Context, ID uuid.UUID) (*group.Group, error) {
 	ret := _m.Called(ctx, ID)
@@ -191,6 +387,52 @@ func (_m *Group) ReadGroupByID(ctx context.Context, ID uuid.UUID) (*group.Group,
 	return r0, r1
 }
 
+// ReadOrganizationByID provides a mock function with given fields: ctx, ID
+func (_m *Group) ReadOrganizationByID(ctx context.Context, ID uuid.UUID) (*organization.Organization, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *organization.Organization
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *organization.Organization); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetGroupACL provides a mock function with given fields: ctx, entry
+func (_m *Group) SetGroupACL(ctx context.Context, entry *acl.Entry) (*acl.Entry, error) {
+	ret := _m.Called(ctx, entry)
+
+	var r0 *acl.Entry
+	if rf, ok := ret.Get(0).(func(context.Context, *acl.Entry) *acl.Entry); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*acl.Entry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *acl.Entry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateGroup provides a mock function with given fields: ctx, ID, updateFn
 func (_m *Group) UpdateGroup(ctx context.Context, ID uuid.UUID, updateFn func(*group.Group) (*group.Group, error)) (*group.Group, error) {
 	ret := _m.Called(ctx, ID, updateFn)
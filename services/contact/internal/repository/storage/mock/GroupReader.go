@@ -4,13 +4,13 @@ package mockStorage
 
 import (
 	context "architecture_go/pkg/type/context"
+	queryParameter "architecture_go/pkg/type/queryParameter"
 	group "architecture_go/services/contact/internal/domain/group"
 
 	mock "github.com/stretchr/testify/mock"
 
-	queryParameter "architecture_go/pkg/type/queryParameter"
-
 	testing "testing"
+	time "time"
 
 	uuid "github.com/google/uuid"
 )
@@ -64,6 +64,29 @@ func (_m *GroupReader) ListGroup(ctx context.Context, parameter queryParameter.Q
 	return r0, r1
 }
 
+// ReadGroupAsOf provides a mock function with given fields: ctx, ID, asOf
+func (_m *GroupReader) ReadGroupAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*group.Group, error) {
+	ret := _m.Called(ctx, ID, asOf)
+
+	var r0 *group.Group
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *group.Group); ok {
+		r0 = rf(ctx, ID, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*group.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, ID, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReadGroupByID provides a mock function with given fields: ctx, ID
 func (_m *GroupReader) ReadGroupByID(ctx context.Context, ID uuid.UUID) (*group.Group, error) {
 	ret := _m.Called(ctx, ID)
@@ -4,15 +4,31 @@ package mockStorage
 
 import (
 	context "architecture_go/pkg/type/context"
+	filter "architecture_go/pkg/type/filter"
+	queryParameter "architecture_go/pkg/type/queryParameter"
+	apiKey "architecture_go/services/contact/internal/domain/apiKey"
+	audit "architecture_go/services/contact/internal/domain/audit"
 	contact "architecture_go/services/contact/internal/domain/contact"
-
+	photo "architecture_go/services/contact/internal/domain/contact/photo"
+	customField "architecture_go/services/contact/internal/domain/customField"
+	delta "architecture_go/services/contact/internal/domain/delta"
 	group "architecture_go/services/contact/internal/domain/group"
+	acl "architecture_go/services/contact/internal/domain/group/acl"
+	note "architecture_go/services/contact/internal/domain/note"
+	organization "architecture_go/services/contact/internal/domain/organization"
+	outbox "architecture_go/services/contact/internal/domain/outbox"
+	session "architecture_go/services/contact/internal/domain/session"
+	share "architecture_go/services/contact/internal/domain/share"
+	name "architecture_go/services/contact/internal/domain/tag/name"
 
 	mock "github.com/stretchr/testify/mock"
 
-	queryParameter "architecture_go/pkg/type/queryParameter"
-
+	tag "architecture_go/services/contact/internal/domain/tag"
+	tenant "architecture_go/services/contact/internal/domain/tenant"
+	version "architecture_go/services/contact/internal/domain/version"
+	webhook "architecture_go/services/contact/internal/domain/webhook"
 	testing "testing"
+	time "time"
 
 	uuid "github.com/google/uuid"
 )
@@ -22,6 +38,36 @@ type Storage struct {
 	mock.Mock
 }
 
+// AddContactTags provides a mock function with given fields: ctx, contactID, tags
+func (_m *Storage) AddContactTags(ctx context.Context, contactID uuid.UUID, tags ...name.Name) (*contact.Contact, error) {
+	_va := make([]interface{}, len(tags))
+	for _i := range tags {
+		_va[_i] = tags[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, contactID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...name.Name) *contact.Contact); ok {
+		r0 = rf(ctx, contactID, tags...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...name.Name) error); ok {
+		r1 = rf(ctx, contactID, tags...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddContactsToGroup provides a mock function with given fields: ctx, groupID, contactIDs
 func (_m *Storage) AddContactsToGroup(ctx context.Context, groupID uuid.UUID, contactIDs ...uuid.UUID) error {
 	_va := make([]interface{}, len(contactIDs))
@@ -33,30 +79,1474 @@ func (_m *Storage) AddContactsToGroup(ctx context.Context, groupID uuid.UUID, co
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...uuid.UUID) error); ok {
-		r0 = rf(ctx, groupID, contactIDs...)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...uuid.UUID) error); ok {
+		r0 = rf(ctx, groupID, contactIDs...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CountAPIKey provides a mock function with given fields: ctx, subject
+func (_m *Storage) CountAPIKey(ctx context.Context, subject string) (uint64, error) {
+	ret := _m.Called(ctx, subject)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, string) uint64); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountAudit provides a mock function with given fields: ctx, filters
+func (_m *Storage) CountAudit(ctx context.Context, filters filter.Filters) (uint64, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, filter.Filters) uint64); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, filter.Filters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountContact provides a mock function with given fields: ctx, filters
+func (_m *Storage) CountContact(ctx context.Context, filters filter.Filters) (uint64, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, filter.Filters) uint64); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, filter.Filters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountContactVersion provides a mock function with given fields: ctx, ID
+func (_m *Storage) CountContactVersion(ctx context.Context, ID uuid.UUID) (uint64, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) uint64); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountCustomField provides a mock function with given fields: ctx
+func (_m *Storage) CountCustomField(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountGroup provides a mock function with given fields: ctx
+func (_m *Storage) CountGroup(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountNote provides a mock function with given fields: ctx, contactID
+func (_m *Storage) CountNote(ctx context.Context, contactID uuid.UUID) (uint64, error) {
+	ret := _m.Called(ctx, contactID)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) uint64); ok {
+		r0 = rf(ctx, contactID)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, contactID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountOrganization provides a mock function with given fields: ctx
+func (_m *Storage) CountOrganization(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountTag provides a mock function with given fields: ctx
+func (_m *Storage) CountTag(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountTenant provides a mock function with given fields: ctx
+func (_m *Storage) CountTenant(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountWebhook provides a mock function with given fields: ctx
+func (_m *Storage) CountWebhook(ctx context.Context) (uint64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountWebhookDelivery provides a mock function with given fields: ctx, webhookID, filters
+func (_m *Storage) CountWebhookDelivery(ctx context.Context, webhookID uuid.UUID, filters filter.Filters) (uint64, error) {
+	ret := _m.Called(ctx, webhookID, filters)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, filter.Filters) uint64); ok {
+		r0 = rf(ctx, webhookID, filters)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, filter.Filters) error); ok {
+		r1 = rf(ctx, webhookID, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, key
+func (_m *Storage) CreateAPIKey(ctx context.Context, key *apiKey.APIKey) (*apiKey.APIKey, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 *apiKey.APIKey
+	if rf, ok := ret.Get(0).(func(context.Context, *apiKey.APIKey) *apiKey.APIKey); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiKey.APIKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiKey.APIKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateContact provides a mock function with given fields: ctx, contacts
+func (_m *Storage) CreateContact(ctx context.Context, contacts ...*contact.Contact) ([]*contact.Contact, error) {
+	_va := make([]interface{}, len(contacts))
+	for _i := range contacts {
+		_va[_i] = contacts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []*contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, ...*contact.Contact) []*contact.Contact); ok {
+		r0 = rf(ctx, contacts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...*contact.Contact) error); ok {
+		r1 = rf(ctx, contacts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateContactIntoGroup provides a mock function with given fields: ctx, groupID, contacts
+func (_m *Storage) CreateContactIntoGroup(ctx context.Context, groupID uuid.UUID, contacts ...*contact.Contact) ([]*contact.Contact, error) {
+	_va := make([]interface{}, len(contacts))
+	for _i := range contacts {
+		_va[_i] = contacts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, groupID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []*contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...*contact.Contact) []*contact.Contact); ok {
+		r0 = rf(ctx, groupID, contacts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...*contact.Contact) error); ok {
+		r1 = rf(ctx, groupID, contacts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCustomField provides a mock function with given fields: ctx, field
+func (_m *Storage) CreateCustomField(ctx context.Context, field *customField.CustomField) (*customField.CustomField, error) {
+	ret := _m.Called(ctx, field)
+
+	var r0 *customField.CustomField
+	if rf, ok := ret.Get(0).(func(context.Context, *customField.CustomField) *customField.CustomField); ok {
+		r0 = rf(ctx, field)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*customField.CustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *customField.CustomField) error); ok {
+		r1 = rf(ctx, field)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateGroup provides a mock function with given fields: ctx, _a1
+func (_m *Storage) CreateGroup(ctx context.Context, _a1 *group.Group) (*group.Group, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *group.Group
+	if rf, ok := ret.Get(0).(func(context.Context, *group.Group) *group.Group); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*group.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *group.Group) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateNote provides a mock function with given fields: ctx, n
+func (_m *Storage) CreateNote(ctx context.Context, n *note.Note) (*note.Note, error) {
+	ret := _m.Called(ctx, n)
+
+	var r0 *note.Note
+	if rf, ok := ret.Get(0).(func(context.Context, *note.Note) *note.Note); ok {
+		r0 = rf(ctx, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*note.Note)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *note.Note) error); ok {
+		r1 = rf(ctx, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOrganization provides a mock function with given fields: ctx, org
+func (_m *Storage) CreateOrganization(ctx context.Context, org *organization.Organization) (*organization.Organization, error) {
+	ret := _m.Called(ctx, org)
+
+	var r0 *organization.Organization
+	if rf, ok := ret.Get(0).(func(context.Context, *organization.Organization) *organization.Organization); ok {
+		r0 = rf(ctx, org)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *organization.Organization) error); ok {
+		r1 = rf(ctx, org)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSession provides a mock function with given fields: ctx, value
+func (_m *Storage) CreateSession(ctx context.Context, value *session.Session) (*session.Session, error) {
+	ret := _m.Called(ctx, value)
+
+	var r0 *session.Session
+	if rf, ok := ret.Get(0).(func(context.Context, *session.Session) *session.Session); ok {
+		r0 = rf(ctx, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.Session)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *session.Session) error); ok {
+		r1 = rf(ctx, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTenant provides a mock function with given fields: ctx, value
+func (_m *Storage) CreateTenant(ctx context.Context, value *tenant.Tenant) (*tenant.Tenant, error) {
+	ret := _m.Called(ctx, value)
+
+	var r0 *tenant.Tenant
+	if rf, ok := ret.Get(0).(func(context.Context, *tenant.Tenant) *tenant.Tenant); ok {
+		r0 = rf(ctx, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tenant.Tenant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *tenant.Tenant) error); ok {
+		r1 = rf(ctx, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWebhook provides a mock function with given fields: ctx, hook
+func (_m *Storage) CreateWebhook(ctx context.Context, hook *webhook.Webhook) (*webhook.Webhook, error) {
+	ret := _m.Called(ctx, hook)
+
+	var r0 *webhook.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.Webhook) *webhook.Webhook); ok {
+		r0 = rf(ctx, hook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *webhook.Webhook) error); ok {
+		r1 = rf(ctx, hook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteContact provides a mock function with given fields: ctx, ID
+func (_m *Storage) DeleteContact(ctx context.Context, ID uuid.UUID) error {
+	ret := _m.Called(ctx, ID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteContactFromGroup provides a mock function with given fields: ctx, groupID, contactID
+func (_m *Storage) DeleteContactFromGroup(ctx context.Context, groupID uuid.UUID, contactID uuid.UUID) error {
+	ret := _m.Called(ctx, groupID, contactID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, groupID, contactID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCustomField provides a mock function with given fields: ctx, ID
+func (_m *Storage) DeleteCustomField(ctx context.Context, ID uuid.UUID) error {
+	ret := _m.Called(ctx, ID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteGroup provides a mock function with given fields: ctx, ID
+func (_m *Storage) DeleteGroup(ctx context.Context, ID uuid.UUID) error {
+	ret := _m.Called(ctx, ID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteGroupACL provides a mock function with given fields: ctx, groupID, grantee
+func (_m *Storage) DeleteGroupACL(ctx context.Context, groupID uuid.UUID, grantee string) error {
+	ret := _m.Called(ctx, groupID, grantee)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, groupID, grantee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteNote provides a mock function with given fields: ctx, contactID, ID
+func (_m *Storage) DeleteNote(ctx context.Context, contactID uuid.UUID, ID uuid.UUID) error {
+	ret := _m.Called(ctx, contactID, ID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, contactID, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOrganization provides a mock function with given fields: ctx, ID
+func (_m *Storage) DeleteOrganization(ctx context.Context, ID uuid.UUID) error {
+	ret := _m.Called(ctx, ID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSession provides a mock function with given fields: ctx, hash
+func (_m *Storage) DeleteSession(ctx context.Context, hash []byte) error {
+	ret := _m.Called(ctx, hash)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) error); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteShare provides a mock function with given fields: ctx, resource, resourceID, grantee
+func (_m *Storage) DeleteShare(ctx context.Context, resource share.Resource, resourceID uuid.UUID, grantee string) error {
+	ret := _m.Called(ctx, resource, resourceID, grantee)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, share.Resource, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, resource, resourceID, grantee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, ID
+func (_m *Storage) DeleteWebhook(ctx context.Context, ID uuid.UUID) error {
+	ret := _m.Called(ctx, ID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LastOutboxSequence provides a mock function with given fields: ctx
+func (_m *Storage) LastOutboxSequence(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAPIKey provides a mock function with given fields: ctx, subject, parameter
+func (_m *Storage) ListAPIKey(ctx context.Context, subject string, parameter queryParameter.QueryParameter) ([]*apiKey.APIKey, error) {
+	ret := _m.Called(ctx, subject, parameter)
+
+	var r0 []*apiKey.APIKey
+	if rf, ok := ret.Get(0).(func(context.Context, string, queryParameter.QueryParameter) []*apiKey.APIKey); ok {
+		r0 = rf(ctx, subject, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*apiKey.APIKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, subject, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAudit provides a mock function with given fields: ctx, parameter
+func (_m *Storage) ListAudit(ctx context.Context, parameter queryParameter.QueryParameter) ([]*audit.Entry, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*audit.Entry
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*audit.Entry); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*audit.Entry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContact provides a mock function with given fields: ctx, parameter
+func (_m *Storage) ListContact(ctx context.Context, parameter queryParameter.QueryParameter) ([]*contact.Contact, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*contact.Contact); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContactBirthday provides a mock function with given fields: ctx, from, to, parameter
+func (_m *Storage) ListContactBirthday(ctx context.Context, from time.Time, to time.Time, parameter queryParameter.QueryParameter) ([]*contact.Contact, error) {
+	ret := _m.Called(ctx, from, to, parameter)
+
+	var r0 []*contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, queryParameter.QueryParameter) []*contact.Contact); ok {
+		r0 = rf(ctx, from, to, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, from, to, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContactVersion provides a mock function with given fields: ctx, ID, parameter
+func (_m *Storage) ListContactVersion(ctx context.Context, ID uuid.UUID, parameter queryParameter.QueryParameter) ([]*version.Version, error) {
+	ret := _m.Called(ctx, ID, parameter)
+
+	var r0 []*version.Version
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, queryParameter.QueryParameter) []*version.Version); ok {
+		r0 = rf(ctx, ID, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*version.Version)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, ID, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCustomField provides a mock function with given fields: ctx, parameter
+func (_m *Storage) ListCustomField(ctx context.Context, parameter queryParameter.QueryParameter) ([]*customField.CustomField, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*customField.CustomField
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*customField.CustomField); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*customField.CustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDelta provides a mock function with given fields: ctx, since, limit
+func (_m *Storage) ListDelta(ctx context.Context, since delta.Token, limit uint64) (*delta.Delta, error) {
+	ret := _m.Called(ctx, since, limit)
+
+	var r0 *delta.Delta
+	if rf, ok := ret.Get(0).(func(context.Context, delta.Token, uint64) *delta.Delta); ok {
+		r0 = rf(ctx, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*delta.Delta)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, delta.Token, uint64) error); ok {
+		r1 = rf(ctx, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGroup provides a mock function with given fields: ctx, parameter
+func (_m *Storage) ListGroup(ctx context.Context, parameter queryParameter.QueryParameter) ([]*group.Group, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*group.Group
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*group.Group); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*group.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListGroupACL provides a mock function with given fields: ctx, groupID
+func (_m *Storage) ListGroupACL(ctx context.Context, groupID uuid.UUID) ([]*acl.Entry, error) {
+	ret := _m.Called(ctx, groupID)
+
+	var r0 []*acl.Entry
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*acl.Entry); ok {
+		r0 = rf(ctx, groupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*acl.Entry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, groupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListNote provides a mock function with given fields: ctx, contactID, parameter
+func (_m *Storage) ListNote(ctx context.Context, contactID uuid.UUID, parameter queryParameter.QueryParameter) ([]*note.Note, error) {
+	ret := _m.Called(ctx, contactID, parameter)
+
+	var r0 []*note.Note
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, queryParameter.QueryParameter) []*note.Note); ok {
+		r0 = rf(ctx, contactID, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*note.Note)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, contactID, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOrganization provides a mock function with given fields: ctx, parameter
+func (_m *Storage) ListOrganization(ctx context.Context, parameter queryParameter.QueryParameter) ([]*organization.Organization, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*organization.Organization
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*organization.Organization); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*organization.Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOutboxAfter provides a mock function with given fields: ctx, after, limit
+func (_m *Storage) ListOutboxAfter(ctx context.Context, after int64, limit uint64) ([]*outbox.Message, error) {
+	ret := _m.Called(ctx, after, limit)
+
+	var r0 []*outbox.Message
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) []*outbox.Message); ok {
+		r0 = rf(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*outbox.Message)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64) error); ok {
+		r1 = rf(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListShare provides a mock function with given fields: ctx, resource, resourceID
+func (_m *Storage) ListShare(ctx context.Context, resource share.Resource, resourceID uuid.UUID) ([]*share.Share, error) {
+	ret := _m.Called(ctx, resource, resourceID)
+
+	var r0 []*share.Share
+	if rf, ok := ret.Get(0).(func(context.Context, share.Resource, uuid.UUID) []*share.Share); ok {
+		r0 = rf(ctx, resource, resourceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*share.Share)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, share.Resource, uuid.UUID) error); ok {
+		r1 = rf(ctx, resource, resourceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTag provides a mock function with given fields: ctx, parameter
+func (_m *Storage) ListTag(ctx context.Context, parameter queryParameter.QueryParameter) ([]*tag.Tag, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*tag.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*tag.Tag); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*tag.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTenant provides a mock function with given fields: ctx, parameter
+func (_m *Storage) ListTenant(ctx context.Context, parameter queryParameter.QueryParameter) ([]*tenant.Tenant, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*tenant.Tenant
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*tenant.Tenant); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*tenant.Tenant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWebhook provides a mock function with given fields: ctx, parameter
+func (_m *Storage) ListWebhook(ctx context.Context, parameter queryParameter.QueryParameter) ([]*webhook.Webhook, error) {
+	ret := _m.Called(ctx, parameter)
+
+	var r0 []*webhook.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*webhook.Webhook); ok {
+		r0 = rf(ctx, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWebhookDelivery provides a mock function with given fields: ctx, webhookID, parameter
+func (_m *Storage) ListWebhookDelivery(ctx context.Context, webhookID uuid.UUID, parameter queryParameter.QueryParameter) ([]*webhook.Delivery, error) {
+	ret := _m.Called(ctx, webhookID, parameter)
+
+	var r0 []*webhook.Delivery
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, queryParameter.QueryParameter) []*webhook.Delivery); ok {
+		r0 = rf(ctx, webhookID, parameter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Delivery)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, queryParameter.QueryParameter) error); ok {
+		r1 = rf(ctx, webhookID, parameter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeTags provides a mock function with given fields: ctx, targetID, sourceIDs
+func (_m *Storage) MergeTags(ctx context.Context, targetID uuid.UUID, sourceIDs ...uuid.UUID) (*tag.Tag, error) {
+	_va := make([]interface{}, len(sourceIDs))
+	for _i := range sourceIDs {
+		_va[_i] = sourceIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, targetID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *tag.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...uuid.UUID) *tag.Tag); ok {
+		r0 = rf(ctx, targetID, sourceIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tag.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...uuid.UUID) error); ok {
+		r1 = rf(ctx, targetID, sourceIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProcessOutbox provides a mock function with given fields: ctx, limit, processFn
+func (_m *Storage) ProcessOutbox(ctx context.Context, limit uint64, processFn func([]*outbox.Message)) (uint64, error) {
+	ret := _m.Called(ctx, limit, processFn)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, func([]*outbox.Message)) uint64); ok {
+		r0 = rf(ctx, limit, processFn)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, func([]*outbox.Message)) error); ok {
+		r1 = rf(ctx, limit, processFn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProcessWebhookDelivery provides a mock function with given fields: ctx, limit, processFn
+func (_m *Storage) ProcessWebhookDelivery(ctx context.Context, limit uint64, processFn func([]*webhook.Delivery, map[uuid.UUID]*webhook.Webhook)) (uint64, error) {
+	ret := _m.Called(ctx, limit, processFn)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, func([]*webhook.Delivery, map[uuid.UUID]*webhook.Webhook)) uint64); ok {
+		r0 = rf(ctx, limit, processFn)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, func([]*webhook.Delivery, map[uuid.UUID]*webhook.Webhook)) error); ok {
+		r1 = rf(ctx, limit, processFn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadAPIKeyByHash provides a mock function with given fields: ctx, hash
+func (_m *Storage) ReadAPIKeyByHash(ctx context.Context, hash []byte) (*apiKey.APIKey, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *apiKey.APIKey
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *apiKey.APIKey); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiKey.APIKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadAccess provides a mock function with given fields: ctx, resource, resourceID, grantees
+func (_m *Storage) ReadAccess(ctx context.Context, resource share.Resource, resourceID uuid.UUID, grantees []string) (*share.Access, error) {
+	ret := _m.Called(ctx, resource, resourceID, grantees)
+
+	var r0 *share.Access
+	if rf, ok := ret.Get(0).(func(context.Context, share.Resource, uuid.UUID, []string) *share.Access); ok {
+		r0 = rf(ctx, resource, resourceID, grantees)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*share.Access)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, share.Resource, uuid.UUID, []string) error); ok {
+		r1 = rf(ctx, resource, resourceID, grantees)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadContactAsOf provides a mock function with given fields: ctx, ID, asOf
+func (_m *Storage) ReadContactAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*contact.Contact, error) {
+	ret := _m.Called(ctx, ID, asOf)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *contact.Contact); ok {
+		r0 = rf(ctx, ID, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, ID, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadContactByID provides a mock function with given fields: ctx, ID
+func (_m *Storage) ReadContactByID(ctx context.Context, ID uuid.UUID) (*contact.Contact, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *contact.Contact); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadContactVersion provides a mock function with given fields: ctx, ID, number
+func (_m *Storage) ReadContactVersion(ctx context.Context, ID uuid.UUID, number uint64) (*contact.Contact, error) {
+	ret := _m.Called(ctx, ID, number)
+
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint64) *contact.Contact); ok {
+		r0 = rf(ctx, ID, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*contact.Contact)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uint64) error); ok {
+		r1 = rf(ctx, ID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadCustomFieldByID provides a mock function with given fields: ctx, ID
+func (_m *Storage) ReadCustomFieldByID(ctx context.Context, ID uuid.UUID) (*customField.CustomField, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *customField.CustomField
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *customField.CustomField); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*customField.CustomField)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadCustomFieldRegistry provides a mock function with given fields: ctx
+func (_m *Storage) ReadCustomFieldRegistry(ctx context.Context) (customField.Registry, error) {
+	ret := _m.Called(ctx)
+
+	var r0 customField.Registry
+	if rf, ok := ret.Get(0).(func(context.Context) customField.Registry); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(customField.Registry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadGroupAsOf provides a mock function with given fields: ctx, ID, asOf
+func (_m *Storage) ReadGroupAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*group.Group, error) {
+	ret := _m.Called(ctx, ID, asOf)
+
+	var r0 *group.Group
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) *group.Group); ok {
+		r0 = rf(ctx, ID, asOf)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*group.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, ID, asOf)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadGroupByID provides a mock function with given fields: ctx, ID
+func (_m *Storage) ReadGroupByID(ctx context.Context, ID uuid.UUID) (*group.Group, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *group.Group
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *group.Group); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*group.Group)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadNoteByID provides a mock function with given fields: ctx, contactID, ID
+func (_m *Storage) ReadNoteByID(ctx context.Context, contactID uuid.UUID, ID uuid.UUID) (*note.Note, error) {
+	ret := _m.Called(ctx, contactID, ID)
+
+	var r0 *note.Note
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *note.Note); ok {
+		r0 = rf(ctx, contactID, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*note.Note)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, contactID, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadOrganizationByID provides a mock function with given fields: ctx, ID
+func (_m *Storage) ReadOrganizationByID(ctx context.Context, ID uuid.UUID) (*organization.Organization, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *organization.Organization
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *organization.Organization); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*organization.Organization)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadSessionByHash provides a mock function with given fields: ctx, hash
+func (_m *Storage) ReadSessionByHash(ctx context.Context, hash []byte) (*session.Session, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *session.Session
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *session.Session); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.Session)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadTagByID provides a mock function with given fields: ctx, ID
+func (_m *Storage) ReadTagByID(ctx context.Context, ID uuid.UUID) (*tag.Tag, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *tag.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *tag.Tag); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tag.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadTenantByID provides a mock function with given fields: ctx, ID
+func (_m *Storage) ReadTenantByID(ctx context.Context, ID string) (*tenant.Tenant, error) {
+	ret := _m.Called(ctx, ID)
+
+	var r0 *tenant.Tenant
+	if rf, ok := ret.Get(0).(func(context.Context, string) *tenant.Tenant); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tenant.Tenant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// CountContact provides a mock function with given fields: ctx
-func (_m *Storage) CountContact(ctx context.Context) (uint64, error) {
-	ret := _m.Called(ctx)
+// ReadTenantUsage provides a mock function with given fields: ctx, ID
+func (_m *Storage) ReadTenantUsage(ctx context.Context, ID string) (*tenant.Usage, error) {
+	ret := _m.Called(ctx, ID)
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
-		r0 = rf(ctx)
+	var r0 *tenant.Usage
+	if rf, ok := ret.Get(0).(func(context.Context, string) *tenant.Usage); ok {
+		r0 = rf(ctx, ID)
 	} else {
-		r0 = ret.Get(0).(uint64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tenant.Usage)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -64,15 +1554,17 @@ func (_m *Storage) CountContact(ctx context.Context) (uint64, error) {
 	return r0, r1
 }
 
-// CountGroup provides a mock function with given fields: ctx
-func (_m *Storage) CountGroup(ctx context.Context) (uint64, error) {
+// ReadUsage provides a mock function with given fields: ctx
+func (_m *Storage) ReadUsage(ctx context.Context) (*tenant.Usage, error) {
 	ret := _m.Called(ctx)
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func(context.Context) uint64); ok {
+	var r0 *tenant.Usage
+	if rf, ok := ret.Get(0).(func(context.Context) *tenant.Usage); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Get(0).(uint64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tenant.Usage)
+		}
 	}
 
 	var r1 error
@@ -85,29 +1577,22 @@ func (_m *Storage) CountGroup(ctx context.Context) (uint64, error) {
 	return r0, r1
 }
 
-// CreateContact provides a mock function with given fields: ctx, contacts
-func (_m *Storage) CreateContact(ctx context.Context, contacts ...*contact.Contact) ([]*contact.Contact, error) {
-	_va := make([]interface{}, len(contacts))
-	for _i := range contacts {
-		_va[_i] = contacts[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, ctx)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// ReadWebhookByID provides a mock function with given fields: ctx, ID
+func (_m *Storage) ReadWebhookByID(ctx context.Context, ID uuid.UUID) (*webhook.Webhook, error) {
+	ret := _m.Called(ctx, ID)
 
-	var r0 []*contact.Contact
-	if rf, ok := ret.Get(0).(func(context.Context, ...*contact.Contact) []*contact.Contact); ok {
-		r0 = rf(ctx, contacts...)
+	var r0 *webhook.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *webhook.Webhook); ok {
+		r0 = rf(ctx, ID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*contact.Contact)
+			r0 = ret.Get(0).(*webhook.Webhook)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, ...*contact.Contact) error); ok {
-		r1 = rf(ctx, contacts...)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -115,29 +1600,29 @@ func (_m *Storage) CreateContact(ctx context.Context, contacts ...*contact.Conta
 	return r0, r1
 }
 
-// CreateContactIntoGroup provides a mock function with given fields: ctx, groupID, contacts
-func (_m *Storage) CreateContactIntoGroup(ctx context.Context, groupID uuid.UUID, contacts ...*contact.Contact) ([]*contact.Contact, error) {
-	_va := make([]interface{}, len(contacts))
-	for _i := range contacts {
-		_va[_i] = contacts[_i]
+// RemoveContactTags provides a mock function with given fields: ctx, contactID, tags
+func (_m *Storage) RemoveContactTags(ctx context.Context, contactID uuid.UUID, tags ...name.Name) (*contact.Contact, error) {
+	_va := make([]interface{}, len(tags))
+	for _i := range tags {
+		_va[_i] = tags[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, ctx, groupID)
+	_ca = append(_ca, ctx, contactID)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 []*contact.Contact
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...*contact.Contact) []*contact.Contact); ok {
-		r0 = rf(ctx, groupID, contacts...)
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...name.Name) *contact.Contact); ok {
+		r0 = rf(ctx, contactID, tags...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*contact.Contact)
+			r0 = ret.Get(0).(*contact.Contact)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...*contact.Contact) error); ok {
-		r1 = rf(ctx, groupID, contacts...)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...name.Name) error); ok {
+		r1 = rf(ctx, contactID, tags...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -145,22 +1630,22 @@ func (_m *Storage) CreateContactIntoGroup(ctx context.Context, groupID uuid.UUID
 	return r0, r1
 }
 
-// CreateGroup provides a mock function with given fields: ctx, _a1
-func (_m *Storage) CreateGroup(ctx context.Context, _a1 *group.Group) (*group.Group, error) {
-	ret := _m.Called(ctx, _a1)
+// RestoreContact provides a mock function with given fields: ctx, ID
+func (_m *Storage) RestoreContact(ctx context.Context, ID uuid.UUID) (*contact.Contact, error) {
+	ret := _m.Called(ctx, ID)
 
-	var r0 *group.Group
-	if rf, ok := ret.Get(0).(func(context.Context, *group.Group) *group.Group); ok {
-		r0 = rf(ctx, _a1)
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *contact.Contact); ok {
+		r0 = rf(ctx, ID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*group.Group)
+			r0 = ret.Get(0).(*contact.Contact)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *group.Group) error); ok {
-		r1 = rf(ctx, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -168,13 +1653,13 @@ func (_m *Storage) CreateGroup(ctx context.Context, _a1 *group.Group) (*group.Gr
 	return r0, r1
 }
 
-// DeleteContact provides a mock function with given fields: ctx, ID
-func (_m *Storage) DeleteContact(ctx context.Context, ID uuid.UUID) error {
-	ret := _m.Called(ctx, ID)
+// RevokeAPIKey provides a mock function with given fields: ctx, subject, ID
+func (_m *Storage) RevokeAPIKey(ctx context.Context, subject string, ID uuid.UUID) error {
+	ret := _m.Called(ctx, subject, ID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, ID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, subject, ID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -182,27 +1667,59 @@ func (_m *Storage) DeleteContact(ctx context.Context, ID uuid.UUID) error {
 	return r0
 }
 
-// DeleteContactFromGroup provides a mock function with given fields: ctx, groupID, contactID
-func (_m *Storage) DeleteContactFromGroup(ctx context.Context, groupID uuid.UUID, contactID uuid.UUID) error {
-	ret := _m.Called(ctx, groupID, contactID)
+// SetGroupACL provides a mock function with given fields: ctx, entry
+func (_m *Storage) SetGroupACL(ctx context.Context, entry *acl.Entry) (*acl.Entry, error) {
+	ret := _m.Called(ctx, entry)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
-		r0 = rf(ctx, groupID, contactID)
+	var r0 *acl.Entry
+	if rf, ok := ret.Get(0).(func(context.Context, *acl.Entry) *acl.Entry); ok {
+		r0 = rf(ctx, entry)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*acl.Entry)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *acl.Entry) error); ok {
+		r1 = rf(ctx, entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DeleteGroup provides a mock function with given fields: ctx, ID
-func (_m *Storage) DeleteGroup(ctx context.Context, ID uuid.UUID) error {
-	ret := _m.Called(ctx, ID)
+// SetShare provides a mock function with given fields: ctx, value
+func (_m *Storage) SetShare(ctx context.Context, value *share.Share) (*share.Share, error) {
+	ret := _m.Called(ctx, value)
+
+	var r0 *share.Share
+	if rf, ok := ret.Get(0).(func(context.Context, *share.Share) *share.Share); ok {
+		r0 = rf(ctx, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*share.Share)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *share.Share) error); ok {
+		r1 = rf(ctx, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TouchAPIKey provides a mock function with given fields: ctx, ID, usedAt
+func (_m *Storage) TouchAPIKey(ctx context.Context, ID uuid.UUID, usedAt time.Time) error {
+	ret := _m.Called(ctx, ID, usedAt)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, ID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, ID, usedAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -210,22 +1727,22 @@ func (_m *Storage) DeleteGroup(ctx context.Context, ID uuid.UUID) error {
 	return r0
 }
 
-// ListContact provides a mock function with given fields: ctx, parameter
-func (_m *Storage) ListContact(ctx context.Context, parameter queryParameter.QueryParameter) ([]*contact.Contact, error) {
-	ret := _m.Called(ctx, parameter)
+// UpdateContact provides a mock function with given fields: ctx, ID, updateFn
+func (_m *Storage) UpdateContact(ctx context.Context, ID uuid.UUID, updateFn func(*contact.Contact) (*contact.Contact, error)) (*contact.Contact, error) {
+	ret := _m.Called(ctx, ID, updateFn)
 
-	var r0 []*contact.Contact
-	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*contact.Contact); ok {
-		r0 = rf(ctx, parameter)
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*contact.Contact) (*contact.Contact, error)) *contact.Contact); ok {
+		r0 = rf(ctx, ID, updateFn)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*contact.Contact)
+			r0 = ret.Get(0).(*contact.Contact)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
-		r1 = rf(ctx, parameter)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, func(*contact.Contact) (*contact.Contact, error)) error); ok {
+		r1 = rf(ctx, ID, updateFn)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -233,22 +1750,22 @@ func (_m *Storage) ListContact(ctx context.Context, parameter queryParameter.Que
 	return r0, r1
 }
 
-// ListGroup provides a mock function with given fields: ctx, parameter
-func (_m *Storage) ListGroup(ctx context.Context, parameter queryParameter.QueryParameter) ([]*group.Group, error) {
-	ret := _m.Called(ctx, parameter)
+// UpdateContactPhoto provides a mock function with given fields: ctx, contactID, value
+func (_m *Storage) UpdateContactPhoto(ctx context.Context, contactID uuid.UUID, value photo.Photo) (*contact.Contact, error) {
+	ret := _m.Called(ctx, contactID, value)
 
-	var r0 []*group.Group
-	if rf, ok := ret.Get(0).(func(context.Context, queryParameter.QueryParameter) []*group.Group); ok {
-		r0 = rf(ctx, parameter)
+	var r0 *contact.Contact
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, photo.Photo) *contact.Contact); ok {
+		r0 = rf(ctx, contactID, value)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*group.Group)
+			r0 = ret.Get(0).(*contact.Contact)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, queryParameter.QueryParameter) error); ok {
-		r1 = rf(ctx, parameter)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, photo.Photo) error); ok {
+		r1 = rf(ctx, contactID, value)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -256,22 +1773,22 @@ func (_m *Storage) ListGroup(ctx context.Context, parameter queryParameter.Query
 	return r0, r1
 }
 
-// ReadContactByID provides a mock function with given fields: ctx, ID
-func (_m *Storage) ReadContactByID(ctx context.Context, ID uuid.UUID) (*contact.Contact, error) {
-	ret := _m.Called(ctx, ID)
+// UpdateCustomField provides a mock function with given fields: ctx, ID, updateFn
+func (_m *Storage) UpdateCustomField(ctx context.Context, ID uuid.UUID, updateFn func(*customField.CustomField) (*customField.CustomField, error)) (*customField.CustomField, error) {
+	ret := _m.Called(ctx, ID, updateFn)
 
-	var r0 *contact.Contact
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *contact.Contact); ok {
-		r0 = rf(ctx, ID)
+	var r0 *customField.CustomField
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*customField.CustomField) (*customField.CustomField, error)) *customField.CustomField); ok {
+		r0 = rf(ctx, ID, updateFn)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*contact.Contact)
+			r0 = ret.Get(0).(*customField.CustomField)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, ID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, func(*customField.CustomField) (*customField.CustomField, error)) error); ok {
+		r1 = rf(ctx, ID, updateFn)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -279,13 +1796,13 @@ func (_m *Storage) ReadContactByID(ctx context.Context, ID uuid.UUID) (*contact.
 	return r0, r1
 }
 
-// ReadGroupByID provides a mock function with given fields: ctx, ID
-func (_m *Storage) ReadGroupByID(ctx context.Context, ID uuid.UUID) (*group.Group, error) {
-	ret := _m.Called(ctx, ID)
+// UpdateGroup provides a mock function with given fields: ctx, ID, updateFn
+func (_m *Storage) UpdateGroup(ctx context.Context, ID uuid.UUID, updateFn func(*group.Group) (*group.Group, error)) (*group.Group, error) {
+	ret := _m.Called(ctx, ID, updateFn)
 
 	var r0 *group.Group
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *group.Group); ok {
-		r0 = rf(ctx, ID)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*group.Group) (*group.Group, error)) *group.Group); ok {
+		r0 = rf(ctx, ID, updateFn)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*group.Group)
@@ -293,8 +1810,8 @@ func (_m *Storage) ReadGroupByID(ctx context.Context, ID uuid.UUID) (*group.Grou
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, ID)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, func(*group.Group) (*group.Group, error)) error); ok {
+		r1 = rf(ctx, ID, updateFn)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -302,21 +1819,44 @@ func (_m *Storage) ReadGroupByID(ctx context.Context, ID uuid.UUID) (*group.Grou
 	return r0, r1
 }
 
-// UpdateContact provides a mock function with given fields: ctx, ID, updateFn
-func (_m *Storage) UpdateContact(ctx context.Context, ID uuid.UUID, updateFn func(*contact.Contact) (*contact.Contact, error)) (*contact.Contact, error) {
+// UpdateNote provides a mock function with given fields: ctx, contactID, ID, updateFn
+func (_m *Storage) UpdateNote(ctx context.Context, contactID uuid.UUID, ID uuid.UUID, updateFn func(*note.Note) (*note.Note, error)) (*note.Note, error) {
+	ret := _m.Called(ctx, contactID, ID, updateFn)
+
+	var r0 *note.Note
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, func(*note.Note) (*note.Note, error)) *note.Note); ok {
+		r0 = rf(ctx, contactID, ID, updateFn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*note.Note)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, func(*note.Note) (*note.Note, error)) error); ok {
+		r1 = rf(ctx, contactID, ID, updateFn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOrganization provides a mock function with given fields: ctx, ID, updateFn
+func (_m *Storage) UpdateOrganization(ctx context.Context, ID uuid.UUID, updateFn func(*organization.Organization) (*organization.Organization, error)) (*organization.Organization, error) {
 	ret := _m.Called(ctx, ID, updateFn)
 
-	var r0 *contact.Contact
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*contact.Contact) (*contact.Contact, error)) *contact.Contact); ok {
+	var r0 *organization.Organization
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*organization.Organization) (*organization.Organization, error)) *organization.Organization); ok {
 		r0 = rf(ctx, ID, updateFn)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*contact.Contact)
+			r0 = ret.Get(0).(*organization.Organization)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, func(*contact.Contact) (*contact.Contact, error)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, func(*organization.Organization) (*organization.Organization, error)) error); ok {
 		r1 = rf(ctx, ID, updateFn)
 	} else {
 		r1 = ret.Error(1)
@@ -325,21 +1865,81 @@ func (_m *Storage) UpdateContact(ctx context.Context, ID uuid.UUID, updateFn fun
 	return r0, r1
 }
 
-// UpdateGroup provides a mock function with given fields: ctx, ID, updateFn
-func (_m *Storage) UpdateGroup(ctx context.Context, ID uuid.UUID, updateFn func(*group.Group) (*group.Group, error)) (*group.Group, error) {
+// UpdateOwner provides a mock function with given fields: ctx, resource, resourceID, owner
+func (_m *Storage) UpdateOwner(ctx context.Context, resource share.Resource, resourceID uuid.UUID, owner string) error {
+	ret := _m.Called(ctx, resource, resourceID, owner)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, share.Resource, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, resource, resourceID, owner)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTag provides a mock function with given fields: ctx, ID, updateFn
+func (_m *Storage) UpdateTag(ctx context.Context, ID uuid.UUID, updateFn func(*tag.Tag) (*tag.Tag, error)) (*tag.Tag, error) {
 	ret := _m.Called(ctx, ID, updateFn)
 
-	var r0 *group.Group
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*group.Group) (*group.Group, error)) *group.Group); ok {
+	var r0 *tag.Tag
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*tag.Tag) (*tag.Tag, error)) *tag.Tag); ok {
 		r0 = rf(ctx, ID, updateFn)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*group.Group)
+			r0 = ret.Get(0).(*tag.Tag)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, func(*group.Group) (*group.Group, error)) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, func(*tag.Tag) (*tag.Tag, error)) error); ok {
+		r1 = rf(ctx, ID, updateFn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTenant provides a mock function with given fields: ctx, ID, updateFn
+func (_m *Storage) UpdateTenant(ctx context.Context, ID string, updateFn func(*tenant.Tenant) (*tenant.Tenant, error)) (*tenant.Tenant, error) {
+	ret := _m.Called(ctx, ID, updateFn)
+
+	var r0 *tenant.Tenant
+	if rf, ok := ret.Get(0).(func(context.Context, string, func(*tenant.Tenant) (*tenant.Tenant, error)) *tenant.Tenant); ok {
+		r0 = rf(ctx, ID, updateFn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tenant.Tenant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, func(*tenant.Tenant) (*tenant.Tenant, error)) error); ok {
+		r1 = rf(ctx, ID, updateFn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWebhook provides a mock function with given fields: ctx, ID, updateFn
+func (_m *Storage) UpdateWebhook(ctx context.Context, ID uuid.UUID, updateFn func(*webhook.Webhook) (*webhook.Webhook, error)) (*webhook.Webhook, error) {
+	ret := _m.Called(ctx, ID, updateFn)
+
+	var r0 *webhook.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, func(*webhook.Webhook) (*webhook.Webhook, error)) *webhook.Webhook); ok {
+		r0 = rf(ctx, ID, updateFn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, func(*webhook.Webhook) (*webhook.Webhook, error)) error); ok {
 		r1 = rf(ctx, ID, updateFn)
 	} else {
 		r1 = ret.Error(1)
@@ -348,6 +1948,29 @@ func (_m *Storage) UpdateGroup(ctx context.Context, ID uuid.UUID, updateFn func(
 	return r0, r1
 }
 
+// UpdateWebhookDelivery provides a mock function with given fields: ctx, webhookID, ID, updateFn
+func (_m *Storage) UpdateWebhookDelivery(ctx context.Context, webhookID uuid.UUID, ID uuid.UUID, updateFn func(*webhook.Delivery) (*webhook.Delivery, error)) (*webhook.Delivery, error) {
+	ret := _m.Called(ctx, webhookID, ID, updateFn)
+
+	var r0 *webhook.Delivery
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, func(*webhook.Delivery) (*webhook.Delivery, error)) *webhook.Delivery); ok {
+		r0 = rf(ctx, webhookID, ID, updateFn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Delivery)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, func(*webhook.Delivery) (*webhook.Delivery, error)) error); ok {
+		r1 = rf(ctx, webhookID, ID, updateFn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewStorage creates a new instance of Storage. It also registers a cleanup function to assert the mocks expectations.
 func NewStorage(t testing.TB) *Storage {
 	mock := &Storage{}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/group/acl"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func (r *Repository) ListGroupACL(c context.Context, groupID uuid.UUID) ([]*acl.Entry, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select(dao.ColumnGroupACL...).
+		From("slurm.group_acl").
+		Where(squirrel.Eq{"group_id": groupID}).
+		Where(tenantScope(ctx, "tenant_id")).
+		OrderBy("created_at", "grantee").
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoEntries []*dao.GroupACL
+	if err = pgxscan.ScanAll(&daoEntries, rows); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*acl.Entry, len(daoEntries))
+	for i, value := range daoEntries {
+		result[i] = value.ToDomainEntry()
+	}
+
+	return result, nil
+}
+
+// SetGroupACL добавляет запись или меняет уровень доступа у существующей
+func (r *Repository) SetGroupACL(c context.Context, entry *acl.Entry) (response *acl.Entry, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	if _, err = r.oneGroupTx(ctx, tx, entry.GroupID()); err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Insert("slurm.group_acl").
+		Columns(dao.ColumnGroupACL...).
+		Values(
+			entry.GroupID(),
+			entry.Grantee(),
+			entry.Level().String(),
+			entry.CreatedAt(),
+		).
+		Suffix(`ON CONFLICT (group_id, grantee) DO UPDATE SET level = EXCLUDED.level
+			RETURNING
+			group_id,
+			grantee,
+			level,
+			created_at`,
+		).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoEntries []*dao.GroupACL
+	if err = pgxscan.ScanAll(&daoEntries, rows); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if err = r.outboxTx(ctx, tx, event.NewGroupAccessChanged(entry.GroupID())); err != nil {
+		return nil, err
+	}
+
+	return daoEntries[0].ToDomainEntry(), nil
+}
+
+func (r *Repository) DeleteGroupACL(c context.Context, groupID uuid.UUID, grantee string) (err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	query, args, err := r.genSQL.Delete("slurm.group_acl").
+		Where(squirrel.Eq{"group_id": groupID, "grantee": grantee}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	commandTag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return useCase.ErrGroupACLNotFound
+	}
+
+	return r.outboxTx(ctx, tx, event.NewGroupAccessChanged(groupID))
+}
@@ -0,0 +1,244 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tracing"
+	"architecture_go/pkg/type/columnCode"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/audit"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/group"
+	"architecture_go/services/contact/internal/domain/tag"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+)
+
+const (
+	filterAuditEntity      columnCode.ColumnCode = "entity"
+	filterAuditEntityID    columnCode.ColumnCode = "entityId"
+	filterAuditActor       columnCode.ColumnCode = "actor"
+	filterAuditAction      columnCode.ColumnCode = "action"
+	filterAuditRequestID   columnCode.ColumnCode = "requestId"
+	filterAuditTraceID     columnCode.ColumnCode = "traceId"
+	filterAuditCreatedFrom columnCode.ColumnCode = "createdFrom"
+	filterAuditCreatedTo   columnCode.ColumnCode = "createdTo"
+)
+
+var mappingFilterAudit = map[columnCode.ColumnCode]string{
+	filterAuditEntity:    "entity",
+	filterAuditEntityID:  "entity_id",
+	filterAuditActor:     "actor",
+	filterAuditAction:    "action",
+	filterAuditRequestID: "request_id",
+	filterAuditTraceID:   "trace_id",
+}
+
+// auditTx записывает изменение сущности в журнал в той же транзакции, что и само изменение.
+// Обновление без изменённых полей в журнал не попадает.
+func (r *Repository) auditTx(ctx context.Context, tx pgx.Tx, entity audit.Entity, entityID uuid.UUID, action audit.Action, before, after audit.Snapshot) error {
+	var changes = audit.Diff(before, after)
+	if action == audit.ActionUpdate && len(changes) == 0 {
+		return nil
+	}
+
+	var entry = audit.New(ctx.Actor(), ctx.ID(), tracing.TraceID(ctx), entity, entityID, action, changes)
+
+	query, args, err := r.genSQL.Insert("slurm.audit_log").
+		Columns(
+			"id",
+			"created_at",
+			"actor",
+			"request_id",
+			"trace_id",
+			"entity",
+			"entity_id",
+			"action",
+			"changes",
+		).
+		Values(
+			entry.ID(),
+			entry.CreatedAt(),
+			entry.Actor(),
+			entry.RequestID(),
+			entry.TraceID(),
+			entry.Entity().String(),
+			entry.EntityID(),
+			entry.Action().String(),
+			dao.ToDaoChanges(entry.Changes()),
+		).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListAudit(c context.Context, parameter queryParameter.QueryParameter) ([]*audit.Entry, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.genSQL.Select(
+		"id",
+		"created_at",
+		"actor",
+		"request_id",
+		"trace_id",
+		"entity",
+		"entity_id",
+		"action",
+		"changes",
+	).
+		From("slurm.audit_log").
+		Where(auditConditions(parameter.Filters)).
+		Where(tenantScope(ctx, "tenant_id")).
+		OrderBy("created_at DESC", "id").
+		Limit(parameter.Pagination.Limit)
+
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoAudit []*dao.Audit
+	if err = pgxscan.Select(ctx, r.db, &daoAudit, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*audit.Entry, len(daoAudit))
+	for i, value := range daoAudit {
+		result[i] = value.ToDomainAudit()
+	}
+
+	return result, nil
+}
+
+func (r *Repository) CountAudit(c context.Context, filters filter.Filters) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(id)").
+		From("slurm.audit_log").
+		Where(auditConditions(filters)).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	return total, nil
+}
+
+// auditConditions значения фильтров проверяются при разборе запроса, некорректные даты пропускаются
+func auditConditions(filters filter.Filters) squirrel.And {
+	var where = squirrel.And{}
+
+	for _, f := range filters {
+		if column, ok := mappingFilterAudit[f.Key]; ok {
+			where = append(where, squirrel.Eq{column: f.Values})
+			continue
+		}
+
+		switch f.Key {
+		case filterAuditCreatedFrom:
+			if value, ok := firstTime(f.Values); ok {
+				where = append(where, squirrel.GtOrEq{"created_at": value})
+			}
+		case filterAuditCreatedTo:
+			if value, ok := firstTime(f.Values); ok {
+				where = append(where, squirrel.Lt{"created_at": value})
+			}
+		}
+	}
+
+	return where
+}
+
+func firstTime(values []string) (time.Time, bool) {
+	if len(values) == 0 {
+		return time.Time{}, false
+	}
+
+	value, err := time.Parse(time.RFC3339, values[0])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return value.UTC(), true
+}
+
+func contactSnapshot(value *contact.Contact) audit.Snapshot {
+	if value == nil {
+		return nil
+	}
+
+	return audit.Snapshot{
+		"phoneNumber":    value.PhoneNumber().String(),
+		"email":          value.Email().String(),
+		"name":           value.Name().String(),
+		"surname":        value.Surname().String(),
+		"patronymic":     value.Patronymic().String(),
+		"age":            value.Age(),
+		"gender":         value.Gender(),
+		"birthday":       birthdayValue(value.Birthday()),
+		"addresses":      dao.ToDaoAddresses(value.Addresses()),
+		"organizationId": organizationIDValue(value.Employment()),
+		"jobTitle":       value.Employment().JobTitle(),
+		"customFields":   customFieldValues(value.CustomFields()),
+		"tags":           tagNames(value.Tags()),
+		"photo":          dao.ToDaoPhoto(value.Photo()),
+	}
+}
+
+func groupSnapshot(value *group.Group) audit.Snapshot {
+	if value == nil {
+		return nil
+	}
+
+	return audit.Snapshot{
+		"name":        value.Name().Value(),
+		"description": value.Description().Value(),
+	}
+}
+
+// membershipSnapshot членство контакта в группе записывается в журнал контакта
+func membershipSnapshot(groupID uuid.UUID) audit.Snapshot {
+	return audit.Snapshot{
+		"group": groupID,
+	}
+}
+
+func tagSnapshot(value *tag.Tag) audit.Snapshot {
+	if value == nil {
+		return nil
+	}
+
+	return audit.Snapshot{
+		"name": value.Name().String(),
+	}
+}
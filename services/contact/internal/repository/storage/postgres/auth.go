@@ -0,0 +1,287 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/columnCode"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/apiKey"
+	"architecture_go/services/contact/internal/domain/session"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+var mappingSortAPIKey = map[columnCode.ColumnCode]string{
+	"id":         "id",
+	"name":       "name",
+	"createdAt":  "created_at",
+	"lastUsedAt": "last_used_at",
+}
+
+func (r *Repository) CreateAPIKey(c context.Context, key *apiKey.APIKey) (*apiKey.APIKey, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Insert("slurm.api_key").
+		Columns(
+			"id",
+			"created_at",
+			"name",
+			"prefix",
+			"hash",
+			"subject",
+			"tenant_id",
+			"roles",
+			"expires_at",
+		).
+		Values(
+			key.ID(),
+			key.CreatedAt(),
+			key.Name(),
+			key.Prefix(),
+			key.Hash(),
+			key.Subject(),
+			key.Tenant(),
+			key.Roles(),
+			dao.NullTime(key.ExpiresAt()),
+		).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = r.db.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return key, nil
+}
+
+// RevokeAPIKey отзывает ключ субъекта, отозванный ключ остаётся в списке
+func (r *Repository) RevokeAPIKey(c context.Context, subject string, ID uuid.UUID) error {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Update("slurm.api_key").
+		Set("revoked_at", time.Now().UTC()).
+		Where(squirrel.Eq{
+			"id":         ID,
+			"subject":    subject,
+			"revoked_at": nil,
+		}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	commandTag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return useCase.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// TouchAPIKey отмечает время последнего использования ключа
+func (r *Repository) TouchAPIKey(c context.Context, ID uuid.UUID, usedAt time.Time) error {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Update("slurm.api_key").
+		Set("last_used_at", usedAt.UTC()).
+		Where(squirrel.Eq{"id": ID}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = r.db.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListAPIKey(c context.Context, subject string, parameter queryParameter.QueryParameter) ([]*apiKey.APIKey, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.genSQL.Select(dao.ColumnAPIKey...).
+		From("slurm.api_key").
+		Where(squirrel.Eq{"subject": subject}).
+		Where(tenantScope(ctx, "tenant_id"))
+
+	if len(parameter.Sorts) > 0 {
+		builder = builder.OrderBy(parameter.Sorts.Parsing(mappingSortAPIKey)...)
+	} else {
+		builder = builder.OrderBy("created_at DESC")
+	}
+
+	builder = builder.Limit(parameter.Pagination.Limit)
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	return r.queryAPIKeys(ctx, builder)
+}
+
+func (r *Repository) CountAPIKey(c context.Context, subject string) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(id)").
+		From("slurm.api_key").
+		Where(squirrel.Eq{"subject": subject}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	return total, nil
+}
+
+// ReadAPIKeyByHash ключ по хешу, в том числе отозванный или истёкший: это проверяет вызывающий
+func (r *Repository) ReadAPIKeyByHash(c context.Context, hash []byte) (*apiKey.APIKey, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	keys, err := r.queryAPIKeys(ctx, r.genSQL.Select(dao.ColumnAPIKey...).
+		From("slurm.api_key").
+		Where(squirrel.Eq{"hash": hash}).
+		Where(tenantScope(ctx, "tenant_id")))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return nil, useCase.ErrAPIKeyNotFound
+	}
+
+	return keys[0], nil
+}
+
+func (r *Repository) queryAPIKeys(ctx context.Context, builder squirrel.SelectBuilder) ([]*apiKey.APIKey, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoKeys []*dao.APIKey
+	if err = pgxscan.Select(ctx, r.db, &daoKeys, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*apiKey.APIKey, len(daoKeys))
+	for i, value := range daoKeys {
+		result[i] = value.ToDomainAPIKey()
+	}
+
+	return result, nil
+}
+
+func (r *Repository) CreateSession(c context.Context, value *session.Session) (*session.Session, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Insert("slurm.session").
+		Columns(dao.ColumnSession...).
+		Values(
+			value.ID(),
+			value.CreatedAt(),
+			value.ExpiresAt(),
+			value.Hash(),
+			value.Subject(),
+			value.Name(),
+			value.Tenant(),
+			value.Roles(),
+		).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = r.db.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return value, nil
+}
+
+// ReadSessionByHash сессия по хешу токена, в том числе истёкшая: это проверяет вызывающий
+func (r *Repository) ReadSessionByHash(c context.Context, hash []byte) (*session.Session, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select(dao.ColumnSession...).
+		From("slurm.session").
+		Where(squirrel.Eq{"hash": hash}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoSessions []*dao.Session
+	if err = pgxscan.Select(ctx, r.db, &daoSessions, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if len(daoSessions) == 0 {
+		return nil, useCase.ErrSessionNotFound
+	}
+
+	return daoSessions[0].ToDomainSession(), nil
+}
+
+// DeleteSession закрывает сессию; истёкшие сессии удаляются заодно
+func (r *Repository) DeleteSession(c context.Context, hash []byte) error {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Delete("slurm.session").
+		Where(squirrel.Or{
+			squirrel.Eq{"hash": hash},
+			squirrel.Lt{"expires_at": time.Now().UTC()},
+		}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = r.db.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
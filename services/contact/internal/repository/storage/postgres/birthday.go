@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/contact/birthday"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+)
+
+// ListContactBirthday контакты, у которых день рождения приходится на период [from, to], в порядке наступления
+func (r *Repository) ListContactBirthday(c context.Context, from, to time.Time, parameter queryParameter.QueryParameter) (response []*contact.Contact, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.genSQL.Select(
+		"id",
+		"created_at",
+		"modified_at",
+		"phone_number",
+		"email",
+		"name",
+		"surname",
+		"patronymic",
+		"age",
+		"gender",
+		"custom_fields",
+		"birthday",
+		"addresses",
+		"organization_id",
+		"job_title",
+		"photo",
+		"owner",
+		columnContactOrganizationName,
+		columnContactTags,
+	).
+		From("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(contactVisibleScope(ctx)).
+		Where(squirrel.Eq{"is_archived": false}).
+		Where(squirrel.NotEq{"birthday": nil}).
+		Where(birthdayPeriod(from, to)).
+		OrderByClause("("+columnContactBirthdayMonthDay+" < ?)", monthDay(from)).
+		OrderBy(columnContactBirthdayMonthDay, "surname", "name").
+		Limit(parameter.Pagination.Limit)
+
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoContacts []*dao.Contact
+	if err = pgxscan.Select(ctx, tx, &daoContacts, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return r.toDomainContacts(daoContacts)
+}
+
+// birthdayPeriod условие на месяц и день рождения для периода [from, to]:
+//   - период в пределах года -- интервал MMDD;
+//   - период через новый год -- два интервала: до конца года и с начала года;
+//   - период от года и больше -- все дни рождения.
+//
+// В невисокосный год дни рождения 29 февраля попадают в период, если в него входит 28 февраля.
+func birthdayPeriod(from, to time.Time) squirrel.Sqlizer {
+	if !to.Before(from.AddDate(1, 0, 0)) {
+		return squirrel.Expr("TRUE")
+	}
+
+	var where squirrel.Or
+	if from.Year() == to.Year() {
+		where = append(where, squirrel.Expr(columnContactBirthdayMonthDay+" BETWEEN ? AND ?", monthDay(from), monthDay(to)))
+	} else {
+		where = append(where,
+			squirrel.GtOrEq{columnContactBirthdayMonthDay: monthDay(from)},
+			squirrel.LtOrEq{columnContactBirthdayMonthDay: monthDay(to)},
+		)
+	}
+
+	for year := from.Year(); year <= to.Year(); year++ {
+		february28 := time.Date(year, time.February, 28, 0, 0, 0, 0, time.UTC)
+		if !birthday.IsLeap(year) && !february28.Before(from) && !february28.After(to) {
+			where = append(where, squirrel.Eq{columnContactBirthdayMonthDay: 229})
+			break
+		}
+	}
+
+	return where
+}
+
+func monthDay(date time.Time) int {
+	return int(date.Month())*100 + date.Day()
+}
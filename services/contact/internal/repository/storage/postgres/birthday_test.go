@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBirthdayPeriod(t *testing.T) {
+	assertion := assert.New(t)
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		sql      string
+		args     []interface{}
+	}{
+		{
+			name: "within year",
+			from: date(2023, time.May, 1),
+			to:   date(2023, time.May, 31),
+			sql:  "(" + columnContactBirthdayMonthDay + " BETWEEN ? AND ?)",
+			args: []interface{}{501, 531},
+		},
+		{
+			name: "new year",
+			from: date(2023, time.December, 20),
+			to:   date(2024, time.January, 10),
+			sql:  "(" + columnContactBirthdayMonthDay + " >= ? OR " + columnContactBirthdayMonthDay + " <= ?)",
+			args: []interface{}{1220, 110},
+		},
+		{
+			name: "february 29 in non-leap year",
+			from: date(2023, time.February, 20),
+			to:   date(2023, time.February, 28),
+			sql:  "(" + columnContactBirthdayMonthDay + " BETWEEN ? AND ? OR " + columnContactBirthdayMonthDay + " = ?)",
+			args: []interface{}{220, 228, 229},
+		},
+		{
+			name: "february 28 in leap year",
+			from: date(2024, time.February, 20),
+			to:   date(2024, time.February, 28),
+			sql:  "(" + columnContactBirthdayMonthDay + " BETWEEN ? AND ?)",
+			args: []interface{}{220, 228},
+		},
+		{
+			name: "whole year",
+			from: date(2023, time.March, 1),
+			to:   date(2024, time.March, 1),
+			sql:  "TRUE",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sql, args, err := birthdayPeriod(test.from, test.to).ToSql()
+			assertion.NoError(err)
+			assertion.Equal(test.sql, sql)
+			assertion.Equal(test.args, args)
+		})
+	}
+}
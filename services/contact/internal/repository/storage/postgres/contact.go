@@ -1,6 +1,10 @@
 package postgres
 
 import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
@@ -12,40 +16,233 @@ import (
 	"architecture_go/pkg/tools/transaction"
 	"architecture_go/pkg/type/columnCode"
 	"architecture_go/pkg/type/context"
-	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/filter"
 	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/pkg/type/sort"
+	"architecture_go/services/contact/internal/domain/audit"
 	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/customField/key"
+	"architecture_go/services/contact/internal/domain/event"
 	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
 	"architecture_go/services/contact/internal/useCase"
 )
 
 var mappingSortContact = map[columnCode.ColumnCode]string{
-	"id":          "id",
-	"fullName":    "full_name",
-	"phoneNumber": "phone_number",
-	"name":        "name",
-	"surname":     "surname",
-	"patronymic":  "patronymic",
-	"email":       "email",
-	"gender":      "gender",
-	"age":         "age",
+	"id":           "id",
+	"fullName":     "full_name",
+	"phoneNumber":  "phone_number",
+	"name":         "name",
+	"surname":      "surname",
+	"patronymic":   "patronymic",
+	"email":        "email",
+	"gender":       "gender",
+	"age":          columnContactAge,
+	"organization": "organization_name",
 }
 
-func (r *Repository) CreateContact(c context.Context, contacts ...*contact.Contact) ([]*contact.Contact, error) {
+// columnContactOrganizationName название организации контакта, сортировка идёт по псевдониму organization_name
+const columnContactOrganizationName = `(
+	SELECT organization.name
+	FROM slurm.organization
+	WHERE organization.id = contact.organization_id
+) AS organization_name`
+
+// columnContactAge возраст по дате рождения, для контактов без даты рождения -- сохранённый возраст
+const columnContactAge = `COALESCE(date_part('year', age(CURRENT_DATE, birthday))::smallint, age)`
+
+// columnContactBirthdayMonthDay месяц и день рождения в виде MMDD, совпадает с индексом ix_contact_birthday_month_day
+const columnContactBirthdayMonthDay = `(EXTRACT(MONTH FROM birthday) * 100 + EXTRACT(DAY FROM birthday))`
+
+// columnContactTags теги контакта, отсортированные по названию
+const columnContactTags = `COALESCE((
+	SELECT array_agg(tag.name ORDER BY tag.name)
+	FROM slurm.contact_tag
+	INNER JOIN slurm.tag ON tag.id = contact_tag.tag_id
+	WHERE contact_tag.contact_id = contact.id
+), '{}') AS tags`
+
+const (
+	// filterTagsAny контакты хотя бы с одним из тегов
+	filterTagsAny columnCode.ColumnCode = "tagsAny"
+	// filterTagsAll контакты со всеми тегами
+	filterTagsAll columnCode.ColumnCode = "tagsAll"
+
+	// filterAge контакты с возрастом из списка
+	filterAge columnCode.ColumnCode = "age"
+	// filterAgeFrom контакты не младше указанного возраста
+	filterAgeFrom columnCode.ColumnCode = "ageFrom"
+	// filterAgeTo контакты не старше указанного возраста
+	filterAgeTo columnCode.ColumnCode = "ageTo"
+
+	// filterCity контакты с адресом в одном из городов
+	filterCity columnCode.ColumnCode = "city"
+	// filterCountry контакты с адресом в одной из стран
+	filterCountry columnCode.ColumnCode = "country"
+
+	// filterOrganization контакты организаций с указанными названиями
+	filterOrganization columnCode.ColumnCode = "organization"
+	// filterOrganizationID контакты организаций с указанными идентификаторами
+	filterOrganizationID columnCode.ColumnCode = "organizationId"
+)
+
+// prefixCustomField префикс ключа сортировки и фильтрации по дополнительному полю, например "customFields.crmId"
+const prefixCustomField = "customFields."
+
+// customFieldKey возвращает проверенный ключ дополнительного поля, ключ безопасно подставлять в SQL
+func customFieldKey(code columnCode.ColumnCode) (string, bool) {
+	if !strings.HasPrefix(code.String(), prefixCustomField) {
+		return "", false
+	}
+
+	fieldKey, err := key.New(strings.TrimPrefix(code.String(), prefixCustomField))
+	if err != nil {
+		return "", false
+	}
+
+	return fieldKey.String(), true
+}
+
+func contactConditions(filters filter.Filters) squirrel.And {
+	var where = squirrel.And{squirrel.Eq{"is_archived": false}}
+
+	for _, f := range filters {
+		if fieldKey, ok := customFieldKey(f.Key); ok {
+			where = append(where, customFieldCondition(fieldKey, f.Values))
+			continue
+		}
+
+		switch f.Key {
+		case filterTagsAny:
+			where = append(where, squirrel.Expr(`id IN (
+				SELECT contact_tag.contact_id
+				FROM slurm.contact_tag
+				INNER JOIN slurm.tag ON tag.id = contact_tag.tag_id
+				WHERE tag.name = ANY(?))`, f.Values))
+		case filterTagsAll:
+			where = append(where, squirrel.Expr(`id IN (
+				SELECT contact_tag.contact_id
+				FROM slurm.contact_tag
+				INNER JOIN slurm.tag ON tag.id = contact_tag.tag_id
+				WHERE tag.name = ANY(?)
+				GROUP BY contact_tag.contact_id
+				HAVING COUNT(*) = ?)`, f.Values, distinctCount(f.Values)))
+		case filterAge:
+			where = append(where, squirrel.Eq{columnContactAge: ageValues(f.Values)})
+		case filterAgeFrom:
+			if values := ageValues(f.Values); len(values) > 0 {
+				where = append(where, squirrel.GtOrEq{columnContactAge: values[0]})
+			}
+		case filterAgeTo:
+			if values := ageValues(f.Values); len(values) > 0 {
+				where = append(where, squirrel.LtOrEq{columnContactAge: values[0]})
+			}
+		case filterCity, filterCountry:
+			where = append(where, squirrel.Expr("addresses @> ANY(?::jsonb[])", addressDocuments(f.Key.String(), f.Values)))
+		case filterOrganization:
+			where = append(where, squirrel.Expr(`organization_id IN (
+				SELECT organization.id
+				FROM slurm.organization
+				WHERE organization.name = ANY(?) AND organization.is_archived = FALSE)`, f.Values))
+		case filterOrganizationID:
+			where = append(where, squirrel.Eq{"organization_id": f.Values})
+		}
+	}
+
+	return where
+}
+
+// ageValues значения проверяются при разборе запроса, некорректные пропускаются
+func ageValues(values []string) []uint8 {
+	var result = make([]uint8, 0, len(values))
+	for _, value := range values {
+		if number, err := strconv.ParseUint(value, 10, 8); err == nil {
+			result = append(result, uint8(number))
+		}
+	}
+	return result
+}
+
+// addressDocuments образцы для оператора @>, например [{"city": "Москва"}]
+func addressDocuments(field string, values []string) []string {
+	var result = make([]string, 0, len(values))
+	for _, value := range values {
+		document, err := json.Marshal([]map[string]string{{field: value}})
+		if err != nil {
+			continue
+		}
+		result = append(result, string(document))
+	}
+	return result
+}
+
+// customFieldCondition условия custom_fields @> '{"key": value}' для каждого значения, объединённые через OR,
+// чтобы запрос использовал индекс ix_contact_custom_fields. Тип поля здесь неизвестен,
+// поэтому значение, похожее на число или логическое, ищется и как строка, и как число или логическое
+func customFieldCondition(fieldKey string, values []string) squirrel.Or {
+	var where = squirrel.Or{}
+	for _, value := range values {
+		var candidates = []interface{}{value}
+		if number, err := strconv.ParseFloat(value, 64); err == nil && !math.IsNaN(number) && !math.IsInf(number, 0) {
+			candidates = append(candidates, number)
+		}
+		if value == "true" || value == "false" {
+			candidates = append(candidates, value == "true")
+		}
+
+		for _, candidate := range candidates {
+			document, err := json.Marshal(map[string]interface{}{fieldKey: candidate})
+			if err != nil {
+				continue
+			}
+			where = append(where, squirrel.Expr("custom_fields @> ?::jsonb", string(document)))
+		}
+	}
+
+	if len(where) == 0 {
+		return squirrel.Or{squirrel.Expr("FALSE")}
+	}
+	return where
+}
+
+func distinctCount(values []string) int {
+	var exists = make(map[string]struct{}, len(values))
+	for _, value := range values {
+		exists[value] = struct{}{}
+	}
+	return len(exists)
+}
+
+// contactOrderBy значения jsonb сравниваются с учётом типа, поэтому числа и даты сортируются корректно
+func contactOrderBy(sorts sort.Sorts) []string {
+	var mapping = make(map[columnCode.ColumnCode]string, len(mappingSortContact)+len(sorts))
+	for code, column := range mappingSortContact {
+		mapping[code] = column
+	}
+
+	for _, s := range sorts {
+		if fieldKey, ok := customFieldKey(s.Key); ok {
+			mapping[s.Key] = "custom_fields->'" + fieldKey + "'"
+		}
+	}
+
+	return sorts.Parsing(mapping)
+}
+
+func (r *Repository) CreateContact(c context.Context, contacts ...*contact.Contact) (response []*contact.Contact, err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
-	response, err := r.createContactTx(ctx, tx, contacts...)
+	response, err = r.createContactTx(ctx, tx, contacts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,49 +261,96 @@ func (r *Repository) createContactTx(ctx context.Context, tx pgx.Tx, contacts ..
 		dao.CreateColumnContact,
 		r.toCopyFromSource(contacts...))
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
+	}
+
+	var contactIDs = make([]uuid.UUID, len(contacts))
+	for i, value := range contacts {
+		contactIDs[i] = value.ID()
+	}
+
+	if err = r.snapshotContactTx(ctx, tx, contactIDs...); err != nil {
+		return nil, err
+	}
+
+	var events = make([]event.Event, len(contacts))
+	for i, value := range contacts {
+		if err = r.auditTx(ctx, tx, audit.EntityContact, value.ID(), audit.ActionCreate, nil, contactSnapshot(value)); err != nil {
+			return nil, err
+		}
+		events[i] = event.NewContactCreated(value)
+	}
+
+	if err = r.outboxTx(ctx, tx, events...); err != nil {
+		return nil, err
 	}
 
 	return contacts, nil
 }
 
-func (r *Repository) UpdateContact(c context.Context, ID uuid.UUID, updateFn func(c *contact.Contact) (*contact.Contact, error)) (*contact.Contact, error) {
+func (r *Repository) UpdateContact(c context.Context, ID uuid.UUID, updateFn func(c *contact.Contact) (*contact.Contact, error)) (response *contact.Contact, err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
 	upContact, err := r.oneContactTx(ctx, tx, ID)
 	if err != nil {
 		return nil, err
 	}
+	// снимок снимается до updateFn, функция может изменить контакт на месте
+	var before = contactSnapshot(upContact)
+
 	in, err := updateFn(upContact)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.updateContactTx(ctx, tx, in)
+	response, err = r.updateContactTx(ctx, tx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.snapshotContactTx(ctx, tx, ID); err != nil {
+		return nil, err
+	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityContact, ID, audit.ActionUpdate, before, contactSnapshot(response)); err != nil {
+		return nil, err
+	}
+
+	if err = r.outboxTx(ctx, tx, event.NewContactUpdated(response)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
 }
 
 func (r *Repository) updateContactTx(ctx context.Context, tx pgx.Tx, in *contact.Contact) (*contact.Contact, error) {
 
 	builder := r.genSQL.Update("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
 		Set("email", in.Email().String()).
 		Set("phone_number", in.PhoneNumber().String()).
 		Set("age", in.Age()).
+		Set("birthday", birthdayValue(in.Birthday())).
+		Set("addresses", dao.ToDaoAddresses(in.Addresses())).
+		Set("organization_id", organizationIDValue(in.Employment())).
+		Set("job_title", in.Employment().JobTitle()).
 		Set("gender", in.Gender()).
 		Set("modified_at", in.ModifiedAt()).
 		Set("name", in.Name().String()).
 		Set("surname", in.Surname().String()).
 		Set("patronymic", in.Patronymic().String()).
+		Set("custom_fields", customFieldValues(in.CustomFields())).
 		Where(squirrel.And{
 			squirrel.Eq{
 				"id":          in.ID(),
@@ -123,39 +367,118 @@ func (r *Repository) updateContactTx(ctx context.Context, tx pgx.Tx, in *contact
 			surname,
 			patronymic,
 			age,
-			gender`,
+			gender,
+			custom_fields,
+			birthday,
+			addresses,
+			organization_id,
+			job_title,
+			photo,
+			owner,
+			` + columnContactOrganizationName + `,
+			` + columnContactTags,
 		)
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	var daoContacts []*dao.Contact
 	if err = pgxscan.ScanAll(&daoContacts, rows); err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
+	}
+
+	// контакт удалён параллельным запросом после чтения
+	if len(daoContacts) == 0 {
+		return nil, useCase.ErrContactNotFound
 	}
 
 	return r.toDomainContact(daoContacts[0])
 }
 
-func (r *Repository) DeleteContact(c context.Context, ID uuid.UUID) error {
+// touchContactsTx отмечает изменёнными контакты, которых коснулось изменение справочника -- тега
+// или организации: у них меняется modified_at, появляется новая версия и событие ContactUpdated
+func (r *Repository) touchContactsTx(ctx context.Context, tx pgx.Tx, where squirrel.Sqlizer) error {
+	query, args, err := r.genSQL.Update("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("modified_at", time.Now().UTC()).
+		Where(squirrel.Eq{"is_archived": false}).
+		Where(where).
+		Suffix(`RETURNING
+			id,
+			created_at,
+			modified_at,
+			phone_number,
+			email,
+			name,
+			surname,
+			patronymic,
+			age,
+			gender,
+			custom_fields,
+			birthday,
+			addresses,
+			organization_id,
+			job_title,
+			photo,
+			owner,
+			` + columnContactOrganizationName + `,
+			` + columnContactTags,
+		).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	var daoContacts []*dao.Contact
+	if err = pgxscan.ScanAll(&daoContacts, rows); err != nil {
+		return storageError(ctx, err)
+	}
+
+	contacts, err := r.toDomainContacts(daoContacts)
+	if err != nil {
+		return err
+	}
+
+	var (
+		contactIDs = make([]uuid.UUID, len(contacts))
+		events     = make([]event.Event, len(contacts))
+	)
+	for i, value := range contacts {
+		contactIDs[i] = value.ID()
+		events[i] = event.NewContactUpdated(value)
+	}
+
+	if err = r.snapshotContactTx(ctx, tx, contactIDs...); err != nil {
+		return err
+	}
+
+	return r.outboxTx(ctx, tx, events...)
+}
+
+func (r *Repository) DeleteContact(c context.Context, ID uuid.UUID) (err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
 	if err = r.deleteContactTx(ctx, tx, ID); err != nil {
@@ -165,35 +488,120 @@ func (r *Repository) DeleteContact(c context.Context, ID uuid.UUID) error {
 	return nil
 }
 
+// deleteContactTx удалить можно только неархивный контакт: для неизвестного и уже удалённого -- ErrContactNotFound,
+// и тогда ни журнал, ни outbox не получают записи
 func (r *Repository) deleteContactTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID) error {
+	deleted, err := r.oneContactTx(ctx, tx, ID)
+	if err != nil {
+		return err
+	}
+
 	builder := r.genSQL.Update("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
 		Set("is_archived", true).
 		Set("modified_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_archived": false, "id": ID})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
-	rows, err := tx.Query(ctx, query, args...)
+	tag, err := tx.Exec(ctx, query, args...)
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
-	var daoContacts []*dao.Contact
-	if err = pgxscan.ScanAll(&daoContacts, rows); err != nil {
-		return log.ErrorWithContext(ctx, err)
+	if tag.RowsAffected() == 0 {
+		return useCase.ErrContactNotFound
+	}
+
+	if err = r.archiveContactNotesTx(ctx, tx, ID, true); err != nil {
+		return err
 	}
 
 	if err = r.updateGroupsContactCountByFilters(ctx, tx, ID); err != nil {
 		return err
 	}
 
+	if err = r.snapshotContactTx(ctx, tx, ID); err != nil {
+		return err
+	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityContact, ID, audit.ActionDelete, contactSnapshot(deleted), nil); err != nil {
+		return err
+	}
+
+	if err = r.outboxTx(ctx, tx, event.NewContactArchived(ID)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (r *Repository) ListContact(c context.Context, parameter queryParameter.QueryParameter) ([]*contact.Contact, error) {
+// RestoreContact возвращает контакт из архива вместе с его заметками
+func (r *Repository) RestoreContact(c context.Context, ID uuid.UUID) (response *contact.Contact, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	query, args, err := r.genSQL.Update("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("is_archived", false).
+		Set("modified_at", time.Now().UTC()).
+		Where(squirrel.Eq{"is_archived": true, "id": ID}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	commandTag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return nil, useCase.ErrContactNotFound
+	}
+
+	if err = r.archiveContactNotesTx(ctx, tx, ID, false); err != nil {
+		return nil, err
+	}
+
+	if err = r.updateGroupsContactCountByFilters(ctx, tx, ID); err != nil {
+		return nil, err
+	}
+
+	response, err = r.oneContactTx(ctx, tx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.snapshotContactTx(ctx, tx, ID); err != nil {
+		return nil, err
+	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityContact, ID, audit.ActionRestore, nil, contactSnapshot(response)); err != nil {
+		return nil, err
+	}
+
+	if err = r.outboxTx(ctx, tx, event.NewContactRestored(response)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (r *Repository) ListContact(c context.Context, parameter queryParameter.QueryParameter) (response []*contact.Contact, err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
@@ -204,11 +612,11 @@ func (r *Repository) ListContact(c context.Context, parameter queryParameter.Que
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
 	if parameter.Pagination.Limit == 0 {
@@ -235,12 +643,23 @@ func (r *Repository) listContactTx(ctx context.Context, tx pgx.Tx, parameter que
 		"patronymic",
 		"age",
 		"gender",
-	).From("slurm.contact")
-
-	builder = builder.Where(squirrel.Eq{"is_archived": false})
+		"custom_fields",
+		"birthday",
+		"addresses",
+		"organization_id",
+		"job_title",
+		"photo",
+		"owner",
+		columnContactOrganizationName,
+		columnContactTags,
+	).From("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(contactVisibleScope(ctx))
+
+	builder = builder.Where(contactConditions(parameter.Filters))
 
 	if len(parameter.Sorts) > 0 {
-		builder = builder.OrderBy(parameter.Sorts.Parsing(mappingSortContact)...)
+		builder = builder.OrderBy(contactOrderBy(parameter.Sorts)...)
 	} else {
 		builder = builder.OrderBy("created_at DESC")
 	}
@@ -254,17 +673,17 @@ func (r *Repository) listContactTx(ctx context.Context, tx pgx.Tx, parameter que
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	var daoContacts []*dao.Contact
 	if err = pgxscan.ScanAll(&daoContacts, rows); err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	return r.toDomainContacts(daoContacts)
@@ -277,11 +696,11 @@ func (r *Repository) ReadContactByID(c context.Context, ID uuid.UUID) (response
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
 	response, err = r.oneContactTx(ctx, tx, ID)
@@ -304,23 +723,33 @@ func (r *Repository) oneContactTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID)
 		"patronymic",
 		"age",
 		"gender",
-	).From("slurm.contact")
+		"custom_fields",
+		"birthday",
+		"addresses",
+		"organization_id",
+		"job_title",
+		"photo",
+		"owner",
+		columnContactOrganizationName,
+		columnContactTags,
+	).From("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id"))
 
 	builder = builder.Where(squirrel.Eq{"is_archived": false, "id": ID})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	var daoContact []*dao.Contact
 	if err = pgxscan.ScanAll(&daoContact, rows); err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	if len(daoContact) == 0 {
@@ -330,28 +759,33 @@ func (r *Repository) oneContactTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID)
 	return r.toDomainContact(daoContact[0])
 }
 
-func (r *Repository) CountContact(c context.Context) (uint64, error) {
+func (r *Repository) CountContact(c context.Context, filters filter.Filters) (uint64, error) {
 
-	span, tmp := opentracing.StartSpanFromContext(c, "CountContact")
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	span, tmp := opentracing.StartSpanFromContext(ctx, "CountContact")
 	defer span.Finish()
-	ctx := context.New(tmp)
+	ctx = context.New(tmp)
 
 	var builder = r.genSQL.Select(
 		"COUNT(id)",
-	).From("slurm.contact")
+	).From("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(contactVisibleScope(ctx))
 
-	builder = builder.Where(squirrel.Eq{"is_archived": false})
+	builder = builder.Where(contactConditions(filters))
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return 0, log.ErrorWithContext(ctx, err)
+		return 0, storageError(ctx, err)
 	}
 
 	var row = r.db.QueryRow(ctx, query, args...)
 	var total uint64
 
 	if err = row.Scan(&total); err != nil {
-		return 0, log.ErrorWithContext(ctx, err)
+		return 0, storageError(ctx, err)
 	}
 
 	return total, nil
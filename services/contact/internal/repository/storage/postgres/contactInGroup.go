@@ -9,25 +9,26 @@ import (
 
 	"architecture_go/pkg/tools/transaction"
 	"architecture_go/pkg/type/context"
-	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/audit"
 	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/event"
 	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
 )
 
-func (r *Repository) CreateContactIntoGroup(c context.Context, groupID uuid.UUID, contacts ...*contact.Contact) ([]*contact.Contact, error) {
+func (r *Repository) CreateContactIntoGroup(c context.Context, groupID uuid.UUID, contacts ...*contact.Contact) (response []*contact.Contact, err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
-	response, err := r.createContactTx(ctx, tx, contacts...)
+	response, err = r.createContactTx(ctx, tx, contacts...)
 	if err != nil {
 		return nil, err
 	}
@@ -43,49 +44,61 @@ func (r *Repository) CreateContactIntoGroup(c context.Context, groupID uuid.UUID
 	return response, nil
 }
 
-func (r *Repository) DeleteContactFromGroup(c context.Context, groupID, contactID uuid.UUID) error {
+func (r *Repository) DeleteContactFromGroup(c context.Context, groupID, contactID uuid.UUID) (err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
 	query, args, err := r.genSQL.
 		Delete("slurm.contact_in_group").
+		Where(tenantScope(ctx, "tenant_id")).
 		Where(squirrel.Eq{"contact_id": contactID, "group_id": groupID}).
 		ToSql()
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
-	if _, err = tx.Exec(ctx, query, args...); err != nil {
-		return log.ErrorWithContext(ctx, err)
+	commandTag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return storageError(ctx, err)
 	}
 
 	if err = r.updateGroupContactCount(ctx, tx, groupID); err != nil {
 		return err
 	}
 
+	if commandTag.RowsAffected() > 0 {
+		if err = r.auditTx(ctx, tx, audit.EntityContact, contactID, audit.ActionRemoveFromGroup, membershipSnapshot(groupID), nil); err != nil {
+			return err
+		}
+
+		if err = r.outboxTx(ctx, tx, event.NewContactRemovedFromGroup(groupID, contactID)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (r *Repository) AddContactsToGroup(c context.Context, groupID uuid.UUID, contactIDs ...uuid.UUID) error {
+func (r *Repository) AddContactsToGroup(c context.Context, groupID uuid.UUID, contactIDs ...uuid.UUID) (err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
 	if err = r.fillGroupTx(ctx, tx, groupID, contactIDs...); err != nil {
@@ -132,13 +145,25 @@ func (r *Repository) fillGroupTx(ctx context.Context, tx pgx.Tx, groupID uuid.UU
 		pgx.CopyFromRows(rows),
 	)
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
 	if err = r.updateGroupContactCount(ctx, tx, groupID); err != nil {
 		return err
 	}
 
+	var events = make([]event.Event, len(contactIDs))
+	for i, contactID := range contactIDs {
+		if err = r.auditTx(ctx, tx, audit.EntityContact, contactID, audit.ActionAddToGroup, nil, membershipSnapshot(groupID)); err != nil {
+			return err
+		}
+		events[i] = event.NewContactAddedToGroup(groupID, contactID)
+	}
+
+	if err = r.outboxTx(ctx, tx, events...); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -156,22 +181,23 @@ func (r *Repository) checkExistContactInGroup(ctx context.Context, tx pgx.Tx, gr
 	query, args, err := r.genSQL.
 		Select("contact_id").
 		From("slurm.contact_in_group").
+		Where(tenantScope(ctx, "tenant_id")).
 		Where(squirrel.Eq{"contact_id": contactIDs, "group_id": groupID}).ToSql()
 
 	if err != nil {
-		return nil, nil, log.ErrorWithContext(ctx, err)
+		return nil, nil, storageError(ctx, err)
 	}
 
 	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
-		return nil, nil, log.ErrorWithContext(ctx, err)
+		return nil, nil, storageError(ctx, err)
 	}
 
 	for rows.Next() {
 		var contactID = uuid.UUID{}
 
 		if err = rows.Scan(&contactID); err != nil {
-			return nil, nil, log.ErrorWithContext(ctx, err)
+			return nil, nil, storageError(ctx, err)
 		}
 
 		listExist = append(listExist, contactID)
@@ -185,7 +211,7 @@ func (r *Repository) checkExistContactInGroup(ctx context.Context, tx pgx.Tx, gr
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, nil, log.ErrorWithContext(ctx, err)
+		return nil, nil, storageError(ctx, err)
 	}
 
 	return listExist, mapExist, nil
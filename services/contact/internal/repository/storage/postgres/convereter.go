@@ -1,6 +1,9 @@
 package postgres
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v4"
 
 	"architecture_go/pkg/type/email"
@@ -8,9 +11,13 @@ import (
 	"architecture_go/pkg/type/phoneNumber"
 	"architecture_go/services/contact/internal/domain/contact"
 	"architecture_go/services/contact/internal/domain/contact/age"
+	"architecture_go/services/contact/internal/domain/contact/birthday"
+	"architecture_go/services/contact/internal/domain/contact/employment"
 	"architecture_go/services/contact/internal/domain/contact/name"
 	"architecture_go/services/contact/internal/domain/contact/patronymic"
 	"architecture_go/services/contact/internal/domain/contact/surname"
+	"architecture_go/services/contact/internal/domain/customField"
+	tagName "architecture_go/services/contact/internal/domain/tag/name"
 	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
 )
 
@@ -29,6 +36,12 @@ func (r Repository) toCopyFromSource(contacts ...*contact.Contact) pgx.CopyFromS
 			val.Patronymic().String(),
 			val.Age(),
 			val.Gender(),
+			customFieldValues(val.CustomFields()),
+			birthdayValue(val.Birthday()),
+			dao.ToDaoAddresses(val.Addresses()),
+			organizationIDValue(val.Employment()),
+			val.Employment().JobTitle(),
+			val.Owner(),
 		}
 	}
 	return pgx.CopyFromRows(rows)
@@ -76,9 +89,74 @@ func (r Repository) toDomainContact(dao *dao.Contact) (*contact.Contact, error)
 	if err != nil {
 		return nil, err
 	}
+	addresses, err := dao.Addresses.ToDomainAddresses()
+	if err != nil {
+		return nil, err
+	}
+	result.SetAddresses(addresses...)
+
+	var organizationID uuid.UUID
+	if dao.OrganizationID != nil {
+		organizationID = *dao.OrganizationID
+	}
+	contactEmployment, err := employment.New(organizationID, dao.JobTitle)
+	if err != nil {
+		return nil, err
+	}
+	if dao.OrganizationName != nil {
+		contactEmployment = contactEmployment.WithOrganizationName(*dao.OrganizationName)
+	}
+	result.SetEmployment(contactEmployment)
+
+	contactPhoto, err := dao.Photo.ToDomainPhoto()
+	if err != nil {
+		return nil, err
+	}
+	result.SetPhoto(contactPhoto)
+
+	result.SetCustomFields(dao.CustomFields)
+
+	if dao.Birthday != nil {
+		contactBirthday, err := birthday.New(*dao.Birthday)
+		if err != nil {
+			return nil, err
+		}
+		result.SetBirthday(contactBirthday)
+	}
+
+	tags, err := tagName.NewList(dao.Tags...)
+	if err != nil {
+		return nil, err
+	}
+	result.SetTags(tags...)
+	result.SetOwner(dao.Owner)
+
 	return result, nil
 }
 
+func customFieldValues(values customField.Values) map[string]interface{} {
+	if values == nil {
+		return map[string]interface{}{}
+	}
+	return values
+}
+
+// organizationIDValue NULL, если контакт не привязан к организации
+func organizationIDValue(value employment.Employment) interface{} {
+	if !value.HasOrganization() {
+		return nil
+	}
+	return value.OrganizationID()
+}
+
+func birthdayValue(value birthday.Birthday) *time.Time {
+	if value.IsEmpty() {
+		return nil
+	}
+	date := value.Value()
+	return &date
+}
+
 func (r Repository) toDomainContacts(dao []*dao.Contact) ([]*contact.Contact, error) {
 	var result = make([]*contact.Contact, len(dao))
 	for i, v := range dao {
@@ -0,0 +1,309 @@
+package postgres
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/columnCode"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/customField"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+var mappingSortCustomField = map[columnCode.ColumnCode]string{
+	"id":    "id",
+	"key":   "key",
+	"label": "label",
+	"type":  "type",
+}
+
+func (r *Repository) CreateCustomField(c context.Context, field *customField.CustomField) (*customField.CustomField, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Insert("slurm.custom_field").
+		Columns(
+			"id",
+			"created_at",
+			"modified_at",
+			"key",
+			"label",
+			"type",
+			"required",
+			"options",
+		).
+		Values(
+			field.ID(),
+			field.CreatedAt(),
+			field.ModifiedAt(),
+			field.Key().String(),
+			field.Label().Value(),
+			field.Type().Number(),
+			field.Required(),
+			customFieldOptions(field.Options()),
+		).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = r.db.Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == codeUniqueViolation {
+			return nil, useCase.ErrCustomFieldExists
+		}
+		return nil, storageError(ctx, err)
+	}
+
+	return field, nil
+}
+
+func (r *Repository) UpdateCustomField(c context.Context, ID uuid.UUID, updateFn func(field *customField.CustomField) (*customField.CustomField, error)) (response *customField.CustomField, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	upField, err := r.oneCustomFieldTx(ctx, tx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldForUpdate, err := updateFn(upField)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Update("slurm.custom_field").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("label", fieldForUpdate.Label().Value()).
+		Set("required", fieldForUpdate.Required()).
+		Set("options", customFieldOptions(fieldForUpdate.Options())).
+		Set("modified_at", fieldForUpdate.ModifiedAt()).
+		Where(squirrel.Eq{
+			"id":          ID,
+			"is_archived": false,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return fieldForUpdate, nil
+}
+
+// DeleteCustomField архивирует описание поля и удаляет его значения из контактов
+func (r *Repository) DeleteCustomField(c context.Context, ID uuid.UUID) (err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	field, err := r.oneCustomFieldTx(ctx, tx, ID)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := r.genSQL.Update("slurm.custom_field").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("is_archived", true).
+		Set("modified_at", time.Now().UTC()).
+		Where(squirrel.Eq{
+			"id":          ID,
+			"is_archived": false,
+		}).ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	query, args, err = r.genSQL.Update("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("custom_fields", squirrel.Expr("custom_fields - ?", field.Key().String())).
+		Where(squirrel.Expr("custom_fields ?? ?", field.Key().String())).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) ListCustomField(c context.Context, parameter queryParameter.QueryParameter) ([]*customField.CustomField, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.selectCustomField(ctx)
+
+	if len(parameter.Sorts) > 0 {
+		builder = builder.OrderBy(parameter.Sorts.Parsing(mappingSortCustomField)...)
+	} else {
+		builder = builder.OrderBy("created_at DESC")
+	}
+
+	builder = builder.Limit(parameter.Pagination.Limit)
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	return r.queryCustomFields(ctx, r.db, builder)
+}
+
+func (r *Repository) ReadCustomFieldByID(c context.Context, ID uuid.UUID) (response *customField.CustomField, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	response, err = r.oneCustomFieldTx(ctx, tx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (r *Repository) ReadCustomFieldRegistry(c context.Context) (customField.Registry, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	fields, err := r.queryCustomFields(ctx, r.db, r.selectCustomField(ctx).OrderBy("key"))
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func (r *Repository) CountCustomField(c context.Context) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(id)").
+		From("slurm.custom_field").
+		Where(squirrel.Eq{"is_archived": false}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	return total, nil
+}
+
+func (r *Repository) oneCustomFieldTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID) (*customField.CustomField, error) {
+	fields, err := r.queryCustomFields(ctx, tx, r.selectCustomField(ctx).Where(squirrel.Eq{"id": ID}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, useCase.ErrCustomFieldNotFound
+	}
+
+	return fields[0], nil
+}
+
+func (r *Repository) selectCustomField(ctx context.Context) squirrel.SelectBuilder {
+	return r.genSQL.Select(
+		"id",
+		"created_at",
+		"modified_at",
+		"key",
+		"label",
+		"type",
+		"required",
+		"options",
+		"is_archived",
+	).
+		From("slurm.custom_field").
+		Where(squirrel.Eq{"is_archived": false}).
+		Where(tenantScope(ctx, "tenant_id"))
+}
+
+func (r *Repository) queryCustomFields(ctx context.Context, db pgxscan.Querier, builder squirrel.SelectBuilder) ([]*customField.CustomField, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoFields []*dao.CustomField
+	if err = pgxscan.Select(ctx, db, &daoFields, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*customField.CustomField, len(daoFields))
+	for i, f := range daoFields {
+		field, err := f.ToDomainCustomField()
+		if err != nil {
+			return nil, storageError(ctx, err)
+		}
+		result[i] = field
+	}
+
+	return result, nil
+}
+
+func customFieldOptions(options []string) []string {
+	if options == nil {
+		return []string{}
+	}
+	return options
+}
@@ -0,0 +1,27 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/group/acl"
+)
+
+type GroupACL struct {
+	GroupID   uuid.UUID `db:"group_id"`
+	Grantee   string    `db:"grantee"`
+	Level     string    `db:"level"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+var ColumnGroupACL = []string{
+	"group_id",
+	"grantee",
+	"level",
+	"created_at",
+}
+
+func (a *GroupACL) ToDomainEntry() *acl.Entry {
+	return acl.NewWithID(a.GroupID, a.Grantee, acl.Level(a.Level), a.CreatedAt)
+}
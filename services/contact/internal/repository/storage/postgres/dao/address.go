@@ -0,0 +1,42 @@
+package dao
+
+import "architecture_go/services/contact/internal/domain/contact/address"
+
+// Address элемент jsonb-массива slurm.contact.addresses
+type Address struct {
+	Label    string `json:"label,omitempty"`
+	Country  string `json:"country"`
+	Region   string `json:"region,omitempty"`
+	City     string `json:"city,omitempty"`
+	Street   string `json:"street,omitempty"`
+	Postcode string `json:"postcode,omitempty"`
+}
+
+type Addresses []Address
+
+func ToDaoAddresses(addresses []address.Address) Addresses {
+	var result = make(Addresses, len(addresses))
+	for i, value := range addresses {
+		result[i] = Address{
+			Label:    value.Label(),
+			Country:  value.Country(),
+			Region:   value.Region(),
+			City:     value.City(),
+			Street:   value.Street(),
+			Postcode: value.Postcode(),
+		}
+	}
+	return result
+}
+
+func (a Addresses) ToDomainAddresses() ([]address.Address, error) {
+	var result = make([]address.Address, len(a))
+	for i, value := range a {
+		domainAddress, err := address.New(value.Label, value.Country, value.Region, value.City, value.Street, value.Postcode)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = domainAddress
+	}
+	return result, nil
+}
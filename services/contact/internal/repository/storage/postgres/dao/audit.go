@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/audit"
+)
+
+type Audit struct {
+	ID        uuid.UUID `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	Actor     string    `db:"actor"`
+	RequestID string    `db:"request_id"`
+	TraceID   string    `db:"trace_id"`
+	Entity    string    `db:"entity"`
+	EntityID  uuid.UUID `db:"entity_id"`
+	Action    string    `db:"action"`
+	Changes   Changes   `db:"changes"`
+}
+
+// Change элемент jsonb-массива slurm.audit_log.changes
+type Change struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+type Changes []Change
+
+func ToDaoChanges(changes []audit.Change) Changes {
+	var result = make(Changes, len(changes))
+	for i, change := range changes {
+		result[i] = Change{
+			Field:  change.Field(),
+			Before: change.Before(),
+			After:  change.After(),
+		}
+	}
+	return result
+}
+
+func (a *Audit) ToDomainAudit() *audit.Entry {
+	var changes = make([]audit.Change, len(a.Changes))
+	for i, change := range a.Changes {
+		changes[i] = audit.NewChange(change.Field, change.Before, change.After)
+	}
+
+	return audit.NewWithID(
+		a.ID,
+		a.CreatedAt,
+		a.Actor,
+		a.RequestID,
+		a.TraceID,
+		audit.Entity(a.Entity),
+		a.EntityID,
+		audit.Action(a.Action),
+		changes,
+	)
+}
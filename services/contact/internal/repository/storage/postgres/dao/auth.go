@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/apiKey"
+	"architecture_go/services/contact/internal/domain/session"
+)
+
+type APIKey struct {
+	ID         uuid.UUID  `db:"id"`
+	CreatedAt  time.Time  `db:"created_at"`
+	Name       string     `db:"name"`
+	Prefix     string     `db:"prefix"`
+	Hash       []byte     `db:"hash"`
+	Subject    string     `db:"subject"`
+	Tenant     string     `db:"tenant_id"`
+	Roles      []string   `db:"roles"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+}
+
+var ColumnAPIKey = []string{
+	"id",
+	"created_at",
+	"name",
+	"prefix",
+	"hash",
+	"subject",
+	"tenant_id",
+	"roles",
+	"expires_at",
+	"last_used_at",
+	"revoked_at",
+}
+
+func (k *APIKey) ToDomainAPIKey() *apiKey.APIKey {
+	return apiKey.NewWithID(
+		k.ID,
+		k.CreatedAt,
+		k.Name,
+		k.Prefix,
+		k.Hash,
+		k.Subject,
+		k.Tenant,
+		k.Roles,
+		timeValue(k.ExpiresAt),
+		timeValue(k.LastUsedAt),
+		timeValue(k.RevokedAt),
+	)
+}
+
+type Session struct {
+	ID        uuid.UUID `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+	Hash      []byte    `db:"hash"`
+	Subject   string    `db:"subject"`
+	Name      string    `db:"name"`
+	Tenant    string    `db:"tenant_id"`
+	Roles     []string  `db:"roles"`
+}
+
+var ColumnSession = []string{
+	"id",
+	"created_at",
+	"expires_at",
+	"hash",
+	"subject",
+	"name",
+	"tenant_id",
+	"roles",
+}
+
+func (s *Session) ToDomainSession() *session.Session {
+	return session.NewWithID(s.ID, s.CreatedAt, s.ExpiresAt, s.Hash, s.Subject, s.Name, s.Tenant, s.Roles)
+}
+
+// NullTime нулевое время хранится как NULL
+func NullTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+	return &value
+}
+
+func timeValue(value *time.Time) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+	return *value
+}
@@ -18,8 +18,23 @@ type Contact struct {
 	Surname    string `db:"surname"`
 	Patronymic string `db:"patronymic"`
 
-	Age    uint8 `db:"age"`
-	Gender uint8 `db:"gender"`
+	Age      uint8      `db:"age"`
+	Birthday *time.Time `db:"birthday"`
+	Gender   uint8      `db:"gender"`
+
+	Addresses Addresses `db:"addresses"`
+
+	OrganizationID   *uuid.UUID `db:"organization_id"`
+	OrganizationName *string    `db:"organization_name"`
+	JobTitle         string     `db:"job_title"`
+
+	Photo Photo `db:"photo"`
+
+	CustomFields map[string]interface{} `db:"custom_fields"`
+
+	Tags []string `db:"tags"`
+
+	Owner string `db:"owner"`
 }
 
 var CreateColumnContact = []string{
@@ -33,6 +48,12 @@ var CreateColumnContact = []string{
 	"patronymic",
 	"age",
 	"gender",
+	"custom_fields",
+	"birthday",
+	"addresses",
+	"organization_id",
+	"job_title",
+	"owner",
 }
 
 var CreateColumnContactInGroup = []string{
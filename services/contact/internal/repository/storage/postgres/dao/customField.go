@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/customField"
+	"architecture_go/services/contact/internal/domain/customField/fieldType"
+	"architecture_go/services/contact/internal/domain/customField/key"
+	"architecture_go/services/contact/internal/domain/customField/label"
+)
+
+type CustomField struct {
+	ID         uuid.UUID `db:"id"`
+	CreatedAt  time.Time `db:"created_at"`
+	ModifiedAt time.Time `db:"modified_at"`
+	Key        string    `db:"key"`
+	Label      string    `db:"label"`
+	Type       uint8     `db:"type"`
+	Required   bool      `db:"required"`
+	Options    []string  `db:"options"`
+	IsArchived bool      `db:"is_archived"`
+}
+
+func (f *CustomField) ToDomainCustomField() (*customField.CustomField, error) {
+	fKey, err := key.New(f.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	fLabel, err := label.New(f.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	return customField.NewWithID(
+		f.ID,
+		f.CreatedAt,
+		f.ModifiedAt,
+		fKey,
+		fLabel,
+		fieldType.New(f.Type),
+		f.Required,
+		f.Options,
+	)
+}
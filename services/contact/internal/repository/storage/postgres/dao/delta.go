@@ -0,0 +1,21 @@
+package dao
+
+// ContactChange контакт с номером последнего изменения, архивные строки тоже читаются
+type ContactChange struct {
+	Contact
+	ChangeSequence int64 `db:"change_sequence"`
+	ChangeXID      int64 `db:"change_xid"`
+	IsArchived     bool  `db:"is_archived"`
+}
+
+// Before изменение контакта идёт раньше изменения (xid, sequence)
+func (c ContactChange) Before(xid, sequence int64) bool {
+	return c.ChangeXID < xid || (c.ChangeXID == xid && c.ChangeSequence < sequence)
+}
+
+// GroupChange группа с номером последнего изменения
+type GroupChange struct {
+	Group
+	ChangeSequence int64 `db:"change_sequence"`
+	ChangeXID      int64 `db:"change_xid"`
+}
@@ -18,6 +18,7 @@ type Group struct {
 	ModifiedAt   time.Time `db:"modified_at"`
 	ContactCount uint64    `db:"contact_count"`
 	IsArchived   bool      `db:"is_archived"`
+	Owner        string    `db:"owner"`
 }
 
 func (g *Group) ToDomainGroup() (*group.Group, error) {
@@ -30,12 +31,14 @@ func (g *Group) ToDomainGroup() (*group.Group, error) {
 	if err != nil {
 		return nil, err
 	}
-	return group.NewWithID(
+	var result = group.NewWithID(
 		g.ID,
 		g.CreatedAt,
 		g.ModifiedAt,
 		gN,
 		gD,
 		g.ContactCount,
-	), nil
+	)
+	result.SetOwner(g.Owner)
+	return result, nil
 }
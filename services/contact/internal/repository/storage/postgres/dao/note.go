@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/note"
+	"architecture_go/services/contact/internal/domain/note/author"
+	"architecture_go/services/contact/internal/domain/note/body"
+	"architecture_go/services/contact/internal/domain/note/noteType"
+)
+
+type Note struct {
+	ID         uuid.UUID `db:"id"`
+	CreatedAt  time.Time `db:"created_at"`
+	ModifiedAt time.Time `db:"modified_at"`
+	ContactID  uuid.UUID `db:"contact_id"`
+	Author     string    `db:"author"`
+	Type       uint8     `db:"type"`
+	OccurredAt time.Time `db:"occurred_at"`
+	Body       string    `db:"body"`
+}
+
+func (n *Note) ToDomainNote() (*note.Note, error) {
+	noteAuthor, err := author.New(n.Author)
+	if err != nil {
+		return nil, err
+	}
+
+	noteBody, err := body.New(n.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return note.NewWithID(n.ID, n.CreatedAt, n.ModifiedAt, n.ContactID, noteAuthor, noteType.New(n.Type), n.OccurredAt, noteBody)
+}
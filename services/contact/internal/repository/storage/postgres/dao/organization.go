@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/organization"
+	"architecture_go/services/contact/internal/domain/organization/name"
+	"architecture_go/services/contact/internal/domain/organization/taxID"
+	"architecture_go/services/contact/internal/domain/organization/website"
+)
+
+type Organization struct {
+	ID           uuid.UUID `db:"id"`
+	CreatedAt    time.Time `db:"created_at"`
+	ModifiedAt   time.Time `db:"modified_at"`
+	Name         string    `db:"name"`
+	TaxID        string    `db:"tax_id"`
+	Website      string    `db:"website"`
+	ContactCount uint64    `db:"contact_count"`
+}
+
+func (o *Organization) ToDomainOrganization() (*organization.Organization, error) {
+	orgName, err := name.New(o.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	orgTaxID, err := taxID.New(o.TaxID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgWebsite, err := website.New(o.Website)
+	if err != nil {
+		return nil, err
+	}
+
+	return organization.NewWithID(o.ID, o.CreatedAt, o.ModifiedAt, orgName, orgTaxID, orgWebsite, o.ContactCount), nil
+}
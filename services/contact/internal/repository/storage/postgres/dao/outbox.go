@@ -0,0 +1,44 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Outbox struct {
+	ID            uuid.UUID  `db:"id"`
+	Sequence      int64      `db:"sequence"`
+	CreatedAt     time.Time  `db:"created_at"`
+	AggregateID   uuid.UUID  `db:"aggregate_id"`
+	Tenant        string     `db:"tenant_id"`
+	Name          string     `db:"name"`
+	Payload       []byte     `db:"payload"`
+	Attempts      uint32     `db:"attempts"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	LastError     string     `db:"last_error"`
+	PublishedAt   *time.Time `db:"published_at"`
+}
+
+// Envelope конверт события в slurm.outbox.payload, в таком виде событие получают подписчики брокера
+type Envelope struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	AggregateID uuid.UUID   `json:"aggregateId"`
+	OccurredAt  time.Time   `json:"occurredAt"`
+	Data        interface{} `json:"data"`
+}
+
+var ColumnOutbox = []string{
+	"id",
+	"sequence",
+	"created_at",
+	"aggregate_id",
+	"tenant_id",
+	"name",
+	"payload",
+	"attempts",
+	"next_attempt_at",
+	"last_error",
+	"published_at",
+}
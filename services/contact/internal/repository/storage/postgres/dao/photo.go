@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"time"
+
+	"architecture_go/services/contact/internal/domain/contact/photo"
+)
+
+// Photo jsonb-объект slurm.contact.photo, пустой объект -- фотографии нет
+type Photo struct {
+	ContentType string    `json:"contentType,omitempty"`
+	Size        int       `json:"size,omitempty"`
+	Width       int       `json:"width,omitempty"`
+	Height      int       `json:"height,omitempty"`
+	UploadedAt  time.Time `json:"uploadedAt,omitempty"`
+}
+
+func ToDaoPhoto(value photo.Photo) Photo {
+	if value.IsEmpty() {
+		return Photo{}
+	}
+
+	return Photo{
+		ContentType: value.ContentType(),
+		Size:        value.Size(),
+		Width:       value.Width(),
+		Height:      value.Height(),
+		UploadedAt:  value.UploadedAt(),
+	}
+}
+
+func (p Photo) ToDomainPhoto() (photo.Photo, error) {
+	if p.ContentType == "" {
+		return photo.Photo{}, nil
+	}
+	return photo.New(p.ContentType, p.Size, p.Width, p.Height, p.UploadedAt)
+}
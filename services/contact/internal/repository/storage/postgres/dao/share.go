@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/share"
+)
+
+type Share struct {
+	Resource   string    `db:"resource"`
+	ResourceID uuid.UUID `db:"resource_id"`
+	Grantee    string    `db:"grantee"`
+	Level      string    `db:"level"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+var ColumnShare = []string{
+	"resource",
+	"resource_id",
+	"grantee",
+	"level",
+	"created_at",
+}
+
+func (s *Share) ToDomainShare() *share.Share {
+	return share.NewWithID(share.Resource(s.Resource), s.ResourceID, s.Grantee, share.Level(s.Level), s.CreatedAt)
+}
+
+type Access struct {
+	Owner    string `db:"owner"`
+	Owned    bool   `db:"owned"`
+	Level    string `db:"level"`
+	ViaGroup bool   `db:"via_group"`
+}
+
+func (a *Access) ToDomainAccess() *share.Access {
+	return &share.Access{
+		Owner:    a.Owner,
+		Owned:    a.Owned,
+		Level:    share.Level(a.Level),
+		ViaGroup: a.ViaGroup,
+	}
+}
@@ -0,0 +1,26 @@
+package dao
+
+import (
+	"time"
+
+	"architecture_go/services/contact/internal/domain/version"
+)
+
+// ContactSnapshot контакт, восстановленный из slurm.contact_snapshot
+type ContactSnapshot struct {
+	Contact
+
+	Version          uint64    `db:"version"`
+	VersionCreatedAt time.Time `db:"version_created_at"`
+	IsArchived       bool      `db:"is_archived"`
+}
+
+type Version struct {
+	Version    uint64    `db:"version"`
+	CreatedAt  time.Time `db:"created_at"`
+	IsArchived bool      `db:"is_archived"`
+}
+
+func (v *Version) ToDomainVersion() *version.Version {
+	return version.New(v.Version, v.CreatedAt, v.IsArchived)
+}
@@ -0,0 +1,27 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/tag"
+	"architecture_go/services/contact/internal/domain/tag/name"
+)
+
+type Tag struct {
+	ID           uuid.UUID `db:"id"`
+	CreatedAt    time.Time `db:"created_at"`
+	ModifiedAt   time.Time `db:"modified_at"`
+	Name         string    `db:"name"`
+	ContactCount uint64    `db:"contact_count"`
+}
+
+func (t *Tag) ToDomainTag() (*tag.Tag, error) {
+	tagName, err := name.New(t.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return tag.NewWithID(t.ID, t.CreatedAt, t.ModifiedAt, tagName, t.ContactCount), nil
+}
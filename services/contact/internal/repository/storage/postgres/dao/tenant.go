@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"time"
+
+	"architecture_go/services/contact/internal/domain/tenant"
+)
+
+type Tenant struct {
+	ID          string     `db:"id"`
+	CreatedAt   time.Time  `db:"created_at"`
+	ModifiedAt  time.Time  `db:"modified_at"`
+	Name        string     `db:"name"`
+	MaxContacts uint64     `db:"max_contacts"`
+	MaxGroups   uint64     `db:"max_groups"`
+	DisabledAt  *time.Time `db:"disabled_at"`
+}
+
+var ColumnTenant = []string{
+	"id",
+	"created_at",
+	"modified_at",
+	"name",
+	"max_contacts",
+	"max_groups",
+	"disabled_at",
+}
+
+func (t *Tenant) ToDomainTenant() *tenant.Tenant {
+	return tenant.NewWithID(
+		t.ID,
+		t.CreatedAt,
+		t.ModifiedAt,
+		t.Name,
+		tenant.Quota{MaxContacts: t.MaxContacts, MaxGroups: t.MaxGroups},
+		timeValue(t.DisabledAt),
+	)
+}
@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/webhook"
+)
+
+type Webhook struct {
+	ID         uuid.UUID `db:"id"`
+	CreatedAt  time.Time `db:"created_at"`
+	ModifiedAt time.Time `db:"modified_at"`
+	URL        string    `db:"url"`
+	Events     []string  `db:"events"`
+	Secret     string    `db:"secret"`
+	IsActive   bool      `db:"is_active"`
+}
+
+var ColumnWebhook = []string{
+	"id",
+	"created_at",
+	"modified_at",
+	"url",
+	"events",
+	"secret",
+	"is_active",
+}
+
+func (w *Webhook) ToDomainWebhook() *webhook.Webhook {
+	var events = make([]event.Name, len(w.Events))
+	for i, value := range w.Events {
+		events[i] = event.Name(value)
+	}
+
+	return webhook.NewWithID(w.ID, w.CreatedAt, w.ModifiedAt, w.URL, events, w.Secret, w.IsActive)
+}
+
+func ToDaoWebhookEvents(events []event.Name) []string {
+	var result = make([]string, len(events))
+	for i, value := range events {
+		result[i] = value.String()
+	}
+	return result
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID  `db:"id"`
+	WebhookID      uuid.UUID  `db:"webhook_id"`
+	EventID        uuid.UUID  `db:"event_id"`
+	EventName      string     `db:"event_name"`
+	Payload        []byte     `db:"payload"`
+	CreatedAt      time.Time  `db:"created_at"`
+	Status         string     `db:"status"`
+	Attempts       uint32     `db:"attempts"`
+	NextAttemptAt  time.Time  `db:"next_attempt_at"`
+	LastStatusCode int        `db:"last_status_code"`
+	LastError      string     `db:"last_error"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+}
+
+var ColumnWebhookDelivery = []string{
+	"id",
+	"webhook_id",
+	"event_id",
+	"event_name",
+	"payload",
+	"created_at",
+	"status",
+	"attempts",
+	"next_attempt_at",
+	"last_status_code",
+	"last_error",
+	"delivered_at",
+}
+
+func (d *WebhookDelivery) ToDomainDelivery() *webhook.Delivery {
+	var deliveredAt time.Time
+	if d.DeliveredAt != nil {
+		deliveredAt = *d.DeliveredAt
+	}
+
+	return webhook.NewDeliveryWithID(
+		d.ID,
+		d.WebhookID,
+		d.EventID,
+		event.Name(d.EventName),
+		d.Payload,
+		d.CreatedAt,
+		webhook.Status(d.Status),
+		d.Attempts,
+		d.NextAttemptAt,
+		d.LastStatusCode,
+		d.LastError,
+		deliveredAt,
+	)
+}
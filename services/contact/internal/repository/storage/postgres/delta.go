@@ -0,0 +1,374 @@
+package postgres
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/delta"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/group"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// changeTable таблица агрегата события; изменения членства относятся к группе
+func changeTable(name event.Name) string {
+	switch name {
+	case event.NameContactCreated, event.NameContactUpdated, event.NameContactArchived, event.NameContactRestored,
+		event.NameContactAccessChanged:
+		return "slurm.contact"
+	case event.NameGroupCreated, event.NameGroupUpdated, event.NameGroupArchived,
+		event.NameContactAddedToGroup, event.NameContactRemovedFromGroup, event.NameGroupAccessChanged:
+		return "slurm.group"
+	default:
+		return ""
+	}
+}
+
+// changeSequenceTx записывает агрегатам номер их последнего события из пачки и транзакцию изменения.
+// Строки агрегатов к этому моменту уже изменены и заблокированы транзакцией.
+func (r *Repository) changeSequenceTx(ctx context.Context, tx pgx.Tx, sequences []int64, events ...event.Event) error {
+	var tables = make(map[string]map[string]int64)
+	for i, e := range events {
+		table := changeTable(e.Name())
+		if table == "" {
+			continue
+		}
+		if tables[table] == nil {
+			tables[table] = make(map[string]int64)
+		}
+		// номера в пачке растут, последнее событие агрегата перезаписывает предыдущие
+		tables[table][e.AggregateID().String()] = sequences[i]
+	}
+
+	for table, sequences := range tables {
+		var (
+			ids    = make([]string, 0, len(sequences))
+			values = make([]int64, 0, len(sequences))
+		)
+		for id, sequence := range sequences {
+			ids = append(ids, id)
+			values = append(values, sequence)
+		}
+
+		query, args, err := r.genSQL.Update(table+" AS target").
+			Set("change_sequence", squirrel.Expr(`(
+				SELECT changes.sequence
+				FROM unnest(?::uuid[], ?::bigint[]) AS changes(id, sequence)
+				WHERE changes.id = target.id
+			)`, ids, values)).
+			Set("change_xid", squirrel.Expr("pg_current_xact_id()")).
+			Where("target.id = ANY(?::uuid[])", ids).
+			ToSql()
+		if err != nil {
+			return storageError(ctx, err)
+		}
+
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			return storageError(ctx, err)
+		}
+	}
+
+	return nil
+}
+
+// ListDelta до limit записей, изменённых после since, в порядке изменений. Контакты и группы
+// упорядочены по (транзакция, номер), как лента outbox, и читаются только до visibleXid,
+// поэтому обе выборки сливаются по этой паре, а токен страницы -- номер последней попавшей
+// в неё записи, а у последней страницы -- номер последнего события до границы видимости.
+// При полной синхронизации (since = 0) архивные записи не отдаются: клиенту нечего удалять.
+// Если события токена нет или после токена у автора с ограниченной видимостью мог измениться
+// набор видимых записей, возвращается delta.ErrTokenExpired: открытые или закрытые ему записи сами не менялись,
+// и ни они, ни их надгробия в выборку изменений не попадут.
+func (r *Repository) ListDelta(c context.Context, since delta.Token, limit uint64) (response *delta.Delta, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	// курсор и граница видимости считаются один раз: обе выборки должны видеть одни и те же транзакции
+	cursor, err := r.changeCursorTx(ctx, tx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := r.visibilityChangedTx(ctx, tx, cursor)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		return nil, delta.ErrTokenExpired
+	}
+
+	// на одну строку больше, чтобы понять, есть ли следующая страница
+	daoContacts, err := r.listContactChangeTx(ctx, tx, cursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	daoGroups, err := r.listGroupChangeTx(ctx, tx, cursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		contacts   = make([]*contact.Contact, 0)
+		groups     = make([]*group.Group, 0)
+		tombstones = make([]*delta.Tombstone, 0)
+		token      = since
+		i, j       int
+	)
+
+	for uint64(i+j) < limit && (i < len(daoContacts) || j < len(daoGroups)) {
+		if j == len(daoGroups) || (i < len(daoContacts) && daoContacts[i].Before(daoGroups[j].ChangeXID, daoGroups[j].ChangeSequence)) {
+			value := daoContacts[i]
+			i++
+			token = delta.Token(value.ChangeSequence)
+
+			if value.IsArchived {
+				tombstones = append(tombstones, delta.NewTombstone(delta.KindContact, value.ID, value.ModifiedAt))
+				continue
+			}
+
+			domainContact, err := r.toDomainContact(&value.Contact)
+			if err != nil {
+				return nil, storageError(ctx, err)
+			}
+			contacts = append(contacts, domainContact)
+			continue
+		}
+
+		value := daoGroups[j]
+		j++
+		token = delta.Token(value.ChangeSequence)
+
+		if value.IsArchived {
+			tombstones = append(tombstones, delta.NewTombstone(delta.KindGroup, value.ID, value.ModifiedAt))
+			continue
+		}
+
+		domainGroup, err := value.ToDomainGroup()
+		if err != nil {
+			return nil, storageError(ctx, err)
+		}
+		groups = append(groups, domainGroup)
+	}
+
+	var hasMore = i < len(daoContacts) || j < len(daoGroups)
+
+	// после последней страницы токен переходит к последнему событию: иначе события, которых автор
+	// не видит, в том числе изменения видимости, снова оказались бы после токена
+	if !hasMore {
+		last, err := r.lastChangeTokenTx(ctx, tx, cursor)
+		if err != nil {
+			return nil, err
+		}
+		if last != 0 {
+			token = last
+		}
+	}
+
+	return delta.New(contacts, groups, tombstones, token, hasMore), nil
+}
+
+// changeCursor позиция клиента в порядке (транзакция, номер) и граница видимости чтения
+type changeCursor struct {
+	since delta.Token
+	xid   int64
+	xmin  int64
+}
+
+// changeCursorTx позиция токена since; токен, по которому нельзя продолжить, -- delta.ErrTokenExpired
+func (r *Repository) changeCursorTx(ctx context.Context, tx pgx.Tx, since delta.Token) (cursor changeCursor, err error) {
+	cursor.since = since
+
+	xid, found, err := r.cursorXid(ctx, tx, int64(since))
+	if err != nil {
+		return cursor, err
+	}
+	if !found {
+		return cursor, delta.ErrTokenExpired
+	}
+	cursor.xid = xid
+
+	query, args, err := r.genSQL.Select("pg_snapshot_xmin(pg_current_snapshot())::text::bigint").ToSql()
+	if err != nil {
+		return cursor, storageError(ctx, err)
+	}
+
+	if err = tx.QueryRow(ctx, query, args...).Scan(&cursor.xmin); err != nil {
+		return cursor, storageError(ctx, err)
+	}
+
+	return cursor, nil
+}
+
+// visibilityChangedTx после курсора были события, меняющие видимость записей для автора с ограниченной
+// видимостью: изменение доступа к контакту или группе, а для открытых ему групп -- членство и удаление
+func (r *Repository) visibilityChangedTx(ctx context.Context, tx pgx.Tx, cursor changeCursor) (bool, error) {
+	values, ok := useCase.Visibility(ctx)
+	if !ok || cursor.since == 0 {
+		return false, nil
+	}
+	values = grantees(values)
+
+	query, args, err := r.genSQL.Select("1").
+		From("slurm.outbox AS outbox").
+		Where(tenantScope(ctx, "outbox.tenant_id")).
+		Where("outbox.xid < ?::bigint::text::xid8", cursor.xmin).
+		Where("(outbox.xid, outbox.sequence) > (?::bigint::text::xid8, ?)", cursor.xid, int64(cursor.since)).
+		Where(squirrel.Or{
+			squirrel.Eq{"outbox.name": []string{
+				event.NameContactAccessChanged.String(),
+				event.NameGroupAccessChanged.String(),
+			}},
+			squirrel.And{
+				squirrel.Eq{"outbox.name": []string{
+					event.NameContactAddedToGroup.String(),
+					event.NameContactRemovedFromGroup.String(),
+					event.NameGroupArchived.String(),
+				}},
+				squirrel.Expr(`EXISTS (SELECT 1 FROM slurm."group" WHERE "group".id = outbox.aggregate_id AND `+groupGranted+`)`,
+					values, values, values),
+			},
+		}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return false, storageError(ctx, err)
+	}
+
+	var found []int
+	if err = pgxscan.Select(ctx, tx, &found, query, args...); err != nil {
+		return false, storageError(ctx, err)
+	}
+
+	return len(found) > 0, nil
+}
+
+// lastChangeTokenTx номер последнего события до границы видимости, 0 если событий нет
+func (r *Repository) lastChangeTokenTx(ctx context.Context, tx pgx.Tx, cursor changeCursor) (delta.Token, error) {
+	query, args, err := r.genSQL.Select("sequence").
+		From("slurm.outbox").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where("xid < ?::bigint::text::xid8", cursor.xmin).
+		OrderBy("xid DESC", "sequence DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var sequences []int64
+	if err = pgxscan.Select(ctx, tx, &sequences, query, args...); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	if len(sequences) == 0 {
+		return 0, nil
+	}
+
+	return delta.Token(sequences[0]), nil
+}
+
+// where условия выборки изменений после курсора
+func (c changeCursor) where(builder squirrel.SelectBuilder) squirrel.SelectBuilder {
+	return builder.
+		Where("change_xid < ?::bigint::text::xid8", c.xmin).
+		Where("(change_xid, change_sequence) > (?::bigint::text::xid8, ?)", c.xid, int64(c.since)).
+		OrderBy("change_xid", "change_sequence")
+}
+
+func (r *Repository) listContactChangeTx(ctx context.Context, tx pgx.Tx, cursor changeCursor, limit uint64) ([]*dao.ContactChange, error) {
+	var builder = r.genSQL.Select(
+		"id",
+		"created_at",
+		"modified_at",
+		"phone_number",
+		"email",
+		"name",
+		"surname",
+		"patronymic",
+		"age",
+		"gender",
+		"custom_fields",
+		"birthday",
+		"addresses",
+		"organization_id",
+		"job_title",
+		"photo",
+		"owner",
+		columnContactOrganizationName,
+		columnContactTags,
+		"change_sequence",
+		"change_xid::text::bigint AS change_xid",
+		"is_archived",
+	).
+		From("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(contactVisibleScope(ctx))
+	builder = cursor.where(builder).Limit(limit)
+
+	if cursor.since == 0 {
+		builder = builder.Where(squirrel.Eq{"is_archived": false})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoContacts []*dao.ContactChange
+	if err = pgxscan.Select(ctx, tx, &daoContacts, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return daoContacts, nil
+}
+
+func (r *Repository) listGroupChangeTx(ctx context.Context, tx pgx.Tx, cursor changeCursor, limit uint64) ([]*dao.GroupChange, error) {
+	var builder = r.genSQL.Select(
+		"id",
+		"name",
+		"description",
+		"created_at",
+		"modified_at",
+		"contact_count",
+		"is_archived",
+		"owner",
+		"change_sequence",
+		"change_xid::text::bigint AS change_xid",
+	).
+		From("slurm.group").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(groupVisibleScope(ctx))
+	builder = cursor.where(builder).Limit(limit)
+
+	if cursor.since == 0 {
+		builder = builder.Where(squirrel.Eq{"is_archived": false})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoGroups []*dao.GroupChange
+	if err = pgxscan.Select(ctx, tx, &daoGroups, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return daoGroups, nil
+}
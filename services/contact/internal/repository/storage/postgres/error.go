@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	stdContext "context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// коды SQLSTATE, которые переводятся в ошибки сценариев
+const (
+	codeUniqueViolation      = "23505"
+	codeForeignKeyViolation  = "23503"
+	codeNotNullViolation     = "23502"
+	codeCheckViolation       = "23514"
+	codeSerializationFailure = "40001"
+	codeDeadlockDetected     = "40P01"
+	codeLockNotAvailable     = "55P03"
+	codeQueryCanceled        = "57014"
+
+	classDataException         = "22"
+	classConnectionException   = "08"
+	classInsufficientResources = "53"
+	classPrerequisiteState     = "55"
+	classOperatorIntervention  = "57"
+)
+
+// storageError пишет ошибку драйвера в журнал и переводит её в ошибку сценария
+func storageError(ctx context.Context, err error) error {
+	return translate(log.ErrorWithContext(ctx, err))
+}
+
+// translate ошибку драйвера в ошибку сценария. Исходная ошибка остаётся в цепочке,
+// но её текст с подробностями запроса клиенту не показывается.
+// Ошибки, которые не удалось классифицировать, возвращаются как есть.
+func translate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var known *translated
+	if errors.As(err, &known) {
+		return err
+	}
+
+	if kind := classify(err); kind != nil {
+		return &translated{kind: kind, err: err}
+	}
+	return err
+}
+
+func classify(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return useCase.ErrNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return classifyCode(pgErr.Code)
+	}
+
+	if pgconn.Timeout(err) || errors.Is(err, stdContext.DeadlineExceeded) {
+		return useCase.ErrStorageTimeout
+	}
+
+	// запрос не дошёл до сервера: соединение не установлено или оборвалось до отправки
+	if pgconn.SafeToRetry(err) {
+		return useCase.ErrStorageUnavailable
+	}
+
+	return nil
+}
+
+func classifyCode(code string) error {
+	switch code {
+	case codeUniqueViolation:
+		return useCase.ErrConflict
+	case codeForeignKeyViolation:
+		return useCase.ErrReferenceViolation
+	case codeNotNullViolation, codeCheckViolation:
+		return useCase.ErrInvalidData
+	case codeSerializationFailure, codeDeadlockDetected:
+		return useCase.ErrConcurrentUpdate
+	case codeLockNotAvailable:
+		return useCase.ErrStorageUnavailable
+	case codeQueryCanceled:
+		return useCase.ErrStorageTimeout
+	}
+
+	switch {
+	case strings.HasPrefix(code, classDataException):
+		return useCase.ErrInvalidData
+	case strings.HasPrefix(code, classPrerequisiteState):
+		return useCase.ErrPreconditionFailed
+	case strings.HasPrefix(code, classConnectionException),
+		strings.HasPrefix(code, classInsufficientResources),
+		strings.HasPrefix(code, classOperatorIntervention):
+		return useCase.ErrStorageUnavailable
+	}
+	return nil
+}
+
+// translated ошибка драйвера, которая для сценария выглядит как kind
+type translated struct {
+	kind error
+	err  error
+}
+
+func (e *translated) Error() string {
+	return e.kind.Error()
+}
+
+func (e *translated) Is(target error) bool {
+	return target == e.kind
+}
+
+func (e *translated) Unwrap() error {
+	return e.err
+}
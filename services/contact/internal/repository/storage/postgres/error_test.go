@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	stdContext "context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "unique", err: &pgconn.PgError{Code: codeUniqueViolation}, want: useCase.ErrConflict},
+		{name: "foreign key", err: &pgconn.PgError{Code: codeForeignKeyViolation}, want: useCase.ErrReferenceViolation},
+		{name: "serialization", err: &pgconn.PgError{Code: codeSerializationFailure}, want: useCase.ErrConcurrentUpdate},
+		{name: "data exception", err: &pgconn.PgError{Code: "22P02"}, want: useCase.ErrInvalidData},
+		{name: "prerequisite state", err: &pgconn.PgError{Code: "55000"}, want: useCase.ErrPreconditionFailed},
+		{name: "admin shutdown", err: &pgconn.PgError{Code: "57P01"}, want: useCase.ErrStorageUnavailable},
+		{name: "query canceled", err: &pgconn.PgError{Code: codeQueryCanceled}, want: useCase.ErrStorageTimeout},
+		{name: "deadline", err: stdContext.DeadlineExceeded, want: useCase.ErrStorageTimeout},
+		{name: "no rows", err: pgx.ErrNoRows, want: useCase.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err = translate(tt.err)
+			assert.ErrorIs(t, err, tt.want)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want.Error(), err.Error())
+			assert.Equal(t, err, translate(err))
+		})
+	}
+
+	var unknown = errors.New("unknown")
+	assert.Equal(t, unknown, translate(unknown))
+	assert.NoError(t, translate(nil))
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/Masterminds/squirrel"
@@ -13,8 +14,9 @@ import (
 	"architecture_go/pkg/tools/transaction"
 	"architecture_go/pkg/type/columnCode"
 	"architecture_go/pkg/type/context"
-	log "architecture_go/pkg/type/logger"
 	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/audit"
+	"architecture_go/services/contact/internal/domain/event"
 	"architecture_go/services/contact/internal/domain/group"
 	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
 	"architecture_go/services/contact/internal/useCase"
@@ -27,11 +29,20 @@ var mappingSortGroup = map[columnCode.ColumnCode]string{
 	"contactCount": "contact_count",
 }
 
-func (r *Repository) CreateGroup(c context.Context, group *group.Group) (*group.Group, error) {
+func (r *Repository) CreateGroup(c context.Context, group *group.Group) (response *group.Group, err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
 	query, args, err := r.genSQL.Insert("slurm.group").
 		Columns(
 			"id",
@@ -39,48 +50,62 @@ func (r *Repository) CreateGroup(c context.Context, group *group.Group) (*group.
 			"description",
 			"created_at",
 			"modified_at",
+			"owner",
 		).
 		Values(
 			group.ID(),
 			group.Name().Value(),
 			group.Description().Value(),
 			group.CreatedAt(),
-			group.ModifiedAt()).
+			group.ModifiedAt(),
+			group.Owner()).
 		ToSql()
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
-	if _, err = r.db.Exec(ctx, query, args...); err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if err = r.snapshotGroupTx(ctx, tx, group.ID()); err != nil {
+		return nil, err
 	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityGroup, group.ID(), audit.ActionCreate, nil, groupSnapshot(group)); err != nil {
+		return nil, err
+	}
+
 	return group, nil
 }
 
-func (r *Repository) UpdateGroup(c context.Context, ID uuid.UUID, updateFn func(group *group.Group) (*group.Group, error)) (*group.Group, error) {
+func (r *Repository) UpdateGroup(c context.Context, ID uuid.UUID, updateFn func(group *group.Group) (*group.Group, error)) (response *group.Group, err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
 	upGroup, err := r.oneGroupTx(ctx, tx, ID)
 	if err != nil {
 		return nil, err
 	}
+	var before = groupSnapshot(upGroup)
+
 	groupForUpdate, err := updateFn(upGroup)
 	if err != nil {
 		return nil, err
 	}
 
 	query, args, err := r.genSQL.Update("slurm.group").
+		Where(tenantScope(ctx, "tenant_id")).
 		Set("name", groupForUpdate.Name().Value()).
 		Set("description", groupForUpdate.Description().Value()).
 		Set("modified_at", groupForUpdate.ModifiedAt()).
@@ -95,38 +120,56 @@ func (r *Repository) UpdateGroup(c context.Context, ID uuid.UUID, updateFn func(
 			name,
 			description,
 			created_at,
-			modified_at`,
+			modified_at,
+			owner`,
 		).
 		ToSql()
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	var daoGroup []*dao.Group
 	if err = pgxscan.ScanAll(&daoGroup, rows); err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
+	}
+
+	// группа удалена параллельным запросом после чтения
+	if len(daoGroup) == 0 {
+		return nil, useCase.ErrGroupNotFound
+	}
+
+	if err = r.snapshotGroupTx(ctx, tx, ID); err != nil {
+		return nil, err
+	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityGroup, ID, audit.ActionUpdate, before, groupSnapshot(groupForUpdate)); err != nil {
+		return nil, err
+	}
+
+	if err = r.outboxTx(ctx, tx, event.NewGroupUpdated(groupForUpdate)); err != nil {
+		return nil, err
 	}
 
 	return groupForUpdate, nil
 }
 
-func (r *Repository) DeleteGroup(c context.Context, ID uuid.UUID) error {
+func (r *Repository) DeleteGroup(c context.Context, ID uuid.UUID) (err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
 	if err = r.deleteGroupTx(ctx, tx, ID); err != nil {
@@ -136,8 +179,18 @@ func (r *Repository) DeleteGroup(c context.Context, ID uuid.UUID) error {
 	return nil
 }
 
+// deleteGroupTx повторное удаление ничего не меняет и в журнал не попадает
 func (r *Repository) deleteGroupTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID) error {
+	deleted, err := r.oneGroupTx(ctx, tx, ID)
+	if err != nil {
+		if errors.Is(err, useCase.ErrGroupNotFound) {
+			return nil
+		}
+		return err
+	}
+
 	query, args, err := r.genSQL.Update("slurm.group").
+		Where(tenantScope(ctx, "tenant_id")).
 		Set("is_archived", true).
 		Set("modified_at", time.Now().UTC()).
 		Where(squirrel.Eq{
@@ -146,31 +199,44 @@ func (r *Repository) deleteGroupTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID)
 		}).ToSql()
 
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
 	if _, err = tx.Exec(ctx, query, args...); err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
 	if err = r.clearGroupTx(ctx, tx, ID); err != nil {
 		return err
 	}
 
+	if err = r.snapshotGroupTx(ctx, tx, ID); err != nil {
+		return err
+	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityGroup, ID, audit.ActionDelete, groupSnapshot(deleted), nil); err != nil {
+		return err
+	}
+
+	if err = r.outboxTx(ctx, tx, event.NewGroupArchived(ID)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (r *Repository) clearGroupTx(ctx context.Context, tx pgx.Tx, groupID uuid.UUID) error {
 	query, args, err := r.genSQL.
 		Delete("slurm.contact_in_group").
+		Where(tenantScope(ctx, "tenant_id")).
 		Where(squirrel.Eq{"group_id": groupID}).
 		ToSql()
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
 	if _, err = tx.Exec(ctx, query, args...); err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
 	if err = r.updateGroupContactCount(ctx, tx, groupID); err != nil {
@@ -180,21 +246,21 @@ func (r *Repository) clearGroupTx(ctx context.Context, tx pgx.Tx, groupID uuid.U
 	return nil
 }
 
-func (r *Repository) ListGroup(c context.Context, parameter queryParameter.QueryParameter) ([]*group.Group, error) {
+func (r *Repository) ListGroup(c context.Context, parameter queryParameter.QueryParameter) (response []*group.Group, err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
-	response, err := r.listGroupTx(ctx, tx, parameter)
+	response, err = r.listGroupTx(ctx, tx, parameter)
 	if err != nil {
 		return nil, err
 	}
@@ -213,8 +279,11 @@ func (r *Repository) listGroupTx(ctx context.Context, tx pgx.Tx, parameter query
 		"modified_at",
 		"contact_count",
 		"is_archived",
+		"owner",
 	).
-		From("slurm.group")
+		From("slurm.group").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(groupVisibleScope(ctx))
 
 	builder = builder.Where(squirrel.Eq{"is_archived": false})
 
@@ -234,39 +303,39 @@ func (r *Repository) listGroupTx(ctx context.Context, tx pgx.Tx, parameter query
 	query, args, err := builder.ToSql()
 	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	var groups []*dao.Group
 	if err = pgxscan.ScanAll(&groups, rows); err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	for _, g := range groups {
 		domainGroup, err := g.ToDomainGroup()
 		if err != nil {
-			return nil, log.ErrorWithContext(ctx, err)
+			return nil, storageError(ctx, err)
 		}
 		result = append(result, domainGroup)
 	}
 	return result, nil
 }
 
-func (r *Repository) ReadGroupByID(c context.Context, ID uuid.UUID) (*group.Group, error) {
+func (r *Repository) ReadGroupByID(c context.Context, ID uuid.UUID) (response *group.Group, err error) {
 
 	ctx := c.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	tx, err := r.db.Begin(ctx)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	defer func(ctx context.Context, t pgx.Tx) {
-		err = transaction.Finish(ctx, t, err)
+		err = translate(transaction.Finish(ctx, t, err))
 	}(ctx, tx)
 
-	response, err := r.oneGroupTx(ctx, tx, ID)
+	response, err = r.oneGroupTx(ctx, tx, ID)
 	if err != nil {
 		return nil, err
 	}
@@ -284,20 +353,22 @@ func (r *Repository) oneGroupTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID) (r
 		"modified_at",
 		"contact_count",
 		"is_archived",
+		"owner",
 	).
-		From("slurm.group")
+		From("slurm.group").
+		Where(tenantScope(ctx, "tenant_id"))
 
 	builder = builder.Where(squirrel.Eq{"is_archived": false, "id": ID})
 
 	query, args, err := builder.ToSql()
 	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	var daoGroup []*dao.Group
 	if err = pgxscan.ScanAll(&daoGroup, rows); err != nil {
-		return nil, log.ErrorWithContext(ctx, err)
+		return nil, storageError(ctx, err)
 	}
 
 	if len(daoGroup) == 0 {
@@ -308,23 +379,29 @@ func (r *Repository) oneGroupTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID) (r
 
 }
 
-func (r *Repository) CountGroup(ctx context.Context) (uint64, error) {
+func (r *Repository) CountGroup(c context.Context) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
 	var builder = r.genSQL.Select(
 		"COUNT(id)",
-	).From("slurm.group")
+	).From("slurm.group").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(groupVisibleScope(ctx))
 
 	builder = builder.Where(squirrel.Eq{"is_archived": false})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return 0, log.ErrorWithContext(ctx, err)
+		return 0, storageError(ctx, err)
 	}
 
 	row := r.db.QueryRow(ctx, query, args...)
 	var total uint64
 
 	if err = row.Scan(&total); err != nil {
-		return 0, log.ErrorWithContext(ctx, err)
+		return 0, storageError(ctx, err)
 	}
 
 	return total, nil
@@ -335,24 +412,25 @@ func (r *Repository) updateGroupsContactCountByFilters(ctx context.Context, tx p
 	builder := r.genSQL.Select("contact_in_group.group_id").
 		From("slurm.contact_in_group").
 		InnerJoin("slurm.contact ON contact_in_group.contact_id = contact.id").
+		Where(tenantScope(ctx, "contact_in_group.tenant_id")).
 		GroupBy("contact_in_group.group_id")
 
 	builder = builder.Where(squirrel.Eq{"contact_in_group.contact_id": ID})
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
 	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 	var groupIDs []uuid.UUID
 	for rows.Next() {
 		var groupID sql.NullString
 		if err = rows.Scan(&groupID); err != nil {
-			return log.ErrorWithContext(ctx, err)
+			return storageError(ctx, err)
 		}
 		groupIDs = append(groupIDs, converter.StringToUUID(groupID.String))
 	}
@@ -364,7 +442,7 @@ func (r *Repository) updateGroupsContactCountByFilters(ctx context.Context, tx p
 	}
 
 	if err = rows.Err(); err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
 	return nil
@@ -374,21 +452,21 @@ func (r *Repository) updateGroupContactCount(ctx context.Context, tx pgx.Tx, gro
 	subSelect := r.genSQL.Select("count(contact_in_group.id)").
 		From("slurm.contact_in_group").
 		InnerJoin("slurm.contact ON contact_in_group.contact_id = contact.id").
+		Where(tenantScope(ctx, "contact_in_group.tenant_id")).
 		Where(squirrel.Eq{"group_id": groupID, "is_archived": false})
 
-	query, _, err := r.genSQL.
+	query, args, err := r.genSQL.
 		Update("slurm.group").
+		Where(tenantScope(ctx, "tenant_id")).
 		Set("contact_count", subSelect).
 		Where(squirrel.Eq{"id": groupID}).
 		ToSql()
 	if err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 
-	var args = []interface{}{groupID, false}
-
 	if _, err = tx.Exec(ctx, query, args...); err != nil {
-		return log.ErrorWithContext(ctx, err)
+		return storageError(ctx, err)
 	}
 	return nil
 }
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS slurm.custom_field
+(
+    id          uuid         DEFAULT gen_random_uuid() NOT NULL
+    CONSTRAINT pk_custom_field
+    PRIMARY KEY,
+    created_at  timestamp    DEFAULT CURRENT_TIMESTAMP,
+    modified_at timestamp    DEFAULT CURRENT_TIMESTAMP,
+    key         varchar(50)                         NOT NULL,
+    label       varchar(250) DEFAULT ''             NOT NULL,
+    type        smallint                            NOT NULL,
+    required    boolean      DEFAULT FALSE          NOT NULL,
+    options     jsonb        DEFAULT '[]'::jsonb    NOT NULL,
+    is_archived boolean      DEFAULT FALSE          NOT NULL
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_custom_field_key
+    ON slurm.custom_field (key)
+    WHERE is_archived = FALSE;
+
+ALTER TABLE slurm.contact
+    ADD COLUMN IF NOT EXISTS custom_fields jsonb DEFAULT '{}'::jsonb NOT NULL;
+
+CREATE INDEX IF NOT EXISTS ix_contact_custom_fields
+    ON slurm.contact USING gin (custom_fields jsonb_path_ops);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS slurm.ix_contact_custom_fields;
+
+ALTER TABLE slurm.contact
+    DROP COLUMN IF EXISTS custom_fields;
+
+DROP TABLE IF EXISTS slurm.custom_field;
+
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS slurm.tag
+(
+    id          uuid        DEFAULT gen_random_uuid() NOT NULL
+    CONSTRAINT pk_tag
+    PRIMARY KEY,
+    created_at  timestamp   DEFAULT CURRENT_TIMESTAMP,
+    modified_at timestamp   DEFAULT CURRENT_TIMESTAMP,
+    name        varchar(50)                         NOT NULL
+    CONSTRAINT ux_tag_name
+    UNIQUE
+    );
+
+CREATE TABLE IF NOT EXISTS slurm.contact_tag
+(
+    contact_id uuid                                not null
+    constraint fk_contact_tag_contact_id
+    references slurm.contact,
+    tag_id     uuid                                not null
+    constraint fk_contact_tag_tag_id
+    references slurm.tag
+    on delete cascade,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT pk_contact_tag
+    PRIMARY KEY (contact_id, tag_id)
+    );
+
+-- обратный индекс для пересечения контактов по набору тегов
+CREATE INDEX IF NOT EXISTS ix_contact_tag_tag_id
+    ON slurm.contact_tag (tag_id, contact_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS slurm.contact_tag;
+DROP TABLE IF EXISTS slurm.tag;
+
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE slurm.contact
+    ADD COLUMN IF NOT EXISTS birthday date;
+
+-- выборка ближайших дней рождения идёт по месяцу и дню без учёта года
+CREATE INDEX IF NOT EXISTS ix_contact_birthday_month_day
+    ON slurm.contact ((EXTRACT(MONTH FROM birthday) * 100 + EXTRACT(DAY FROM birthday)))
+    WHERE birthday IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS slurm.ix_contact_birthday_month_day;
+
+ALTER TABLE slurm.contact
+    DROP COLUMN IF EXISTS birthday;
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE slurm.contact
+    ADD COLUMN IF NOT EXISTS addresses jsonb DEFAULT '[]'::jsonb NOT NULL;
+
+-- фильтры по городу и стране: addresses @> '[{"city": "..."}]'
+CREATE INDEX IF NOT EXISTS ix_contact_addresses
+    ON slurm.contact USING gin (addresses jsonb_path_ops);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS slurm.ix_contact_addresses;
+
+ALTER TABLE slurm.contact
+    DROP COLUMN IF EXISTS addresses;
+
+-- +goose StatementEnd
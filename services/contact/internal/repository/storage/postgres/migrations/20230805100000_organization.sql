@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS slurm.organization
+(
+    id          uuid         DEFAULT gen_random_uuid() NOT NULL
+    CONSTRAINT pk_organization
+    PRIMARY KEY,
+    created_at  timestamp    DEFAULT CURRENT_TIMESTAMP,
+    modified_at timestamp    DEFAULT CURRENT_TIMESTAMP,
+    name        varchar(250)                        NOT NULL,
+    tax_id      varchar(20)  DEFAULT ''             NOT NULL,
+    website     varchar(250) DEFAULT ''             NOT NULL,
+    is_archived boolean      DEFAULT FALSE          NOT NULL
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_organization_tax_id
+    ON slurm.organization (tax_id)
+    WHERE is_archived = FALSE AND tax_id <> '';
+
+CREATE INDEX IF NOT EXISTS ix_organization_name
+    ON slurm.organization (name)
+    WHERE is_archived = FALSE;
+
+ALTER TABLE slurm.contact
+    ADD COLUMN IF NOT EXISTS organization_id uuid
+        CONSTRAINT fk_contact_organization_id
+            REFERENCES slurm.organization,
+    ADD COLUMN IF NOT EXISTS job_title varchar(250) DEFAULT '' NOT NULL;
+
+CREATE INDEX IF NOT EXISTS ix_contact_organization_id
+    ON slurm.contact (organization_id)
+    WHERE organization_id IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS slurm.ix_contact_organization_id;
+
+ALTER TABLE slurm.contact
+    DROP COLUMN IF EXISTS organization_id,
+    DROP COLUMN IF EXISTS job_title;
+
+DROP TABLE IF EXISTS slurm.organization;
+
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS slurm.contact_note
+(
+    id                  uuid         DEFAULT gen_random_uuid() NOT NULL
+    CONSTRAINT pk_contact_note
+    PRIMARY KEY,
+    created_at          timestamp    DEFAULT CURRENT_TIMESTAMP,
+    modified_at         timestamp    DEFAULT CURRENT_TIMESTAMP,
+    contact_id          uuid                                NOT NULL
+    CONSTRAINT fk_contact_note_contact_id
+    REFERENCES slurm.contact
+    ON DELETE CASCADE,
+    author              varchar(100)                        NOT NULL,
+    type                smallint                            NOT NULL,
+    occurred_at         timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    body                text                                NOT NULL,
+    is_archived         boolean      DEFAULT FALSE          NOT NULL,
+    -- заметка скрыта вместе с архивным контактом и восстанавливается вместе с ним
+    is_contact_archived boolean      DEFAULT FALSE          NOT NULL
+    );
+
+CREATE INDEX IF NOT EXISTS ix_contact_note_contact_id_occurred_at
+    ON slurm.contact_note (contact_id, occurred_at DESC, created_at DESC)
+    WHERE is_archived = FALSE AND is_contact_archived = FALSE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS slurm.contact_note;
+
+-- +goose StatementEnd
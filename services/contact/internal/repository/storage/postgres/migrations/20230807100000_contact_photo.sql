@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- photo описание фотографии контакта, файлы лежат в хранилище файлов
+ALTER TABLE slurm.contact
+    ADD COLUMN IF NOT EXISTS photo jsonb DEFAULT '{}'::jsonb NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE slurm.contact
+    DROP COLUMN IF EXISTS photo;
+
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS slurm.audit_log
+(
+    id         uuid         DEFAULT gen_random_uuid() NOT NULL
+    CONSTRAINT pk_audit_log
+    PRIMARY KEY,
+    created_at timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    actor      varchar(250)                        NOT NULL,
+    request_id varchar(100) DEFAULT ''             NOT NULL,
+    trace_id   varchar(100) DEFAULT ''             NOT NULL,
+    entity     varchar(50)                         NOT NULL,
+    entity_id  uuid                                NOT NULL,
+    action     varchar(50)                         NOT NULL,
+    -- список изменённых полей вида [{"field": "name", "before": "Иван", "after": "Пётр"}]
+    changes    jsonb        DEFAULT '[]'           NOT NULL
+    );
+
+CREATE INDEX IF NOT EXISTS ix_audit_log_entity_entity_id_created_at
+    ON slurm.audit_log (entity, entity_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS ix_audit_log_created_at
+    ON slurm.audit_log (created_at DESC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS slurm.audit_log;
+
+-- +goose StatementEnd
@@ -0,0 +1,67 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- версии контактов: состояние строки slurm.contact после каждого изменения, теги хранятся в ключе tags
+CREATE TABLE IF NOT EXISTS slurm.contact_snapshot
+(
+    contact_id uuid                                NOT NULL
+    CONSTRAINT fk_contact_snapshot_contact_id
+    REFERENCES slurm.contact
+    ON DELETE CASCADE,
+    version    bigint                              NOT NULL,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    data       jsonb                               NOT NULL,
+    CONSTRAINT pk_contact_snapshot
+    PRIMARY KEY (contact_id, version)
+    );
+
+CREATE INDEX IF NOT EXISTS ix_contact_snapshot_contact_id_created_at
+    ON slurm.contact_snapshot (contact_id, created_at DESC);
+
+-- версии групп: состояние строки slurm.group после каждого изменения
+CREATE TABLE IF NOT EXISTS slurm.group_snapshot
+(
+    group_id   uuid                                NOT NULL
+    CONSTRAINT fk_group_snapshot_group_id
+    REFERENCES slurm."group"
+    ON DELETE CASCADE,
+    version    bigint                              NOT NULL,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    data       jsonb                               NOT NULL,
+    CONSTRAINT pk_group_snapshot
+    PRIMARY KEY (group_id, version)
+    );
+
+CREATE INDEX IF NOT EXISTS ix_group_snapshot_group_id_created_at
+    ON slurm.group_snapshot (group_id, created_at DESC);
+
+-- существующие записи получают первую версию на момент последнего изменения
+INSERT INTO slurm.contact_snapshot (contact_id, version, created_at, data)
+SELECT contact.id,
+       1,
+       COALESCE(contact.modified_at, CURRENT_TIMESTAMP),
+       to_jsonb(contact) || jsonb_build_object('tags', COALESCE((
+           SELECT jsonb_agg(tag.name ORDER BY tag.name)
+           FROM slurm.contact_tag
+           INNER JOIN slurm.tag ON tag.id = contact_tag.tag_id
+           WHERE contact_tag.contact_id = contact.id
+       ), '[]'::jsonb))
+FROM slurm.contact;
+
+INSERT INTO slurm.group_snapshot (group_id, version, created_at, data)
+SELECT "group".id,
+       1,
+       COALESCE("group".modified_at, CURRENT_TIMESTAMP),
+       to_jsonb("group")
+FROM slurm."group";
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS slurm.group_snapshot;
+
+DROP TABLE IF EXISTS slurm.contact_snapshot;
+
+-- +goose StatementEnd
@@ -0,0 +1,93 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Требуется PostgreSQL 13 или новее: xid8, pg_current_xact_id() и pg_snapshot_xmin() появились в 13.
+
+-- события, записанные в той же транзакции, что и изменение контакта или группы;
+-- фоновый relay доставляет их брокеру по возрастанию sequence в пределах aggregate_id.
+-- Номера выдаёт последовательность и не блокируют параллельные изменения, поэтому фиксируются
+-- не по порядку. Строки помнят свою транзакцию, а лента изменений и синхронизация читают их
+-- в порядке (транзакция, номер) и только до pg_snapshot_xmin: транзакции младше него уже завершены,
+-- и ни одна строка не появится позади курсора клиента
+CREATE TABLE IF NOT EXISTS slurm.outbox
+(
+    id              uuid                                    NOT NULL
+    CONSTRAINT pk_outbox
+    PRIMARY KEY,
+    sequence        bigserial                               NOT NULL,
+    xid             xid8      DEFAULT pg_current_xact_id()  NOT NULL,
+    created_at      timestamp DEFAULT CURRENT_TIMESTAMP     NOT NULL,
+    aggregate_id    uuid                                    NOT NULL,
+    name            varchar(100)                            NOT NULL,
+    -- конверт события вида {"id": "...", "name": "contact.created", "aggregateId": "...", "occurredAt": "...", "data": {...}}
+    payload         jsonb                                   NOT NULL,
+    attempts        integer   DEFAULT 0                     NOT NULL,
+    next_attempt_at timestamp DEFAULT CURRENT_TIMESTAMP     NOT NULL,
+    last_error      text      DEFAULT ''                    NOT NULL,
+    published_at    timestamp,
+    -- сообщение, которое не удалось отправить за MaxAttempts попыток, откладывается в сторону:
+    -- relay его больше не берёт, и оно не держит следующие события своего агрегата
+    parked_at       timestamp
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS ux_outbox_sequence
+    ON slurm.outbox (sequence);
+
+CREATE INDEX IF NOT EXISTS ix_outbox_xid_sequence
+    ON slurm.outbox (xid, sequence);
+
+CREATE INDEX IF NOT EXISTS ix_outbox_pending_aggregate_id_sequence
+    ON slurm.outbox (aggregate_id, sequence)
+    WHERE published_at IS NULL AND parked_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS ix_outbox_pending_next_attempt_at
+    ON slurm.outbox (next_attempt_at)
+    WHERE published_at IS NULL AND parked_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS ix_outbox_parked_at
+    ON slurm.outbox (parked_at)
+    WHERE parked_at IS NOT NULL;
+
+-- номер и транзакция последнего изменения строки: синхронизация отдаёт строки после токена клиента
+-- в том же порядке (транзакция, номер), что и лента outbox
+ALTER TABLE slurm.contact
+    ADD COLUMN IF NOT EXISTS change_sequence bigint DEFAULT 0   NOT NULL,
+    ADD COLUMN IF NOT EXISTS change_xid      xid8   DEFAULT '0' NOT NULL;
+
+ALTER TABLE slurm."group"
+    ADD COLUMN IF NOT EXISTS change_sequence bigint DEFAULT 0   NOT NULL,
+    ADD COLUMN IF NOT EXISTS change_xid      xid8   DEFAULT '0' NOT NULL;
+
+-- существующие строки получают номера из той же последовательности и транзакцию 0:
+-- они уже зафиксированы и читаются первыми
+UPDATE slurm.contact
+SET change_sequence = nextval('slurm.outbox_sequence_seq');
+
+UPDATE slurm."group"
+SET change_sequence = nextval('slurm.outbox_sequence_seq');
+
+CREATE INDEX IF NOT EXISTS ix_contact_change_xid_sequence
+    ON slurm.contact (change_xid, change_sequence);
+
+CREATE INDEX IF NOT EXISTS ix_group_change_xid_sequence
+    ON slurm."group" (change_xid, change_sequence);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS slurm.ix_group_change_xid_sequence;
+DROP INDEX IF EXISTS slurm.ix_contact_change_xid_sequence;
+
+ALTER TABLE slurm."group"
+    DROP COLUMN IF EXISTS change_xid,
+    DROP COLUMN IF EXISTS change_sequence;
+
+ALTER TABLE slurm.contact
+    DROP COLUMN IF EXISTS change_xid,
+    DROP COLUMN IF EXISTS change_sequence;
+
+DROP TABLE IF EXISTS slurm.outbox;
+
+-- +goose StatementEnd
@@ -0,0 +1,59 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS slurm.webhook
+(
+    id          uuid                                NOT NULL
+    CONSTRAINT pk_webhook
+    PRIMARY KEY,
+    created_at  timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    modified_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    url         varchar(2000)                       NOT NULL,
+    -- фильтр по названиям событий, пустой массив -- все события
+    events      text[]    DEFAULT '{}'              NOT NULL,
+    secret      varchar(250)                        NOT NULL,
+    is_active   boolean   DEFAULT TRUE              NOT NULL,
+    is_archived boolean   DEFAULT FALSE             NOT NULL
+    );
+
+-- доставки создаются вместе с записью slurm.outbox, по одной на каждую подходящую подписку
+CREATE TABLE IF NOT EXISTS slurm.webhook_delivery
+(
+    id               uuid      DEFAULT gen_random_uuid()  NOT NULL
+    CONSTRAINT pk_webhook_delivery
+    PRIMARY KEY,
+    webhook_id       uuid                                 NOT NULL
+    CONSTRAINT fk_webhook_delivery_webhook_id
+    REFERENCES slurm.webhook
+    ON DELETE CASCADE,
+    event_id         uuid                                 NOT NULL,
+    event_name       varchar(100)                         NOT NULL,
+    payload          jsonb                                NOT NULL,
+    created_at       timestamp DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    status           varchar(20) DEFAULT 'pending'        NOT NULL,
+    attempts         integer   DEFAULT 0                  NOT NULL,
+    next_attempt_at  timestamp DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    last_status_code integer   DEFAULT 0                  NOT NULL,
+    last_error       text      DEFAULT ''                 NOT NULL,
+    delivered_at     timestamp,
+    CONSTRAINT ux_webhook_delivery_webhook_id_event_id
+    UNIQUE (webhook_id, event_id)
+    );
+
+CREATE INDEX IF NOT EXISTS ix_webhook_delivery_pending_next_attempt_at
+    ON slurm.webhook_delivery (next_attempt_at)
+    WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS ix_webhook_delivery_webhook_id_created_at
+    ON slurm.webhook_delivery (webhook_id, created_at DESC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS slurm.webhook_delivery;
+
+DROP TABLE IF EXISTS slurm.webhook;
+
+-- +goose StatementEnd
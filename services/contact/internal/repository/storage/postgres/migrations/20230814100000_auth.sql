@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ключи доступа: хранится только SHA-256 ключа, открыто -- первые символы для узнавания в списке
+CREATE TABLE IF NOT EXISTS slurm.api_key
+(
+    id           uuid                                NOT NULL
+    CONSTRAINT pk_api_key
+    PRIMARY KEY,
+    created_at   timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    name         varchar(100)                        NOT NULL,
+    prefix       varchar(20)                         NOT NULL,
+    hash         bytea                               NOT NULL
+    CONSTRAINT ux_api_key_hash
+    UNIQUE,
+    subject      varchar(250)                        NOT NULL,
+    roles        text[]    DEFAULT '{}'              NOT NULL,
+    expires_at   timestamp,
+    last_used_at timestamp,
+    revoked_at   timestamp
+    );
+
+CREATE INDEX IF NOT EXISTS ix_api_key_subject
+    ON slurm.api_key (subject, created_at);
+
+-- сессии браузера: хранится только SHA-256 токена из cookie
+CREATE TABLE IF NOT EXISTS slurm.session
+(
+    id         uuid                                NOT NULL
+    CONSTRAINT pk_session
+    PRIMARY KEY,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at timestamp                           NOT NULL,
+    hash       bytea                               NOT NULL
+    CONSTRAINT ux_session_hash
+    UNIQUE,
+    subject    varchar(250)                        NOT NULL,
+    name       varchar(250) DEFAULT ''             NOT NULL,
+    roles      text[]    DEFAULT '{}'              NOT NULL
+    );
+
+CREATE INDEX IF NOT EXISTS ix_session_expires_at
+    ON slurm.session (expires_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS slurm.session;
+DROP TABLE IF EXISTS slurm.api_key;
+
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- список доступа группы: grantee -- субъект или роль с префиксом "role:"
+CREATE TABLE IF NOT EXISTS slurm.group_acl
+(
+    group_id   uuid                                NOT NULL
+    CONSTRAINT fk_group_acl_group_id
+    REFERENCES slurm."group"
+    ON DELETE CASCADE,
+    grantee    varchar(250)                        NOT NULL,
+    level      varchar(20)                         NOT NULL,
+    created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT pk_group_acl
+    PRIMARY KEY (group_id, grantee)
+    );
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS slurm.group_acl;
+
+-- +goose StatementEnd
@@ -0,0 +1,113 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- арендаторы сервиса; нулевая квота -- без ограничения
+CREATE TABLE IF NOT EXISTS slurm.tenant
+(
+    id           varchar(63)                         NOT NULL
+    CONSTRAINT pk_tenant
+    PRIMARY KEY,
+    created_at   timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    modified_at  timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    name         varchar(250)                        NOT NULL,
+    max_contacts bigint    DEFAULT 0                 NOT NULL,
+    max_groups   bigint    DEFAULT 0                 NOT NULL,
+    disabled_at  timestamp
+    );
+
+-- существующие данные переходят к арендатору default
+INSERT INTO slurm.tenant (id, name)
+VALUES ('default', 'default')
+ON CONFLICT DO NOTHING;
+
+-- Арендатор строки берётся из настройки app.tenant_id, которую репозиторий выставляет соединению
+-- при выдаче из пула. Без настройки вставка не проходит: у tenant_id нет значения.
+-- Политика tenant_isolation пропускает только строки своего арендатора, фоновые обработчики
+-- работают с настройкой '*' и видят все строки, но создать строку арендатора '*' нельзя.
+-- FORCE распространяет политику на владельца таблиц; суперпользователь RLS не подчиняется,
+-- поэтому сервис должен подключаться обычной ролью.
+DO
+$$
+    DECLARE
+        name text;
+    BEGIN
+        FOREACH name IN ARRAY ARRAY [
+            'contact', 'group', 'contact_in_group', 'custom_field', 'tag', 'contact_tag',
+            'organization', 'contact_note', 'audit_log', 'contact_snapshot', 'group_snapshot',
+            'outbox', 'webhook', 'webhook_delivery', 'api_key', 'session', 'group_acl'
+            ]
+            LOOP
+                EXECUTE format('ALTER TABLE slurm.%I ADD COLUMN IF NOT EXISTS tenant_id varchar(63) DEFAULT %L NOT NULL',
+                               name, 'default');
+                EXECUTE format('ALTER TABLE slurm.%I ALTER COLUMN tenant_id SET DEFAULT NULLIF(current_setting(%L, TRUE), %L)',
+                               name, 'app.tenant_id', '');
+                EXECUTE format('CREATE INDEX IF NOT EXISTS %I ON slurm.%I (tenant_id)', 'ix_' || name || '_tenant_id', name);
+                EXECUTE format('ALTER TABLE slurm.%I ENABLE ROW LEVEL SECURITY', name);
+                EXECUTE format('ALTER TABLE slurm.%I FORCE ROW LEVEL SECURITY', name);
+                EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON slurm.%I', name);
+                EXECUTE format('CREATE POLICY tenant_isolation ON slurm.%I
+                    USING (tenant_id = current_setting(%L, TRUE) OR current_setting(%L, TRUE) = %L)
+                    WITH CHECK (tenant_id <> %L AND (tenant_id = current_setting(%L, TRUE) OR current_setting(%L, TRUE) = %L))',
+                               name, 'app.tenant_id', 'app.tenant_id', '*', '*', 'app.tenant_id', 'app.tenant_id', '*');
+            END LOOP;
+    END
+$$;
+
+-- уникальность ключей, названий и ИНН -- в пределах арендатора
+DROP INDEX IF EXISTS slurm.ux_custom_field_key;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_custom_field_tenant_id_key
+    ON slurm.custom_field (tenant_id, key)
+    WHERE is_archived = FALSE;
+
+ALTER TABLE slurm.tag
+    DROP CONSTRAINT IF EXISTS ux_tag_name;
+ALTER TABLE slurm.tag
+    ADD CONSTRAINT ux_tag_tenant_id_name UNIQUE (tenant_id, name);
+
+DROP INDEX IF EXISTS slurm.ux_organization_tax_id;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_organization_tenant_id_tax_id
+    ON slurm.organization (tenant_id, tax_id)
+    WHERE is_archived = FALSE AND tax_id <> '';
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS slurm.ux_organization_tenant_id_tax_id;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_organization_tax_id
+    ON slurm.organization (tax_id)
+    WHERE is_archived = FALSE AND tax_id <> '';
+
+ALTER TABLE slurm.tag
+    DROP CONSTRAINT IF EXISTS ux_tag_tenant_id_name;
+ALTER TABLE slurm.tag
+    ADD CONSTRAINT ux_tag_name UNIQUE (name);
+
+DROP INDEX IF EXISTS slurm.ux_custom_field_tenant_id_key;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_custom_field_key
+    ON slurm.custom_field (key)
+    WHERE is_archived = FALSE;
+
+DO
+$$
+    DECLARE
+        name text;
+    BEGIN
+        FOREACH name IN ARRAY ARRAY [
+            'contact', 'group', 'contact_in_group', 'custom_field', 'tag', 'contact_tag',
+            'organization', 'contact_note', 'audit_log', 'contact_snapshot', 'group_snapshot',
+            'outbox', 'webhook', 'webhook_delivery', 'api_key', 'session', 'group_acl'
+            ]
+            LOOP
+                EXECUTE format('DROP POLICY IF EXISTS tenant_isolation ON slurm.%I', name);
+                EXECUTE format('ALTER TABLE slurm.%I NO FORCE ROW LEVEL SECURITY', name);
+                EXECUTE format('ALTER TABLE slurm.%I DISABLE ROW LEVEL SECURITY', name);
+                EXECUTE format('ALTER TABLE slurm.%I DROP COLUMN IF EXISTS tenant_id', name);
+            END LOOP;
+    END
+$$;
+
+DROP TABLE IF EXISTS slurm.tenant;
+
+-- +goose StatementEnd
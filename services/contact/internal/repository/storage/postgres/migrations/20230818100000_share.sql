@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- владелец -- субъект или команда (роль с префиксом "role:"), пустая строка -- запись создана
+-- до появления владельцев и видна всем в пределах арендатора
+ALTER TABLE slurm.contact
+    ADD COLUMN IF NOT EXISTS owner varchar(250) DEFAULT '' NOT NULL;
+CREATE INDEX IF NOT EXISTS ix_contact_owner ON slurm.contact (owner);
+
+ALTER TABLE slurm."group"
+    ADD COLUMN IF NOT EXISTS owner varchar(250) DEFAULT '' NOT NULL;
+CREATE INDEX IF NOT EXISTS ix_group_owner ON slurm."group" (owner);
+
+-- доступ к контакту или группе; доступ к группе открывает на чтение всех её участников
+CREATE TABLE IF NOT EXISTS slurm.share
+(
+    resource    varchar(20)                         NOT NULL
+    CONSTRAINT ck_share_resource
+    CHECK (resource IN ('contact', 'group')),
+    resource_id uuid                                NOT NULL,
+    grantee     varchar(250)                        NOT NULL,
+    level       varchar(20)                         NOT NULL
+    CONSTRAINT ck_share_level
+    CHECK (level IN ('read', 'write')),
+    created_at  timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    tenant_id   varchar(63) DEFAULT NULLIF(current_setting('app.tenant_id', TRUE), '') NOT NULL,
+    CONSTRAINT pk_share
+    PRIMARY KEY (resource, resource_id, grantee)
+    );
+
+CREATE INDEX IF NOT EXISTS ix_share_grantee ON slurm.share (grantee, resource);
+CREATE INDEX IF NOT EXISTS ix_share_tenant_id ON slurm.share (tenant_id);
+
+ALTER TABLE slurm.share ENABLE ROW LEVEL SECURITY;
+ALTER TABLE slurm.share FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON slurm.share;
+CREATE POLICY tenant_isolation ON slurm.share
+    USING (tenant_id = current_setting('app.tenant_id', TRUE) OR current_setting('app.tenant_id', TRUE) = '*')
+    WITH CHECK (tenant_id <> '*' AND
+                (tenant_id = current_setting('app.tenant_id', TRUE) OR current_setting('app.tenant_id', TRUE) = '*'));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS slurm.share;
+
+DROP INDEX IF EXISTS slurm.ix_group_owner;
+ALTER TABLE slurm."group"
+    DROP COLUMN IF EXISTS owner;
+
+DROP INDEX IF EXISTS slurm.ix_contact_owner;
+ALTER TABLE slurm.contact
+    DROP COLUMN IF EXISTS owner;
+
+-- +goose StatementEnd
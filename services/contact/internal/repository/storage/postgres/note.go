@@ -0,0 +1,313 @@
+package postgres
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/note"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func (r *Repository) CreateNote(c context.Context, n *note.Note) (response *note.Note, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	if _, err = r.oneContactTx(ctx, tx, n.ContactID()); err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Insert("slurm.contact_note").
+		Columns(
+			"id",
+			"created_at",
+			"modified_at",
+			"contact_id",
+			"author",
+			"type",
+			"occurred_at",
+			"body",
+		).
+		Values(
+			n.ID(),
+			n.CreatedAt(),
+			n.ModifiedAt(),
+			n.ContactID(),
+			n.Author().Value(),
+			n.Type().Number(),
+			n.OccurredAt(),
+			n.Body().Value(),
+		).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return n, nil
+}
+
+func (r *Repository) UpdateNote(c context.Context, contactID, ID uuid.UUID, updateFn func(n *note.Note) (*note.Note, error)) (response *note.Note, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	if _, err = r.oneContactTx(ctx, tx, contactID); err != nil {
+		return nil, err
+	}
+
+	upNote, err := r.oneNoteTx(ctx, tx, contactID, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	noteForUpdate, err := updateFn(upNote)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Update("slurm.contact_note").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("type", noteForUpdate.Type().Number()).
+		Set("occurred_at", noteForUpdate.OccurredAt()).
+		Set("body", noteForUpdate.Body().Value()).
+		Set("modified_at", noteForUpdate.ModifiedAt()).
+		Where(squirrel.Eq{
+			"id":          ID,
+			"contact_id":  contactID,
+			"is_archived": false,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return noteForUpdate, nil
+}
+
+func (r *Repository) DeleteNote(c context.Context, contactID, ID uuid.UUID) (err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	if _, err = r.oneContactTx(ctx, tx, contactID); err != nil {
+		return err
+	}
+
+	if _, err = r.oneNoteTx(ctx, tx, contactID, ID); err != nil {
+		return err
+	}
+
+	query, args, err := r.genSQL.Update("slurm.contact_note").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("is_archived", true).
+		Where(squirrel.Eq{
+			"id":          ID,
+			"contact_id":  contactID,
+			"is_archived": false,
+		}).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+// ListNote лента контакта, новые записи первыми
+func (r *Repository) ListNote(c context.Context, contactID uuid.UUID, parameter queryParameter.QueryParameter) (response []*note.Note, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	if _, err = r.oneContactTx(ctx, tx, contactID); err != nil {
+		return nil, err
+	}
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.selectNote(ctx, contactID).
+		OrderBy("occurred_at DESC", "created_at DESC").
+		Limit(parameter.Pagination.Limit)
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	return r.queryNotes(ctx, tx, builder)
+}
+
+func (r *Repository) ReadNoteByID(c context.Context, contactID, ID uuid.UUID) (response *note.Note, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	if _, err = r.oneContactTx(ctx, tx, contactID); err != nil {
+		return nil, err
+	}
+
+	return r.oneNoteTx(ctx, tx, contactID, ID)
+}
+
+func (r *Repository) CountNote(c context.Context, contactID uuid.UUID) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(id)").
+		From("slurm.contact_note").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{
+			"contact_id":          contactID,
+			"is_archived":         false,
+			"is_contact_archived": false,
+		}).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	return total, nil
+}
+
+func (r *Repository) oneNoteTx(ctx context.Context, tx pgx.Tx, contactID, ID uuid.UUID) (*note.Note, error) {
+	notes, err := r.queryNotes(ctx, tx, r.selectNote(ctx, contactID).Where(squirrel.Eq{"id": ID}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(notes) == 0 {
+		return nil, useCase.ErrNoteNotFound
+	}
+
+	return notes[0], nil
+}
+
+// archiveContactNotesTx скрывает заметки вместе с контактом, удалённые ранее заметки не затрагиваются
+func (r *Repository) archiveContactNotesTx(ctx context.Context, tx pgx.Tx, contactID uuid.UUID, archived bool) error {
+	query, args, err := r.genSQL.Update("slurm.contact_note").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("is_contact_archived", archived).
+		Where(squirrel.Eq{"contact_id": contactID}).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) selectNote(ctx context.Context, contactID uuid.UUID) squirrel.SelectBuilder {
+	return r.genSQL.Select(
+		"id",
+		"created_at",
+		"modified_at",
+		"contact_id",
+		"author",
+		"type",
+		"occurred_at",
+		"body",
+	).
+		From("slurm.contact_note").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{
+			"contact_id":          contactID,
+			"is_archived":         false,
+			"is_contact_archived": false,
+		})
+}
+
+func (r *Repository) queryNotes(ctx context.Context, db pgxscan.Querier, builder squirrel.SelectBuilder) ([]*note.Note, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoNotes []*dao.Note
+	if err = pgxscan.Select(ctx, db, &daoNotes, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*note.Note, len(daoNotes))
+	for i, n := range daoNotes {
+		value, err := n.ToDomainNote()
+		if err != nil {
+			return nil, storageError(ctx, err)
+		}
+		result[i] = value
+	}
+
+	return result, nil
+}
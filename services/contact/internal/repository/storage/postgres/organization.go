@@ -0,0 +1,303 @@
+package postgres
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/columnCode"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/organization"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+var mappingSortOrganization = map[columnCode.ColumnCode]string{
+	"id":           "id",
+	"name":         "name",
+	"taxId":        "tax_id",
+	"createdAt":    "created_at",
+	"contactCount": "contact_count",
+}
+
+func (r *Repository) CreateOrganization(c context.Context, org *organization.Organization) (*organization.Organization, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Insert("slurm.organization").
+		Columns(
+			"id",
+			"created_at",
+			"modified_at",
+			"name",
+			"tax_id",
+			"website",
+		).
+		Values(
+			org.ID(),
+			org.CreatedAt(),
+			org.ModifiedAt(),
+			org.Name().Value(),
+			org.TaxID().String(),
+			org.Website().String(),
+		).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = r.db.Exec(ctx, query, args...); err != nil {
+		return nil, organizationError(ctx, err)
+	}
+
+	return org, nil
+}
+
+func (r *Repository) UpdateOrganization(c context.Context, ID uuid.UUID, updateFn func(org *organization.Organization) (*organization.Organization, error)) (response *organization.Organization, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	upOrganization, err := r.oneOrganizationTx(ctx, tx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgForUpdate, err := updateFn(upOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Update("slurm.organization").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("name", orgForUpdate.Name().Value()).
+		Set("tax_id", orgForUpdate.TaxID().String()).
+		Set("website", orgForUpdate.Website().String()).
+		Set("modified_at", orgForUpdate.ModifiedAt()).
+		Where(squirrel.Eq{
+			"id":          ID,
+			"is_archived": false,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, organizationError(ctx, err)
+	}
+
+	// название организации входит в данные контакта: подписчики должны узнать, что изменились и её сотрудники
+	if upOrganization.Name().Value() != orgForUpdate.Name().Value() {
+		if err = r.touchContactsTx(ctx, tx, squirrel.Eq{"organization_id": ID}); err != nil {
+			return nil, err
+		}
+	}
+
+	return orgForUpdate, nil
+}
+
+// DeleteOrganization архивирует организацию и отвязывает от неё контакты
+func (r *Repository) DeleteOrganization(c context.Context, ID uuid.UUID) (err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	if _, err = r.oneOrganizationTx(ctx, tx, ID); err != nil {
+		return err
+	}
+
+	var timeNow = time.Now().UTC()
+
+	query, args, err := r.genSQL.Update("slurm.organization").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("is_archived", true).
+		Set("modified_at", timeNow).
+		Where(squirrel.Eq{
+			"id":          ID,
+			"is_archived": false,
+		}).ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	query, args, err = r.genSQL.Update("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("organization_id", nil).
+		Set("job_title", "").
+		Set("modified_at", timeNow).
+		Where(squirrel.Eq{"organization_id": ID}).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	var contactIDs []uuid.UUID
+	if err = pgxscan.Select(ctx, tx, &contactIDs, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	if len(contactIDs) == 0 {
+		return nil
+	}
+
+	return r.touchContactsTx(ctx, tx, squirrel.Eq{"id": contactIDs})
+}
+
+func (r *Repository) ListOrganization(c context.Context, parameter queryParameter.QueryParameter) ([]*organization.Organization, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.selectOrganization(ctx)
+
+	if len(parameter.Sorts) > 0 {
+		builder = builder.OrderBy(parameter.Sorts.Parsing(mappingSortOrganization)...)
+	} else {
+		builder = builder.OrderBy("name")
+	}
+
+	builder = builder.Limit(parameter.Pagination.Limit)
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	return r.queryOrganizations(ctx, r.db, builder)
+}
+
+func (r *Repository) ReadOrganizationByID(c context.Context, ID uuid.UUID) (response *organization.Organization, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	return r.oneOrganizationTx(ctx, tx, ID)
+}
+
+func (r *Repository) CountOrganization(c context.Context) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(id)").
+		From("slurm.organization").
+		Where(squirrel.Eq{"is_archived": false}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	return total, nil
+}
+
+func (r *Repository) oneOrganizationTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID) (*organization.Organization, error) {
+	organizations, err := r.queryOrganizations(ctx, tx, r.selectOrganization(ctx).Where(squirrel.Eq{"id": ID}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(organizations) == 0 {
+		return nil, useCase.ErrOrganizationNotFound
+	}
+
+	return organizations[0], nil
+}
+
+func (r *Repository) selectOrganization(ctx context.Context) squirrel.SelectBuilder {
+	return r.genSQL.Select(
+		"id",
+		"created_at",
+		"modified_at",
+		"name",
+		"tax_id",
+		"website",
+		`(
+			SELECT COUNT(*)
+			FROM slurm.contact
+			WHERE contact.organization_id = organization.id AND contact.is_archived = FALSE
+		) AS contact_count`,
+	).
+		From("slurm.organization").
+		Where(squirrel.Eq{"is_archived": false}).
+		Where(tenantScope(ctx, "tenant_id"))
+}
+
+func (r *Repository) queryOrganizations(ctx context.Context, db pgxscan.Querier, builder squirrel.SelectBuilder) ([]*organization.Organization, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoOrganizations []*dao.Organization
+	if err = pgxscan.Select(ctx, db, &daoOrganizations, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*organization.Organization, len(daoOrganizations))
+	for i, o := range daoOrganizations {
+		org, err := o.ToDomainOrganization()
+		if err != nil {
+			return nil, storageError(ctx, err)
+		}
+		result[i] = org
+	}
+
+	return result, nil
+}
+
+func organizationError(ctx context.Context, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == codeUniqueViolation {
+		return useCase.ErrOrganizationExists
+	}
+	return storageError(ctx, err)
+}
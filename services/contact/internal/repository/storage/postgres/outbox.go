@@ -0,0 +1,403 @@
+package postgres
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/audit"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/outbox"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+)
+
+// outboxTx записывает события в slurm.outbox в той же транзакции, что и изменение агрегата:
+// событие уходит брокеру и подпискам на вебхуки тогда и только тогда, когда изменение зафиксировано
+func (r *Repository) outboxTx(ctx context.Context, tx pgx.Tx, events ...event.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	sequences, err := r.nextOutboxSequenceTx(ctx, tx, len(events))
+	if err != nil {
+		return err
+	}
+
+	var builder = r.genSQL.Insert("slurm.outbox").
+		Columns(
+			"id",
+			"sequence",
+			"created_at",
+			"aggregate_id",
+			"name",
+			"payload",
+			"next_attempt_at",
+		)
+
+	var eventIDs = make([]uuid.UUID, len(events))
+	for i, e := range events {
+		eventIDs[i] = e.ID()
+		builder = builder.Values(
+			e.ID(),
+			sequences[i],
+			e.OccurredAt(),
+			e.AggregateID(),
+			e.Name().String(),
+			dao.Envelope{
+				ID:          e.ID(),
+				Name:        e.Name().String(),
+				AggregateID: e.AggregateID(),
+				OccurredAt:  e.OccurredAt(),
+				Data:        eventData(e),
+			},
+			e.OccurredAt(),
+		)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	if err = r.changeSequenceTx(ctx, tx, sequences, events...); err != nil {
+		return err
+	}
+
+	return r.webhookDeliveryTx(ctx, tx, eventIDs...)
+}
+
+// nextOutboxSequenceTx выделяет count номеров из последовательности по возрастанию.
+// Номера не блокируют параллельные изменения и могут фиксироваться не по порядку,
+// поэтому читатели по номеру ограничиваются условием visibleXid.
+func (r *Repository) nextOutboxSequenceTx(ctx context.Context, tx pgx.Tx, count int) ([]int64, error) {
+	query, args, err := r.genSQL.Select("nextval('slurm.outbox_sequence_seq')").
+		From("generate_series(1, " + strconv.Itoa(count) + ")").
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var sequences []int64
+	if err = pgxscan.Select(ctx, tx, &sequences, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+	return sequences, nil
+}
+
+// visibleXid строки транзакций не младше pg_snapshot_xmin ещё не читаются: такая транзакция
+// может зафиксировать меньший номер позже, и клиент, продолжающий с курсора, его бы пропустил.
+// Все транзакции младше xmin завершены, поэтому в порядке (транзакция, номер) новые строки
+// появляются только после уже прочитанных. Долгая транзакция задерживает ленту, но не теряет событий
+func visibleXid(column string) squirrel.Sqlizer {
+	return squirrel.Expr(column + " < pg_snapshot_xmin(pg_current_snapshot())")
+}
+
+// cursorXid транзакция события с номером sequence, с которого клиент продолжает чтение. Номер 0 и номера строк,
+// пронумерованных при миграции, -- транзакция 0, с которой начинаются все строки. Для номера, которого
+// нет ни у события, ни у такой строки, found = false: продолжить с него нельзя, ни одной записи
+// при этом не пропустив
+func (r *Repository) cursorXid(ctx context.Context, db pgxscan.Querier, sequence int64) (xid int64, found bool, err error) {
+	if sequence == 0 {
+		return 0, true, nil
+	}
+
+	query, args, err := r.genSQL.Select().
+		Column(squirrel.Expr(`COALESCE(
+			(SELECT outbox.xid FROM slurm.outbox WHERE outbox.sequence = ? AND ?),
+			(SELECT '0'::xid8 WHERE
+				EXISTS (SELECT 1 FROM slurm.contact WHERE contact.change_sequence = ? AND contact.change_xid = '0' AND ?)
+				OR EXISTS (SELECT 1 FROM slurm."group" WHERE "group".change_sequence = ? AND "group".change_xid = '0' AND ?))
+		)::text::bigint`,
+			sequence, tenantScope(ctx, "outbox.tenant_id"),
+			sequence, tenantScope(ctx, "contact.tenant_id"),
+			sequence, tenantScope(ctx, `"group".tenant_id`),
+		)).
+		ToSql()
+	if err != nil {
+		return 0, false, storageError(ctx, err)
+	}
+
+	var values []*int64
+	if err = pgxscan.Select(ctx, db, &values, query, args...); err != nil {
+		return 0, false, storageError(ctx, err)
+	}
+
+	if len(values) == 0 || values[0] == nil {
+		return 0, false, nil
+	}
+
+	return *values[0], true, nil
+}
+
+// ProcessOutbox забирает до limit сообщений, готовых к отправке, и передаёт их processFn.
+// Из каждого агрегата берётся только самое раннее неотправленное и неотложенное сообщение, поэтому
+// следующее событие агрегата не уйдёт раньше предыдущего, даже если то ждёт повторной попытки;
+// отложенное после MaxAttempts попыток сообщение агрегат больше не держит.
+// Брокер вызывается вне транзакции: сообщения забираются короткой транзакцией на options.Lease,
+// а их состояние (отправлено, время следующей попытки или отложено) сохраняется отдельной.
+func (r *Repository) ProcessOutbox(c context.Context, limit uint64, processFn func(messages []*outbox.Message)) (uint64, error) {
+	messages, err := r.claimOutbox(c, limit)
+	if err != nil || len(messages) == 0 {
+		return 0, err
+	}
+
+	processFn(messages)
+
+	if err = r.saveOutbox(c, messages); err != nil {
+		return 0, err
+	}
+
+	return uint64(len(messages)), nil
+}
+
+// claimOutbox блокирует готовые сообщения, пропуская заблокированные другим relay, и переносит
+// их следующую попытку на options.Lease вперёд. Возвращаются сообщения в прежнем состоянии
+func (r *Repository) claimOutbox(c context.Context, limit uint64) (messages []*outbox.Message, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	var now = time.Now().UTC()
+
+	query, args, err := r.genSQL.Select(dao.ColumnOutbox...).
+		From("slurm.outbox AS outbox").
+		Where(tenantScope(ctx, "outbox.tenant_id")).
+		Where(squirrel.Eq{"outbox.published_at": nil, "outbox.parked_at": nil}).
+		Where(squirrel.LtOrEq{"outbox.next_attempt_at": now}).
+		Where(`NOT EXISTS (
+			SELECT 1
+			FROM slurm.outbox AS previous
+			WHERE previous.aggregate_id = outbox.aggregate_id
+			  AND previous.published_at IS NULL
+			  AND previous.parked_at IS NULL
+			  AND previous.sequence < outbox.sequence
+		)`).
+		OrderBy("outbox.sequence").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoMessages []*dao.Outbox
+	if err = pgxscan.Select(ctx, tx, &daoMessages, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if len(daoMessages) == 0 {
+		return nil, nil
+	}
+
+	var messageIDs = make([]uuid.UUID, len(daoMessages))
+	messages = make([]*outbox.Message, len(daoMessages))
+	for i, value := range daoMessages {
+		messageIDs[i] = value.ID
+		messages[i] = r.toDomainOutbox(value)
+	}
+
+	query, args, err = r.genSQL.Update("slurm.outbox").
+		Set("next_attempt_at", now.Add(r.options.Lease)).
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{"id": messageIDs}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return messages, nil
+}
+
+// saveOutbox сохраняет состояние сообщений после отправки
+func (r *Repository) saveOutbox(c context.Context, messages []*outbox.Message) (err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	for _, message := range messages {
+		if err = r.updateOutboxTx(ctx, tx, message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListOutboxAfter события после события after в порядке (транзакция, номер), только видимые по visibleXid.
+// Если события after нет, возвращается outbox.ErrEventExpired
+func (r *Repository) ListOutboxAfter(c context.Context, after int64, limit uint64) ([]*outbox.Message, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	xid, found, err := r.cursorXid(ctx, r.db, after)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, outbox.ErrEventExpired
+	}
+
+	query, args, err := r.genSQL.Select(dao.ColumnOutbox...).
+		From("slurm.outbox AS outbox").
+		Where(tenantScope(ctx, "outbox.tenant_id")).
+		Where(outboxVisibleScope(ctx)).
+		Where(visibleXid("outbox.xid")).
+		Where("(outbox.xid, outbox.sequence) > (?::bigint::text::xid8, ?)", xid, after).
+		OrderBy("outbox.xid", "outbox.sequence").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoMessages []*dao.Outbox
+	if err = pgxscan.Select(ctx, r.db, &daoMessages, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var messages = make([]*outbox.Message, len(daoMessages))
+	for i, value := range daoMessages {
+		messages[i] = r.toDomainOutbox(value)
+	}
+
+	return messages, nil
+}
+
+// LastOutboxSequence номер последнего видимого события в порядке ListOutboxAfter
+func (r *Repository) LastOutboxSequence(c context.Context) (int64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("sequence").
+		From("slurm.outbox").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(visibleXid("xid")).
+		OrderBy("xid DESC", "sequence DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var sequences []int64
+	if err = pgxscan.Select(ctx, r.db, &sequences, query, args...); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	if len(sequences) == 0 {
+		return 0, nil
+	}
+
+	return sequences[0], nil
+}
+
+func (r *Repository) updateOutboxTx(ctx context.Context, tx pgx.Tx, message *outbox.Message) error {
+	var builder = r.genSQL.Update("slurm.outbox").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("attempts", message.Attempts()).
+		Set("next_attempt_at", message.NextAttemptAt()).
+		Set("last_error", message.LastError()).
+		Where(squirrel.Eq{"id": message.ID()})
+
+	if message.Published() {
+		builder = builder.Set("published_at", message.PublishedAt())
+	}
+
+	if message.Parked() {
+		builder = builder.Set("parked_at", message.ParkedAt())
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+func (r Repository) toDomainOutbox(value *dao.Outbox) *outbox.Message {
+	return outbox.NewWithID(
+		value.ID,
+		value.Sequence,
+		value.CreatedAt,
+		value.AggregateID,
+		value.Tenant,
+		event.Name(value.Name),
+		value.Payload,
+		value.Attempts,
+		value.NextAttemptAt,
+		value.LastError,
+	)
+}
+
+// eventData данные события в конверте: состояние агрегата после изменения
+// или идентификаторы, если состояния у события нет
+func eventData(e event.Event) audit.Snapshot {
+	switch value := e.(type) {
+	case *event.ContactCreated:
+		return withID(contactSnapshot(value.Contact()), e)
+	case *event.ContactUpdated:
+		return withID(contactSnapshot(value.Contact()), e)
+	case *event.ContactRestored:
+		return withID(contactSnapshot(value.Contact()), e)
+	case *event.GroupCreated:
+		return withID(groupSnapshot(value.Group()), e)
+	case *event.GroupUpdated:
+		return withID(groupSnapshot(value.Group()), e)
+	case *event.ContactAddedToGroup:
+		return audit.Snapshot{"groupId": value.GroupID(), "contactId": value.ContactID()}
+	case *event.ContactRemovedFromGroup:
+		return audit.Snapshot{"groupId": value.GroupID(), "contactId": value.ContactID()}
+	default:
+		return withID(nil, e)
+	}
+}
+
+func withID(data audit.Snapshot, e event.Event) audit.Snapshot {
+	if data == nil {
+		data = audit.Snapshot{}
+	}
+	data["id"] = e.AggregateID()
+	return data
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/audit"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/contact/photo"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+)
+
+// UpdateContactPhoto сохраняет описание фотографии контакта, пустое описание удаляет фотографию
+func (r *Repository) UpdateContactPhoto(c context.Context, contactID uuid.UUID, value photo.Photo) (response *contact.Contact, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	before, err := r.oneContactTx(ctx, tx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Update("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("photo", dao.ToDaoPhoto(value)).
+		Set("modified_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": contactID, "is_archived": false}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	response, err = r.oneContactTx(ctx, tx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.snapshotContactTx(ctx, tx, contactID); err != nil {
+		return nil, err
+	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityContact, contactID, audit.ActionUpdate, contactSnapshot(before), contactSnapshot(response)); err != nil {
+		return nil, err
+	}
+
+	if err = r.outboxTx(ctx, tx, event.NewContactUpdated(response)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -21,22 +21,30 @@ type Repository struct {
 	db      *pgxpool.Pool
 	genSQL  squirrel.StatementBuilderType
 	options Options
+
+	// migrationVersion версия схемы после применения миграций
+	migrationVersion int64
 }
 
 type Options struct {
 	Timeout       time.Duration
 	DefaultLimit  uint64
 	DefaultOffset uint64
+	// Lease на сколько фоновая отправка забирает доставки вебхуков и события outbox: пока срок не истёк,
+	// другие экземпляры их не берут, а если процесс упал, после срока они уйдут снова
+	Lease time.Duration
 }
 
 func New(db *pgxpool.Pool, o Options) (*Repository, error) {
-	if err := migrations(db); err != nil {
+	version, err := migrations(db)
+	if err != nil {
 		return nil, err
 	}
 
 	var r = &Repository{
-		genSQL: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
-		db:     db,
+		genSQL:           squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		db:               db,
+		migrationVersion: version,
 	}
 
 	r.SetOptions(o)
@@ -54,17 +62,27 @@ func (r *Repository) SetOptions(options Options) {
 		log.Debug("set default options.Timeout", zap.Any("timeout", options.Timeout))
 	}
 
+	if options.Lease == 0 {
+		options.Lease = time.Minute * 5
+		log.Debug("set default options.Lease", zap.Any("lease", options.Lease))
+	}
+
 	if r.options != options {
 		r.options = options
 		log.Info("set new options", zap.Any("options", r.options))
 	}
 }
 
-func migrations(pool *pgxpool.Pool) (err error) {
+// MigrationVersion версия последней применённой миграции
+func (r *Repository) MigrationVersion() int64 {
+	return r.migrationVersion
+}
+
+func migrations(pool *pgxpool.Pool) (version int64, err error) {
 	db, err := goose.OpenDBWithDriver("postgres", pool.Config().ConnConfig.ConnString())
 	if err != nil {
 		log.Error(err)
-		return err
+		return 0, err
 	}
 	defer func() {
 		if errClose := db.Close(); errClose != nil {
@@ -78,7 +96,12 @@ func migrations(pool *pgxpool.Pool) (err error) {
 	goose.SetTableName("contact_version")
 	if err = goose.Run("up", db, dir); err != nil {
 		log.Error(err, zap.String("command", "up"))
-		return err
+		return 0, err
+	}
+
+	if version, err = goose.GetDBVersion(db); err != nil {
+		log.Error(err, zap.String("command", "version"))
+		return 0, err
 	}
-	return
+	return version, nil
 }
@@ -0,0 +1,343 @@
+package postgres
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/share"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// groupGranted группа принадлежит grantees или открыта им записью доступа или списком доступа группы.
+// Общие группы без владельца сюда не входят: иначе контакт, добавленный в общую группу, стал бы виден всем.
+// Ожидает три одинаковых аргумента grantees.
+const groupGranted = `("group".owner = ANY(?)
+	OR EXISTS (
+		SELECT 1 FROM slurm.share
+		WHERE share.resource = 'group' AND share.resource_id = "group".id AND share.grantee = ANY(?)
+	)
+	OR EXISTS (
+		SELECT 1 FROM slurm.group_acl
+		WHERE group_acl.group_id = "group".id AND group_acl.grantee = ANY(?)
+	))`
+
+// contactInGrantedGroup контакт входит в действующую группу, доступную grantees
+const contactInGrantedGroup = `EXISTS (
+	SELECT 1 FROM slurm.contact_in_group
+	INNER JOIN slurm."group" ON "group".id = contact_in_group.group_id
+	WHERE contact_in_group.contact_id = contact.id AND "group".is_archived = FALSE AND ` + groupGranted + `
+)`
+
+// grantees пустой список вместо nil: ANY(NULL) даёт NULL, а не FALSE
+func grantees(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// contactVisibleScope условие на контакты, видимые автору запроса, если их ограничил useCase.WithVisibility
+func contactVisibleScope(ctx context.Context) squirrel.Sqlizer {
+	values, ok := useCase.Visibility(ctx)
+	if !ok {
+		return squirrel.Expr("TRUE")
+	}
+
+	values = grantees(values)
+	return squirrel.Expr(`(contact.owner = '' OR contact.owner = ANY(?)
+		OR EXISTS (
+			SELECT 1 FROM slurm.share
+			WHERE share.resource = 'contact' AND share.resource_id = contact.id AND share.grantee = ANY(?)
+		)
+		OR `+contactInGrantedGroup+`)`,
+		values, values, values, values, values)
+}
+
+// groupVisibleScope условие на группы, видимые автору запроса, если их ограничил useCase.WithVisibility
+func groupVisibleScope(ctx context.Context) squirrel.Sqlizer {
+	values, ok := useCase.Visibility(ctx)
+	if !ok {
+		return squirrel.Expr("TRUE")
+	}
+
+	values = grantees(values)
+	return squirrel.Expr(`("group".owner = '' OR `+groupGranted+`)`, values, values, values)
+}
+
+// outboxVisibleScope условие на события outbox, если их ограничил useCase.WithVisibility:
+// событие видно, когда виден его агрегат -- контакт или группа
+func outboxVisibleScope(ctx context.Context) squirrel.Sqlizer {
+	if _, ok := useCase.Visibility(ctx); !ok {
+		return squirrel.Expr("TRUE")
+	}
+
+	return squirrel.Expr(`(EXISTS (SELECT 1 FROM slurm.contact WHERE contact.id = outbox.aggregate_id AND ?)
+		OR EXISTS (SELECT 1 FROM slurm."group" WHERE "group".id = outbox.aggregate_id AND ?))`,
+		contactVisibleScope(ctx), groupVisibleScope(ctx))
+}
+
+// accessChanged событие ресурса, у которого изменились владелец или доступ
+func accessChanged(resource share.Resource, resourceID uuid.UUID) event.Event {
+	if resource == share.ResourceGroup {
+		return event.NewGroupAccessChanged(resourceID)
+	}
+	return event.NewContactAccessChanged(resourceID)
+}
+
+// shareTable таблица ресурса и ошибка на случай, если его нет
+func shareTable(resource share.Resource) (string, error) {
+	switch resource {
+	case share.ResourceContact:
+		return "slurm.contact", useCase.ErrContactNotFound
+	case share.ResourceGroup:
+		return `slurm."group"`, useCase.ErrGroupNotFound
+	}
+	return "", share.ErrWrongResource
+}
+
+func (r *Repository) ListShare(c context.Context, resource share.Resource, resourceID uuid.UUID) ([]*share.Share, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select(dao.ColumnShare...).
+		From("slurm.share").
+		Where(squirrel.Eq{"resource": resource.String(), "resource_id": resourceID}).
+		Where(tenantScope(ctx, "tenant_id")).
+		OrderBy("created_at", "grantee").
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoShares []*dao.Share
+	if err = pgxscan.Select(ctx, r.db, &daoShares, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*share.Share, len(daoShares))
+	for i, value := range daoShares {
+		result[i] = value.ToDomainShare()
+	}
+
+	return result, nil
+}
+
+// ReadAccess архивный ресурс тоже найден: без этого владелец не смог бы восстановить свой контакт
+func (r *Repository) ReadAccess(c context.Context, resource share.Resource, resourceID uuid.UUID, values []string) (*share.Access, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	table, errNotFound := shareTable(resource)
+	if table == "" {
+		return nil, errNotFound
+	}
+
+	values = grantees(values)
+
+	// 'write' > 'read', поэтому max выбирает лучший уровень
+	var (
+		alias = "contact"
+		level = squirrel.Expr(`COALESCE((
+			SELECT max(share.level) FROM slurm.share
+			WHERE share.resource = 'contact' AND share.resource_id = contact.id AND share.grantee = ANY(?)
+		), '') AS level`, values)
+		viaGroup = squirrel.Expr(contactInGrantedGroup+" AS via_group", values, values, values)
+	)
+	if resource == share.ResourceGroup {
+		// список доступа группы открывает её на чтение
+		alias = `"group"`
+		level = squirrel.Expr(`COALESCE(GREATEST((
+			SELECT max(share.level) FROM slurm.share
+			WHERE share.resource = 'group' AND share.resource_id = "group".id AND share.grantee = ANY(?)
+		), (
+			SELECT 'read' FROM slurm.group_acl
+			WHERE group_acl.group_id = "group".id AND group_acl.grantee = ANY(?) LIMIT 1
+		)), '') AS level`, values, values)
+		viaGroup = squirrel.Expr("FALSE AS via_group")
+	}
+
+	query, args, err := r.genSQL.Select(alias + ".owner").
+		Column(squirrel.Expr(alias+".owner = ANY(?) AS owned", values)).
+		Column(level).
+		Column(viaGroup).
+		From(table).
+		Where(squirrel.Eq{alias + ".id": resourceID}).
+		Where(tenantScope(ctx, alias+".tenant_id")).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoAccess []*dao.Access
+	if err = pgxscan.Select(ctx, r.db, &daoAccess, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if len(daoAccess) == 0 {
+		return nil, errNotFound
+	}
+
+	return daoAccess[0].ToDomainAccess(), nil
+}
+
+// SetShare добавляет запись или меняет уровень доступа у существующей
+func (r *Repository) SetShare(c context.Context, value *share.Share) (response *share.Share, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	if err = r.existsShareTargetTx(ctx, tx, value.Resource(), value.ResourceID()); err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Insert("slurm.share").
+		Columns(dao.ColumnShare...).
+		Values(
+			value.Resource().String(),
+			value.ResourceID(),
+			value.Grantee(),
+			value.Level().String(),
+			value.CreatedAt(),
+		).
+		Suffix(`ON CONFLICT (resource, resource_id, grantee) DO UPDATE SET level = EXCLUDED.level
+			RETURNING
+			resource,
+			resource_id,
+			grantee,
+			level,
+			created_at`,
+		).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoShares []*dao.Share
+	if err = pgxscan.Select(ctx, tx, &daoShares, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if err = r.outboxTx(ctx, tx, accessChanged(value.Resource(), value.ResourceID())); err != nil {
+		return nil, err
+	}
+
+	return daoShares[0].ToDomainShare(), nil
+}
+
+func (r *Repository) DeleteShare(c context.Context, resource share.Resource, resourceID uuid.UUID, grantee string) (err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	query, args, err := r.genSQL.Delete("slurm.share").
+		Where(squirrel.Eq{"resource": resource.String(), "resource_id": resourceID, "grantee": grantee}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	commandTag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return useCase.ErrShareNotFound
+	}
+
+	return r.outboxTx(ctx, tx, accessChanged(resource, resourceID))
+}
+
+func (r *Repository) UpdateOwner(c context.Context, resource share.Resource, resourceID uuid.UUID, owner string) (err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	table, errNotFound := shareTable(resource)
+	if table == "" {
+		return errNotFound
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	query, args, err := r.genSQL.Update(table).
+		Set("owner", owner).
+		Where(squirrel.Eq{"id": resourceID, "is_archived": false}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	commandTag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return errNotFound
+	}
+
+	return r.outboxTx(ctx, tx, accessChanged(resource, resourceID))
+}
+
+func (r *Repository) existsShareTargetTx(ctx context.Context, tx pgx.Tx, resource share.Resource, resourceID uuid.UUID) error {
+	table, errNotFound := shareTable(resource)
+	if table == "" {
+		return errNotFound
+	}
+
+	query, args, err := r.genSQL.Select("1").
+		From(table).
+		Where(squirrel.Eq{"id": resourceID, "is_archived": false}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	var found []int
+	if err = pgxscan.Select(ctx, tx, &found, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	if len(found) == 0 {
+		return errNotFound
+	}
+
+	return nil
+}
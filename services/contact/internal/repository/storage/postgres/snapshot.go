@@ -0,0 +1,300 @@
+package postgres
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/group"
+	"architecture_go/services/contact/internal/domain/version"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// columnSnapshotContactData состояние строки контакта вместе с тегами, теги хранятся отдельно от контакта
+const columnSnapshotContactData = `to_jsonb(contact) || jsonb_build_object('tags', COALESCE((
+	SELECT jsonb_agg(tag.name ORDER BY tag.name)
+	FROM slurm.contact_tag
+	INNER JOIN slurm.tag ON tag.id = contact_tag.tag_id
+	WHERE contact_tag.contact_id = contact.id
+), '[]'::jsonb))`
+
+// columnSnapshotContactTags теги контакта на момент версии
+const columnSnapshotContactTags = `ARRAY(
+	SELECT jsonb_array_elements_text(COALESCE(snapshot.data->'tags', '[]'::jsonb))
+) AS tags`
+
+// snapshotContactTx сохраняет текущее состояние контактов как их новые версии.
+// Вызывается после каждого изменения в той же транзакции, строка контакта к этому моменту
+// заблокирована изменением, поэтому номера версий не пересекаются.
+func (r *Repository) snapshotContactTx(ctx context.Context, tx pgx.Tx, contactIDs ...uuid.UUID) error {
+	if len(contactIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := r.genSQL.Insert("slurm.contact_snapshot").
+		Columns(
+			"contact_id",
+			"version",
+			"created_at",
+			"data",
+		).
+		Select(
+			r.genSQL.Select("contact.id").
+				Column(`COALESCE((
+					SELECT MAX(snapshot.version)
+					FROM slurm.contact_snapshot AS snapshot
+					WHERE snapshot.contact_id = contact.id
+				), 0) + 1`).
+				Column(squirrel.Expr("?::timestamp", time.Now().UTC())).
+				Column(columnSnapshotContactData).
+				From("slurm.contact").
+				Where(tenantScope(ctx, "tenant_id")).
+				Where(squirrel.Eq{"contact.id": contactIDs}),
+		).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+// snapshotGroupTx сохраняет текущее состояние группы как её новую версию
+func (r *Repository) snapshotGroupTx(ctx context.Context, tx pgx.Tx, groupID uuid.UUID) error {
+	query, args, err := r.genSQL.Insert("slurm.group_snapshot").
+		Columns(
+			"group_id",
+			"version",
+			"created_at",
+			"data",
+		).
+		Select(
+			r.genSQL.Select(`"group".id`).
+				Column(`COALESCE((
+					SELECT MAX(snapshot.version)
+					FROM slurm.group_snapshot AS snapshot
+					WHERE snapshot.group_id = "group".id
+				), 0) + 1`).
+				Column(squirrel.Expr("?::timestamp", time.Now().UTC())).
+				Column(`to_jsonb("group")`).
+				From(`slurm."group"`).
+				Where(tenantScope(ctx, "tenant_id")).
+				Where(squirrel.Eq{`"group".id`: groupID}),
+		).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+// ReadContactAsOf состояние контакта на момент asOf, удалённый к этому моменту контакт не найден
+func (r *Repository) ReadContactAsOf(c context.Context, ID uuid.UUID, asOf time.Time) (*contact.Contact, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	snapshot, err := r.oneContactSnapshot(ctx, squirrel.And{
+		squirrel.Eq{"snapshot.contact_id": ID},
+		squirrel.LtOrEq{"snapshot.created_at": asOf.UTC()},
+	})
+	if err != nil {
+		if errors.Is(err, useCase.ErrVersionNotFound) {
+			return nil, useCase.ErrContactNotFound
+		}
+		return nil, err
+	}
+
+	if snapshot.IsArchived {
+		return nil, useCase.ErrContactNotFound
+	}
+
+	return r.toDomainContact(&snapshot.Contact)
+}
+
+// ReadContactVersion состояние контакта в указанной версии, в том числе версии удаления
+func (r *Repository) ReadContactVersion(c context.Context, ID uuid.UUID, number uint64) (*contact.Contact, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	snapshot, err := r.oneContactSnapshot(ctx, squirrel.Eq{
+		"snapshot.contact_id": ID,
+		"snapshot.version":    number,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.toDomainContact(&snapshot.Contact)
+}
+
+func (r *Repository) ListContactVersion(c context.Context, ID uuid.UUID, parameter queryParameter.QueryParameter) ([]*version.Version, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.genSQL.Select(
+		"version",
+		"created_at",
+		"COALESCE((data->>'is_archived')::boolean, FALSE) AS is_archived",
+	).
+		From("slurm.contact_snapshot").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{"contact_id": ID}).
+		OrderBy("version DESC").
+		Limit(parameter.Pagination.Limit)
+
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoVersions []*dao.Version
+	if err = pgxscan.Select(ctx, r.db, &daoVersions, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*version.Version, len(daoVersions))
+	for i, value := range daoVersions {
+		result[i] = value.ToDomainVersion()
+	}
+
+	return result, nil
+}
+
+func (r *Repository) CountContactVersion(c context.Context, ID uuid.UUID) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(*)").
+		From("slurm.contact_snapshot").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{"contact_id": ID}).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	return total, nil
+}
+
+// ReadGroupAsOf состояние группы на момент asOf, удалённая к этому моменту группа не найдена
+func (r *Repository) ReadGroupAsOf(c context.Context, ID uuid.UUID, asOf time.Time) (response *group.Group, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select(
+		`"group".id`,
+		`"group".name`,
+		`COALESCE("group".description, '') AS description`,
+		`"group".created_at`,
+		`"group".modified_at`,
+		`"group".contact_count`,
+		`"group".is_archived`,
+	).
+		From("slurm.group_snapshot AS snapshot").
+		JoinClause(`CROSS JOIN LATERAL jsonb_populate_record(NULL::slurm."group", snapshot.data) AS "group"`).
+		Where(tenantScope(ctx, "snapshot.tenant_id")).
+		Where(squirrel.And{
+			squirrel.Eq{"snapshot.group_id": ID},
+			squirrel.LtOrEq{"snapshot.created_at": asOf.UTC()},
+		}).
+		OrderBy("snapshot.version DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoGroups []*dao.Group
+	if err = pgxscan.Select(ctx, r.db, &daoGroups, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if len(daoGroups) == 0 || daoGroups[0].IsArchived {
+		return nil, useCase.ErrGroupNotFound
+	}
+
+	return daoGroups[0].ToDomainGroup()
+}
+
+// oneContactSnapshot последняя версия контакта, удовлетворяющая условию
+func (r *Repository) oneContactSnapshot(ctx context.Context, where squirrel.Sqlizer) (*dao.ContactSnapshot, error) {
+	query, args, err := r.genSQL.Select(
+		"snapshot.version",
+		"snapshot.created_at AS version_created_at",
+		"contact.is_archived",
+		"contact.id",
+		"contact.created_at",
+		"contact.modified_at",
+		"contact.phone_number",
+		"contact.email",
+		"contact.name",
+		"contact.surname",
+		"contact.patronymic",
+		"contact.age",
+		"contact.gender",
+		"COALESCE(contact.custom_fields, '{}') AS custom_fields",
+		"contact.birthday",
+		"COALESCE(contact.addresses, '[]') AS addresses",
+		"contact.organization_id",
+		"contact.job_title",
+		"COALESCE(contact.photo, '{}') AS photo",
+		columnContactOrganizationName,
+		columnSnapshotContactTags,
+	).
+		From("slurm.contact_snapshot AS snapshot").
+		JoinClause("CROSS JOIN LATERAL jsonb_populate_record(NULL::slurm.contact, snapshot.data) AS contact").
+		Where(tenantScope(ctx, "snapshot.tenant_id")).
+		Where(where).
+		OrderBy("snapshot.version DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var snapshots []*dao.ContactSnapshot
+	if err = pgxscan.Select(ctx, r.db, &snapshots, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if len(snapshots) == 0 {
+		return nil, useCase.ErrVersionNotFound
+	}
+
+	return snapshots[0], nil
+}
@@ -0,0 +1,501 @@
+package postgres
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/columnCode"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/audit"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/tag"
+	tagName "architecture_go/services/contact/internal/domain/tag/name"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+var mappingSortTag = map[columnCode.ColumnCode]string{
+	"id":           "tag.id",
+	"name":         "tag.name",
+	"contactCount": "contact_count",
+}
+
+// AddContactTags создаёт недостающие теги и привязывает их к контакту
+func (r *Repository) AddContactTags(c context.Context, contactID uuid.UUID, tags ...tagName.Name) (response *contact.Contact, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	before, err := r.oneContactTx(ctx, tx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) == 0 {
+		return r.oneContactTx(ctx, tx, contactID)
+	}
+
+	var (
+		timeNow = time.Now().UTC()
+		builder = r.genSQL.Insert("slurm.tag").
+			Columns(
+				"id",
+				"created_at",
+				"modified_at",
+				"name",
+			)
+	)
+	for _, name := range tags {
+		builder = builder.Values(uuid.New(), timeNow, timeNow, name.String())
+	}
+
+	query, args, err := builder.Suffix("ON CONFLICT (tenant_id, name) DO NOTHING").ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	query, args, err = r.genSQL.Insert("slurm.contact_tag").
+		Columns(
+			"contact_id",
+			"tag_id",
+			"created_at",
+		).
+		Select(
+			r.genSQL.Select().
+				Column(squirrel.Expr("?::uuid", contactID)).
+				Column("id").
+				Column(squirrel.Expr("?::timestamp", timeNow)).
+				From("slurm.tag").
+				Where(tenantScope(ctx, "tenant_id")).
+				Where(squirrel.Eq{"name": tagNames(tags)}),
+		).
+		Suffix("ON CONFLICT DO NOTHING").
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if err = r.touchContactTx(ctx, tx, contactID, timeNow); err != nil {
+		return nil, err
+	}
+
+	response, err = r.oneContactTx(ctx, tx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.snapshotContactTx(ctx, tx, contactID); err != nil {
+		return nil, err
+	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityContact, contactID, audit.ActionUpdate, contactSnapshot(before), contactSnapshot(response)); err != nil {
+		return nil, err
+	}
+
+	if err = r.outboxTx(ctx, tx, event.NewContactUpdated(response)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// RemoveContactTags отвязывает теги от контакта, сами теги остаются в справочнике
+func (r *Repository) RemoveContactTags(c context.Context, contactID uuid.UUID, tags ...tagName.Name) (response *contact.Contact, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	before, err := r.oneContactTx(ctx, tx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) == 0 {
+		return r.oneContactTx(ctx, tx, contactID)
+	}
+
+	query, args, err := r.genSQL.Delete("slurm.contact_tag").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.And{
+			squirrel.Eq{"contact_id": contactID},
+			squirrel.Expr("tag_id IN (SELECT id FROM slurm.tag WHERE name = ANY(?))", tagNames(tags)),
+		}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if err = r.touchContactTx(ctx, tx, contactID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	response, err = r.oneContactTx(ctx, tx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.snapshotContactTx(ctx, tx, contactID); err != nil {
+		return nil, err
+	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityContact, contactID, audit.ActionUpdate, contactSnapshot(before), contactSnapshot(response)); err != nil {
+		return nil, err
+	}
+
+	if err = r.outboxTx(ctx, tx, event.NewContactUpdated(response)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (r *Repository) touchContactTx(ctx context.Context, tx pgx.Tx, contactID uuid.UUID, modifiedAt time.Time) error {
+	query, args, err := r.genSQL.Update("slurm.contact").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("modified_at", modifiedAt).
+		Where(squirrel.Eq{"id": contactID}).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdateTag(c context.Context, ID uuid.UUID, updateFn func(t *tag.Tag) (*tag.Tag, error)) (response *tag.Tag, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	upTag, err := r.oneTagTx(ctx, tx, ID)
+	if err != nil {
+		return nil, err
+	}
+	var before = tagSnapshot(upTag)
+
+	tagForUpdate, err := updateFn(upTag)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Update("slurm.tag").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("name", tagForUpdate.Name().String()).
+		Set("modified_at", tagForUpdate.ModifiedAt()).
+		Where(squirrel.Eq{"id": ID}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == codeUniqueViolation {
+			return nil, useCase.ErrTagExists
+		}
+		return nil, storageError(ctx, err)
+	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityTag, ID, audit.ActionUpdate, before, tagSnapshot(tagForUpdate)); err != nil {
+		return nil, err
+	}
+
+	// название тега входит в данные контакта: подписчики должны узнать, что изменились и контакты с ним
+	if upTag.Name() != tagForUpdate.Name() {
+		if err = r.touchContactsTx(ctx, tx, squirrel.Expr("id IN (SELECT contact_id FROM slurm.contact_tag WHERE tag_id = ?)", ID)); err != nil {
+			return nil, err
+		}
+	}
+
+	return tagForUpdate, nil
+}
+
+// MergeTags переносит контакты с тегов sourceIDs на тег targetID и удаляет исходные теги
+func (r *Repository) MergeTags(c context.Context, targetID uuid.UUID, sourceIDs ...uuid.UUID) (response *tag.Tag, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	if _, err = r.oneTagTx(ctx, tx, targetID); err != nil {
+		return nil, err
+	}
+
+	if len(sourceIDs) == 0 {
+		return r.oneTagTx(ctx, tx, targetID)
+	}
+
+	sources, err := r.queryTags(ctx, tx, r.selectTag(ctx).Where(squirrel.Eq{"tag.id": sourceIDs}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sources) != len(sourceIDs) {
+		return nil, useCase.ErrTagNotFound
+	}
+
+	query, args, err := r.genSQL.Insert("slurm.contact_tag").
+		Columns(
+			"contact_id",
+			"tag_id",
+			"created_at",
+		).
+		Select(
+			r.genSQL.Select("contact_id").
+				Column(squirrel.Expr("?::uuid", targetID)).
+				Column("created_at").
+				From("slurm.contact_tag").
+				Where(tenantScope(ctx, "tenant_id")).
+				Where(squirrel.Eq{"tag_id": sourceIDs}),
+		).
+		Suffix("ON CONFLICT DO NOTHING").
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	// контакты исходных тегов запоминаются до удаления привязок: у них меняется список тегов
+	query, args, err = r.genSQL.Select("DISTINCT contact_id").
+		From("slurm.contact_tag").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{"tag_id": sourceIDs}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var contactIDs []uuid.UUID
+	if err = pgxscan.Select(ctx, tx, &contactIDs, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	// привязки исходных тегов удаляются каскадно
+	query, args, err = r.genSQL.Delete("slurm.tag").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{"id": sourceIDs}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	query, args, err = r.genSQL.Update("slurm.tag").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("modified_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": targetID}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var merged = make([]string, len(sources))
+	for i, source := range sources {
+		merged[i] = source.Name().String()
+	}
+
+	if err = r.auditTx(ctx, tx, audit.EntityTag, targetID, audit.ActionMerge, nil, audit.Snapshot{"mergedTags": merged}); err != nil {
+		return nil, err
+	}
+
+	if len(contactIDs) > 0 {
+		if err = r.touchContactsTx(ctx, tx, squirrel.Eq{"id": contactIDs}); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.oneTagTx(ctx, tx, targetID)
+}
+
+func (r *Repository) ListTag(c context.Context, parameter queryParameter.QueryParameter) ([]*tag.Tag, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.selectTag(ctx)
+
+	if len(parameter.Sorts) > 0 {
+		builder = builder.OrderBy(parameter.Sorts.Parsing(mappingSortTag)...)
+	} else {
+		builder = builder.OrderBy("contact_count DESC", "tag.name")
+	}
+
+	builder = builder.Limit(parameter.Pagination.Limit)
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	return r.queryTags(ctx, r.db, builder)
+}
+
+func (r *Repository) ReadTagByID(c context.Context, ID uuid.UUID) (response *tag.Tag, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	return r.oneTagTx(ctx, tx, ID)
+}
+
+func (r *Repository) CountTag(c context.Context) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(id)").
+		From("slurm.tag").
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	return total, nil
+}
+
+func (r *Repository) oneTagTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID) (*tag.Tag, error) {
+	tags, err := r.queryTags(ctx, tx, r.selectTag(ctx).Where(squirrel.Eq{"tag.id": ID}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) == 0 {
+		return nil, useCase.ErrTagNotFound
+	}
+
+	return tags[0], nil
+}
+
+// selectTag архивные контакты не учитываются в количестве
+func (r *Repository) selectTag(ctx context.Context) squirrel.SelectBuilder {
+	return r.genSQL.Select(
+		"tag.id",
+		"tag.created_at",
+		"tag.modified_at",
+		"tag.name",
+		"COUNT(contact.id) AS contact_count",
+	).
+		From("slurm.tag").
+		LeftJoin("slurm.contact_tag ON contact_tag.tag_id = tag.id").
+		LeftJoin("slurm.contact ON contact.id = contact_tag.contact_id AND contact.is_archived = FALSE").
+		Where(tenantScope(ctx, "tag.tenant_id")).
+		GroupBy("tag.id")
+}
+
+func (r *Repository) queryTags(ctx context.Context, db pgxscan.Querier, builder squirrel.SelectBuilder) ([]*tag.Tag, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoTags []*dao.Tag
+	if err = pgxscan.Select(ctx, db, &daoTags, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*tag.Tag, len(daoTags))
+	for i, t := range daoTags {
+		domainTag, err := t.ToDomainTag()
+		if err != nil {
+			return nil, storageError(ctx, err)
+		}
+		result[i] = domainTag
+	}
+
+	return result, nil
+}
+
+func tagNames(tags []tagName.Name) []string {
+	var result = make([]string, len(tags))
+	for i, name := range tags {
+		result[i] = name.String()
+	}
+	return result
+}
@@ -0,0 +1,265 @@
+package postgres
+
+import (
+	stdContext "context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/columnCode"
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/tenant"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// settingTenant настройка соединения, по которой работают политики RLS и значение tenant_id по умолчанию
+const settingTenant = "app.tenant_id"
+
+var mappingSortTenant = map[columnCode.ColumnCode]string{
+	"id":        "id",
+	"name":      "name",
+	"createdAt": "created_at",
+}
+
+// AcquireTenant выставляет соединению арендатора из контекста запроса при каждой выдаче из пула.
+// Запрос без арендатора получает пустую настройку: RLS не покажет ему ни одной строки.
+func AcquireTenant(ctx stdContext.Context, conn *pgx.Conn) bool {
+	tenant, _ := ctx.Value(context.KeyTenant).(string)
+
+	if _, err := conn.Exec(ctx, "SELECT set_config($1, $2, FALSE)", settingTenant, tenant); err != nil {
+		// соединение без настройки выдавать нельзя, пул закроет его и возьмёт другое
+		log.Error(err, zap.String("tenant", tenant))
+		return false
+	}
+	return true
+}
+
+// tenantScope явное условие на арендатора для запросов к таблицам под RLS: база отфильтрует строки
+// и сама, условие защищает при подключении ролью, которая RLS не подчиняется, и помогает планировщику
+func tenantScope(ctx context.Context, column string) squirrel.Sqlizer {
+	var tenant = ctx.Tenant()
+	if tenant == context.TenantAll {
+		return squirrel.Expr("TRUE")
+	}
+	return squirrel.Eq{column: tenant}
+}
+
+func (r *Repository) CreateTenant(c context.Context, value *tenant.Tenant) (*tenant.Tenant, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Insert("slurm.tenant").
+		Columns(dao.ColumnTenant...).
+		Values(
+			value.ID(),
+			value.CreatedAt(),
+			value.ModifiedAt(),
+			value.Name(),
+			value.Quota().MaxContacts,
+			value.Quota().MaxGroups,
+			dao.NullTime(value.DisabledAt()),
+		).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = r.db.Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == codeUniqueViolation {
+			return nil, useCase.ErrTenantExists
+		}
+		return nil, storageError(ctx, err)
+	}
+
+	return value, nil
+}
+
+func (r *Repository) UpdateTenant(c context.Context, ID string, updateFn func(value *tenant.Tenant) (*tenant.Tenant, error)) (response *tenant.Tenant, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	tenants, err := r.queryTenants(ctx, tx, r.selectTenant().Where(squirrel.Eq{"id": ID}).Suffix("FOR UPDATE"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tenants) == 0 {
+		return nil, useCase.ErrTenantNotFound
+	}
+
+	response, err = updateFn(tenants[0])
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Update("slurm.tenant").
+		Set("name", response.Name()).
+		Set("max_contacts", response.Quota().MaxContacts).
+		Set("max_groups", response.Quota().MaxGroups).
+		Set("disabled_at", dao.NullTime(response.DisabledAt())).
+		Set("modified_at", response.ModifiedAt()).
+		Where(squirrel.Eq{"id": ID}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return response, nil
+}
+
+func (r *Repository) ListTenant(c context.Context, parameter queryParameter.QueryParameter) ([]*tenant.Tenant, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.selectTenant()
+
+	if len(parameter.Sorts) > 0 {
+		builder = builder.OrderBy(parameter.Sorts.Parsing(mappingSortTenant)...)
+	} else {
+		builder = builder.OrderBy("id")
+	}
+
+	builder = builder.Limit(parameter.Pagination.Limit)
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	return r.queryTenants(ctx, r.db, builder)
+}
+
+func (r *Repository) ReadTenantByID(c context.Context, ID string) (*tenant.Tenant, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tenants, err := r.queryTenants(ctx, r.db, r.selectTenant().Where(squirrel.Eq{"id": ID}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tenants) == 0 {
+		return nil, useCase.ErrTenantNotFound
+	}
+
+	return tenants[0], nil
+}
+
+func (r *Repository) CountTenant(c context.Context) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(id)").
+		From("slurm.tenant").
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	return total, nil
+}
+
+// ReadTenantUsage считает записи арендатора ID независимо от арендатора запроса:
+// оператор смотрит чужое потребление, поэтому запрос идёт в обход RLS с явным условием
+func (r *Repository) ReadTenantUsage(c context.Context, ID string) (*tenant.Usage, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+	ctx.WithValue(context.KeyTenant, context.TenantAll)
+
+	query, args, err := r.genSQL.Select().
+		Column("(SELECT COUNT(*) FROM slurm.contact WHERE tenant_id = ? AND is_archived = FALSE)", ID).
+		Column(`(SELECT COUNT(*) FROM slurm."group" WHERE tenant_id = ? AND is_archived = FALSE)`, ID).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result tenant.Usage
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&result.Contacts, &result.Groups); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return &result, nil
+}
+
+// ReadUsage считает неархивные записи всех арендаторов, запрос идёт в обход RLS
+func (r *Repository) ReadUsage(c context.Context) (*tenant.Usage, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+	ctx.WithValue(context.KeyTenant, context.TenantAll)
+
+	query, args, err := r.genSQL.Select().
+		Column("(SELECT COUNT(*) FROM slurm.contact WHERE is_archived = FALSE)").
+		Column(`(SELECT COUNT(*) FROM slurm."group" WHERE is_archived = FALSE)`).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result tenant.Usage
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&result.Contacts, &result.Groups); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return &result, nil
+}
+
+func (r *Repository) selectTenant() squirrel.SelectBuilder {
+	return r.genSQL.Select(dao.ColumnTenant...).
+		From("slurm.tenant")
+}
+
+func (r *Repository) queryTenants(ctx context.Context, db pgxscan.Querier, builder squirrel.SelectBuilder) ([]*tenant.Tenant, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoTenants []*dao.Tenant
+	if err = pgxscan.Select(ctx, db, &daoTenants, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*tenant.Tenant, len(daoTenants))
+	for i, value := range daoTenants {
+		result[i] = value.ToDomainTenant()
+	}
+
+	return result, nil
+}
@@ -0,0 +1,550 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/columnCode"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/webhook"
+	"architecture_go/services/contact/internal/repository/storage/postgres/dao"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+var mappingSortWebhook = map[columnCode.ColumnCode]string{
+	"id":         "id",
+	"url":        "url",
+	"createdAt":  "created_at",
+	"modifiedAt": "modified_at",
+}
+
+var mappingFilterWebhookDelivery = map[columnCode.ColumnCode]string{
+	"status": "status",
+	"event":  "event_name",
+}
+
+// webhookDeliveryTx создаёт доставки событий outbox всем активным подпискам с подходящим фильтром.
+// Вызывается из outboxTx, поэтому доставка появляется тогда и только тогда, когда зафиксировано изменение.
+func (r *Repository) webhookDeliveryTx(ctx context.Context, tx pgx.Tx, eventIDs ...uuid.UUID) error {
+	query, args, err := r.genSQL.Insert("slurm.webhook_delivery").
+		Columns(
+			"webhook_id",
+			"event_id",
+			"event_name",
+			"payload",
+			"created_at",
+			"next_attempt_at",
+		).
+		Select(
+			r.genSQL.Select(
+				"webhook.id",
+				"outbox.id",
+				"outbox.name",
+				"outbox.payload",
+				"outbox.created_at",
+				"outbox.created_at",
+			).
+				From("slurm.outbox").
+				CrossJoin("slurm.webhook").
+				Where(tenantScope(ctx, "outbox.tenant_id")).
+				Where("webhook.tenant_id = outbox.tenant_id").
+				Where(squirrel.Eq{
+					"outbox.id":           eventIDs,
+					"webhook.is_active":   true,
+					"webhook.is_archived": false,
+				}).
+				Where("(cardinality(webhook.events) = 0 OR outbox.name = ANY(webhook.events))"),
+		).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreateWebhook(c context.Context, hook *webhook.Webhook) (*webhook.Webhook, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Insert("slurm.webhook").
+		Columns(dao.ColumnWebhook...).
+		Values(
+			hook.ID(),
+			hook.CreatedAt(),
+			hook.ModifiedAt(),
+			hook.URL(),
+			dao.ToDaoWebhookEvents(hook.Events()),
+			hook.Secret(),
+			hook.Active(),
+		).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = r.db.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return hook, nil
+}
+
+func (r *Repository) UpdateWebhook(c context.Context, ID uuid.UUID, updateFn func(hook *webhook.Webhook) (*webhook.Webhook, error)) (response *webhook.Webhook, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	upWebhook, err := r.oneWebhookTx(ctx, tx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err = updateFn(upWebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Update("slurm.webhook").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("url", response.URL()).
+		Set("events", dao.ToDaoWebhookEvents(response.Events())).
+		Set("secret", response.Secret()).
+		Set("is_active", response.Active()).
+		Set("modified_at", response.ModifiedAt()).
+		Where(squirrel.Eq{
+			"id":          ID,
+			"is_archived": false,
+		}).
+		ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	return response, nil
+}
+
+// DeleteWebhook архивирует подписку, журнал доставок сохраняется, неотправленные доставки больше не отправляются
+func (r *Repository) DeleteWebhook(c context.Context, ID uuid.UUID) error {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Update("slurm.webhook").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("is_archived", true).
+		Set("modified_at", time.Now().UTC()).
+		Where(squirrel.Eq{
+			"id":          ID,
+			"is_archived": false,
+		}).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	commandTag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return useCase.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ListWebhook(c context.Context, parameter queryParameter.QueryParameter) ([]*webhook.Webhook, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.selectWebhook(ctx)
+
+	if len(parameter.Sorts) > 0 {
+		builder = builder.OrderBy(parameter.Sorts.Parsing(mappingSortWebhook)...)
+	} else {
+		builder = builder.OrderBy("created_at")
+	}
+
+	builder = builder.Limit(parameter.Pagination.Limit)
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	return r.queryWebhooks(ctx, r.db, builder)
+}
+
+func (r *Repository) ReadWebhookByID(c context.Context, ID uuid.UUID) (*webhook.Webhook, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	webhooks, err := r.queryWebhooks(ctx, r.db, r.selectWebhook(ctx).Where(squirrel.Eq{"id": ID}))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(webhooks) == 0 {
+		return nil, useCase.ErrWebhookNotFound
+	}
+
+	return webhooks[0], nil
+}
+
+func (r *Repository) CountWebhook(c context.Context) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(id)").
+		From("slurm.webhook").
+		Where(squirrel.Eq{"is_archived": false}).
+		Where(tenantScope(ctx, "tenant_id")).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	return total, nil
+}
+
+// ListWebhookDelivery журнал доставок подписки, новые первыми
+func (r *Repository) ListWebhookDelivery(c context.Context, webhookID uuid.UUID, parameter queryParameter.QueryParameter) ([]*webhook.Delivery, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.genSQL.Select(dao.ColumnWebhookDelivery...).
+		From("slurm.webhook_delivery").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{"webhook_id": webhookID}).
+		Where(webhookDeliveryConditions(parameter.Filters)).
+		OrderBy("created_at DESC", "id").
+		Limit(parameter.Pagination.Limit)
+
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	return r.queryWebhookDeliveries(ctx, r.db, builder)
+}
+
+func (r *Repository) CountWebhookDelivery(c context.Context, webhookID uuid.UUID, filters filter.Filters) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(id)").
+		From("slurm.webhook_delivery").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{"webhook_id": webhookID}).
+		Where(webhookDeliveryConditions(filters)).
+		ToSql()
+	if err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, storageError(ctx, err)
+	}
+
+	return total, nil
+}
+
+func (r *Repository) UpdateWebhookDelivery(c context.Context, webhookID, ID uuid.UUID, updateFn func(delivery *webhook.Delivery) (*webhook.Delivery, error)) (response *webhook.Delivery, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	deliveries, err := r.queryWebhookDeliveries(ctx, tx, r.genSQL.Select(dao.ColumnWebhookDelivery...).
+		From("slurm.webhook_delivery").
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{"id": ID, "webhook_id": webhookID}).
+		Suffix("FOR UPDATE"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deliveries) == 0 {
+		return nil, useCase.ErrWebhookDeliveryNotFound
+	}
+
+	response, err = updateFn(deliveries[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.updateWebhookDeliveryTx(ctx, tx, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// ProcessWebhookDelivery забирает до limit доставок, которым пора уходить, и передаёт их processFn
+// вместе с подписками. Доставки архивированных и выключенных подписок ждут, пока подписку не включат.
+// Запросы подписчикам идут вне транзакции: доставки забираются короткой транзакцией на options.Lease,
+// а их состояние после processFn сохраняется отдельной.
+func (r *Repository) ProcessWebhookDelivery(c context.Context, limit uint64, processFn func(deliveries []*webhook.Delivery, webhooks map[uuid.UUID]*webhook.Webhook)) (uint64, error) {
+	deliveries, webhooks, err := r.claimWebhookDelivery(c, limit)
+	if err != nil || len(deliveries) == 0 {
+		return 0, err
+	}
+
+	processFn(deliveries, webhooks)
+
+	if err = r.saveWebhookDelivery(c, deliveries); err != nil {
+		return 0, err
+	}
+
+	return uint64(len(deliveries)), nil
+}
+
+// claimWebhookDelivery блокирует доставки, которым пора уходить, и переносит их следующую попытку
+// на options.Lease вперёд, чтобы другие экземпляры их пропустили. Возвращаются доставки в прежнем состоянии
+func (r *Repository) claimWebhookDelivery(c context.Context, limit uint64) (deliveries []*webhook.Delivery, webhooks map[uuid.UUID]*webhook.Webhook, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, nil, storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	var columns = make([]string, len(dao.ColumnWebhookDelivery))
+	for i, column := range dao.ColumnWebhookDelivery {
+		columns[i] = "delivery." + column
+	}
+
+	var now = time.Now().UTC()
+
+	deliveries, err = r.queryWebhookDeliveries(ctx, tx, r.genSQL.Select(columns...).
+		From("slurm.webhook_delivery AS delivery").
+		InnerJoin("slurm.webhook ON webhook.id = delivery.webhook_id").
+		Where(tenantScope(ctx, "delivery.tenant_id")).
+		Where(squirrel.Eq{
+			"delivery.status":     webhook.StatusPending.String(),
+			"webhook.is_active":   true,
+			"webhook.is_archived": false,
+		}).
+		Where(squirrel.LtOrEq{"delivery.next_attempt_at": now}).
+		OrderBy("delivery.next_attempt_at").
+		Limit(limit).
+		Suffix("FOR UPDATE OF delivery SKIP LOCKED"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(deliveries) == 0 {
+		return nil, nil, nil
+	}
+
+	var (
+		deliveryIDs = make([]uuid.UUID, 0, len(deliveries))
+		webhookIDs  = make([]uuid.UUID, 0, len(deliveries))
+	)
+	for _, delivery := range deliveries {
+		deliveryIDs = append(deliveryIDs, delivery.ID())
+		webhookIDs = append(webhookIDs, delivery.WebhookID())
+	}
+
+	query, args, err := r.genSQL.Update("slurm.webhook_delivery").
+		Set("next_attempt_at", now.Add(r.options.Lease)).
+		Where(tenantScope(ctx, "tenant_id")).
+		Where(squirrel.Eq{"id": deliveryIDs}).
+		ToSql()
+	if err != nil {
+		return nil, nil, storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, nil, storageError(ctx, err)
+	}
+
+	list, err := r.queryWebhooks(ctx, tx, r.selectWebhook(ctx).Where(squirrel.Eq{"id": webhookIDs}))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhooks = make(map[uuid.UUID]*webhook.Webhook, len(list))
+	for _, hook := range list {
+		webhooks[hook.ID()] = hook
+	}
+
+	return deliveries, webhooks, nil
+}
+
+// saveWebhookDelivery сохраняет состояние доставок после отправки; доставки, которые processFn не трогал,
+// получают обратно прежнее время следующей попытки
+func (r *Repository) saveWebhookDelivery(c context.Context, deliveries []*webhook.Delivery) (err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = translate(transaction.Finish(ctx, t, err))
+	}(ctx, tx)
+
+	for _, delivery := range deliveries {
+		if err = r.updateWebhookDeliveryTx(ctx, tx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) updateWebhookDeliveryTx(ctx context.Context, tx pgx.Tx, delivery *webhook.Delivery) error {
+	var deliveredAt interface{}
+	if !delivery.DeliveredAt().IsZero() {
+		deliveredAt = delivery.DeliveredAt()
+	}
+
+	query, args, err := r.genSQL.Update("slurm.webhook_delivery").
+		Where(tenantScope(ctx, "tenant_id")).
+		Set("status", delivery.Status().String()).
+		Set("attempts", delivery.Attempts()).
+		Set("next_attempt_at", delivery.NextAttemptAt()).
+		Set("last_status_code", delivery.LastStatusCode()).
+		Set("last_error", delivery.LastError()).
+		Set("delivered_at", deliveredAt).
+		Where(squirrel.Eq{"id": delivery.ID()}).
+		ToSql()
+	if err != nil {
+		return storageError(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return storageError(ctx, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) oneWebhookTx(ctx context.Context, tx pgx.Tx, ID uuid.UUID) (*webhook.Webhook, error) {
+	webhooks, err := r.queryWebhooks(ctx, tx, r.selectWebhook(ctx).Where(squirrel.Eq{"id": ID}).Suffix("FOR UPDATE"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(webhooks) == 0 {
+		return nil, useCase.ErrWebhookNotFound
+	}
+
+	return webhooks[0], nil
+}
+
+func (r *Repository) selectWebhook(ctx context.Context) squirrel.SelectBuilder {
+	return r.genSQL.Select(dao.ColumnWebhook...).
+		From("slurm.webhook").
+		Where(squirrel.Eq{"is_archived": false}).
+		Where(tenantScope(ctx, "tenant_id"))
+}
+
+func (r *Repository) queryWebhooks(ctx context.Context, db pgxscan.Querier, builder squirrel.SelectBuilder) ([]*webhook.Webhook, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoWebhooks []*dao.Webhook
+	if err = pgxscan.Select(ctx, db, &daoWebhooks, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*webhook.Webhook, len(daoWebhooks))
+	for i, value := range daoWebhooks {
+		result[i] = value.ToDomainWebhook()
+	}
+
+	return result, nil
+}
+
+func (r *Repository) queryWebhookDeliveries(ctx context.Context, db pgxscan.Querier, builder squirrel.SelectBuilder) ([]*webhook.Delivery, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var daoDeliveries []*dao.WebhookDelivery
+	if err = pgxscan.Select(ctx, db, &daoDeliveries, query, args...); err != nil {
+		return nil, storageError(ctx, err)
+	}
+
+	var result = make([]*webhook.Delivery, len(daoDeliveries))
+	for i, value := range daoDeliveries {
+		result[i] = value.ToDomainDelivery()
+	}
+
+	return result, nil
+}
+
+func webhookDeliveryConditions(filters filter.Filters) squirrel.And {
+	var where = squirrel.And{}
+	for _, f := range filters {
+		if column, ok := mappingFilterWebhookDelivery[f.Key]; ok {
+			where = append(where, squirrel.Eq{column: f.Values})
+		}
+	}
+	return where
+}
@@ -0,0 +1,248 @@
+package jwt
+
+import (
+	"crypto"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MicahParks/keyfunc"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/principal"
+)
+
+func init() {
+	viper.SetDefault("JWT_ROLES_CLAIM", "roles")
+	viper.SetDefault("JWT_TENANT_CLAIM", "tenant")
+}
+
+var (
+	ErrNoKey        = errors.New("jwt verification key is not configured: set JWT_JWKS_URL, JWT_PUBLIC_KEY or JWT_SECRET")
+	ErrWrongClaims  = errors.New("jwt claims are not valid")
+	ErrWrongSubject = errors.New("jwt has no subject")
+)
+
+// Repository проверяет JWT одним из способов, в порядке приоритета: набором ключей JWKS,
+// открытым ключом из PEM или общим секретом HMAC. Алгоритм токена должен соответствовать ключу,
+// поэтому токен с alg=none или HMAC-токен, подписанный открытым ключом, не пройдут.
+type Repository struct {
+	keyFunc jwt.Keyfunc
+	parser  *jwt.Parser
+	jwks    *keyfunc.JWKS
+	options Options
+}
+
+type Options struct {
+	// JWKSURL адрес набора ключей; набор обновляется в фоне и при встрече неизвестного kid
+	JWKSURL string
+	// PublicKey PEM открытого ключа RSA, ECDSA или Ed25519
+	PublicKey string
+	// Secret общий секрет HMAC
+	Secret string
+
+	// Issuer ожидаемый iss, пустая строка -- не проверяется
+	Issuer string
+	// Audience ожидаемый aud, пустая строка -- не проверяется
+	Audience string
+	// RolesClaim claim со списком ролей: массив строк или строка через пробел
+	RolesClaim string
+	// TenantClaim claim с идентификатором арендатора; токен без него относится к арендатору по умолчанию
+	TenantClaim string
+	// Leeway допустимое расхождение часов при проверке exp и nbf
+	Leeway time.Duration
+}
+
+func New(o Options) (*Repository, error) {
+	var r = &Repository{}
+	r.SetOptions(o)
+
+	switch {
+	case r.options.JWKSURL != "":
+		jwks, err := keyfunc.Get(r.options.JWKSURL, keyfunc.Options{
+			RefreshInterval:   time.Hour,
+			RefreshRateLimit:  time.Minute,
+			RefreshTimeout:    time.Second * 10,
+			RefreshUnknownKID: true,
+			RefreshErrorHandler: func(err error) {
+				log.Warn("jwks refresh failed", zap.String("url", r.options.JWKSURL), zap.Error(err))
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		r.jwks = jwks
+		r.keyFunc = jwks.Keyfunc
+		r.parser = jwt.NewParser(jwt.WithoutClaimsValidation())
+
+	case r.options.PublicKey != "":
+		key, methods, err := parsePublicKey([]byte(r.options.PublicKey))
+		if err != nil {
+			return nil, err
+		}
+		r.keyFunc = func(*jwt.Token) (interface{}, error) { return key, nil }
+		r.parser = jwt.NewParser(jwt.WithValidMethods(methods), jwt.WithoutClaimsValidation())
+
+	case r.options.Secret != "":
+		var key = []byte(r.options.Secret)
+		r.keyFunc = func(*jwt.Token) (interface{}, error) { return key, nil }
+		r.parser = jwt.NewParser(jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"}), jwt.WithoutClaimsValidation())
+
+	default:
+		return nil, ErrNoKey
+	}
+
+	return r, nil
+}
+
+func (r *Repository) SetOptions(options Options) {
+	if options.JWKSURL == "" {
+		options.JWKSURL = viper.GetString("JWT_JWKS_URL")
+	}
+
+	if options.PublicKey == "" {
+		options.PublicKey = viper.GetString("JWT_PUBLIC_KEY")
+	}
+
+	if options.Secret == "" {
+		options.Secret = viper.GetString("JWT_SECRET")
+	}
+
+	if options.Issuer == "" {
+		options.Issuer = viper.GetString("JWT_ISSUER")
+	}
+
+	if options.Audience == "" {
+		options.Audience = viper.GetString("JWT_AUDIENCE")
+	}
+
+	if options.RolesClaim == "" {
+		options.RolesClaim = viper.GetString("JWT_ROLES_CLAIM")
+		log.Debug("set default options.RolesClaim", zap.Any("rolesClaim", options.RolesClaim))
+	}
+
+	if options.TenantClaim == "" {
+		options.TenantClaim = viper.GetString("JWT_TENANT_CLAIM")
+		log.Debug("set default options.TenantClaim", zap.Any("tenantClaim", options.TenantClaim))
+	}
+
+	if options.Leeway == 0 {
+		options.Leeway = time.Minute
+		log.Debug("set default options.Leeway", zap.Any("leeway", options.Leeway))
+	}
+
+	if r.options != options {
+		r.options = options
+		// ключи в лог не попадают
+		log.Info("set new options",
+			zap.String("jwksUrl", r.options.JWKSURL),
+			zap.Bool("publicKey", r.options.PublicKey != ""),
+			zap.Bool("secret", r.options.Secret != ""),
+			zap.String("issuer", r.options.Issuer),
+			zap.String("audience", r.options.Audience),
+			zap.String("rolesClaim", r.options.RolesClaim),
+			zap.String("tenantClaim", r.options.TenantClaim),
+			zap.Duration("leeway", r.options.Leeway),
+		)
+	}
+}
+
+// Close останавливает фоновое обновление JWKS
+func (r *Repository) Close() error {
+	if r.jwks != nil {
+		r.jwks.EndBackground()
+	}
+	return nil
+}
+
+func (r *Repository) Verify(_ context.Context, value string) (*principal.Principal, error) {
+	var claims = jwt.MapClaims{}
+	if _, err := r.parser.ParseWithClaims(value, claims, r.keyFunc); err != nil {
+		return nil, err
+	}
+
+	var now = time.Now()
+	if !claims.VerifyExpiresAt(now.Add(-r.options.Leeway).Unix(), true) {
+		return nil, errors.Wrap(ErrWrongClaims, "token is expired or has no exp")
+	}
+	if !claims.VerifyNotBefore(now.Add(r.options.Leeway).Unix(), false) {
+		return nil, errors.Wrap(ErrWrongClaims, "token is not valid yet")
+	}
+	if r.options.Issuer != "" && !claims.VerifyIssuer(r.options.Issuer, true) {
+		return nil, errors.Wrap(ErrWrongClaims, "unexpected issuer")
+	}
+	if r.options.Audience != "" && !claims.VerifyAudience(r.options.Audience, true) {
+		return nil, errors.Wrap(ErrWrongClaims, "unexpected audience")
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, ErrWrongSubject
+	}
+
+	var result = &principal.Principal{
+		Subject: subject,
+		Method:  principal.MethodToken,
+		Roles:   stringList(claims[r.options.RolesClaim]),
+	}
+
+	if name, ok := claims["name"].(string); ok {
+		result.Name = name
+	} else if name, ok = claims["preferred_username"].(string); ok {
+		result.Name = name
+	}
+
+	if tenant, ok := claims[r.options.TenantClaim].(string); ok {
+		result.Tenant = tenant
+	}
+
+	if id, ok := claims["jti"].(string); ok {
+		result.CredentialID = id
+	}
+
+	if exp, ok := claims["exp"].(float64); ok {
+		result.ExpiresAt = time.Unix(int64(exp), 0).UTC()
+	}
+
+	return result, nil
+}
+
+// stringList массив строк JSON или строка через пробел, как в claim scope
+func stringList(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []interface{}:
+		var result = make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return []string{}
+	}
+}
+
+// parsePublicKey открытый ключ и подходящие ему алгоритмы подписи
+func parsePublicKey(pem []byte) (crypto.PublicKey, []string, error) {
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(pem); err == nil {
+		return key, []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}, nil
+	}
+
+	if key, err := jwt.ParseECPublicKeyFromPEM(pem); err == nil {
+		return key, []string{"ES256", "ES384", "ES512"}, nil
+	}
+
+	if key, err := jwt.ParseEdPublicKeyFromPEM(pem); err == nil {
+		return key, []string{"EdDSA"}, nil
+	}
+
+	return nil, nil, fmt.Errorf("jwt public key must be a PEM encoded RSA, ECDSA or Ed25519 key")
+}
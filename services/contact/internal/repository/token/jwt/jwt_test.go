@@ -0,0 +1,94 @@
+package jwt
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"architecture_go/pkg/type/context"
+)
+
+func TestVerifySecret(t *testing.T) {
+	var secret = "0123456789abcdef"
+
+	r, err := New(Options{Secret: secret, Issuer: "user"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(claims jwt.MapClaims) string {
+		value, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+
+	var exp = time.Now().Add(time.Hour).Unix()
+
+	result, err := r.Verify(context.Empty(), sign(jwt.MapClaims{"sub": "42", "iss": "user", "exp": exp, "roles": "viewer editor", "tenant": "acme"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Subject != "42" || !result.HasRole("editor") || result.ExpiresAt.Unix() != exp || result.Tenant != "acme" {
+		t.Errorf("unexpected principal %+v", result)
+	}
+
+	var cases = map[string]jwt.MapClaims{
+		"expired":    {"sub": "42", "iss": "user", "exp": time.Now().Add(-time.Hour).Unix()},
+		"no exp":     {"sub": "42", "iss": "user"},
+		"issuer":     {"sub": "42", "iss": "other", "exp": exp},
+		"no subject": {"iss": "user", "exp": exp},
+	}
+	for name, claims := range cases {
+		if _, err = r.Verify(context.Empty(), sign(claims)); err == nil {
+			t.Errorf("%s: token must be rejected", name)
+		}
+	}
+
+	if _, err = r.Verify(context.Empty(), sign(jwt.MapClaims{"iss": "user", "exp": exp})); !errors.Is(err, ErrWrongSubject) {
+		t.Errorf("expected ErrWrongSubject, got %v", err)
+	}
+}
+
+func TestVerifyPublicKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var public = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	r, err := New(Options{PublicKey: string(public)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var claims = jwt.MapClaims{"sub": "42", "exp": time.Now().Add(time.Hour).Unix()}
+
+	value, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = r.Verify(context.Empty(), value); err != nil {
+		t.Errorf("RS256 token must be accepted: %v", err)
+	}
+
+	// подпись HMAC открытым ключом -- классическая подмена алгоритма
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(public)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = r.Verify(context.Empty(), forged); err == nil {
+		t.Error("HS256 token signed with the public key must be rejected")
+	}
+}
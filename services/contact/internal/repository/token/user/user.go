@@ -0,0 +1,105 @@
+package user
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	user "architecture_go/pkg/protobuff/user"
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/principal"
+)
+
+var ErrNoAddress = errors.New("user service address is not configured: set USER_GRPC_ADDR")
+
+// Repository проверяет токены вызовом Authenticate сервиса user. В отличие от проверки подписи на месте,
+// удаление пользователя и смена его ролей действуют сразу, ценой одного вызова на запрос.
+type Repository struct {
+	conn    *grpc.ClientConn
+	client  user.UserServiceClient
+	options Options
+}
+
+type Options struct {
+	// Address адрес gRPC сервиса user, host:port
+	Address string
+	// Timeout ограничение на один вызов Authenticate
+	Timeout time.Duration
+}
+
+func New(o Options) (*Repository, error) {
+	var r = &Repository{}
+	r.SetOptions(o)
+
+	if r.options.Address == "" {
+		return nil, ErrNoAddress
+	}
+
+	conn, err := grpc.Dial(r.options.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	r.conn = conn
+	r.client = user.NewUserServiceClient(conn)
+	return r, nil
+}
+
+func (r *Repository) SetOptions(options Options) {
+	if options.Address == "" {
+		options.Address = viper.GetString("USER_GRPC_ADDR")
+	}
+
+	if options.Timeout == 0 {
+		options.Timeout = time.Second * 5
+		log.Debug("set default options.Timeout", zap.Any("timeout", options.Timeout))
+	}
+
+	if r.options != options {
+		r.options = options
+		log.Info("set new options", zap.Any("options", r.options))
+	}
+}
+
+func (r *Repository) Close() error {
+	return r.conn.Close()
+}
+
+func (r *Repository) Verify(c context.Context, value string) (*principal.Principal, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	response, err := r.client.Authenticate(ctx, &user.AuthenticateRequest{AccessToken: value})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return nil, errors.Wrap(err, "token is rejected by user service")
+		}
+		return nil, log.ErrorWithContext(ctx, err)
+	}
+
+	var p = response.GetPrincipal()
+	var result = &principal.Principal{
+		Subject:      p.GetSubject(),
+		Name:         p.GetName(),
+		Method:       principal.MethodToken,
+		CredentialID: p.GetCredentialId(),
+		Tenant:       p.GetTenant(),
+		Roles:        p.GetRoles(),
+	}
+	if result.Roles == nil {
+		result.Roles = []string{}
+	}
+	if p.GetExpiresAt() != nil {
+		result.ExpiresAt = p.GetExpiresAt().AsTime()
+	}
+
+	return result, nil
+}
@@ -0,0 +1,128 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/webhook"
+)
+
+const (
+	HeaderID        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	// HeaderSignature значение вида "sha256=<hex>", см. webhook.Sign
+	HeaderSignature = "X-Webhook-Signature"
+
+	// maxResponseBody ответ подписчика не нужен, но его дочитывают, чтобы соединение вернулось в пул
+	maxResponseBody = 64 << 10
+)
+
+var ErrUnexpectedStatus = errors.New("webhook receiver responded with non-2xx status")
+
+// Repository отправляет доставки POST-запросом с телом-конвертом события
+type Repository struct {
+	client  *http.Client
+	options Options
+}
+
+type Options struct {
+	// Timeout время на весь запрос к подписчику, включая чтение ответа
+	Timeout time.Duration
+	// AllowPrivate разрешает соединения с адресами внутренней сети; только для тестов и локальной отладки
+	AllowPrivate bool
+}
+
+func New(o Options) *Repository {
+	var r = &Repository{}
+	r.SetOptions(o)
+
+	// адрес проверяется при соединении, уже после разрешения имени: имя подписчика может указывать
+	// во внутреннюю сеть или сменить адрес после проверки. Прокси окружения не используется -- иначе
+	// проверялся бы адрес прокси, а не подписчика. Перенаправления не выполняются: ответ 3xx -- ошибка доставки
+	var dialer = &net.Dialer{Timeout: r.options.Timeout, Control: r.control}
+	r.client = &http.Client{
+		Timeout: r.options.Timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return r
+}
+
+func (r *Repository) SetOptions(options Options) {
+	if options.Timeout == 0 {
+		options.Timeout = time.Second * 10
+		log.Debug("set default options.Timeout", zap.Any("timeout", options.Timeout))
+	}
+
+	if r.options != options {
+		r.options = options
+		log.Info("set new options", zap.Any("options", r.options))
+	}
+}
+
+// control отказывает в соединении с адресом внутренней сети
+func (r *Repository) control(_, address string, _ syscall.RawConn) error {
+	if r.options.AllowPrivate {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if !webhook.PublicIP(net.ParseIP(host)) {
+		return errors.Wrapf(webhook.ErrPrivateURL, "address %s", host)
+	}
+	return nil
+}
+
+func (r *Repository) Send(ctx context.Context, hook *webhook.Webhook, delivery *webhook.Delivery) (int, error) {
+	var timestamp = time.Now()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL(), bytes.NewReader(delivery.Payload()))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", viper.GetString("SERVICE_NAME"))
+	request.Header.Set(HeaderID, delivery.ID().String())
+	request.Header.Set(HeaderEvent, delivery.EventName().String())
+	request.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	request.Header.Set(HeaderSignature, webhook.Sign(hook.Secret(), timestamp, delivery.Payload()))
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, maxResponseBody))
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return response.StatusCode, errors.Wrapf(ErrUnexpectedStatus, "status %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/webhook"
+)
+
+func TestSend(t *testing.T) {
+	var (
+		secret  = "0123456789abcdef"
+		payload = []byte(`{"name":"contact.created"}`)
+		status  = http.StatusNoContent
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		unix, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+		if !webhook.Verify(secret, time.Unix(unix, 0), body, r.Header.Get(HeaderSignature)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	var (
+		now      = time.Now()
+		hook     = webhook.NewWithID(uuid.New(), now, now, server.URL, nil, secret, true)
+		delivery = webhook.NewDeliveryWithID(uuid.New(), hook.ID(), uuid.New(), event.NameContactCreated, payload, now,
+			webhook.StatusPending, 0, now, 0, "", time.Time{})
+		sender = New(Options{AllowPrivate: true})
+	)
+
+	statusCode, err := sender.Send(context.Empty(), hook, delivery)
+	if err != nil || statusCode != http.StatusNoContent {
+		t.Fatalf("expected signed delivery to be accepted, got %d %v", statusCode, err)
+	}
+
+	status = http.StatusInternalServerError
+	if statusCode, err = sender.Send(context.Empty(), hook, delivery); err == nil || statusCode != http.StatusInternalServerError {
+		t.Fatalf("expected error for status 500, got %d %v", statusCode, err)
+	}
+}
+
+func TestSendRefusesPrivateAndRedirect(t *testing.T) {
+	var target = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+
+	var redirect = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer redirect.Close()
+
+	var (
+		now      = time.Now()
+		delivery = webhook.NewDeliveryWithID(uuid.New(), uuid.New(), uuid.New(), event.NameContactCreated, []byte(`{}`), now,
+			webhook.StatusPending, 0, now, 0, "", time.Time{})
+	)
+
+	// имя или адрес подписчика, указывающий во внутреннюю сеть, не принимается при соединении
+	var hook = webhook.NewWithID(uuid.New(), now, now, target.URL, nil, "0123456789abcdef", true)
+	if _, err := New(Options{}).Send(context.Empty(), hook, delivery); !errors.Is(err, webhook.ErrPrivateURL) {
+		t.Fatalf("expected ErrPrivateURL, got %v", err)
+	}
+
+	// перенаправление не выполняется даже туда, куда соединение разрешено
+	hook = webhook.NewWithID(uuid.New(), now, now, redirect.URL, nil, "0123456789abcdef", true)
+	statusCode, err := New(Options{AllowPrivate: true}).Send(context.Empty(), hook, delivery)
+	if err == nil || statusCode != http.StatusTemporaryRedirect {
+		t.Fatalf("expected redirect to be refused, got %d %v", statusCode, err)
+	}
+}
@@ -0,0 +1,14 @@
+package blob
+
+import (
+	"architecture_go/pkg/type/context"
+)
+
+// Blob хранилище файлов, ключ -- путь вида "contacts/<id>/photo"
+type Blob interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// Get возвращает useCase.ErrBlobNotFound, если файла нет
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete не возвращает ошибку, если файла нет
+	Delete(ctx context.Context, key string) error
+}
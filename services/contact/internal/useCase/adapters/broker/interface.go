@@ -0,0 +1,12 @@
+package broker
+
+import (
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/outbox"
+)
+
+// Broker доставляет сообщения outbox внешним подписчикам.
+// Доставка «хотя бы один раз»: при повторе подписчик получит сообщение с тем же ID.
+type Broker interface {
+	Publish(ctx context.Context, message *outbox.Message) error
+}
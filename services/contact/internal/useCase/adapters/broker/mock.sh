@@ -0,0 +1 @@
+mockery --all --keeptree --output ../../../repository/broker/mock --outpkg mockBroker
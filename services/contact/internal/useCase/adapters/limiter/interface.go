@@ -0,0 +1,12 @@
+package limiter
+
+import (
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+)
+
+// Limiter хранилище вёдер. Реплики сервиса делят лимит, только если делят хранилище.
+type Limiter interface {
+	// Take забирает cost маркеров из ведра key с лимитом limit
+	Take(ctx context.Context, key string, limit rateLimit.Limit, cost uint64) (*rateLimit.Result, error)
+}
@@ -0,0 +1 @@
+mockery --all --keeptree --output ../../../repository/limiter/mock --outpkg mockLimiter
@@ -0,0 +1,11 @@
+package publisher
+
+import (
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/event"
+)
+
+// Publisher доставляет доменные события подписчикам, вызывается после сохранения изменений
+type Publisher interface {
+	Publish(ctx context.Context, events ...event.Event) error
+}
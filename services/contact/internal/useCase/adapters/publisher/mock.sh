@@ -0,0 +1 @@
+mockery --all --keeptree --output ../../../repository/event/mock --outpkg mockPublisher
@@ -0,0 +1,13 @@
+package sender
+
+import (
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/webhook"
+)
+
+// Sender отправляет подписанную доставку на адрес подписки
+type Sender interface {
+	// Send возвращает код ответа подписчика, 0 -- ответа не было.
+	// Ошибка означает сетевой сбой или ответ с кодом вне 2xx.
+	Send(ctx context.Context, hook *webhook.Webhook, delivery *webhook.Delivery) (statusCode int, err error)
+}
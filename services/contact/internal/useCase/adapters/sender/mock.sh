@@ -0,0 +1 @@
+mockery --all --keeptree --output ../../../repository/webhook/mock --outpkg mockSender
@@ -1,31 +1,85 @@
 package storage
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
 	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/apiKey"
+	"architecture_go/services/contact/internal/domain/audit"
 	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/contact/photo"
+	"architecture_go/services/contact/internal/domain/customField"
+	"architecture_go/services/contact/internal/domain/delta"
 	"architecture_go/services/contact/internal/domain/group"
+	"architecture_go/services/contact/internal/domain/group/acl"
+	"architecture_go/services/contact/internal/domain/note"
+	"architecture_go/services/contact/internal/domain/organization"
+	"architecture_go/services/contact/internal/domain/outbox"
+	"architecture_go/services/contact/internal/domain/session"
+	"architecture_go/services/contact/internal/domain/share"
+	"architecture_go/services/contact/internal/domain/tag"
+	tagName "architecture_go/services/contact/internal/domain/tag/name"
+	"architecture_go/services/contact/internal/domain/tenant"
+	"architecture_go/services/contact/internal/domain/version"
+	"architecture_go/services/contact/internal/domain/webhook"
 )
 
 type Storage interface {
 	Contact
 	Group
+	CustomField
+	Tag
+	Organization
+	Note
+	Audit
+	Outbox
+	Webhook
+	Delta
+	Auth
+	Tenant
+	Share
+	Usage
 }
 
 type Contact interface {
 	CreateContact(ctx context.Context, contacts ...*contact.Contact) ([]*contact.Contact, error)
 	UpdateContact(ctx context.Context, ID uuid.UUID, updateFn func(c *contact.Contact) (*contact.Contact, error)) (*contact.Contact, error)
 	DeleteContact(ctx context.Context, ID uuid.UUID) error
+	// RestoreContact возвращает контакт из архива вместе с его заметками
+	RestoreContact(ctx context.Context, ID uuid.UUID) (*contact.Contact, error)
+
+	AddContactTags(ctx context.Context, contactID uuid.UUID, tags ...tagName.Name) (*contact.Contact, error)
+	RemoveContactTags(ctx context.Context, contactID uuid.UUID, tags ...tagName.Name) (*contact.Contact, error)
+
+	// UpdateContactPhoto сохраняет описание фотографии, пустое описание удаляет фотографию
+	UpdateContactPhoto(ctx context.Context, contactID uuid.UUID, value photo.Photo) (*contact.Contact, error)
 
 	ContactReader
+	CustomFieldReader
+	OrganizationReader
 }
 
 type ContactReader interface {
 	ListContact(ctx context.Context, parameter queryParameter.QueryParameter) ([]*contact.Contact, error)
 	ReadContactByID(ctx context.Context, ID uuid.UUID) (response *contact.Contact, err error)
-	CountContact(ctx context.Context /*Тут можно передавать фильтр*/) (uint64, error)
+	CountContact(ctx context.Context, filters filter.Filters) (uint64, error)
+	ListContactBirthday(ctx context.Context, from, to time.Time, parameter queryParameter.QueryParameter) ([]*contact.Contact, error)
+
+	ContactVersionReader
+}
+
+// ContactVersionReader версии сохраняются методами изменения контакта в их транзакциях
+type ContactVersionReader interface {
+	// ReadContactAsOf состояние контакта на момент asOf, удалённый к этому моменту контакт не найден
+	ReadContactAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*contact.Contact, error)
+	// ReadContactVersion состояние контакта в указанной версии
+	ReadContactVersion(ctx context.Context, ID uuid.UUID, number uint64) (*contact.Contact, error)
+	ListContactVersion(ctx context.Context, ID uuid.UUID, parameter queryParameter.QueryParameter) ([]*version.Version, error)
+	CountContactVersion(ctx context.Context, ID uuid.UUID) (uint64, error)
 }
 
 type Group interface {
@@ -35,16 +89,209 @@ type Group interface {
 
 	GroupReader
 	ContactInGroup
+	GroupACL
 }
 
 type GroupReader interface {
 	ListGroup(ctx context.Context, parameter queryParameter.QueryParameter) ([]*group.Group, error)
 	ReadGroupByID(ctx context.Context, ID uuid.UUID) (*group.Group, error)
 	CountGroup(ctx context.Context /*Тут можно передавать фильтр*/) (uint64, error)
+	// ReadGroupAsOf состояние группы на момент asOf, удалённая к этому моменту группа не найдена
+	ReadGroupAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*group.Group, error)
+}
+
+// GroupACL списки доступа групп
+type GroupACL interface {
+	ListGroupACL(ctx context.Context, groupID uuid.UUID) ([]*acl.Entry, error)
+	// SetGroupACL добавляет запись или меняет уровень доступа у существующей
+	SetGroupACL(ctx context.Context, entry *acl.Entry) (*acl.Entry, error)
+	DeleteGroupACL(ctx context.Context, groupID uuid.UUID, grantee string) error
+}
+
+// Share доступ к контактам и группам и их владельцы
+type Share interface {
+	// SetShare добавляет запись или меняет уровень доступа у существующей
+	SetShare(ctx context.Context, value *share.Share) (*share.Share, error)
+	DeleteShare(ctx context.Context, resource share.Resource, resourceID uuid.UUID, grantee string) error
+	UpdateOwner(ctx context.Context, resource share.Resource, resourceID uuid.UUID, owner string) error
+
+	ShareReader
+}
+
+type ShareReader interface {
+	ListShare(ctx context.Context, resource share.Resource, resourceID uuid.UUID) ([]*share.Share, error)
+	// ReadAccess доступ grantees к ресурсу, в том числе архивному
+	ReadAccess(ctx context.Context, resource share.Resource, resourceID uuid.UUID, grantees []string) (*share.Access, error)
+}
+
+// Policy всё, что нужно проверкам доступа
+type Policy interface {
+	GroupACL
+	ShareReader
 }
 
 type ContactInGroup interface {
 	CreateContactIntoGroup(ctx context.Context, groupID uuid.UUID, contacts ...*contact.Contact) ([]*contact.Contact, error)
 	DeleteContactFromGroup(ctx context.Context, groupID, contactID uuid.UUID) error
 	AddContactsToGroup(ctx context.Context, groupID uuid.UUID, contactIDs ...uuid.UUID) error
+
+	CustomFieldReader
+	OrganizationReader
+}
+
+type CustomField interface {
+	CreateCustomField(ctx context.Context, field *customField.CustomField) (*customField.CustomField, error)
+	UpdateCustomField(ctx context.Context, ID uuid.UUID, updateFn func(field *customField.CustomField) (*customField.CustomField, error)) (*customField.CustomField, error)
+	DeleteCustomField(ctx context.Context, ID uuid.UUID) error
+
+	CustomFieldReader
+}
+
+type CustomFieldReader interface {
+	ListCustomField(ctx context.Context, parameter queryParameter.QueryParameter) ([]*customField.CustomField, error)
+	ReadCustomFieldByID(ctx context.Context, ID uuid.UUID) (*customField.CustomField, error)
+	CountCustomField(ctx context.Context) (uint64, error)
+	// ReadCustomFieldRegistry возвращает все действующие описания полей
+	ReadCustomFieldRegistry(ctx context.Context) (customField.Registry, error)
+}
+
+type Tag interface {
+	// UpdateTag при переименовании у контактов с тегом появляются новая версия и событие contact.updated
+	UpdateTag(ctx context.Context, ID uuid.UUID, updateFn func(t *tag.Tag) (*tag.Tag, error)) (*tag.Tag, error)
+	// MergeTags у контактов исходных тегов появляются новая версия и событие contact.updated
+	MergeTags(ctx context.Context, targetID uuid.UUID, sourceIDs ...uuid.UUID) (*tag.Tag, error)
+
+	TagReader
+}
+
+type TagReader interface {
+	ListTag(ctx context.Context, parameter queryParameter.QueryParameter) ([]*tag.Tag, error)
+	ReadTagByID(ctx context.Context, ID uuid.UUID) (*tag.Tag, error)
+	CountTag(ctx context.Context) (uint64, error)
+}
+
+type Organization interface {
+	CreateOrganization(ctx context.Context, org *organization.Organization) (*organization.Organization, error)
+	// UpdateOrganization при переименовании у сотрудников организации появляются новая версия и событие contact.updated
+	UpdateOrganization(ctx context.Context, ID uuid.UUID, updateFn func(org *organization.Organization) (*organization.Organization, error)) (*organization.Organization, error)
+	// DeleteOrganization архивирует организацию и отвязывает от неё контакты, как и UpdateOrganization, с версией и событием
+	DeleteOrganization(ctx context.Context, ID uuid.UUID) error
+
+	OrganizationReader
+}
+
+type OrganizationReader interface {
+	ListOrganization(ctx context.Context, parameter queryParameter.QueryParameter) ([]*organization.Organization, error)
+	ReadOrganizationByID(ctx context.Context, ID uuid.UUID) (*organization.Organization, error)
+	CountOrganization(ctx context.Context) (uint64, error)
+}
+
+type Note interface {
+	CreateNote(ctx context.Context, n *note.Note) (*note.Note, error)
+	UpdateNote(ctx context.Context, contactID, ID uuid.UUID, updateFn func(n *note.Note) (*note.Note, error)) (*note.Note, error)
+	DeleteNote(ctx context.Context, contactID, ID uuid.UUID) error
+
+	NoteReader
+}
+
+type NoteReader interface {
+	// ListNote лента контакта, новые записи первыми
+	ListNote(ctx context.Context, contactID uuid.UUID, parameter queryParameter.QueryParameter) ([]*note.Note, error)
+	ReadNoteByID(ctx context.Context, contactID, ID uuid.UUID) (*note.Note, error)
+	CountNote(ctx context.Context, contactID uuid.UUID) (uint64, error)
+}
+
+// Audit записи журнала создаются методами изменения в их транзакциях, поэтому порт только читает журнал
+type Audit interface {
+	// ListAudit новые записи первыми
+	ListAudit(ctx context.Context, parameter queryParameter.QueryParameter) ([]*audit.Entry, error)
+	CountAudit(ctx context.Context, filters filter.Filters) (uint64, error)
+}
+
+// Outbox события записываются методами изменения в их транзакциях, порт только выдаёт их на доставку
+type Outbox interface {
+	// ProcessOutbox передаёт processFn готовые к отправке сообщения, не более одного на агрегат,
+	// и сохраняет их состояние после вызова. processFn вызывается вне транзакции, отложенные
+	// сообщения не выдаются. Возвращает количество обработанных сообщений.
+	ProcessOutbox(ctx context.Context, limit uint64, processFn func(messages []*outbox.Message)) (uint64, error)
+
+	OutboxReader
+}
+
+// OutboxReader чтение журнала событий по номеру, независимо от доставки брокеру
+type OutboxReader interface {
+	// ListOutboxAfter до limit событий, следующих за событием с номером after. Номера фиксируются
+	// не по порядку, поэтому порядок ленты -- порядок транзакций, а не номеров, но курсор after
+	// по-прежнему номер последнего прочитанного события. С useCase.WithVisibility -- только события
+	// видимых контактов и групп. outbox.ErrEventExpired, если события after нет
+	ListOutboxAfter(ctx context.Context, after int64, limit uint64) ([]*outbox.Message, error)
+	// LastOutboxSequence номер последнего события в порядке ListOutboxAfter, 0 если событий нет
+	LastOutboxSequence(ctx context.Context) (int64, error)
+}
+
+// Delta чтение изменений контактов и групп для синхронизации клиентов
+type Delta interface {
+	// ListDelta до limit записей, изменённых после since, с токеном следующей страницы.
+	// delta.ErrTokenExpired, если события since нет или после since изменилась видимость записей для автора запроса
+	ListDelta(ctx context.Context, since delta.Token, limit uint64) (*delta.Delta, error)
+}
+
+type Webhook interface {
+	CreateWebhook(ctx context.Context, hook *webhook.Webhook) (*webhook.Webhook, error)
+	UpdateWebhook(ctx context.Context, ID uuid.UUID, updateFn func(hook *webhook.Webhook) (*webhook.Webhook, error)) (*webhook.Webhook, error)
+	DeleteWebhook(ctx context.Context, ID uuid.UUID) error
+
+	UpdateWebhookDelivery(ctx context.Context, webhookID, ID uuid.UUID, updateFn func(delivery *webhook.Delivery) (*webhook.Delivery, error)) (*webhook.Delivery, error)
+	// ProcessWebhookDelivery передаёт processFn доставки, которым пора уходить, вместе с их подписками
+	// и сохраняет состояние доставок после вызова. processFn вызывается вне транзакции, пока он работает,
+	// другие экземпляры эти доставки не получают. Возвращает количество обработанных доставок.
+	ProcessWebhookDelivery(ctx context.Context, limit uint64, processFn func(deliveries []*webhook.Delivery, webhooks map[uuid.UUID]*webhook.Webhook)) (uint64, error)
+
+	WebhookReader
+}
+
+type WebhookReader interface {
+	ListWebhook(ctx context.Context, parameter queryParameter.QueryParameter) ([]*webhook.Webhook, error)
+	ReadWebhookByID(ctx context.Context, ID uuid.UUID) (*webhook.Webhook, error)
+	CountWebhook(ctx context.Context) (uint64, error)
+
+	// ListWebhookDelivery журнал доставок подписки, новые первыми
+	ListWebhookDelivery(ctx context.Context, webhookID uuid.UUID, parameter queryParameter.QueryParameter) ([]*webhook.Delivery, error)
+	CountWebhookDelivery(ctx context.Context, webhookID uuid.UUID, filters filter.Filters) (uint64, error)
+}
+
+// Tenant арендаторы и их квоты. Таблица арендаторов общая, под разделение по арендаторам она не попадает.
+type Tenant interface {
+	CreateTenant(ctx context.Context, value *tenant.Tenant) (*tenant.Tenant, error)
+	UpdateTenant(ctx context.Context, ID string, updateFn func(value *tenant.Tenant) (*tenant.Tenant, error)) (*tenant.Tenant, error)
+
+	TenantReader
+}
+
+type TenantReader interface {
+	ListTenant(ctx context.Context, parameter queryParameter.QueryParameter) ([]*tenant.Tenant, error)
+	ReadTenantByID(ctx context.Context, ID string) (*tenant.Tenant, error)
+	CountTenant(ctx context.Context) (uint64, error)
+	// ReadTenantUsage сколько неархивных контактов и групп у арендатора ID
+	ReadTenantUsage(ctx context.Context, ID string) (*tenant.Usage, error)
+}
+
+// Usage потребление всех арендаторов вместе, для метрик сервиса
+type Usage interface {
+	// ReadUsage сколько неархивных контактов и групп во всех арендаторах
+	ReadUsage(ctx context.Context) (*tenant.Usage, error)
+}
+
+// Auth ключи доступа и сессии; ключи и токены хранятся только в виде хешей
+type Auth interface {
+	CreateAPIKey(ctx context.Context, key *apiKey.APIKey) (*apiKey.APIKey, error)
+	RevokeAPIKey(ctx context.Context, subject string, ID uuid.UUID) error
+	TouchAPIKey(ctx context.Context, ID uuid.UUID, usedAt time.Time) error
+	ListAPIKey(ctx context.Context, subject string, parameter queryParameter.QueryParameter) ([]*apiKey.APIKey, error)
+	CountAPIKey(ctx context.Context, subject string) (uint64, error)
+	ReadAPIKeyByHash(ctx context.Context, hash []byte) (*apiKey.APIKey, error)
+
+	CreateSession(ctx context.Context, value *session.Session) (*session.Session, error)
+	ReadSessionByHash(ctx context.Context, hash []byte) (*session.Session, error)
+	DeleteSession(ctx context.Context, hash []byte) error
 }
@@ -0,0 +1,13 @@
+package token
+
+import (
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+)
+
+// Verifier проверяет bearer-токен, выданный внешним сервером авторизации
+type Verifier interface {
+	// Verify возвращает автора запроса из проверенного токена.
+	// Ошибка означает неверную подпись, истёкший или неподходящий токен.
+	Verify(ctx context.Context, token string) (*principal.Principal, error)
+}
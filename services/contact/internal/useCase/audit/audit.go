@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/audit"
+)
+
+const (
+	filterEntity   = "entity"
+	filterEntityID = "entityId"
+)
+
+func (uc *UseCase) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*audit.Entry, error) {
+	return uc.adapterStorage.ListAudit(ctx, parameter)
+}
+
+func (uc *UseCase) Count(ctx context.Context, filters filter.Filters) (uint64, error) {
+	return uc.adapterStorage.CountAudit(ctx, filters)
+}
+
+func (uc *UseCase) History(ctx context.Context, contactID uuid.UUID, parameter queryParameter.QueryParameter) ([]*audit.Entry, error) {
+	parameter.Filters = historyFilters(contactID, parameter.Filters)
+	return uc.adapterStorage.ListAudit(ctx, parameter)
+}
+
+func (uc *UseCase) CountHistory(ctx context.Context, contactID uuid.UUID, filters filter.Filters) (uint64, error) {
+	return uc.adapterStorage.CountAudit(ctx, historyFilters(contactID, filters))
+}
+
+// historyFilters фильтры по сущности заменяются на контакт, остальные сохраняются
+func historyFilters(contactID uuid.UUID, filters filter.Filters) filter.Filters {
+	var result = filter.Filters{
+		{Key: filterEntity, Values: []string{audit.EntityContact.String()}},
+		{Key: filterEntityID, Values: []string{contactID.String()}},
+	}
+	for _, f := range filters {
+		if f.Key == filterEntity || f.Key == filterEntityID {
+			continue
+		}
+		result = append(result, f)
+	}
+	return result
+}
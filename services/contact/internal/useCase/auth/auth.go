@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/tools/secret"
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/apiKey"
+	"architecture_go/services/contact/internal/domain/session"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// lookupContext арендатор запроса становится известен только из учётных данных,
+// поэтому ключ и сессия ищутся среди всех арендаторов
+func lookupContext(c context.Context) context.Context {
+	var ctx = c.Copy()
+	ctx.WithValue(context.KeyTenant, context.TenantAll)
+	return ctx
+}
+
+// schemeBearer схема заголовка Authorization
+const schemeBearer = "Bearer"
+
+// Authenticate проверяет учётные данные в порядке: Authorization, ключ доступа, сессия.
+// Переданные, но неверные учётные данные -- всегда ErrUnauthenticated, даже если разрешены анонимные запросы.
+func (uc *UseCase) Authenticate(c context.Context, credentials useCase.Credentials) (*principal.Principal, error) {
+	result, err := uc.authenticate(c, credentials)
+	if errors.Is(err, useCase.ErrNoCredentials) && uc.options.Anonymous {
+		return nil, nil
+	}
+	return result, err
+}
+
+func (uc *UseCase) authenticate(c context.Context, credentials useCase.Credentials) (*principal.Principal, error) {
+	if credentials.Authorization != "" {
+		scheme, value, found := strings.Cut(credentials.Authorization, " ")
+		if !found || !strings.EqualFold(scheme, schemeBearer) || strings.TrimSpace(value) == "" {
+			return nil, useCase.ErrUnauthenticated
+		}
+		value = strings.TrimSpace(value)
+
+		// ключ доступа можно передать и как bearer-токен, ключи узнаются по префиксу
+		if strings.HasPrefix(value, apiKey.Prefix) {
+			return uc.authenticateAPIKey(c, value)
+		}
+
+		if !uc.methods[principal.MethodToken] {
+			return nil, useCase.ErrUnauthenticated
+		}
+		return uc.AuthenticateToken(c, value)
+	}
+
+	if credentials.APIKey != "" {
+		return uc.authenticateAPIKey(c, credentials.APIKey)
+	}
+
+	if uc.methods[principal.MethodSession] && credentials.Session != "" {
+		return uc.AuthenticateSession(c, credentials.Session)
+	}
+
+	return nil, useCase.ErrNoCredentials
+}
+
+func (uc *UseCase) authenticateAPIKey(c context.Context, value string) (*principal.Principal, error) {
+	if !uc.methods[principal.MethodAPIKey] {
+		return nil, useCase.ErrUnauthenticated
+	}
+	return uc.AuthenticateAPIKey(c, value)
+}
+
+// AuthenticateToken автор запроса по bearer-токену
+func (uc *UseCase) AuthenticateToken(c context.Context, value string) (*principal.Principal, error) {
+	if uc.adapterVerifier == nil {
+		return nil, useCase.ErrUnauthenticated
+	}
+
+	result, err := uc.adapterVerifier.Verify(c, value)
+	if err != nil {
+		log.WarnWithContext(c, "bearer token rejected", zap.Error(err))
+		return nil, useCase.ErrUnauthenticated
+	}
+
+	return result, nil
+}
+
+// AuthenticateAPIKey автор запроса по ключу доступа
+func (uc *UseCase) AuthenticateAPIKey(c context.Context, value string) (*principal.Principal, error) {
+	var ctx = lookupContext(c)
+
+	key, err := uc.adapterStorage.ReadAPIKeyByHash(ctx, secret.Hash(value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrAPIKeyNotFound) {
+			return nil, useCase.ErrUnauthenticated
+		}
+		return nil, err
+	}
+
+	var now = time.Now().UTC()
+	if !key.Active(now) {
+		return nil, useCase.ErrUnauthenticated
+	}
+
+	if now.Sub(key.LastUsedAt()) >= uc.options.TouchInterval {
+		// неудачная отметка не должна мешать запросу
+		if err = uc.adapterStorage.TouchAPIKey(ctx, key.ID(), now); err != nil {
+			_ = log.ErrorWithContext(c, err)
+		}
+	}
+
+	return key.Principal(), nil
+}
+
+// AuthenticateSession автор запроса по токену сессии из cookie
+func (uc *UseCase) AuthenticateSession(c context.Context, value string) (*principal.Principal, error) {
+	stored, err := uc.adapterStorage.ReadSessionByHash(lookupContext(c), secret.Hash(value))
+	if err != nil {
+		if errors.Is(err, useCase.ErrSessionNotFound) {
+			return nil, useCase.ErrUnauthenticated
+		}
+		return nil, err
+	}
+
+	if stored.Expired(time.Now()) {
+		return nil, useCase.ErrUnauthenticated
+	}
+
+	return stored.Principal(), nil
+}
+
+// CreateSession открывает сессию для уже аутентифицированного автора запроса
+func (uc *UseCase) CreateSession(c context.Context, p principal.Principal) (*session.Session, error) {
+	value, err := session.New(p, uc.options.SessionTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.adapterStorage.CreateSession(c, value)
+}
+
+// DeleteSession закрывает сессию по токену; закрытие несуществующей сессии не ошибка
+func (uc *UseCase) DeleteSession(c context.Context, value string) error {
+	return uc.adapterStorage.DeleteSession(c, secret.Hash(value))
+}
+
+// CreateAPIKey выпускает ключ для автора запроса. Роли ключа -- подмножество ролей автора,
+// пустой список ролей -- все роли автора
+func (uc *UseCase) CreateAPIKey(c context.Context, p principal.Principal, name string, roles []string, expiresAt time.Time) (*apiKey.APIKey, error) {
+	// ключ без арендатора по запросу мог бы выбрать любого: автор запроса должен быть уже привязан к арендатору
+	if p.Tenant == "" || p.Tenant == context.TenantAll {
+		return nil, apiKey.ErrWrongTenant
+	}
+
+	if len(roles) == 0 {
+		roles = p.Roles
+	}
+
+	for _, role := range roles {
+		if !p.HasRole(role) {
+			return nil, useCase.ErrRoleNotGranted
+		}
+	}
+
+	// ключ, выпущенный по другому ключу или сессии, не переживает их. Срок bearer-токена
+	// не учитывается: токены короткие, ключи выпускают как раз для долгой работы
+	if !p.ExpiresAt.IsZero() && p.Method != principal.MethodToken && (expiresAt.IsZero() || expiresAt.After(p.ExpiresAt)) {
+		expiresAt = p.ExpiresAt
+	}
+
+	key, err := apiKey.New(name, p.Subject, p.Tenant, roles, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.adapterStorage.CreateAPIKey(c, key)
+}
+
+func (uc *UseCase) RevokeAPIKey(c context.Context, subject string, ID uuid.UUID) error {
+	return uc.adapterStorage.RevokeAPIKey(c, subject, ID)
+}
+
+func (uc *UseCase) ListAPIKey(c context.Context, subject string, parameter queryParameter.QueryParameter) ([]*apiKey.APIKey, error) {
+	return uc.adapterStorage.ListAPIKey(c, subject, parameter)
+}
+
+func (uc *UseCase) CountAPIKey(c context.Context, subject string) (uint64, error) {
+	return uc.adapterStorage.CountAPIKey(c, subject)
+}
@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/contact/internal/domain/apiKey"
+	mockStorage "architecture_go/services/contact/internal/repository/storage/mock"
+	mockToken "architecture_go/services/contact/internal/repository/token/mock"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func TestAuthenticate(t *testing.T) {
+	var (
+		ctx          = context.Empty()
+		verifierMock = new(mockToken.Verifier)
+		caller       = &principal.Principal{Subject: "alice", Tenant: "acme", Method: principal.MethodToken}
+	)
+	verifierMock.On("Verify", mock.Anything, "valid").Return(caller, nil)
+	verifierMock.On("Verify", mock.Anything, "forged").Return(nil, assert.AnError)
+
+	var uc = New(new(mockStorage.Auth), verifierMock, Options{})
+
+	result, err := uc.Authenticate(ctx, useCase.Credentials{Authorization: "Bearer valid"})
+	assert.NoError(t, err)
+	assert.Equal(t, caller, result)
+
+	_, err = uc.Authenticate(ctx, useCase.Credentials{})
+	assert.ErrorIs(t, err, useCase.ErrNoCredentials)
+
+	_, err = uc.Authenticate(ctx, useCase.Credentials{Authorization: "Basic valid"})
+	assert.ErrorIs(t, err, useCase.ErrUnauthenticated)
+
+	// анонимный запрос разрешён, но неверные учётные данные всё равно отклоняются
+	uc.SetOptions(Options{Anonymous: true})
+
+	result, err = uc.Authenticate(ctx, useCase.Credentials{})
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	_, err = uc.Authenticate(ctx, useCase.Credentials{Authorization: "Bearer forged"})
+	assert.ErrorIs(t, err, useCase.ErrUnauthenticated)
+
+	// выключенный способ аутентификации не принимается
+	uc.SetOptions(Options{Methods: string(principal.MethodAPIKey)})
+
+	_, err = uc.Authenticate(ctx, useCase.Credentials{Authorization: "Bearer valid"})
+	assert.ErrorIs(t, err, useCase.ErrUnauthenticated)
+}
+
+func TestCreateAPIKeyWithoutTenant(t *testing.T) {
+	var (
+		storageMock = new(mockStorage.Auth)
+		uc          = New(storageMock, nil, Options{})
+		caller      = principal.Principal{Subject: "bob", Roles: []string{"admin"}}
+	)
+
+	_, err := uc.CreateAPIKey(context.Empty(), caller, "ci", nil, time.Time{})
+	assert.ErrorIs(t, err, apiKey.ErrWrongTenant)
+	storageMock.AssertNotCalled(t, "CreateAPIKey", mock.Anything, mock.Anything)
+}
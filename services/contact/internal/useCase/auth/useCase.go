@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/contact/internal/useCase/adapters/storage"
+	"architecture_go/services/contact/internal/useCase/adapters/token"
+)
+
+type UseCase struct {
+	adapterStorage storage.Auth
+	// adapterVerifier nil -- вход по bearer-токену выключен
+	adapterVerifier token.Verifier
+	options         Options
+
+	// methods включённые способы аутентификации из options.Methods
+	methods map[principal.Method]bool
+}
+
+type Options struct {
+	// SessionTTL время жизни сессии браузера
+	SessionTTL time.Duration
+	// TouchInterval как часто обновлять время последнего использования ключа:
+	// запись на каждый запрос не нужна
+	TouchInterval time.Duration
+	// Methods включённые способы аутентификации через запятую: token, apiKey, session
+	Methods string
+	// Anonymous разрешены запросы без учётных данных
+	Anonymous bool
+}
+
+func New(storage storage.Auth, verifier token.Verifier, options Options) *UseCase {
+	var uc = &UseCase{
+		adapterStorage:  storage,
+		adapterVerifier: verifier,
+	}
+	uc.SetOptions(options)
+	return uc
+}
+
+func (uc *UseCase) SetOptions(options Options) {
+	if options.SessionTTL == 0 {
+		options.SessionTTL = time.Hour * 12
+		log.Debug("set default options.SessionTTL", zap.Any("sessionTTL", options.SessionTTL))
+	}
+
+	if options.TouchInterval == 0 {
+		options.TouchInterval = time.Minute
+		log.Debug("set default options.TouchInterval", zap.Any("touchInterval", options.TouchInterval))
+	}
+
+	if options.Methods == "" {
+		options.Methods = strings.Join([]string{string(principal.MethodToken), string(principal.MethodAPIKey), string(principal.MethodSession)}, ",")
+		log.Debug("set default options.Methods", zap.Any("methods", options.Methods))
+	}
+
+	if uc.options != options {
+		uc.options = options
+		log.Info("set new options", zap.Any("options", uc.options))
+	}
+
+	uc.methods = make(map[principal.Method]bool)
+	for _, value := range strings.Split(options.Methods, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			uc.methods[principal.Method(value)] = true
+		}
+	}
+}
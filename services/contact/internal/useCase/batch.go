@@ -0,0 +1,32 @@
+package useCase
+
+import (
+	"architecture_go/services/contact/internal/domain/contact"
+)
+
+type BatchMode uint8
+
+const (
+	// BatchModeAtomic -- пакет сохраняется целиком либо не сохраняется совсем
+	BatchModeAtomic BatchMode = iota
+	// BatchModeBestEffort -- сохраняются только корректные записи пакета
+	BatchModeBestEffort
+)
+
+func (m BatchMode) String() string {
+	switch m {
+	case BatchModeBestEffort:
+		return "bestEffort"
+	default:
+		return "atomic"
+	}
+}
+
+// BatchItem
+// Элемент пакетного создания контактов. Err заполняется доставкой при ошибке валидации
+// или сценарием при ошибке сохранения, Created -- после успешной вставки.
+type BatchItem struct {
+	Contact *contact.Contact
+	Err     error
+	Created bool
+}
@@ -7,17 +7,166 @@ import (
 	"github.com/opentracing/opentracing-go"
 
 	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
 	"architecture_go/pkg/type/queryParameter"
 	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/customField"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/organization"
+	tagName "architecture_go/services/contact/internal/domain/tag/name"
+	"architecture_go/services/contact/internal/domain/version"
+	"architecture_go/services/contact/internal/useCase"
 )
 
 func (uc *UseCase) Create(ctx context.Context, contacts ...*contact.Contact) ([]*contact.Contact, error) {
-	return uc.adapterStorage.CreateContact(ctx, contacts...)
+	registry, err := uc.adapterStorage.ReadCustomFieldRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range contacts {
+		if err = c.CheckCustomFields(registry); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = uc.checkEmployment(ctx, contacts...); err != nil {
+		return nil, err
+	}
+
+	own(ctx, contacts...)
+
+	created, err := uc.adapterStorage.CreateContact(ctx, contacts...)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, contactCreated(created...)...)
+	return created, nil
+}
+
+// own контакт без владельца достаётся автору запроса
+func own(ctx context.Context, contacts ...*contact.Contact) {
+	var owner = useCase.Owner(ctx)
+	for _, c := range contacts {
+		if c.Owner() == "" {
+			c.SetOwner(owner)
+		}
+	}
+}
+
+// CreateBatch
+// Сохраняет пакет контактов одной транзакцией. В режиме BatchModeAtomic любая ошибка
+// валидации отклоняет весь пакет, в режиме BatchModeBestEffort сохраняются только корректные записи.
+func (uc *UseCase) CreateBatch(ctx context.Context, mode useCase.BatchMode, items ...*useCase.BatchItem) ([]*useCase.BatchItem, error) {
+	if len(items) == 0 {
+		return nil, useCase.ErrBatchEmpty
+	}
+
+	if len(items) > uc.options.MaxBatchSize {
+		return nil, useCase.ErrBatchTooLarge
+	}
+
+	registry, err := uc.adapterStorage.ReadCustomFieldRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		valid    = make([]*useCase.BatchItem, 0, len(items))
+		contacts = make([]*contact.Contact, 0, len(items))
+	)
+	for _, item := range items {
+		if item.Err == nil {
+			item.Err = item.Contact.CheckCustomFields(registry)
+		}
+
+		if item.Err == nil {
+			item.Err = uc.checkEmployment(ctx, item.Contact)
+		}
+
+		if item.Err != nil {
+			if mode == useCase.BatchModeAtomic {
+				return items, useCase.ErrBatchRejected
+			}
+			continue
+		}
+
+		valid = append(valid, item)
+		contacts = append(contacts, item.Contact)
+	}
+
+	if len(contacts) == 0 {
+		return items, nil
+	}
+
+	own(ctx, contacts...)
+
+	created, err := uc.adapterStorage.CreateContact(ctx, contacts...)
+	if err != nil {
+		for _, item := range valid {
+			item.Err = err
+		}
+		return items, err
+	}
+
+	for i, item := range valid {
+		item.Contact = created[i]
+		item.Created = true
+	}
+
+	uc.publish(ctx, contactCreated(created...)...)
+	return items, nil
 }
 
 func (uc *UseCase) Update(ctx context.Context, contactUpdate contact.Contact) (*contact.Contact, error) {
-	return uc.adapterStorage.UpdateContact(ctx, contactUpdate.ID(), func(oldContact *contact.Contact) (*contact.Contact, error) {
-		return contact.NewWithID(
+	registry, err := uc.adapterStorage.ReadCustomFieldRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = uc.checkEmployment(ctx, &contactUpdate); err != nil {
+		return nil, err
+	}
+
+	response, err := uc.adapterStorage.UpdateContact(ctx, contactUpdate.ID(), replaceFn(contactUpdate, registry))
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, event.NewContactUpdated(response))
+	return response, nil
+}
+
+// Revert теги и фотография не входят в изменяемые поля контакта и не возвращаются
+func (uc *UseCase) Revert(ctx context.Context, ID uuid.UUID, number uint64) (*contact.Contact, error) {
+	target, err := uc.adapterStorage.ReadContactVersion(ctx, ID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := uc.adapterStorage.ReadCustomFieldRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = uc.checkEmployment(ctx, target); err != nil {
+		return nil, err
+	}
+
+	response, err := uc.adapterStorage.UpdateContact(ctx, ID, replaceFn(*target, registry))
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, event.NewContactUpdated(response))
+	return response, nil
+}
+
+// replaceFn функция изменения контакта для UpdateContact: изменяемые поля берутся из contactUpdate
+func replaceFn(contactUpdate contact.Contact, registry customField.Registry) func(oldContact *contact.Contact) (*contact.Contact, error) {
+	return func(oldContact *contact.Contact) (*contact.Contact, error) {
+		newContact, err := contact.NewWithID(
 			oldContact.ID(),
 			oldContact.CreatedAt(),
 			time.Now().UTC(),
@@ -29,11 +178,59 @@ func (uc *UseCase) Update(ctx context.Context, contactUpdate contact.Contact) (*
 			contactUpdate.Age(),
 			contactUpdate.Gender(),
 		)
-	})
+		if err != nil {
+			return nil, err
+		}
+
+		newContact.SetBirthday(contactUpdate.Birthday())
+		newContact.SetAddresses(contactUpdate.Addresses()...)
+		newContact.SetEmployment(contactUpdate.Employment())
+		newContact.SetCustomFields(contactUpdate.CustomFields())
+		if err = newContact.CheckCustomFields(registry); err != nil {
+			return nil, err
+		}
+
+		return newContact, nil
+	}
 }
 
 func (uc *UseCase) Delete(ctx context.Context, ID uuid.UUID) error {
-	return uc.adapterStorage.DeleteContact(ctx, ID)
+	if err := uc.adapterStorage.DeleteContact(ctx, ID); err != nil {
+		return err
+	}
+
+	uc.publish(ctx, event.NewContactArchived(ID))
+	return nil
+}
+
+func (uc *UseCase) Restore(ctx context.Context, ID uuid.UUID) (*contact.Contact, error) {
+	response, err := uc.adapterStorage.RestoreContact(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, event.NewContactRestored(response))
+	return response, nil
+}
+
+func (uc *UseCase) AddTags(ctx context.Context, contactID uuid.UUID, tags ...tagName.Name) (*contact.Contact, error) {
+	response, err := uc.adapterStorage.AddContactTags(ctx, contactID, tags...)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, event.NewContactUpdated(response))
+	return response, nil
+}
+
+func (uc *UseCase) RemoveTags(ctx context.Context, contactID uuid.UUID, tags ...tagName.Name) (*contact.Contact, error) {
+	response, err := uc.adapterStorage.RemoveContactTags(ctx, contactID, tags...)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, event.NewContactUpdated(response))
+	return response, nil
 }
 
 func (uc *UseCase) List(c context.Context, parameter queryParameter.QueryParameter) ([]*contact.Contact, error) {
@@ -49,9 +246,67 @@ func (uc *UseCase) ReadByID(ctx context.Context, ID uuid.UUID) (response *contac
 	return uc.adapterStorage.ReadContactByID(ctx, ID)
 }
 
-func (uc *UseCase) Count(c context.Context) (uint64, error) {
+func (uc *UseCase) Count(c context.Context, filters filter.Filters) (uint64, error) {
 	span, ctx := opentracing.StartSpanFromContext(c, "Count")
 	defer span.Finish()
 
-	return uc.adapterStorage.CountContact(context.New(ctx))
+	return uc.adapterStorage.CountContact(context.New(ctx), filters)
+}
+
+func (uc *UseCase) ListBirthday(c context.Context, from, to time.Time, parameter queryParameter.QueryParameter) ([]*contact.Contact, error) {
+	span, ctx := opentracing.StartSpanFromContext(c, "ListBirthday")
+	defer span.Finish()
+
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	if to.Before(from) {
+		return nil, useCase.ErrWrongPeriod
+	}
+
+	return uc.adapterStorage.ListContactBirthday(context.New(ctx), from, to, parameter)
+}
+
+func (uc *UseCase) ReadByIDAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*contact.Contact, error) {
+	return uc.adapterStorage.ReadContactAsOf(ctx, ID, asOf)
+}
+
+func (uc *UseCase) ListVersion(ctx context.Context, ID uuid.UUID, parameter queryParameter.QueryParameter) ([]*version.Version, error) {
+	return uc.adapterStorage.ListContactVersion(ctx, ID, parameter)
+}
+
+func (uc *UseCase) CountVersion(ctx context.Context, ID uuid.UUID) (uint64, error) {
+	return uc.adapterStorage.CountContactVersion(ctx, ID)
+}
+
+// checkEmployment проверяет, что организации контактов существуют, и заполняет их названия
+func (uc *UseCase) checkEmployment(ctx context.Context, contacts ...*contact.Contact) error {
+	var organizations = make(map[uuid.UUID]*organization.Organization)
+
+	for _, c := range contacts {
+		if !c.Employment().HasOrganization() {
+			continue
+		}
+
+		var ID = c.Employment().OrganizationID()
+		org, ok := organizations[ID]
+		if !ok {
+			var err error
+			if org, err = uc.adapterStorage.ReadOrganizationByID(ctx, ID); err != nil {
+				return err
+			}
+			organizations[ID] = org
+		}
+
+		c.SetEmployment(c.Employment().WithOrganizationName(org.Name().Value()))
+	}
+
+	return nil
+}
+
+func contactCreated(contacts ...*contact.Contact) []event.Event {
+	var result = make([]event.Event, len(contacts))
+	for i, c := range contacts {
+		result[i] = event.NewContactCreated(c)
+	}
+	return result
 }
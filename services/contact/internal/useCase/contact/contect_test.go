@@ -3,6 +3,7 @@ package contact
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -17,12 +18,16 @@ import (
 	"architecture_go/services/contact/internal/domain/contact/name"
 	"architecture_go/services/contact/internal/domain/contact/patronymic"
 	"architecture_go/services/contact/internal/domain/contact/surname"
+	"architecture_go/services/contact/internal/domain/customField"
+	"architecture_go/services/contact/internal/domain/event"
+	mockPublisher "architecture_go/services/contact/internal/repository/event/mock"
 	mockStorage "architecture_go/services/contact/internal/repository/storage/mock"
 	"architecture_go/services/contact/internal/useCase"
 )
 
 var (
 	storageRepository = new(mockStorage.Contact)
+	publisherMock     = new(mockPublisher.Publisher)
 	ucDialog          *UseCase
 	data              = make(map[uuid.UUID]*contact.Contact)
 	createContacts    []*contact.Contact
@@ -50,6 +55,9 @@ func TestMain(m *testing.M) {
 
 func initTestUseCaseContact(t *testing.T) {
 	assertion := assert.New(t)
+	publisherMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	storageRepository.On("ReadCustomFieldRegistry", mock.Anything).
+		Return(customField.Registry{}, nil)
 	storageRepository.On("CreateContact",
 		mock.Anything,
 		mock.Anything).
@@ -91,7 +99,7 @@ func initTestUseCaseContact(t *testing.T) {
 func TestContact(t *testing.T) {
 
 	initTestUseCaseContact(t)
-	ucDialog = New(storageRepository, Options{})
+	ucDialog = New(storageRepository, publisherMock, Options{})
 
 	assertion := assert.New(t)
 	t.Run("create contact", func(t *testing.T) {
@@ -100,6 +108,9 @@ func TestContact(t *testing.T) {
 		result, err := ucDialog.Create(ctx, createContacts...)
 		assertion.NoError(err)
 		assertion.Equal(result, createContacts)
+		publisherMock.AssertCalled(t, "Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+			return e.Name() == event.NameContactCreated && e.AggregateID() == createContacts[0].ID()
+		}))
 	})
 
 	t.Run("get contact", func(t *testing.T) {
@@ -110,3 +121,175 @@ func TestContact(t *testing.T) {
 		assertion.Equal(result, createContacts[0])
 	})
 }
+
+func TestContactBatch(t *testing.T) {
+	assertion := assert.New(t)
+
+	batchStorage := new(mockStorage.Contact)
+	batchStorage.On("ReadCustomFieldRegistry", mock.Anything).
+		Return(customField.Registry{}, nil)
+	batchStorage.On("CreateContact", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, contacts ...*contact.Contact) []*contact.Contact {
+			return contacts
+		}, func(ctx context.Context, contacts ...*contact.Contact) error {
+			return nil
+		})
+	ucBatch := New(batchStorage, publisherMock, Options{MaxBatchSize: 2})
+
+	t.Run("atomic batch with invalid contact is rejected", func(t *testing.T) {
+		items := []*useCase.BatchItem{
+			{Contact: createContacts[0]},
+			{Err: contact.ErrPhoneNumberRequired},
+		}
+
+		result, err := ucBatch.CreateBatch(context.Empty(), useCase.BatchModeAtomic, items...)
+		assertion.ErrorIs(err, useCase.ErrBatchRejected)
+		assertion.False(result[0].Created)
+		batchStorage.AssertNotCalled(t, "CreateContact", mock.Anything, mock.Anything)
+	})
+
+	t.Run("best effort batch saves valid contacts", func(t *testing.T) {
+		items := []*useCase.BatchItem{
+			{Contact: createContacts[0]},
+			{Err: contact.ErrPhoneNumberRequired},
+		}
+
+		result, err := ucBatch.CreateBatch(context.Empty(), useCase.BatchModeBestEffort, items...)
+		assertion.NoError(err)
+		assertion.True(result[0].Created)
+		assertion.Equal(createContacts[0], result[0].Contact)
+		assertion.False(result[1].Created)
+		assertion.ErrorIs(result[1].Err, contact.ErrPhoneNumberRequired)
+	})
+
+	t.Run("batch larger than limit", func(t *testing.T) {
+		_, err := ucBatch.CreateBatch(context.Empty(), useCase.BatchModeBestEffort,
+			&useCase.BatchItem{}, &useCase.BatchItem{}, &useCase.BatchItem{})
+		assertion.ErrorIs(err, useCase.ErrBatchTooLarge)
+	})
+}
+
+func TestDeleteUnknownContact(t *testing.T) {
+	assertion := assert.New(t)
+
+	deleteStorage := new(mockStorage.Contact)
+	deletePublisher := new(mockPublisher.Publisher)
+	deleteStorage.On("DeleteContact", mock.Anything, mock.AnythingOfType("uuid.UUID")).
+		Return(useCase.ErrContactNotFound)
+	ucDelete := New(deleteStorage, deletePublisher, Options{})
+
+	err := ucDelete.Delete(context.Empty(), uuid.New())
+	assertion.ErrorIs(err, useCase.ErrContactNotFound)
+	deletePublisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
+
+func TestArchiveRestore(t *testing.T) {
+	assertion := assert.New(t)
+
+	var (
+		ID             = createContacts[0].ID()
+		unknownID      = uuid.New()
+		archiveStorage = new(mockStorage.Contact)
+		archivePublish = new(mockPublisher.Publisher)
+	)
+
+	archiveStorage.On("DeleteContact", mock.Anything, ID).Return(nil)
+	archiveStorage.On("DeleteContact", mock.Anything, unknownID).Return(useCase.ErrContactNotFound)
+	archiveStorage.On("RestoreContact", mock.Anything, ID).Return(createContacts[0], nil)
+	archiveStorage.On("RestoreContact", mock.Anything, unknownID).Return(nil, useCase.ErrContactNotFound)
+	archivePublish.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+	var uc = New(archiveStorage, archivePublish, Options{})
+
+	t.Run("archive unknown contact", func(t *testing.T) {
+		assertion.ErrorIs(uc.Delete(context.Empty(), unknownID), useCase.ErrContactNotFound)
+		archivePublish.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("restore unknown contact", func(t *testing.T) {
+		result, err := uc.Restore(context.Empty(), unknownID)
+		assertion.ErrorIs(err, useCase.ErrContactNotFound)
+		assertion.Nil(result)
+		archivePublish.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+
+	t.Run("archive contact", func(t *testing.T) {
+		assertion.NoError(uc.Delete(context.Empty(), ID))
+		archivePublish.AssertCalled(t, "Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+			return e.Name() == event.NameContactArchived && e.AggregateID() == ID
+		}))
+	})
+
+	t.Run("restore contact", func(t *testing.T) {
+		result, err := uc.Restore(context.Empty(), ID)
+		assertion.NoError(err)
+		assertion.Equal(createContacts[0], result)
+		archivePublish.AssertCalled(t, "Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+			return e.Name() == event.NameContactRestored && e.AggregateID() == ID
+		}))
+	})
+}
+
+func TestVersionHistory(t *testing.T) {
+	assertion := assert.New(t)
+
+	var (
+		ID = createContacts[0].ID()
+		at = time.Date(2023, 8, 1, 10, 30, 0, 0, time.UTC)
+	)
+
+	renamedName, _ := name.New("Пётр")
+	renamed, _ := contact.NewWithID(
+		ID,
+		createContacts[0].CreatedAt(),
+		at,
+		createContacts[0].PhoneNumber(),
+		createContacts[0].Email(),
+		*renamedName,
+		createContacts[0].Surname(),
+		createContacts[0].Patronymic(),
+		createContacts[0].Age(),
+		createContacts[0].Gender(),
+	)
+
+	var (
+		versionStorage = new(mockStorage.Contact)
+		versionPublish = new(mockPublisher.Publisher)
+	)
+	versionPublish.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	versionStorage.On("ReadCustomFieldRegistry", mock.Anything).Return(customField.Registry{}, nil)
+	versionStorage.On("ReadContactAsOf", mock.Anything, ID, at).Return(renamed, nil)
+	versionStorage.On("ReadContactVersion", mock.Anything, ID, uint64(1)).Return(createContacts[0], nil)
+	versionStorage.On("ReadContactVersion", mock.Anything, ID, uint64(9)).Return(nil, useCase.ErrVersionNotFound)
+	// хранилище применяет изменение к текущему состоянию контакта
+	versionStorage.On("UpdateContact", mock.Anything, ID, mock.Anything).
+		Return(func(ctx context.Context, ID uuid.UUID, updateFn func(c *contact.Contact) (*contact.Contact, error)) *contact.Contact {
+			result, _ := updateFn(renamed)
+			return result
+		}, nil)
+
+	var uc = New(versionStorage, versionPublish, Options{})
+
+	t.Run("as of", func(t *testing.T) {
+		result, err := uc.ReadByIDAsOf(context.Empty(), ID, at)
+		assertion.NoError(err)
+		assertion.Equal(renamed, result)
+	})
+
+	t.Run("revert to unknown version", func(t *testing.T) {
+		result, err := uc.Revert(context.Empty(), ID, 9)
+		assertion.ErrorIs(err, useCase.ErrVersionNotFound)
+		assertion.Nil(result)
+		versionStorage.AssertNotCalled(t, "UpdateContact", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("revert to version", func(t *testing.T) {
+		result, err := uc.Revert(context.Empty(), ID, 1)
+		assertion.NoError(err)
+		assertion.Equal(createContacts[0].Name(), result.Name())
+		assertion.Equal(createContacts[0].CreatedAt(), result.CreatedAt())
+		versionPublish.AssertCalled(t, "Publish", mock.Anything, mock.MatchedBy(func(e event.Event) bool {
+			return e.Name() == event.NameContactUpdated && e.AggregateID() == ID
+		}))
+	})
+}
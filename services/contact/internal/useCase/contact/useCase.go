@@ -3,29 +3,49 @@ package contact
 import (
 	"go.uber.org/zap"
 
+	"architecture_go/services/contact/internal/useCase/adapters/publisher"
 	"architecture_go/services/contact/internal/useCase/adapters/storage"
 
+	"architecture_go/pkg/type/context"
 	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/event"
 )
 
 type UseCase struct {
-	adapterStorage storage.Contact
-	options        Options
+	adapterStorage   storage.Contact
+	adapterPublisher publisher.Publisher
+	options          Options
 }
 
-type Options struct{}
+type Options struct {
+	// MaxBatchSize -- максимальное количество контактов в одном пакете
+	MaxBatchSize int
+}
 
-func New(storage storage.Contact, options Options) *UseCase {
+func New(storage storage.Contact, publisher publisher.Publisher, options Options) *UseCase {
 	var uc = &UseCase{
-		adapterStorage: storage,
+		adapterStorage:   storage,
+		adapterPublisher: publisher,
 	}
 	uc.SetOptions(options)
 	return uc
 }
 
 func (uc *UseCase) SetOptions(options Options) {
+	if options.MaxBatchSize <= 0 {
+		options.MaxBatchSize = 100
+		log.Debug("set default options.MaxBatchSize", zap.Any("maxBatchSize", options.MaxBatchSize))
+	}
+
 	if uc.options != options {
 		uc.options = options
 		log.Info("set new options", zap.Any("options", uc.options))
 	}
 }
+
+// publish события публикуются после сохранения изменений, ошибка подписчика не отменяет изменение
+func (uc *UseCase) publish(ctx context.Context, events ...event.Event) {
+	if err := uc.adapterPublisher.Publish(ctx, events...); err != nil {
+		_ = log.ErrorWithContext(ctx, err)
+	}
+}
@@ -0,0 +1,47 @@
+package customField
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/customField"
+)
+
+func (uc *UseCase) Create(ctx context.Context, fieldCreate *customField.CustomField) (*customField.CustomField, error) {
+	return uc.adapterStorage.CreateCustomField(ctx, fieldCreate)
+}
+
+// Update ключ и тип поля не изменяются, чтобы не нарушать уже сохранённые значения
+func (uc *UseCase) Update(ctx context.Context, fieldUpdate *customField.CustomField) (*customField.CustomField, error) {
+	return uc.adapterStorage.UpdateCustomField(ctx, fieldUpdate.ID(), func(oldField *customField.CustomField) (*customField.CustomField, error) {
+		return customField.NewWithID(
+			oldField.ID(),
+			oldField.CreatedAt(),
+			time.Now().UTC(),
+			oldField.Key(),
+			fieldUpdate.Label(),
+			oldField.Type(),
+			fieldUpdate.Required(),
+			fieldUpdate.Options(),
+		)
+	})
+}
+
+func (uc *UseCase) Delete(ctx context.Context, ID uuid.UUID) error {
+	return uc.adapterStorage.DeleteCustomField(ctx, ID)
+}
+
+func (uc *UseCase) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*customField.CustomField, error) {
+	return uc.adapterStorage.ListCustomField(ctx, parameter)
+}
+
+func (uc *UseCase) ReadByID(ctx context.Context, ID uuid.UUID) (*customField.CustomField, error) {
+	return uc.adapterStorage.ReadCustomFieldByID(ctx, ID)
+}
+
+func (uc *UseCase) Count(ctx context.Context) (uint64, error) {
+	return uc.adapterStorage.CountCustomField(ctx)
+}
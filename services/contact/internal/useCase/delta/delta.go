@@ -0,0 +1,17 @@
+package delta
+
+import (
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/delta"
+)
+
+func (uc *UseCase) Changes(c context.Context, since delta.Token, limit uint64) (*delta.Delta, error) {
+	if limit == 0 {
+		limit = uc.options.DefaultLimit
+	}
+	if limit > uc.options.MaxLimit {
+		limit = uc.options.MaxLimit
+	}
+
+	return uc.adapterStorage.ListDelta(c, since, limit)
+}
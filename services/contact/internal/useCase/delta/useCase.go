@@ -0,0 +1,49 @@
+package delta
+
+import (
+	"go.uber.org/zap"
+
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/useCase/adapters/storage"
+)
+
+type UseCase struct {
+	adapterStorage storage.Delta
+	options        Options
+}
+
+type Options struct {
+	// DefaultLimit размер страницы, если клиент его не указал
+	DefaultLimit uint64
+	// MaxLimit наибольший размер страницы
+	MaxLimit uint64
+}
+
+func New(storage storage.Delta, options Options) *UseCase {
+	var uc = &UseCase{
+		adapterStorage: storage,
+	}
+	uc.SetOptions(options)
+	return uc
+}
+
+func (uc *UseCase) SetOptions(options Options) {
+	if options.DefaultLimit == 0 {
+		options.DefaultLimit = 100
+		log.Debug("set default options.DefaultLimit", zap.Any("defaultLimit", options.DefaultLimit))
+	}
+
+	if options.MaxLimit == 0 {
+		options.MaxLimit = 1000
+		log.Debug("set default options.MaxLimit", zap.Any("maxLimit", options.MaxLimit))
+	}
+
+	if options.MaxLimit < options.DefaultLimit {
+		options.MaxLimit = options.DefaultLimit
+	}
+
+	if uc.options != options {
+		uc.options = options
+		log.Info("set new options", zap.Any("options", uc.options))
+	}
+}
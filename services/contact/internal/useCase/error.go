@@ -5,4 +5,74 @@ import "errors"
 var (
 	ErrContactNotFound = errors.New("contact not found")
 	ErrGroupNotFound   = errors.New("group not found")
+
+	ErrGroupACLNotFound = errors.New("group access entry not found")
+	ErrShareNotFound    = errors.New("share not found")
+
+	ErrCustomFieldNotFound = errors.New("custom field not found")
+	ErrCustomFieldExists   = errors.New("custom field with this key already exists")
+
+	ErrTagNotFound = errors.New("tag not found")
+	ErrTagExists   = errors.New("tag with this name already exists, merge tags instead")
+
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrOrganizationExists   = errors.New("organization with this tax id already exists")
+
+	ErrNoteNotFound = errors.New("note not found")
+
+	ErrVersionNotFound = errors.New("version not found")
+
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+	ErrAPIKeyNotFound  = errors.New("api key not found")
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrUnauthenticated учётные данные не переданы, неверны, отозваны или истекли
+	ErrUnauthenticated = errors.New("authentication required")
+	// ErrNoCredentials запрос без учётных данных, а анонимные запросы не разрешены
+	ErrNoCredentials = errors.New("credentials are required: bearer token, api key or session cookie")
+	// ErrRoleNotGranted у ключа не может быть ролей, которых нет у его создателя
+	ErrRoleNotGranted = errors.New("role is not granted to the caller")
+	// ErrPermissionDenied автор запроса известен, но действие ему не разрешено
+	ErrPermissionDenied = errors.New("permission denied")
+
+	ErrTenantNotFound = errors.New("tenant not found")
+	ErrTenantExists   = errors.New("tenant with this id already exists")
+	// ErrTenantDisabled арендатор отключён оператором, его данные недоступны до включения
+	ErrTenantDisabled = errors.New("tenant is disabled")
+	// ErrTenantMismatch запрошен арендатор, к которому автор запроса не привязан
+	ErrTenantMismatch = errors.New("tenant does not match credentials")
+	// ErrTenantQuotaExceeded создание превысило бы квоту арендатора
+	ErrTenantQuotaExceeded = errors.New("tenant quota exceeded")
+	// ErrRateLimited клиент исчерпал лимит запросов группы маршрутов
+	ErrRateLimited = errors.New("rate limit exceeded")
+
+	ErrPhotoNotFound = errors.New("contact has no photo")
+	ErrPhotoTooLarge = errors.New("photo is too large")
+	ErrBlobNotFound  = errors.New("file not found in blob storage")
+
+	ErrWrongPeriod = errors.New("period end must not be before period start")
+
+	ErrBatchEmpty    = errors.New("batch is empty")
+	ErrBatchTooLarge = errors.New("batch is too large")
+	ErrBatchRejected = errors.New("batch rejected: some contacts are invalid")
+
+	// ошибки хранилища, общие для всех сценариев; хранилище переводит в них ошибки драйвера
+
+	// ErrNotFound запись не найдена, когда у сценария нет более точной ошибки
+	ErrNotFound = errors.New("resource not found")
+	// ErrConflict запись с такими уникальными значениями уже есть
+	ErrConflict = errors.New("resource conflicts with existing data")
+	// ErrReferenceViolation связанная запись не существует или ещё используется
+	ErrReferenceViolation = errors.New("related resource does not exist or is still in use")
+	// ErrInvalidData значение не прошло ограничения хранилища
+	ErrInvalidData = errors.New("value violates storage constraints")
+	// ErrConcurrentUpdate транзакция прервана из-за параллельного изменения, запрос можно повторить
+	ErrConcurrentUpdate = errors.New("concurrent update, retry the request")
+	// ErrPreconditionFailed запись не в том состоянии, которое нужно для операции
+	ErrPreconditionFailed = errors.New("resource is not in the required state")
+	// ErrStorageUnavailable хранилище недоступно или перегружено, запрос можно повторить
+	ErrStorageUnavailable = errors.New("storage is unavailable")
+	// ErrStorageTimeout хранилище не ответило за отведённое время
+	ErrStorageTimeout = errors.New("storage did not respond in time")
 )
@@ -0,0 +1,101 @@
+package feed
+
+import (
+	"time"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/outbox"
+)
+
+// Changes события после события с номером after, не более BatchSize за вызов
+func (uc *UseCase) Changes(c context.Context, after int64) ([]*outbox.Message, error) {
+	return uc.adapterStorage.ListOutboxAfter(c, after, uc.options.BatchSize)
+}
+
+// Check проверяет, что ленту можно продолжить с события after, до начала потока: иначе клиент
+// узнал бы об устаревшем номере только после того, как ответ уже начат
+func (uc *UseCase) Check(c context.Context, after int64) error {
+	_, err := uc.adapterStorage.ListOutboxAfter(c, after, 1)
+	return err
+}
+
+// LastSequence номер последнего события: с него начинает подписчик без Last-Event-ID
+func (uc *UseCase) LastSequence(c context.Context) (int64, error) {
+	return uc.adapterStorage.LastOutboxSequence(c)
+}
+
+// Follow передаёт fn события после события с номером after по мере их появления, пока не отменён c
+// или fn не вернёт ошибку. Если за интервал опроса событий не было, fn вызывается с пустым
+// списком: по нему доставка отправляет клиенту heartbeat.
+func (uc *UseCase) Follow(c context.Context, after int64, fn func(messages []*outbox.Message) error) error {
+	notify, unsubscribe := uc.Subscribe()
+	defer unsubscribe()
+
+	var ticker = time.NewTicker(uc.options.PollInterval)
+	defer ticker.Stop()
+
+	var idle bool
+	for {
+		messages, err := uc.Changes(c, after)
+		if err != nil {
+			return err
+		}
+
+		if len(messages) > 0 || idle {
+			if err = fn(messages); err != nil {
+				return err
+			}
+		}
+
+		if len(messages) > 0 {
+			after = messages[len(messages)-1].Sequence()
+			// пачка заполнена целиком -- за ней, скорее всего, есть ещё события
+			if uint64(len(messages)) == uc.options.BatchSize && c.Err() == nil {
+				continue
+			}
+		}
+
+		idle = false
+		select {
+		case <-c.Done():
+			return nil
+		case <-notify:
+		case <-ticker.C:
+			idle = true
+		}
+	}
+}
+
+// Subscribe канал уведомлений о новых событиях и функция отписки.
+// Уведомление только будит подписчика, сами события он читает через Changes.
+func (uc *UseCase) Subscribe() (<-chan struct{}, func()) {
+	var notify = make(chan struct{}, 1)
+
+	uc.mu.Lock()
+	uc.subscribers[notify] = struct{}{}
+	uc.mu.Unlock()
+
+	return notify, func() {
+		uc.mu.Lock()
+		delete(uc.subscribers, notify)
+		uc.mu.Unlock()
+	}
+}
+
+// Notify обработчик шины событий. Шина вызывает его после фиксации изменения,
+// поэтому событие уже есть в outbox. Медленный подписчик не блокирует остальных:
+// если уведомление ещё не прочитано, новое не нужно.
+func (uc *UseCase) Notify(_ context.Context, _ event.Event) error {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	for notify := range uc.subscribers {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
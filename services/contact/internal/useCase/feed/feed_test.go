@@ -0,0 +1,72 @@
+package feed
+
+import (
+	stdContext "context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/outbox"
+	mockStorage "architecture_go/services/contact/internal/repository/storage/mock"
+)
+
+func TestFollow(t *testing.T) {
+	var (
+		assertion   = assert.New(t)
+		first       = newMessage(11, event.NameContactCreated)
+		second      = newMessage(12, event.NameContactUpdated)
+		base, stop  = stdContext.WithCancel(stdContext.Background())
+		ctx         = context.New(base)
+		storageMock = new(mockStorage.OutboxReader)
+	)
+	defer stop()
+
+	storageMock.On("ListOutboxAfter", mock.Anything, int64(10), uint64(100)).Return([]*outbox.Message{first}, nil).Once()
+	storageMock.On("ListOutboxAfter", mock.Anything, int64(11), uint64(100)).Return([]*outbox.Message{second}, nil).Once()
+
+	// длинный интервал опроса: следующее чтение ленты возможно только по уведомлению
+	var uc = New(storageMock, Options{PollInterval: time.Hour})
+
+	var received []int64
+	err := uc.Follow(ctx, 10, func(messages []*outbox.Message) error {
+		for _, message := range messages {
+			received = append(received, message.Sequence())
+		}
+
+		switch len(received) {
+		case 1:
+			go func() { _ = uc.Notify(ctx, nil) }()
+		case 2:
+			stop()
+		}
+		return nil
+	})
+
+	assertion.NoError(err)
+	assertion.Equal([]int64{11, 12}, received)
+	storageMock.AssertExpectations(t)
+}
+
+func TestCheck(t *testing.T) {
+	var (
+		storageMock = new(mockStorage.OutboxReader)
+		uc          = New(storageMock, Options{})
+	)
+
+	storageMock.On("ListOutboxAfter", mock.Anything, int64(10), uint64(1)).Return([]*outbox.Message{}, nil).Once()
+	storageMock.On("ListOutboxAfter", mock.Anything, int64(7), uint64(1)).Return(nil, outbox.ErrEventExpired).Once()
+
+	assert.NoError(t, uc.Check(context.Empty(), 10))
+	assert.ErrorIs(t, uc.Check(context.Empty(), 7), outbox.ErrEventExpired)
+	storageMock.AssertExpectations(t)
+}
+
+func newMessage(sequence int64, name event.Name) *outbox.Message {
+	var now = time.Now()
+	return outbox.NewWithID(uuid.New(), sequence, now, uuid.New(), "default", name, []byte(`{}`), 0, now, "")
+}
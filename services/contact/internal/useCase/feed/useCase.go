@@ -0,0 +1,56 @@
+package feed
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/useCase/adapters/storage"
+)
+
+// UseCase лента изменений поверх журнала outbox: события читаются по номеру,
+// подписчики будятся сразу после изменения в этом экземпляре и по таймеру, чтобы
+// увидеть изменения, сделанные другими экземплярами сервиса
+type UseCase struct {
+	adapterStorage storage.OutboxReader
+
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+
+	options Options
+}
+
+type Options struct {
+	// BatchSize сколько событий отдаётся за одно чтение ленты
+	BatchSize uint64
+	// PollInterval как часто подписчик перечитывает ленту без уведомления
+	PollInterval time.Duration
+}
+
+func New(storage storage.OutboxReader, options Options) *UseCase {
+	var uc = &UseCase{
+		adapterStorage: storage,
+		subscribers:    make(map[chan struct{}]struct{}),
+	}
+	uc.SetOptions(options)
+	return uc
+}
+
+func (uc *UseCase) SetOptions(options Options) {
+	if options.BatchSize == 0 {
+		options.BatchSize = 100
+		log.Debug("set default options.BatchSize", zap.Any("batchSize", options.BatchSize))
+	}
+
+	if options.PollInterval == 0 {
+		options.PollInterval = time.Second * 5
+		log.Debug("set default options.PollInterval", zap.Any("pollInterval", options.PollInterval))
+	}
+
+	if uc.options != options {
+		uc.options = options
+		log.Info("set new options", zap.Any("options", uc.options))
+	}
+}
@@ -0,0 +1,24 @@
+package group
+
+import (
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/group/acl"
+)
+
+func (uc *UseCase) ListACL(ctx context.Context, groupID uuid.UUID) ([]*acl.Entry, error) {
+	// у несуществующей группы нет и пустого списка
+	if _, err := uc.adapterStorage.ReadGroupByID(ctx, groupID); err != nil {
+		return nil, err
+	}
+	return uc.adapterStorage.ListGroupACL(ctx, groupID)
+}
+
+func (uc *UseCase) SetACL(ctx context.Context, entry *acl.Entry) (*acl.Entry, error) {
+	return uc.adapterStorage.SetGroupACL(ctx, entry)
+}
+
+func (uc *UseCase) DeleteACL(ctx context.Context, groupID uuid.UUID, grantee string) error {
+	return uc.adapterStorage.DeleteGroupACL(ctx, groupID, grantee)
+}
@@ -5,16 +5,87 @@ import (
 
 	"architecture_go/pkg/type/context"
 	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/organization"
+	"architecture_go/services/contact/internal/useCase"
 )
 
 func (uc *UseCase) CreateContactIntoGroup(ctx context.Context, groupID uuid.UUID, contacts ...*contact.Contact) ([]*contact.Contact, error) {
-	return uc.adapterStorage.CreateContactIntoGroup(ctx, groupID, contacts...)
+	registry, err := uc.adapterStorage.ReadCustomFieldRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range contacts {
+		if err = c.CheckCustomFields(registry); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = uc.checkEmployment(ctx, contacts...); err != nil {
+		return nil, err
+	}
+
+	var owner = useCase.Owner(ctx)
+	for _, c := range contacts {
+		if c.Owner() == "" {
+			c.SetOwner(owner)
+		}
+	}
+
+	created, err := uc.adapterStorage.CreateContactIntoGroup(ctx, groupID, contacts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var events = make([]event.Event, 0, len(created)*2)
+	for _, c := range created {
+		events = append(events, event.NewContactCreated(c), event.NewContactAddedToGroup(groupID, c.ID()))
+	}
+
+	uc.publish(ctx, events...)
+	return created, nil
 }
 
 func (uc *UseCase) AddContactToGroup(ctx context.Context, groupID, contactID uuid.UUID) error {
-	return uc.adapterStorage.AddContactsToGroup(ctx, groupID, contactID)
+	if err := uc.adapterStorage.AddContactsToGroup(ctx, groupID, contactID); err != nil {
+		return err
+	}
+
+	uc.publish(ctx, event.NewContactAddedToGroup(groupID, contactID))
+	return nil
 }
 
 func (uc *UseCase) DeleteContactFromGroup(ctx context.Context, groupID, contactID uuid.UUID) error {
-	return uc.adapterStorage.DeleteContactFromGroup(ctx, groupID, contactID)
+	if err := uc.adapterStorage.DeleteContactFromGroup(ctx, groupID, contactID); err != nil {
+		return err
+	}
+
+	uc.publish(ctx, event.NewContactRemovedFromGroup(groupID, contactID))
+	return nil
+}
+
+// checkEmployment проверяет, что организации контактов существуют, и заполняет их названия
+func (uc *UseCase) checkEmployment(ctx context.Context, contacts ...*contact.Contact) error {
+	var organizations = make(map[uuid.UUID]*organization.Organization)
+
+	for _, c := range contacts {
+		if !c.Employment().HasOrganization() {
+			continue
+		}
+
+		var ID = c.Employment().OrganizationID()
+		org, ok := organizations[ID]
+		if !ok {
+			var err error
+			if org, err = uc.adapterStorage.ReadOrganizationByID(ctx, ID); err != nil {
+				return err
+			}
+			organizations[ID] = org
+		}
+
+		c.SetEmployment(c.Employment().WithOrganizationName(org.Name().Value()))
+	}
+
+	return nil
 }
@@ -7,21 +7,46 @@ import (
 
 	"architecture_go/pkg/type/context"
 	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/event"
 	"architecture_go/services/contact/internal/domain/group"
+	"architecture_go/services/contact/internal/useCase"
 )
 
 func (uc *UseCase) Create(ctx context.Context, groupCreate *group.Group) (*group.Group, error) {
-	return uc.adapterStorage.CreateGroup(ctx, groupCreate)
+	if groupCreate.Owner() == "" {
+		groupCreate.SetOwner(useCase.Owner(ctx))
+	}
+
+	response, err := uc.adapterStorage.CreateGroup(ctx, groupCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, event.NewGroupCreated(response))
+	return response, nil
 }
 
 func (uc *UseCase) Update(ctx context.Context, groupUpdate *group.Group) (*group.Group, error) {
-	return uc.adapterStorage.UpdateGroup(ctx, groupUpdate.ID(), func(oldGroup *group.Group) (*group.Group, error) {
-		return group.NewWithID(oldGroup.ID(), oldGroup.CreatedAt(), time.Now().UTC(), groupUpdate.Name(), groupUpdate.Description(), oldGroup.ContactCount()), nil
+	response, err := uc.adapterStorage.UpdateGroup(ctx, groupUpdate.ID(), func(oldGroup *group.Group) (*group.Group, error) {
+		var result = group.NewWithID(oldGroup.ID(), oldGroup.CreatedAt(), time.Now().UTC(), groupUpdate.Name(), groupUpdate.Description(), oldGroup.ContactCount())
+		result.SetOwner(oldGroup.Owner())
+		return result, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publish(ctx, event.NewGroupUpdated(response))
+	return response, nil
 }
 
 func (uc *UseCase) Delete(ctx context.Context, ID uuid.UUID) error {
-	return uc.adapterStorage.DeleteGroup(ctx, ID)
+	if err := uc.adapterStorage.DeleteGroup(ctx, ID); err != nil {
+		return err
+	}
+
+	uc.publish(ctx, event.NewGroupArchived(ID))
+	return nil
 }
 
 func (uc *UseCase) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*group.Group, error) {
@@ -35,3 +60,7 @@ func (uc *UseCase) ReadByID(ctx context.Context, ID uuid.UUID) (*group.Group, er
 func (uc *UseCase) Count(ctx context.Context) (uint64, error) {
 	return uc.adapterStorage.CountGroup(ctx)
 }
+
+func (uc *UseCase) ReadByIDAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*group.Group, error) {
+	return uc.adapterStorage.ReadGroupAsOf(ctx, ID, asOf)
+}
@@ -3,20 +3,25 @@ package group
 import (
 	"go.uber.org/zap"
 
+	"architecture_go/pkg/type/context"
 	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/useCase/adapters/publisher"
 	"architecture_go/services/contact/internal/useCase/adapters/storage"
 )
 
 type UseCase struct {
-	adapterStorage storage.Group
-	options        Options
+	adapterStorage   storage.Group
+	adapterPublisher publisher.Publisher
+	options          Options
 }
 
 type Options struct{}
 
-func New(storage storage.Group, options Options) *UseCase {
+func New(storage storage.Group, publisher publisher.Publisher, options Options) *UseCase {
 	var uc = &UseCase{
-		adapterStorage: storage,
+		adapterStorage:   storage,
+		adapterPublisher: publisher,
 	}
 	uc.SetOptions(options)
 	return uc
@@ -28,3 +33,10 @@ func (uc *UseCase) SetOptions(options Options) {
 		log.Info("set new options", zap.Any("options", uc.options))
 	}
 }
+
+// publish события публикуются после сохранения изменений, ошибка подписчика не отменяет изменение
+func (uc *UseCase) publish(ctx context.Context, events ...event.Event) {
+	if err := uc.adapterPublisher.Publish(ctx, events...); err != nil {
+		_ = log.ErrorWithContext(ctx, err)
+	}
+}
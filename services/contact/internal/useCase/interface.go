@@ -1,18 +1,47 @@
 package useCase
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/principal"
 	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/apiKey"
+	"architecture_go/services/contact/internal/domain/audit"
 	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/customField"
+	"architecture_go/services/contact/internal/domain/delta"
 	"architecture_go/services/contact/internal/domain/group"
+	"architecture_go/services/contact/internal/domain/group/acl"
+	"architecture_go/services/contact/internal/domain/note"
+	"architecture_go/services/contact/internal/domain/organization"
+	"architecture_go/services/contact/internal/domain/outbox"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+	"architecture_go/services/contact/internal/domain/session"
+	"architecture_go/services/contact/internal/domain/share"
+	"architecture_go/services/contact/internal/domain/tag"
+	tagName "architecture_go/services/contact/internal/domain/tag/name"
+	"architecture_go/services/contact/internal/domain/tenant"
+	"architecture_go/services/contact/internal/domain/version"
+	"architecture_go/services/contact/internal/domain/webhook"
 )
 
 type Contact interface {
 	Create(c context.Context, contacts ...*contact.Contact) ([]*contact.Contact, error)
+	CreateBatch(c context.Context, mode BatchMode, items ...*BatchItem) ([]*BatchItem, error)
 	Update(c context.Context, contactUpdate contact.Contact) (*contact.Contact, error)
 	Delete(c context.Context, ID uuid.UUID /*Тут можно передавать фильтр*/) error
+	// Restore возвращает удалённый контакт из архива
+	Restore(c context.Context, ID uuid.UUID) (*contact.Contact, error)
+
+	AddTags(c context.Context, contactID uuid.UUID, tags ...tagName.Name) (*contact.Contact, error)
+	RemoveTags(c context.Context, contactID uuid.UUID, tags ...tagName.Name) (*contact.Contact, error)
+
+	// Revert возвращает поля контакта к указанной версии, возврат сохраняется как новая версия
+	Revert(c context.Context, ID uuid.UUID, number uint64) (*contact.Contact, error)
 
 	ContactReader
 }
@@ -20,7 +49,15 @@ type Contact interface {
 type ContactReader interface {
 	List(c context.Context, parameter queryParameter.QueryParameter) ([]*contact.Contact, error)
 	ReadByID(c context.Context, ID uuid.UUID) (response *contact.Contact, err error)
-	Count(c context.Context /*Тут можно передавать фильтр*/) (uint64, error)
+	Count(c context.Context, filters filter.Filters) (uint64, error)
+	// ListBirthday контакты, у которых день рождения приходится на период [from, to], в порядке наступления
+	ListBirthday(c context.Context, from, to time.Time, parameter queryParameter.QueryParameter) ([]*contact.Contact, error)
+
+	// ReadByIDAsOf состояние контакта на момент asOf
+	ReadByIDAsOf(c context.Context, ID uuid.UUID, asOf time.Time) (*contact.Contact, error)
+	// ListVersion версии контакта, новые первыми
+	ListVersion(c context.Context, ID uuid.UUID, parameter queryParameter.QueryParameter) ([]*version.Version, error)
+	CountVersion(c context.Context, ID uuid.UUID) (uint64, error)
 }
 
 type Group interface {
@@ -30,12 +67,34 @@ type Group interface {
 
 	GroupReader
 	ContactInGroup
+	GroupACL
 }
 
 type GroupReader interface {
 	List(c context.Context, parameter queryParameter.QueryParameter) ([]*group.Group, error)
 	ReadByID(c context.Context, ID uuid.UUID) (*group.Group, error)
 	Count(c context.Context /*Тут можно передавать фильтр*/) (uint64, error)
+	// ReadByIDAsOf состояние группы на момент asOf
+	ReadByIDAsOf(c context.Context, ID uuid.UUID, asOf time.Time) (*group.Group, error)
+}
+
+// GroupACL список доступа группы: кто, кроме администраторов, может её изменять
+type GroupACL interface {
+	ListACL(c context.Context, groupID uuid.UUID) ([]*acl.Entry, error)
+	// SetACL добавляет запись или меняет уровень доступа у существующей
+	SetACL(c context.Context, entry *acl.Entry) (*acl.Entry, error)
+	DeleteACL(c context.Context, groupID uuid.UUID, grantee string) error
+}
+
+// Share доступ к контактам и группам, у которых есть владелец. Доступ к группе открывает
+// на чтение всех её участников
+type Share interface {
+	// Share выдаёт доступ или меняет уровень существующего
+	Share(c context.Context, value *share.Share) (*share.Share, error)
+	Unshare(c context.Context, resource share.Resource, resourceID uuid.UUID, grantee string) error
+	List(c context.Context, resource share.Resource, resourceID uuid.UUID) ([]*share.Share, error)
+	// TransferOwner передаёт ресурс другому субъекту или команде
+	TransferOwner(c context.Context, resource share.Resource, resourceID uuid.UUID, owner string) error
 }
 
 type ContactInGroup interface {
@@ -43,3 +102,178 @@ type ContactInGroup interface {
 	AddContactToGroup(c context.Context, groupID, contactID uuid.UUID) error
 	DeleteContactFromGroup(c context.Context, groupID, contactID uuid.UUID) error
 }
+
+type CustomField interface {
+	Create(c context.Context, fieldCreate *customField.CustomField) (*customField.CustomField, error)
+	Update(c context.Context, fieldUpdate *customField.CustomField) (*customField.CustomField, error)
+	Delete(c context.Context, ID uuid.UUID) error
+
+	CustomFieldReader
+}
+
+type CustomFieldReader interface {
+	List(c context.Context, parameter queryParameter.QueryParameter) ([]*customField.CustomField, error)
+	ReadByID(c context.Context, ID uuid.UUID) (*customField.CustomField, error)
+	Count(c context.Context) (uint64, error)
+}
+
+type Tag interface {
+	// Update переименовывает тег
+	Update(c context.Context, tagUpdate *tag.Tag) (*tag.Tag, error)
+	// Merge переносит контакты с тегов sourceIDs на тег targetID, исходные теги удаляются
+	Merge(c context.Context, targetID uuid.UUID, sourceIDs ...uuid.UUID) (*tag.Tag, error)
+
+	TagReader
+}
+
+type TagReader interface {
+	List(c context.Context, parameter queryParameter.QueryParameter) ([]*tag.Tag, error)
+	ReadByID(c context.Context, ID uuid.UUID) (*tag.Tag, error)
+	Count(c context.Context) (uint64, error)
+}
+
+type Organization interface {
+	Create(c context.Context, orgCreate *organization.Organization) (*organization.Organization, error)
+	Update(c context.Context, orgUpdate *organization.Organization) (*organization.Organization, error)
+	Delete(c context.Context, ID uuid.UUID) error
+
+	OrganizationReader
+}
+
+type OrganizationReader interface {
+	List(c context.Context, parameter queryParameter.QueryParameter) ([]*organization.Organization, error)
+	ReadByID(c context.Context, ID uuid.UUID) (*organization.Organization, error)
+	Count(c context.Context) (uint64, error)
+}
+
+type Note interface {
+	Create(c context.Context, noteCreate *note.Note) (*note.Note, error)
+	Update(c context.Context, noteUpdate *note.Note) (*note.Note, error)
+	Delete(c context.Context, contactID, ID uuid.UUID) error
+
+	NoteReader
+}
+
+type NoteReader interface {
+	List(c context.Context, contactID uuid.UUID, parameter queryParameter.QueryParameter) ([]*note.Note, error)
+	ReadByID(c context.Context, contactID, ID uuid.UUID) (*note.Note, error)
+	Count(c context.Context, contactID uuid.UUID) (uint64, error)
+}
+
+type Photo interface {
+	// Upload сохраняет фотографию контакта и её миниатюру
+	Upload(c context.Context, contactID uuid.UUID, data []byte) (*contact.Contact, error)
+	Read(c context.Context, contactID uuid.UUID, thumbnail bool) ([]byte, string, error)
+	Delete(c context.Context, contactID uuid.UUID) (*contact.Contact, error)
+}
+
+type Audit interface {
+	List(c context.Context, parameter queryParameter.QueryParameter) ([]*audit.Entry, error)
+	Count(c context.Context, filters filter.Filters) (uint64, error)
+
+	// History изменения контакта, включая изменения членства в группах
+	History(c context.Context, contactID uuid.UUID, parameter queryParameter.QueryParameter) ([]*audit.Entry, error)
+	CountHistory(c context.Context, contactID uuid.UUID, filters filter.Filters) (uint64, error)
+}
+
+type Webhook interface {
+	// Create возвращает подписку вместе с ключом подписи, больше ключ не отдаётся
+	Create(c context.Context, hookCreate *webhook.Webhook) (*webhook.Webhook, error)
+	Update(c context.Context, hookUpdate *webhook.Webhook) (*webhook.Webhook, error)
+	Delete(c context.Context, ID uuid.UUID) error
+
+	// Replay ставит доставку в очередь заново, в том числе из dead-letter
+	Replay(c context.Context, webhookID, ID uuid.UUID) (*webhook.Delivery, error)
+
+	WebhookReader
+}
+
+type WebhookReader interface {
+	List(c context.Context, parameter queryParameter.QueryParameter) ([]*webhook.Webhook, error)
+	ReadByID(c context.Context, ID uuid.UUID) (*webhook.Webhook, error)
+	Count(c context.Context) (uint64, error)
+
+	ListDelivery(c context.Context, webhookID uuid.UUID, parameter queryParameter.QueryParameter) ([]*webhook.Delivery, error)
+	CountDelivery(c context.Context, webhookID uuid.UUID, filters filter.Filters) (uint64, error)
+}
+
+// Delta инкрементальная синхронизация клиентов по токену
+type Delta interface {
+	// Changes страница изменений после токена since; нулевой токен -- полная синхронизация.
+	// delta.ErrTokenExpired -- продолжить с токена нельзя, клиент начинает полную синхронизацию заново
+	Changes(c context.Context, since delta.Token, limit uint64) (*delta.Delta, error)
+}
+
+// Feed лента изменений контактов и групп для потоковой доставки клиентам
+type Feed interface {
+	// Follow передаёт fn события с номером больше after, пока не отменён контекст или fn не вернёт ошибку.
+	// Автор запроса получает события только тех контактов и групп, которые ему видны.
+	// Без новых событий fn периодически вызывается с пустым списком.
+	Follow(c context.Context, after int64, fn func(messages []*outbox.Message) error) error
+	// Check outbox.ErrEventExpired, если события after нет и продолжить ленту с него нельзя
+	Check(c context.Context, after int64) error
+	// LastSequence номер последнего события, с него начинает клиент без Last-Event-ID
+	LastSequence(c context.Context) (int64, error)
+}
+
+// Tenant арендаторы сервиса: управление оператором и проверка арендатора запроса
+type Tenant interface {
+	Create(c context.Context, tenantCreate *tenant.Tenant) (*tenant.Tenant, error)
+	// Update меняет название и квоты
+	Update(c context.Context, tenantUpdate *tenant.Tenant) (*tenant.Tenant, error)
+	// Disable закрывает доступ к данным арендатора, данные сохраняются
+	Disable(c context.Context, ID string) (*tenant.Tenant, error)
+	Enable(c context.Context, ID string) (*tenant.Tenant, error)
+
+	// Resolve арендатор запроса: ErrTenantNotFound для неизвестного, ErrTenantDisabled для отключённого
+	Resolve(c context.Context, ID string) (*tenant.Tenant, error)
+	// Select арендатор запроса автора p, который запросил арендатора requested
+	Select(c context.Context, p *principal.Principal, requested string) (string, error)
+
+	TenantReader
+}
+
+type TenantReader interface {
+	List(c context.Context, parameter queryParameter.QueryParameter) ([]*tenant.Tenant, error)
+	ReadByID(c context.Context, ID string) (*tenant.Tenant, error)
+	Count(c context.Context) (uint64, error)
+	Usage(c context.Context, ID string) (*tenant.Usage, error)
+}
+
+// Credentials учётные данные запроса в том виде, в каком их передал транспорт:
+// заголовки и cookie HTTP или метаданные gRPC
+type Credentials struct {
+	// Authorization значение вида "Bearer <token>"; ключ доступа тоже можно передать так
+	Authorization string
+	APIKey        string
+	Session       string
+	// Tenant арендатор, которого запросил клиент
+	Tenant string
+}
+
+// Auth аутентификация запросов и управление ключами доступа и сессиями.
+// Неверные, отозванные и истёкшие учётные данные дают ErrUnauthenticated.
+type Auth interface {
+	// Authenticate автор запроса по учётным данным. Без учётных данных -- ErrNoCredentials,
+	// а если анонимные запросы разрешены -- nil без ошибки
+	Authenticate(c context.Context, credentials Credentials) (*principal.Principal, error)
+	AuthenticateToken(c context.Context, token string) (*principal.Principal, error)
+	AuthenticateAPIKey(c context.Context, key string) (*principal.Principal, error)
+	AuthenticateSession(c context.Context, token string) (*principal.Principal, error)
+
+	CreateSession(c context.Context, p principal.Principal) (*session.Session, error)
+	DeleteSession(c context.Context, token string) error
+
+	// CreateAPIKey возвращает ключ вместе с секретом, больше секрет не отдаётся
+	CreateAPIKey(c context.Context, p principal.Principal, name string, roles []string, expiresAt time.Time) (*apiKey.APIKey, error)
+	RevokeAPIKey(c context.Context, subject string, ID uuid.UUID) error
+	ListAPIKey(c context.Context, subject string, parameter queryParameter.QueryParameter) ([]*apiKey.APIKey, error)
+	CountAPIKey(c context.Context, subject string) (uint64, error)
+}
+
+// RateLimit ограничение частоты запросов клиентов по группам маршрутов
+type RateLimit interface {
+	// Take расходует запрос клиента; nil -- запрос проходит без ограничения.
+	// В группе rateLimit.GroupAddress клиент -- всегда address, даже если запрос уже аутентифицирован
+	Take(c context.Context, group rateLimit.Group, address string) (*rateLimit.Result, error)
+}
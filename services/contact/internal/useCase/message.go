@@ -0,0 +1,306 @@
+package useCase
+
+import "architecture_go/pkg/type/problem"
+
+// коды причин, по которым поле запроса не прошло проверку при разборе
+const (
+	CodeFieldRequired  problem.Code = "field_required"
+	CodeFieldMinLength problem.Code = "field_min_length"
+	CodeFieldMaxLength problem.Code = "field_max_length"
+	CodeFieldLength    problem.Code = "field_length"
+	CodeFieldMin       problem.Code = "field_min"
+	CodeFieldMax       problem.Code = "field_max"
+	CodeFieldMinItems  problem.Code = "field_min_items"
+	CodeFieldMaxItems  problem.Code = "field_max_items"
+	CodeFieldEmail     problem.Code = "field_email"
+	CodeFieldUUID      problem.Code = "field_uuid"
+	CodeFieldURL       problem.Code = "field_url"
+	CodeFieldOneOf     problem.Code = "field_one_of"
+	CodeFieldDatetime  problem.Code = "field_datetime"
+	CodeFieldType      problem.Code = "field_type"
+	CodeFieldInvalid   problem.Code = "field_invalid"
+)
+
+// Messages тексты ошибок каталога Problems, причин проверки полей и статусов без своего кода.
+// В шаблонах {max}, {min} и другие -- значения из Entry.Params.
+var Messages = problem.Messages{
+	problem.LanguageEN: {
+		"bad_request":                "bad request",
+		"unauthorized":               "authentication required",
+		"forbidden":                  "access denied",
+		"not_found":                  "resource not found",
+		"conflict":                   "resource conflicts with existing data",
+		"precondition_failed":        "resource is not in the required state",
+		"too_many_requests":          "too many requests",
+		"internal_server_error":      "internal server error",
+		"service_unavailable":        "service is unavailable",
+		"gateway_timeout":            "service did not respond in time",
+		"request_entity_too_large":   "request is too large",
+		"unsupported_media_type":     "unsupported content type",
+		CodeValidation:               "request validation failed",
+		"contact_not_found":          "contact not found",
+		"group_not_found":            "group not found",
+		"group_acl_not_found":        "group access entry not found",
+		"share_not_found":            "share not found",
+		"custom_field_not_found":     "custom field not found",
+		"custom_field_exists":        "custom field with this key already exists",
+		"tag_not_found":              "tag not found",
+		"tag_exists":                 "tag with this name already exists, merge tags instead",
+		"organization_not_found":     "organization not found",
+		"organization_exists":        "organization with this tax id already exists",
+		"note_not_found":             "note not found",
+		"version_not_found":          "version not found",
+		"webhook_not_found":          "webhook not found",
+		"webhook_delivery_not_found": "webhook delivery not found",
+		"api_key_not_found":          "api key not found",
+		"session_not_found":          "session not found",
+		"unauthenticated":            "authentication required",
+		"credentials_required":       "credentials are required: bearer token, api key or session cookie",
+		"role_not_granted":           "role is not granted to the caller",
+		"permission_denied":          "permission denied",
+		"tenant_not_found":           "tenant not found",
+		"tenant_exists":              "tenant with this id already exists",
+		"tenant_disabled":            "tenant is disabled",
+		"tenant_mismatch":            "tenant does not match credentials",
+		"tenant_quota_exceeded":      "tenant quota exceeded",
+		"rate_limited":               "rate limit exceeded",
+		"photo_not_found":            "contact has no photo",
+		"photo_too_large":            "photo is too large",
+		"blob_not_found":             "file not found in blob storage",
+		"period_invalid":             "period end must not be before period start",
+		"batch_empty":                "batch is empty",
+		"batch_too_large":            "batch is too large",
+		"batch_rejected":             "batch rejected: some contacts are invalid",
+		"reference_violation":        "related resource does not exist or is still in use",
+		"invalid_data":               "value violates storage constraints",
+		"concurrent_update":          "concurrent update, retry the request",
+		"storage_unavailable":        "storage is unavailable",
+		"storage_timeout":            "storage did not respond in time",
+
+		"contact_phone_number_required":   "phone number is required",
+		"contact_name_too_long":           "name must be less than or equal to {max} characters",
+		"contact_surname_too_long":        "surname must be less than or equal to {max} characters",
+		"contact_patronymic_too_long":     "patronymic must be less than or equal to {max} characters",
+		"contact_age_invalid":             "age must be less than or equal to {max}",
+		"contact_job_title_too_long":      "job title must be less than or equal to {max} characters",
+		"contact_birthday_format_invalid": "birthday must be a date in format {layout}",
+		"contact_birthday_out_of_range":   "birthday must not be in the future or more than {years} years ago",
+		"address_country_invalid":         "country must be an ISO 3166-1 alpha-2 code",
+		"address_field_too_long":          "address field is too long",
+		"address_postcode_invalid":        "postcode does not match the country format",
+		"photo_type_unsupported":          "photo must be a JPEG, PNG, GIF or WebP image",
+		"photo_dimensions_too_large":      "photo must not be larger than {width}x{height} pixels",
+
+		"group_name_too_long":        "name must be less than or equal to {max} characters",
+		"group_description_too_long": "description must be less than or equal to {max} characters",
+		"group_acl_grantee_invalid":  "grantee must be from 1 to {max} characters",
+		"group_acl_level_invalid":    "level must be one of: {levels}",
+
+		"share_grantee_invalid":  "grantee must be from 1 to {max} characters",
+		"share_owner_invalid":    "owner must be from 1 to {max} characters",
+		"share_level_invalid":    "level must be one of: {levels}",
+		"share_resource_invalid": "resource must be one of: {resources}",
+
+		"custom_field_unknown":             "unknown custom field",
+		"custom_field_required":            "custom field is required",
+		"custom_field_value_invalid":       "wrong custom field value",
+		"custom_field_options_required":    "options are required for enum field",
+		"custom_field_options_not_allowed": "options are allowed only for enum field",
+		"custom_field_key_invalid":         "key must start with a lowercase latin letter, contain only latin letters, digits or '_' and be less than or equal to {max} characters",
+		"custom_field_label_too_long":      "label must be less than or equal to {max} characters",
+		"custom_field_type_unknown":        "type must be one of: string, number, date, enum, bool",
+
+		"note_type_required":  "note type is required",
+		"note_type_unknown":   "type must be one of: call, email, meeting, note",
+		"note_body_invalid":   "body must not be empty and must be less than or equal to {max} characters",
+		"note_author_invalid": "author must not be empty and must be less than or equal to {max} characters",
+
+		"tag_name_invalid":        "tag must not be empty and must be less than or equal to {max} characters",
+		"tag_name_format_invalid": "tag must not contain ',' or '/'",
+
+		"organization_name_invalid":            "name must not be empty and must be less than or equal to {max} characters",
+		"organization_tax_id_format_invalid":   "tax id must contain only latin letters, digits or '-' and be from 5 to {max} characters",
+		"organization_tax_id_checksum_invalid": "INN must contain 10 or 12 digits with a valid checksum",
+		"organization_website_invalid":         "website must be an absolute http or https URL less than or equal to {max} characters",
+
+		"webhook_url_invalid":    "webhook url must be an absolute http or https URL less than or equal to {max} characters",
+		"webhook_url_private":    "webhook url must point to a public internet address",
+		"webhook_event_unknown":  "unknown event name in webhook filter",
+		"webhook_secret_invalid": "webhook secret must be from {min} to {max} characters",
+
+		"tenant_id_invalid":   "tenant id must be from 1 to 63 lowercase latin letters, digits, '-' or '_' and start with a letter or digit",
+		"tenant_name_invalid": "tenant name must be from 1 to {max} characters",
+
+		"api_key_name_invalid":     "api key name must be from 1 to {max} characters",
+		"api_key_subject_required": "api key subject is required",
+		"api_key_tenant_required":  "api key tenant is required",
+		"api_key_expires_invalid":  "api key expiration must be in the future",
+
+		"session_ttl_invalid": "session lifetime must be positive",
+		"sync_token_invalid":  "sync token is not valid",
+		"sync_token_expired":  "sync token expired, start over with a full sync",
+
+		"last_event_id_expired": "last event id is unknown, reconnect without it",
+
+		CodeFieldRequired:  "is required",
+		CodeFieldMinLength: "must be at least {min} characters",
+		CodeFieldMaxLength: "must be at most {max} characters",
+		CodeFieldLength:    "must be exactly {len} characters",
+		CodeFieldMin:       "must be at least {min}",
+		CodeFieldMax:       "must be at most {max}",
+		CodeFieldMinItems:  "must contain at least {min} items",
+		CodeFieldMaxItems:  "must contain at most {max} items",
+		CodeFieldEmail:     "must be a valid email",
+		CodeFieldUUID:      "must be a UUID",
+		CodeFieldURL:       "must be an absolute URL",
+		CodeFieldOneOf:     "must be one of: {values}",
+		CodeFieldDatetime:  "must be a date in format {layout}",
+		CodeFieldType:      "must be {type}",
+		CodeFieldInvalid:   "failed on the '{rule}' rule",
+	},
+	problem.LanguageRU: {
+		"bad_request":                "некорректный запрос",
+		"unauthorized":               "требуется аутентификация",
+		"forbidden":                  "доступ запрещён",
+		"not_found":                  "запись не найдена",
+		"conflict":                   "запись конфликтует с существующими данными",
+		"precondition_failed":        "запись не в том состоянии, которое нужно для операции",
+		"too_many_requests":          "слишком много запросов",
+		"internal_server_error":      "внутренняя ошибка сервера",
+		"service_unavailable":        "сервис недоступен",
+		"gateway_timeout":            "сервис не ответил вовремя",
+		"request_entity_too_large":   "запрос слишком большой",
+		"unsupported_media_type":     "неподдерживаемый тип содержимого",
+		CodeValidation:               "запрос не прошёл проверку",
+		"contact_not_found":          "контакт не найден",
+		"group_not_found":            "группа не найдена",
+		"group_acl_not_found":        "правило доступа к группе не найдено",
+		"share_not_found":            "доступ не найден",
+		"custom_field_not_found":     "дополнительное поле не найдено",
+		"custom_field_exists":        "дополнительное поле с таким ключом уже есть",
+		"tag_not_found":              "метка не найдена",
+		"tag_exists":                 "метка с таким названием уже есть, объедините метки",
+		"organization_not_found":     "организация не найдена",
+		"organization_exists":        "организация с таким ИНН уже есть",
+		"note_not_found":             "заметка не найдена",
+		"version_not_found":          "версия не найдена",
+		"webhook_not_found":          "вебхук не найден",
+		"webhook_delivery_not_found": "доставка вебхука не найдена",
+		"api_key_not_found":          "ключ доступа не найден",
+		"session_not_found":          "сессия не найдена",
+		"unauthenticated":            "требуется аутентификация",
+		"credentials_required":       "нужны учётные данные: bearer-токен, ключ доступа или cookie сессии",
+		"role_not_granted":           "у автора запроса нет этой роли",
+		"permission_denied":          "действие запрещено",
+		"tenant_not_found":           "арендатор не найден",
+		"tenant_exists":              "арендатор с таким идентификатором уже есть",
+		"tenant_disabled":            "арендатор отключён",
+		"tenant_mismatch":            "арендатор не совпадает с арендатором учётных данных",
+		"tenant_quota_exceeded":      "превышена квота арендатора",
+		"rate_limited":               "превышен лимит запросов",
+		"photo_not_found":            "у контакта нет фотографии",
+		"photo_too_large":            "фотография слишком большая",
+		"blob_not_found":             "файл не найден в хранилище",
+		"period_invalid":             "конец периода не может быть раньше начала",
+		"batch_empty":                "пакет пуст",
+		"batch_too_large":            "пакет слишком большой",
+		"batch_rejected":             "пакет отклонён: некоторые контакты некорректны",
+		"reference_violation":        "связанная запись не существует или ещё используется",
+		"invalid_data":               "значение нарушает ограничения хранилища",
+		"concurrent_update":          "запись изменена параллельным запросом, повторите запрос",
+		"storage_unavailable":        "хранилище недоступно",
+		"storage_timeout":            "хранилище не ответило вовремя",
+
+		"contact_phone_number_required":   "номер телефона обязателен",
+		"contact_name_too_long":           "имя должно быть не длиннее {max} символов",
+		"contact_surname_too_long":        "фамилия должна быть не длиннее {max} символов",
+		"contact_patronymic_too_long":     "отчество должно быть не длиннее {max} символов",
+		"contact_age_invalid":             "возраст должен быть не больше {max}",
+		"contact_job_title_too_long":      "должность должна быть не длиннее {max} символов",
+		"contact_birthday_format_invalid": "день рождения должен быть датой в формате {layout}",
+		"contact_birthday_out_of_range":   "день рождения не может быть в будущем или больше {years} лет назад",
+		"address_country_invalid":         "страна должна быть кодом ISO 3166-1 alpha-2",
+		"address_field_too_long":          "поле адреса слишком длинное",
+		"address_postcode_invalid":        "индекс не соответствует формату страны",
+		"photo_type_unsupported":          "фотография должна быть в формате JPEG, PNG, GIF или WebP",
+		"photo_dimensions_too_large":      "фотография должна быть не больше {width}x{height} пикселей",
+
+		"group_name_too_long":        "название должно быть не длиннее {max} символов",
+		"group_description_too_long": "описание должно быть не длиннее {max} символов",
+		"group_acl_grantee_invalid":  "получатель доступа должен содержать от 1 до {max} символов",
+		"group_acl_level_invalid":    "уровень доступа должен быть одним из: {levels}",
+
+		"share_grantee_invalid":  "получатель доступа должен содержать от 1 до {max} символов",
+		"share_owner_invalid":    "владелец должен содержать от 1 до {max} символов",
+		"share_level_invalid":    "уровень доступа должен быть одним из: {levels}",
+		"share_resource_invalid": "ресурс должен быть одним из: {resources}",
+
+		"custom_field_unknown":             "неизвестное дополнительное поле",
+		"custom_field_required":            "дополнительное поле обязательно",
+		"custom_field_value_invalid":       "некорректное значение дополнительного поля",
+		"custom_field_options_required":    "для поля enum нужны варианты",
+		"custom_field_options_not_allowed": "варианты допустимы только для поля enum",
+		"custom_field_key_invalid":         "ключ должен начинаться со строчной латинской буквы, содержать только латинские буквы, цифры или '_' и быть не длиннее {max} символов",
+		"custom_field_label_too_long":      "подпись должна быть не длиннее {max} символов",
+		"custom_field_type_unknown":        "тип должен быть одним из: string, number, date, enum, bool",
+
+		"note_type_required":  "тип заметки обязателен",
+		"note_type_unknown":   "тип должен быть одним из: call, email, meeting, note",
+		"note_body_invalid":   "текст заметки не может быть пустым и должен быть не длиннее {max} символов",
+		"note_author_invalid": "автор не может быть пустым и должен быть не длиннее {max} символов",
+
+		"tag_name_invalid":        "метка не может быть пустой и должна быть не длиннее {max} символов",
+		"tag_name_format_invalid": "метка не может содержать ',' или '/'",
+
+		"organization_name_invalid":            "название не может быть пустым и должно быть не длиннее {max} символов",
+		"organization_tax_id_format_invalid":   "ИНН может содержать только латинские буквы, цифры или '-' и должен быть длиной от 5 до {max} символов",
+		"organization_tax_id_checksum_invalid": "ИНН должен содержать 10 или 12 цифр с верной контрольной суммой",
+		"organization_website_invalid":         "сайт должен быть абсолютным адресом http или https не длиннее {max} символов",
+
+		"webhook_url_invalid":    "адрес вебхука должен быть абсолютным адресом http или https не длиннее {max} символов",
+		"webhook_url_private":    "адрес вебхука должен быть публичным адресом в интернете",
+		"webhook_event_unknown":  "неизвестное событие в фильтре вебхука",
+		"webhook_secret_invalid": "секрет вебхука должен содержать от {min} до {max} символов",
+
+		"tenant_id_invalid":   "идентификатор арендатора должен содержать от 1 до 63 строчных латинских букв, цифр, '-' или '_' и начинаться с буквы или цифры",
+		"tenant_name_invalid": "название арендатора должно содержать от 1 до {max} символов",
+
+		"api_key_name_invalid":     "название ключа доступа должно содержать от 1 до {max} символов",
+		"api_key_subject_required": "субъект ключа доступа обязателен",
+		"api_key_tenant_required":  "арендатор ключа доступа обязателен",
+		"api_key_expires_invalid":  "срок действия ключа доступа должен быть в будущем",
+
+		"session_ttl_invalid": "время жизни сессии должно быть положительным",
+		"sync_token_invalid":  "токен синхронизации недействителен",
+		"sync_token_expired":  "токен синхронизации устарел, нужна полная синхронизация",
+
+		"last_event_id_expired": "номер последнего события неизвестен, подключитесь заново без него",
+
+		CodeFieldRequired:  "обязательное поле",
+		CodeFieldMinLength: "должно содержать не меньше {min} символов",
+		CodeFieldMaxLength: "должно содержать не больше {max} символов",
+		CodeFieldLength:    "должно содержать ровно {len} символов",
+		CodeFieldMin:       "должно быть не меньше {min}",
+		CodeFieldMax:       "должно быть не больше {max}",
+		CodeFieldMinItems:  "должно содержать не меньше {min} элементов",
+		CodeFieldMaxItems:  "должно содержать не больше {max} элементов",
+		CodeFieldEmail:     "должно быть корректным адресом электронной почты",
+		CodeFieldUUID:      "должно быть UUID",
+		CodeFieldURL:       "должно быть абсолютным адресом URL",
+		CodeFieldOneOf:     "должно быть одним из: {values}",
+		CodeFieldDatetime:  "должно быть датой в формате {layout}",
+		CodeFieldType:      "должно иметь тип {type}",
+		CodeFieldInvalid:   "не прошло проверку '{rule}'",
+	},
+}
+
+// Message текст ошибки на языке lang: шаблон её кода из каталога Problems,
+// а если ошибки нет в каталоге или у кода нет шаблона -- текст самой ошибки
+func Message(lang problem.Language, err error) string {
+	if entry, ok := Problems.Find(err); ok {
+		if message, ok := Messages.Message(lang, entry.Code, entry.Params); ok {
+			return message
+		}
+	}
+	return err.Error()
+}
@@ -0,0 +1,29 @@
+package useCase
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"architecture_go/pkg/type/problem"
+	"architecture_go/services/contact/internal/domain/contact/name"
+)
+
+func TestMessages(t *testing.T) {
+	for _, lang := range []problem.Language{problem.LanguageEN, problem.LanguageRU} {
+		for _, entry := range Problems {
+			template, ok := Messages[lang][entry.Code]
+			assert.True(t, ok, "%s: no message for %s", lang, entry.Code)
+			assert.Len(t, Messages[problem.LanguageEN], len(Messages[lang]), "%s: catalogues differ", lang)
+
+			message, _ := Messages.Message(lang, entry.Code, entry.Params)
+			assert.NotContains(t, message, "{", "%s: %s has unfilled parameters in %q", lang, entry.Code, template)
+		}
+	}
+
+	var err = errors.Wrap(name.ErrWrongLength, "create contact")
+	assert.Equal(t, "name must be less than or equal to 50 characters", Message(problem.LanguageEN, err))
+	assert.Equal(t, "имя должно быть не длиннее 50 символов", Message(problem.LanguageRU, err))
+	assert.Equal(t, "unknown", Message(problem.LanguageRU, errors.New("unknown")))
+}
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/contact"
+	tagName "architecture_go/services/contact/internal/domain/tag/name"
+	"architecture_go/services/contact/internal/domain/version"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+type contactMetrics struct {
+	next    useCase.Contact
+	metrics *UseCase
+}
+
+// Contact измеряет длительность методов сценария контактов вместе с проверками обёрнутых сценариев
+func (uc *UseCase) Contact(next useCase.Contact) useCase.Contact {
+	return &contactMetrics{next: next, metrics: uc}
+}
+
+func (m *contactMetrics) Create(ctx context.Context, contacts ...*contact.Contact) (response []*contact.Contact, err error) {
+	defer m.metrics.observe(useCaseContact, "Create", time.Now(), &err)
+	return m.next.Create(ctx, contacts...)
+}
+
+func (m *contactMetrics) CreateBatch(ctx context.Context, mode useCase.BatchMode, items ...*useCase.BatchItem) (response []*useCase.BatchItem, err error) {
+	defer m.metrics.observe(useCaseContact, "CreateBatch", time.Now(), &err)
+	return m.next.CreateBatch(ctx, mode, items...)
+}
+
+func (m *contactMetrics) Update(ctx context.Context, contactUpdate contact.Contact) (response *contact.Contact, err error) {
+	defer m.metrics.observe(useCaseContact, "Update", time.Now(), &err)
+	return m.next.Update(ctx, contactUpdate)
+}
+
+func (m *contactMetrics) Delete(ctx context.Context, ID uuid.UUID) (err error) {
+	defer m.metrics.observe(useCaseContact, "Delete", time.Now(), &err)
+	return m.next.Delete(ctx, ID)
+}
+
+func (m *contactMetrics) Restore(ctx context.Context, ID uuid.UUID) (response *contact.Contact, err error) {
+	defer m.metrics.observe(useCaseContact, "Restore", time.Now(), &err)
+	return m.next.Restore(ctx, ID)
+}
+
+func (m *contactMetrics) AddTags(ctx context.Context, contactID uuid.UUID, tags ...tagName.Name) (response *contact.Contact, err error) {
+	defer m.metrics.observe(useCaseContact, "AddTags", time.Now(), &err)
+	return m.next.AddTags(ctx, contactID, tags...)
+}
+
+func (m *contactMetrics) RemoveTags(ctx context.Context, contactID uuid.UUID, tags ...tagName.Name) (response *contact.Contact, err error) {
+	defer m.metrics.observe(useCaseContact, "RemoveTags", time.Now(), &err)
+	return m.next.RemoveTags(ctx, contactID, tags...)
+}
+
+func (m *contactMetrics) Revert(ctx context.Context, ID uuid.UUID, number uint64) (response *contact.Contact, err error) {
+	defer m.metrics.observe(useCaseContact, "Revert", time.Now(), &err)
+	return m.next.Revert(ctx, ID, number)
+}
+
+func (m *contactMetrics) List(ctx context.Context, parameter queryParameter.QueryParameter) (response []*contact.Contact, err error) {
+	defer m.metrics.observe(useCaseContact, "List", time.Now(), &err)
+	return m.next.List(ctx, parameter)
+}
+
+func (m *contactMetrics) ReadByID(ctx context.Context, ID uuid.UUID) (response *contact.Contact, err error) {
+	defer m.metrics.observe(useCaseContact, "ReadByID", time.Now(), &err)
+	return m.next.ReadByID(ctx, ID)
+}
+
+func (m *contactMetrics) Count(ctx context.Context, filters filter.Filters) (response uint64, err error) {
+	defer m.metrics.observe(useCaseContact, "Count", time.Now(), &err)
+	return m.next.Count(ctx, filters)
+}
+
+func (m *contactMetrics) ListBirthday(ctx context.Context, from, to time.Time, parameter queryParameter.QueryParameter) (response []*contact.Contact, err error) {
+	defer m.metrics.observe(useCaseContact, "ListBirthday", time.Now(), &err)
+	return m.next.ListBirthday(ctx, from, to, parameter)
+}
+
+func (m *contactMetrics) ReadByIDAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (response *contact.Contact, err error) {
+	defer m.metrics.observe(useCaseContact, "ReadByIDAsOf", time.Now(), &err)
+	return m.next.ReadByIDAsOf(ctx, ID, asOf)
+}
+
+func (m *contactMetrics) ListVersion(ctx context.Context, ID uuid.UUID, parameter queryParameter.QueryParameter) (response []*version.Version, err error) {
+	defer m.metrics.observe(useCaseContact, "ListVersion", time.Now(), &err)
+	return m.next.ListVersion(ctx, ID, parameter)
+}
+
+func (m *contactMetrics) CountVersion(ctx context.Context, ID uuid.UUID) (response uint64, err error) {
+	defer m.metrics.observe(useCaseContact, "CountVersion", time.Now(), &err)
+	return m.next.CountVersion(ctx, ID)
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/group"
+	"architecture_go/services/contact/internal/domain/group/acl"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+type groupMetrics struct {
+	next    useCase.Group
+	metrics *UseCase
+}
+
+// Group измеряет длительность методов сценария групп, включая состав и список доступа
+func (uc *UseCase) Group(next useCase.Group) useCase.Group {
+	return &groupMetrics{next: next, metrics: uc}
+}
+
+func (m *groupMetrics) Create(ctx context.Context, groupCreate *group.Group) (response *group.Group, err error) {
+	defer m.metrics.observe(useCaseGroup, "Create", time.Now(), &err)
+	return m.next.Create(ctx, groupCreate)
+}
+
+func (m *groupMetrics) Update(ctx context.Context, groupUpdate *group.Group) (response *group.Group, err error) {
+	defer m.metrics.observe(useCaseGroup, "Update", time.Now(), &err)
+	return m.next.Update(ctx, groupUpdate)
+}
+
+func (m *groupMetrics) Delete(ctx context.Context, ID uuid.UUID) (err error) {
+	defer m.metrics.observe(useCaseGroup, "Delete", time.Now(), &err)
+	return m.next.Delete(ctx, ID)
+}
+
+func (m *groupMetrics) List(ctx context.Context, parameter queryParameter.QueryParameter) (response []*group.Group, err error) {
+	defer m.metrics.observe(useCaseGroup, "List", time.Now(), &err)
+	return m.next.List(ctx, parameter)
+}
+
+func (m *groupMetrics) ReadByID(ctx context.Context, ID uuid.UUID) (response *group.Group, err error) {
+	defer m.metrics.observe(useCaseGroup, "ReadByID", time.Now(), &err)
+	return m.next.ReadByID(ctx, ID)
+}
+
+func (m *groupMetrics) Count(ctx context.Context) (response uint64, err error) {
+	defer m.metrics.observe(useCaseGroup, "Count", time.Now(), &err)
+	return m.next.Count(ctx)
+}
+
+func (m *groupMetrics) ReadByIDAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (response *group.Group, err error) {
+	defer m.metrics.observe(useCaseGroup, "ReadByIDAsOf", time.Now(), &err)
+	return m.next.ReadByIDAsOf(ctx, ID, asOf)
+}
+
+func (m *groupMetrics) CreateContactIntoGroup(ctx context.Context, groupID uuid.UUID, contacts ...*contact.Contact) (response []*contact.Contact, err error) {
+	defer m.metrics.observe(useCaseGroup, "CreateContactIntoGroup", time.Now(), &err)
+	return m.next.CreateContactIntoGroup(ctx, groupID, contacts...)
+}
+
+func (m *groupMetrics) AddContactToGroup(ctx context.Context, groupID, contactID uuid.UUID) (err error) {
+	defer m.metrics.observe(useCaseGroup, "AddContactToGroup", time.Now(), &err)
+	return m.next.AddContactToGroup(ctx, groupID, contactID)
+}
+
+func (m *groupMetrics) DeleteContactFromGroup(ctx context.Context, groupID, contactID uuid.UUID) (err error) {
+	defer m.metrics.observe(useCaseGroup, "DeleteContactFromGroup", time.Now(), &err)
+	return m.next.DeleteContactFromGroup(ctx, groupID, contactID)
+}
+
+func (m *groupMetrics) ListACL(ctx context.Context, groupID uuid.UUID) (response []*acl.Entry, err error) {
+	defer m.metrics.observe(useCaseGroup, "ListACL", time.Now(), &err)
+	return m.next.ListACL(ctx, groupID)
+}
+
+func (m *groupMetrics) SetACL(ctx context.Context, entry *acl.Entry) (response *acl.Entry, err error) {
+	defer m.metrics.observe(useCaseGroup, "SetACL", time.Now(), &err)
+	return m.next.SetACL(ctx, entry)
+}
+
+func (m *groupMetrics) DeleteACL(ctx context.Context, groupID uuid.UUID, grantee string) (err error) {
+	defer m.metrics.observe(useCaseGroup, "DeleteACL", time.Now(), &err)
+	return m.next.DeleteACL(ctx, groupID, grantee)
+}
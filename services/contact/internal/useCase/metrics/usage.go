@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+)
+
+// Run пересчитывает показатели данных раз в Options.Interval, пока не отменён ctx
+func (uc *UseCase) Run(ctx context.Context) {
+	var ticker = time.NewTicker(uc.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := uc.Refresh(ctx); err != nil && ctx.Err() == nil {
+			log.WarnWithContext(ctx, "metrics not refreshed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh один пересчёт: число неархивных контактов и групп во всех арендаторах
+func (uc *UseCase) Refresh(ctx context.Context) error {
+	usage, err := uc.adapterStorage.ReadUsage(ctx)
+	if err != nil {
+		return err
+	}
+
+	uc.contacts.Set(float64(usage.Contacts))
+	uc.groups.Set(float64(usage.Groups))
+	return nil
+}
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	pkgMetrics "architecture_go/pkg/metrics"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/useCase/adapters/storage"
+)
+
+// имена сценариев в метке use_case
+const (
+	useCaseContact = "contact"
+	useCaseGroup   = "group"
+)
+
+// UseCase метрики сценариев: длительность методов и показатели данных сервиса
+type UseCase struct {
+	adapterStorage storage.Usage
+	duration       *pkgMetrics.UseCase
+	contacts       prometheus.Gauge
+	groups         prometheus.Gauge
+	options        Options
+}
+
+type Options struct {
+	// Namespace префикс имён метрик
+	Namespace string
+	// Interval как часто пересчитываются показатели данных: запрос считает все записи
+	Interval time.Duration
+}
+
+func New(storage storage.Usage, options Options) *UseCase {
+	var uc = &UseCase{adapterStorage: storage}
+	uc.SetOptions(options)
+
+	uc.duration = pkgMetrics.NewUseCase(uc.options.Namespace)
+	uc.contacts = pkgMetrics.NewGauge(uc.options.Namespace, "contacts_active", "Number of not archived contacts in all tenants.")
+	uc.groups = pkgMetrics.NewGauge(uc.options.Namespace, "groups_active", "Number of not archived groups in all tenants.")
+	return uc
+}
+
+func (uc *UseCase) SetOptions(options Options) {
+	if options.Namespace == "" {
+		options.Namespace = "contact"
+		log.Debug("set default options.Namespace", zap.Any("namespace", options.Namespace))
+	}
+
+	if options.Interval == 0 {
+		options.Interval = time.Minute
+		log.Debug("set default options.Interval", zap.Any("interval", options.Interval))
+	}
+
+	if uc.options != options {
+		uc.options = options
+		log.Info("set new options", zap.Any("options", uc.options))
+	}
+}
+
+// observe учитывает вызов, начатый в start; err читается после возврата из метода
+func (uc *UseCase) observe(useCase, method string, start time.Time, err *error) {
+	uc.duration.Observe(useCase, method, start, *err)
+}
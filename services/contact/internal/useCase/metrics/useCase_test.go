@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	pkgMetrics "architecture_go/pkg/metrics"
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/tenant"
+	mockStorage "architecture_go/services/contact/internal/repository/storage/mock"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func TestRefresh(t *testing.T) {
+	var (
+		assertion         = assert.New(t)
+		ctx               = context.Empty()
+		storageRepository = new(mockStorage.Usage)
+		uc                = New(storageRepository, Options{Namespace: "test"})
+	)
+
+	storageRepository.On("ReadUsage", mock.Anything).Return(&tenant.Usage{Contacts: 12, Groups: 3}, nil).Once()
+	assertion.NoError(uc.Refresh(ctx))
+	assertion.Equal(12.0, testutil.ToFloat64(uc.contacts))
+	assertion.Equal(3.0, testutil.ToFloat64(uc.groups))
+
+	// при ошибке хранилища остаются прежние значения
+	var errStorage = errors.New("storage unavailable")
+	storageRepository.On("ReadUsage", mock.Anything).Return(nil, errStorage).Once()
+	assertion.ErrorIs(uc.Refresh(ctx), errStorage)
+	assertion.Equal(12.0, testutil.ToFloat64(uc.contacts))
+
+	storageRepository.AssertExpectations(t)
+}
+
+type contactStub struct {
+	useCase.Contact
+}
+
+func (contactStub) Delete(context.Context, uuid.UUID) error {
+	return useCase.ErrContactNotFound
+}
+
+func TestContact(t *testing.T) {
+	var uc = New(new(mockStorage.Usage), Options{Namespace: "test"})
+
+	assert.ErrorIs(t, uc.Contact(contactStub{}).Delete(context.Empty(), uuid.New()), useCase.ErrContactNotFound)
+	count, err := testutil.GatherAndCount(pkgMetrics.Registry, "test_use_case_duration_seconds")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -0,0 +1,52 @@
+package note
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/note"
+)
+
+func (uc *UseCase) Create(ctx context.Context, noteCreate *note.Note) (*note.Note, error) {
+	return uc.adapterStorage.CreateNote(ctx, noteCreate)
+}
+
+// Update автор и время создания заметки не изменяются
+func (uc *UseCase) Update(ctx context.Context, noteUpdate *note.Note) (*note.Note, error) {
+	return uc.adapterStorage.UpdateNote(ctx, noteUpdate.ContactID(), noteUpdate.ID(), func(oldNote *note.Note) (*note.Note, error) {
+		var occurredAt = noteUpdate.OccurredAt()
+		if occurredAt.IsZero() {
+			occurredAt = oldNote.OccurredAt()
+		}
+
+		return note.NewWithID(
+			oldNote.ID(),
+			oldNote.CreatedAt(),
+			time.Now().UTC(),
+			oldNote.ContactID(),
+			oldNote.Author(),
+			noteUpdate.Type(),
+			occurredAt,
+			noteUpdate.Body(),
+		)
+	})
+}
+
+func (uc *UseCase) Delete(ctx context.Context, contactID, ID uuid.UUID) error {
+	return uc.adapterStorage.DeleteNote(ctx, contactID, ID)
+}
+
+func (uc *UseCase) List(ctx context.Context, contactID uuid.UUID, parameter queryParameter.QueryParameter) ([]*note.Note, error) {
+	return uc.adapterStorage.ListNote(ctx, contactID, parameter)
+}
+
+func (uc *UseCase) ReadByID(ctx context.Context, contactID, ID uuid.UUID) (*note.Note, error) {
+	return uc.adapterStorage.ReadNoteByID(ctx, contactID, ID)
+}
+
+func (uc *UseCase) Count(ctx context.Context, contactID uuid.UUID) (uint64, error) {
+	return uc.adapterStorage.CountNote(ctx, contactID)
+}
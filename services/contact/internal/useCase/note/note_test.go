@@ -0,0 +1,52 @@
+package note
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/note"
+	"architecture_go/services/contact/internal/domain/note/author"
+	"architecture_go/services/contact/internal/domain/note/body"
+	"architecture_go/services/contact/internal/domain/note/noteType"
+	mockStorage "architecture_go/services/contact/internal/repository/storage/mock"
+)
+
+func TestListPagination(t *testing.T) {
+	var (
+		assertion = assert.New(t)
+		contactID = uuid.New()
+		notes     = make([]*note.Note, 5)
+	)
+
+	noteAuthor, _ := author.New("Иван")
+	noteBody, _ := body.New("Позвонить после обеда")
+	for i := range notes {
+		notes[i], _ = note.New(contactID, noteAuthor, noteType.CALL, time.Now().Add(-time.Duration(i)*time.Hour), noteBody)
+	}
+
+	var (
+		page              = queryParameter.QueryParameter{Pagination: pagination.Pagination{Limit: 2, Offset: 2}}
+		storageRepository = new(mockStorage.Note)
+	)
+	// постраничный выбор делает хранилище, use case передаёт параметры без изменений
+	storageRepository.On("ListNote", mock.Anything, contactID, page).Return(notes[2:4], nil)
+	storageRepository.On("CountNote", mock.Anything, contactID).Return(uint64(len(notes)), nil)
+
+	var uc = New(storageRepository, Options{})
+
+	result, err := uc.List(context.Empty(), contactID, page)
+	assertion.NoError(err)
+	assertion.Equal(notes[2:4], result)
+	storageRepository.AssertCalled(t, "ListNote", mock.Anything, contactID, page)
+
+	total, err := uc.Count(context.Empty(), contactID)
+	assertion.NoError(err)
+	assertion.Equal(uint64(len(notes)), total)
+}
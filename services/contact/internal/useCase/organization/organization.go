@@ -0,0 +1,45 @@
+package organization
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/organization"
+)
+
+func (uc *UseCase) Create(ctx context.Context, orgCreate *organization.Organization) (*organization.Organization, error) {
+	return uc.adapterStorage.CreateOrganization(ctx, orgCreate)
+}
+
+func (uc *UseCase) Update(ctx context.Context, orgUpdate *organization.Organization) (*organization.Organization, error) {
+	return uc.adapterStorage.UpdateOrganization(ctx, orgUpdate.ID(), func(oldOrganization *organization.Organization) (*organization.Organization, error) {
+		return organization.NewWithID(
+			oldOrganization.ID(),
+			oldOrganization.CreatedAt(),
+			time.Now().UTC(),
+			orgUpdate.Name(),
+			orgUpdate.TaxID(),
+			orgUpdate.Website(),
+			oldOrganization.ContactCount(),
+		), nil
+	})
+}
+
+func (uc *UseCase) Delete(ctx context.Context, ID uuid.UUID) error {
+	return uc.adapterStorage.DeleteOrganization(ctx, ID)
+}
+
+func (uc *UseCase) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*organization.Organization, error) {
+	return uc.adapterStorage.ListOrganization(ctx, parameter)
+}
+
+func (uc *UseCase) ReadByID(ctx context.Context, ID uuid.UUID) (*organization.Organization, error) {
+	return uc.adapterStorage.ReadOrganizationByID(ctx, ID)
+}
+
+func (uc *UseCase) Count(ctx context.Context) (uint64, error) {
+	return uc.adapterStorage.CountOrganization(ctx)
+}
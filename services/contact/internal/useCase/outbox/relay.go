@@ -0,0 +1,55 @@
+package outbox
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/tools/backoff"
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/outbox"
+)
+
+// Run доставляет сообщения outbox брокеру, пока не отменён ctx.
+// Если проход что-то отправил, следующий начинается сразу: за проход из каждого
+// агрегата берётся только одно сообщение.
+func (uc *UseCase) Run(ctx context.Context) {
+	var ticker = time.NewTicker(uc.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		processed, err := uc.Relay(ctx)
+		if err == nil && processed > 0 && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relay один проход: отправка готовых сообщений и планирование повторов для неудачных,
+// после MaxAttempts попыток сообщение откладывается
+func (uc *UseCase) Relay(ctx context.Context) (uint64, error) {
+	return uc.adapterStorage.ProcessOutbox(ctx, uc.options.BatchSize, func(messages []*outbox.Message) {
+		for _, message := range messages {
+			if err := uc.adapterBroker.Publish(ctx, message); err != nil {
+				message.MarkFailed(err, time.Now().Add(backoff.Exponential(uc.options.MinBackoff, uc.options.MaxBackoff, message.Attempts())), uc.options.MaxAttempts)
+				log.WarnWithContext(ctx, "outbox message not published",
+					zap.String("id", message.ID().String()),
+					zap.String("name", message.Name().String()),
+					zap.Uint32("attempts", message.Attempts()),
+					zap.Bool("parked", message.Parked()),
+					zap.Error(err),
+				)
+				continue
+			}
+
+			message.MarkPublished(time.Now())
+		}
+	})
+}
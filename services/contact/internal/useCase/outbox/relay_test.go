@@ -0,0 +1,83 @@
+package outbox
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/outbox"
+	mockBroker "architecture_go/services/contact/internal/repository/broker/mock"
+	mockStorage "architecture_go/services/contact/internal/repository/storage/mock"
+)
+
+func TestRelay(t *testing.T) {
+	var (
+		assertion = assert.New(t)
+		ctx       = context.Empty()
+		published = newMessage(event.NameContactCreated)
+		failed    = newMessage(event.NameGroupUpdated)
+		errBroker = errors.New("broker unavailable")
+	)
+
+	var storageRepository = new(mockStorage.Outbox)
+	storageRepository.On("ProcessOutbox", mock.Anything, uint64(100), mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(func(messages []*outbox.Message))([]*outbox.Message{published, failed})
+		}).
+		Return(uint64(2), nil)
+
+	var brokerMock = new(mockBroker.Broker)
+	brokerMock.On("Publish", mock.Anything, published).Return(nil)
+	brokerMock.On("Publish", mock.Anything, failed).Return(errBroker)
+
+	var uc = New(storageRepository, brokerMock, Options{})
+
+	var before = time.Now()
+	processed, err := uc.Relay(ctx)
+	assertion.NoError(err)
+	assertion.Equal(uint64(2), processed)
+
+	assertion.True(published.Published())
+	assertion.Equal(uint32(0), published.Attempts())
+
+	assertion.False(failed.Published())
+	assertion.Equal(uint32(1), failed.Attempts())
+	assertion.Equal(errBroker.Error(), failed.LastError())
+	assertion.True(failed.NextAttemptAt().After(before))
+	assertion.False(failed.Parked())
+}
+
+func TestRelayParksAfterMaxAttempts(t *testing.T) {
+	var (
+		assertion = assert.New(t)
+		failed    = newMessage(event.NameContactUpdated)
+	)
+
+	var storageRepository = new(mockStorage.Outbox)
+	storageRepository.On("ProcessOutbox", mock.Anything, uint64(100), mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(func(messages []*outbox.Message))([]*outbox.Message{failed})
+		}).
+		Return(uint64(1), nil)
+
+	var brokerMock = new(mockBroker.Broker)
+	brokerMock.On("Publish", mock.Anything, failed).Return(errors.New("broker unavailable"))
+
+	var uc = New(storageRepository, brokerMock, Options{MaxAttempts: 1})
+
+	_, err := uc.Relay(context.Empty())
+	assertion.NoError(err)
+	assertion.False(failed.Published())
+	assertion.True(failed.Parked())
+}
+
+func newMessage(name event.Name) *outbox.Message {
+	var now = time.Now()
+	return outbox.NewWithID(uuid.New(), 1, now, uuid.New(), "default", name, []byte(`{}`), 0, now, "")
+}
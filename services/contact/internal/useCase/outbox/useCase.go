@@ -0,0 +1,74 @@
+package outbox
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/useCase/adapters/broker"
+	"architecture_go/services/contact/internal/useCase/adapters/storage"
+)
+
+type UseCase struct {
+	adapterStorage storage.Outbox
+	adapterBroker  broker.Broker
+	options        Options
+}
+
+type Options struct {
+	// BatchSize сколько сообщений relay забирает за один проход
+	BatchSize uint64
+	// Interval пауза между проходами, когда отправлять нечего
+	Interval time.Duration
+	// MinBackoff задержка перед первой повторной попыткой, дальше удваивается до MaxBackoff
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// MaxAttempts после стольких неудачных попыток сообщение откладывается и перестаёт держать свой агрегат
+	MaxAttempts uint32
+}
+
+func New(storage storage.Outbox, broker broker.Broker, options Options) *UseCase {
+	var uc = &UseCase{
+		adapterStorage: storage,
+		adapterBroker:  broker,
+	}
+	uc.SetOptions(options)
+	return uc
+}
+
+func (uc *UseCase) SetOptions(options Options) {
+	if options.BatchSize == 0 {
+		options.BatchSize = 100
+		log.Debug("set default options.BatchSize", zap.Any("batchSize", options.BatchSize))
+	}
+
+	if options.Interval == 0 {
+		options.Interval = time.Second
+		log.Debug("set default options.Interval", zap.Any("interval", options.Interval))
+	}
+
+	if options.MinBackoff == 0 {
+		options.MinBackoff = time.Second
+		log.Debug("set default options.MinBackoff", zap.Any("minBackoff", options.MinBackoff))
+	}
+
+	if options.MaxBackoff == 0 {
+		options.MaxBackoff = 5 * time.Minute
+		log.Debug("set default options.MaxBackoff", zap.Any("maxBackoff", options.MaxBackoff))
+	}
+
+	if options.MaxBackoff < options.MinBackoff {
+		options.MaxBackoff = options.MinBackoff
+	}
+
+	if options.MaxAttempts == 0 {
+		options.MaxAttempts = 20
+		log.Debug("set default options.MaxAttempts", zap.Any("maxAttempts", options.MaxAttempts))
+	}
+
+	if uc.options != options {
+		uc.options = options
+		log.Info("set new options", zap.Any("options", uc.options))
+	}
+}
@@ -0,0 +1,131 @@
+package photo
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	_ "golang.org/x/image/webp"
+
+	"architecture_go/pkg/tools/thumbnail"
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/contact/photo"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// ThumbnailContentType миниатюры всегда сохраняются в JPEG
+const ThumbnailContentType = "image/jpeg"
+
+func originalKey(contactID uuid.UUID) string {
+	return "contacts/" + contactID.String() + "/photo"
+}
+
+func thumbnailKey(contactID uuid.UUID) string {
+	return "contacts/" + contactID.String() + "/thumbnail.jpg"
+}
+
+// Upload тип изображения определяется по содержимому, а не по заголовкам запроса
+func (uc *UseCase) Upload(ctx context.Context, contactID uuid.UUID, data []byte) (*contact.Contact, error) {
+	if len(data) > uc.options.MaxSize {
+		return nil, useCase.ErrPhotoTooLarge
+	}
+
+	var contentType = http.DetectContentType(data)
+	if !photo.IsSupported(contentType) {
+		return nil, photo.ErrUnsupportedType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, photo.ErrUnsupportedType
+	}
+
+	value, err := photo.New(contentType, len(data), config.Width, config.Height, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = uc.adapterStorage.ReadContactByID(ctx, contactID); err != nil {
+		return nil, err
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, photo.ErrUnsupportedType
+	}
+
+	var thumb bytes.Buffer
+	if err = jpeg.Encode(&thumb, thumbnail.Fit(src, uc.options.ThumbnailSize), &jpeg.Options{Quality: 85}); err != nil {
+		return nil, err
+	}
+
+	if err = uc.adapterBlob.Put(ctx, originalKey(contactID), contentType, data); err != nil {
+		return nil, err
+	}
+
+	if err = uc.adapterBlob.Put(ctx, thumbnailKey(contactID), ThumbnailContentType, thumb.Bytes()); err != nil {
+		return nil, err
+	}
+
+	return uc.adapterStorage.UpdateContactPhoto(ctx, contactID, value)
+}
+
+// Read возвращает изображение и его тип, миниатюра запрашивается флагом thumbnail
+func (uc *UseCase) Read(ctx context.Context, contactID uuid.UUID, thumbnail bool) ([]byte, string, error) {
+	c, err := uc.adapterStorage.ReadContactByID(ctx, contactID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if c.Photo().IsEmpty() {
+		return nil, "", useCase.ErrPhotoNotFound
+	}
+
+	var (
+		key         = originalKey(contactID)
+		contentType = c.Photo().ContentType()
+	)
+	if thumbnail {
+		key, contentType = thumbnailKey(contactID), ThumbnailContentType
+	}
+
+	data, err := uc.adapterBlob.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, useCase.ErrBlobNotFound) {
+			return nil, "", useCase.ErrPhotoNotFound
+		}
+		return nil, "", err
+	}
+
+	return data, contentType, nil
+}
+
+func (uc *UseCase) Delete(ctx context.Context, contactID uuid.UUID) (*contact.Contact, error) {
+	c, err := uc.adapterStorage.ReadContactByID(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Photo().IsEmpty() {
+		return nil, useCase.ErrPhotoNotFound
+	}
+
+	if c, err = uc.adapterStorage.UpdateContactPhoto(ctx, contactID, photo.Photo{}); err != nil {
+		return nil, err
+	}
+
+	for _, key := range []string{originalKey(contactID), thumbnailKey(contactID)} {
+		if err = uc.adapterBlob.Delete(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
@@ -0,0 +1,48 @@
+package photo
+
+import (
+	"go.uber.org/zap"
+
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/useCase/adapters/blob"
+	"architecture_go/services/contact/internal/useCase/adapters/storage"
+)
+
+type UseCase struct {
+	adapterStorage storage.Contact
+	adapterBlob    blob.Blob
+	options        Options
+}
+
+type Options struct {
+	// MaxSize максимальный размер файла фотографии в байтах
+	MaxSize int
+	// ThumbnailSize сторона квадрата, в который вписывается миниатюра
+	ThumbnailSize int
+}
+
+func New(storage storage.Contact, blob blob.Blob, options Options) *UseCase {
+	var uc = &UseCase{
+		adapterStorage: storage,
+		adapterBlob:    blob,
+	}
+	uc.SetOptions(options)
+	return uc
+}
+
+func (uc *UseCase) SetOptions(options Options) {
+	if options.MaxSize == 0 {
+		options.MaxSize = 5 << 20
+		log.Debug("set default options.MaxSize", zap.Any("maxSize", options.MaxSize))
+	}
+
+	if options.ThumbnailSize == 0 {
+		options.ThumbnailSize = 256
+		log.Debug("set default options.ThumbnailSize", zap.Any("thumbnailSize", options.ThumbnailSize))
+	}
+
+	if uc.options != options {
+		uc.options = options
+		log.Info("set new options", zap.Any("options", uc.options))
+	}
+}
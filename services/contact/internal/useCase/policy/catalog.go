@@ -0,0 +1,160 @@
+package policy
+
+import (
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/customField"
+	"architecture_go/services/contact/internal/domain/organization"
+	"architecture_go/services/contact/internal/domain/tag"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+type customFieldPolicy struct {
+	next   useCase.CustomField
+	policy *UseCase
+}
+
+// CustomField удаление поля стирает его значения у всех контактов, поэтому доступно только admin
+func (uc *UseCase) CustomField(next useCase.CustomField) useCase.CustomField {
+	return &customFieldPolicy{next: next, policy: uc}
+}
+
+func (p *customFieldPolicy) Create(ctx context.Context, fieldCreate *customField.CustomField) (*customField.CustomField, error) {
+	if err := p.policy.Require(ctx, RoleEditor); err != nil {
+		return nil, err
+	}
+	return p.next.Create(ctx, fieldCreate)
+}
+
+func (p *customFieldPolicy) Update(ctx context.Context, fieldUpdate *customField.CustomField) (*customField.CustomField, error) {
+	if err := p.policy.Require(ctx, RoleEditor); err != nil {
+		return nil, err
+	}
+	return p.next.Update(ctx, fieldUpdate)
+}
+
+func (p *customFieldPolicy) Delete(ctx context.Context, ID uuid.UUID) error {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return err
+	}
+	return p.next.Delete(ctx, ID)
+}
+
+func (p *customFieldPolicy) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*customField.CustomField, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.List(ctx, parameter)
+}
+
+func (p *customFieldPolicy) ReadByID(ctx context.Context, ID uuid.UUID) (*customField.CustomField, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.ReadByID(ctx, ID)
+}
+
+func (p *customFieldPolicy) Count(ctx context.Context) (uint64, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return 0, err
+	}
+	return p.next.Count(ctx)
+}
+
+type tagPolicy struct {
+	next   useCase.Tag
+	policy *UseCase
+}
+
+// Tag слияние удаляет исходные теги, поэтому доступно только admin
+func (uc *UseCase) Tag(next useCase.Tag) useCase.Tag {
+	return &tagPolicy{next: next, policy: uc}
+}
+
+func (p *tagPolicy) Update(ctx context.Context, tagUpdate *tag.Tag) (*tag.Tag, error) {
+	if err := p.policy.Require(ctx, RoleEditor); err != nil {
+		return nil, err
+	}
+	return p.next.Update(ctx, tagUpdate)
+}
+
+func (p *tagPolicy) Merge(ctx context.Context, targetID uuid.UUID, sourceIDs ...uuid.UUID) (*tag.Tag, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return nil, err
+	}
+	return p.next.Merge(ctx, targetID, sourceIDs...)
+}
+
+func (p *tagPolicy) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*tag.Tag, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.List(ctx, parameter)
+}
+
+func (p *tagPolicy) ReadByID(ctx context.Context, ID uuid.UUID) (*tag.Tag, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.ReadByID(ctx, ID)
+}
+
+func (p *tagPolicy) Count(ctx context.Context) (uint64, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return 0, err
+	}
+	return p.next.Count(ctx)
+}
+
+type organizationPolicy struct {
+	next   useCase.Organization
+	policy *UseCase
+}
+
+func (uc *UseCase) Organization(next useCase.Organization) useCase.Organization {
+	return &organizationPolicy{next: next, policy: uc}
+}
+
+func (p *organizationPolicy) Create(ctx context.Context, orgCreate *organization.Organization) (*organization.Organization, error) {
+	if err := p.policy.Require(ctx, RoleEditor); err != nil {
+		return nil, err
+	}
+	return p.next.Create(ctx, orgCreate)
+}
+
+func (p *organizationPolicy) Update(ctx context.Context, orgUpdate *organization.Organization) (*organization.Organization, error) {
+	if err := p.policy.Require(ctx, RoleEditor); err != nil {
+		return nil, err
+	}
+	return p.next.Update(ctx, orgUpdate)
+}
+
+func (p *organizationPolicy) Delete(ctx context.Context, ID uuid.UUID) error {
+	if err := p.policy.Require(ctx, RoleEditor); err != nil {
+		return err
+	}
+	return p.next.Delete(ctx, ID)
+}
+
+func (p *organizationPolicy) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*organization.Organization, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.List(ctx, parameter)
+}
+
+func (p *organizationPolicy) ReadByID(ctx context.Context, ID uuid.UUID) (*organization.Organization, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.ReadByID(ctx, ID)
+}
+
+func (p *organizationPolicy) Count(ctx context.Context) (uint64, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return 0, err
+	}
+	return p.next.Count(ctx)
+}
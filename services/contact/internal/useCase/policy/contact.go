@@ -0,0 +1,215 @@
+package policy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/note"
+	"architecture_go/services/contact/internal/domain/share"
+	tagName "architecture_go/services/contact/internal/domain/tag/name"
+	"architecture_go/services/contact/internal/domain/version"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+type contactPolicy struct {
+	next   useCase.Contact
+	policy *UseCase
+}
+
+// Contact создают editor и admin. Контакт без владельца читают все роли, а изменяют editor и admin;
+// контакт с владельцем видят и изменяют его владелец и те, кому он открыт, см. UseCase.RequireShared
+func (uc *UseCase) Contact(next useCase.Contact) useCase.Contact {
+	return &contactPolicy{next: next, policy: uc}
+}
+
+func (p *contactPolicy) Create(ctx context.Context, contacts ...*contact.Contact) ([]*contact.Contact, error) {
+	if err := p.policy.Require(ctx, RoleEditor); err != nil {
+		return nil, err
+	}
+	return p.next.Create(ctx, contacts...)
+}
+
+func (p *contactPolicy) CreateBatch(ctx context.Context, mode useCase.BatchMode, items ...*useCase.BatchItem) ([]*useCase.BatchItem, error) {
+	if err := p.policy.Require(ctx, RoleEditor); err != nil {
+		return nil, err
+	}
+	return p.next.CreateBatch(ctx, mode, items...)
+}
+
+func (p *contactPolicy) Update(ctx context.Context, contactUpdate contact.Contact) (*contact.Contact, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactUpdate.ID(), share.LevelWrite); err != nil {
+		return nil, err
+	}
+	return p.next.Update(ctx, contactUpdate)
+}
+
+func (p *contactPolicy) Delete(ctx context.Context, ID uuid.UUID) error {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, ID, share.LevelWrite); err != nil {
+		return err
+	}
+	return p.next.Delete(ctx, ID)
+}
+
+func (p *contactPolicy) Restore(ctx context.Context, ID uuid.UUID) (*contact.Contact, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, ID, share.LevelWrite); err != nil {
+		return nil, err
+	}
+	return p.next.Restore(ctx, ID)
+}
+
+func (p *contactPolicy) AddTags(ctx context.Context, contactID uuid.UUID, tags ...tagName.Name) (*contact.Contact, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactID, share.LevelWrite); err != nil {
+		return nil, err
+	}
+	return p.next.AddTags(ctx, contactID, tags...)
+}
+
+func (p *contactPolicy) RemoveTags(ctx context.Context, contactID uuid.UUID, tags ...tagName.Name) (*contact.Contact, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactID, share.LevelWrite); err != nil {
+		return nil, err
+	}
+	return p.next.RemoveTags(ctx, contactID, tags...)
+}
+
+func (p *contactPolicy) Revert(ctx context.Context, ID uuid.UUID, number uint64) (*contact.Contact, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, ID, share.LevelWrite); err != nil {
+		return nil, err
+	}
+	return p.next.Revert(ctx, ID, number)
+}
+
+func (p *contactPolicy) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*contact.Contact, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.List(p.policy.restrict(ctx), parameter)
+}
+
+func (p *contactPolicy) ReadByID(ctx context.Context, ID uuid.UUID) (*contact.Contact, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, ID, share.LevelRead); err != nil {
+		return nil, err
+	}
+	return p.next.ReadByID(ctx, ID)
+}
+
+func (p *contactPolicy) Count(ctx context.Context, filters filter.Filters) (uint64, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return 0, err
+	}
+	return p.next.Count(p.policy.restrict(ctx), filters)
+}
+
+func (p *contactPolicy) ListBirthday(ctx context.Context, from, to time.Time, parameter queryParameter.QueryParameter) ([]*contact.Contact, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.ListBirthday(p.policy.restrict(ctx), from, to, parameter)
+}
+
+func (p *contactPolicy) ReadByIDAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*contact.Contact, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, ID, share.LevelRead); err != nil {
+		return nil, err
+	}
+	return p.next.ReadByIDAsOf(ctx, ID, asOf)
+}
+
+func (p *contactPolicy) ListVersion(ctx context.Context, ID uuid.UUID, parameter queryParameter.QueryParameter) ([]*version.Version, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, ID, share.LevelRead); err != nil {
+		return nil, err
+	}
+	return p.next.ListVersion(ctx, ID, parameter)
+}
+
+func (p *contactPolicy) CountVersion(ctx context.Context, ID uuid.UUID) (uint64, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, ID, share.LevelRead); err != nil {
+		return 0, err
+	}
+	return p.next.CountVersion(ctx, ID)
+}
+
+type notePolicy struct {
+	next   useCase.Note
+	policy *UseCase
+}
+
+// Note заметки контакта подчиняются тем же правилам, что и сам контакт
+func (uc *UseCase) Note(next useCase.Note) useCase.Note {
+	return &notePolicy{next: next, policy: uc}
+}
+
+func (p *notePolicy) Create(ctx context.Context, noteCreate *note.Note) (*note.Note, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, noteCreate.ContactID(), share.LevelWrite); err != nil {
+		return nil, err
+	}
+	return p.next.Create(ctx, noteCreate)
+}
+
+func (p *notePolicy) Update(ctx context.Context, noteUpdate *note.Note) (*note.Note, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, noteUpdate.ContactID(), share.LevelWrite); err != nil {
+		return nil, err
+	}
+	return p.next.Update(ctx, noteUpdate)
+}
+
+func (p *notePolicy) Delete(ctx context.Context, contactID, ID uuid.UUID) error {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactID, share.LevelWrite); err != nil {
+		return err
+	}
+	return p.next.Delete(ctx, contactID, ID)
+}
+
+func (p *notePolicy) List(ctx context.Context, contactID uuid.UUID, parameter queryParameter.QueryParameter) ([]*note.Note, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactID, share.LevelRead); err != nil {
+		return nil, err
+	}
+	return p.next.List(ctx, contactID, parameter)
+}
+
+func (p *notePolicy) ReadByID(ctx context.Context, contactID, ID uuid.UUID) (*note.Note, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactID, share.LevelRead); err != nil {
+		return nil, err
+	}
+	return p.next.ReadByID(ctx, contactID, ID)
+}
+
+func (p *notePolicy) Count(ctx context.Context, contactID uuid.UUID) (uint64, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactID, share.LevelRead); err != nil {
+		return 0, err
+	}
+	return p.next.Count(ctx, contactID)
+}
+
+type photoPolicy struct {
+	next   useCase.Photo
+	policy *UseCase
+}
+
+func (uc *UseCase) Photo(next useCase.Photo) useCase.Photo {
+	return &photoPolicy{next: next, policy: uc}
+}
+
+func (p *photoPolicy) Upload(ctx context.Context, contactID uuid.UUID, data []byte) (*contact.Contact, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactID, share.LevelWrite); err != nil {
+		return nil, err
+	}
+	return p.next.Upload(ctx, contactID, data)
+}
+
+func (p *photoPolicy) Read(ctx context.Context, contactID uuid.UUID, thumbnail bool) ([]byte, string, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactID, share.LevelRead); err != nil {
+		return nil, "", err
+	}
+	return p.next.Read(ctx, contactID, thumbnail)
+}
+
+func (p *photoPolicy) Delete(ctx context.Context, contactID uuid.UUID) (*contact.Contact, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactID, share.LevelWrite); err != nil {
+		return nil, err
+	}
+	return p.next.Delete(ctx, contactID)
+}
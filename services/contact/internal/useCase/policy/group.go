@@ -0,0 +1,125 @@
+package policy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/group"
+	"architecture_go/services/contact/internal/domain/group/acl"
+	"architecture_go/services/contact/internal/domain/share"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+type groupPolicy struct {
+	next   useCase.Group
+	policy *UseCase
+}
+
+// Group без владельца читают все роли, группу с владельцем -- те, кому она открыта. Изменение группы и её состава -- уровень editor, удаление группы
+// и управление её списком доступа -- уровень admin, см. UseCase.RequireGroup
+func (uc *UseCase) Group(next useCase.Group) useCase.Group {
+	return &groupPolicy{next: next, policy: uc}
+}
+
+func (p *groupPolicy) Create(ctx context.Context, groupCreate *group.Group) (*group.Group, error) {
+	if err := p.policy.Require(ctx, RoleEditor); err != nil {
+		return nil, err
+	}
+	return p.next.Create(ctx, groupCreate)
+}
+
+func (p *groupPolicy) Update(ctx context.Context, groupUpdate *group.Group) (*group.Group, error) {
+	if err := p.policy.RequireGroup(ctx, groupUpdate.ID(), acl.LevelEditor); err != nil {
+		return nil, err
+	}
+	return p.next.Update(ctx, groupUpdate)
+}
+
+func (p *groupPolicy) Delete(ctx context.Context, ID uuid.UUID) error {
+	if err := p.policy.RequireGroup(ctx, ID, acl.LevelAdmin); err != nil {
+		return err
+	}
+	return p.next.Delete(ctx, ID)
+}
+
+func (p *groupPolicy) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*group.Group, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.List(p.policy.restrict(ctx), parameter)
+}
+
+func (p *groupPolicy) ReadByID(ctx context.Context, ID uuid.UUID) (*group.Group, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceGroup, ID, share.LevelRead); err != nil {
+		return nil, err
+	}
+	return p.next.ReadByID(ctx, ID)
+}
+
+func (p *groupPolicy) Count(ctx context.Context) (uint64, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return 0, err
+	}
+	return p.next.Count(p.policy.restrict(ctx))
+}
+
+func (p *groupPolicy) ReadByIDAsOf(ctx context.Context, ID uuid.UUID, asOf time.Time) (*group.Group, error) {
+	if err := p.policy.RequireShared(ctx, share.ResourceGroup, ID, share.LevelRead); err != nil {
+		return nil, err
+	}
+	return p.next.ReadByIDAsOf(ctx, ID, asOf)
+}
+
+// CreateContactIntoGroup создаёт контакты, поэтому вдобавок к уровню editor в группе нужна роль editor
+func (p *groupPolicy) CreateContactIntoGroup(ctx context.Context, groupID uuid.UUID, contacts ...*contact.Contact) ([]*contact.Contact, error) {
+	if err := p.policy.Require(ctx, RoleEditor); err != nil {
+		return nil, err
+	}
+	if err := p.policy.RequireGroup(ctx, groupID, acl.LevelEditor); err != nil {
+		return nil, err
+	}
+	return p.next.CreateContactIntoGroup(ctx, groupID, contacts...)
+}
+
+// AddContactToGroup добавить в группу можно только видимый контакт: группа откроет его всем, кому открыта она
+func (p *groupPolicy) AddContactToGroup(ctx context.Context, groupID, contactID uuid.UUID) error {
+	if err := p.policy.RequireGroup(ctx, groupID, acl.LevelEditor); err != nil {
+		return err
+	}
+	if err := p.policy.RequireShared(ctx, share.ResourceContact, contactID, share.LevelRead); err != nil {
+		return err
+	}
+	return p.next.AddContactToGroup(ctx, groupID, contactID)
+}
+
+func (p *groupPolicy) DeleteContactFromGroup(ctx context.Context, groupID, contactID uuid.UUID) error {
+	if err := p.policy.RequireGroup(ctx, groupID, acl.LevelEditor); err != nil {
+		return err
+	}
+	return p.next.DeleteContactFromGroup(ctx, groupID, contactID)
+}
+
+func (p *groupPolicy) ListACL(ctx context.Context, groupID uuid.UUID) ([]*acl.Entry, error) {
+	if err := p.policy.RequireGroup(ctx, groupID, acl.LevelEditor); err != nil {
+		return nil, err
+	}
+	return p.next.ListACL(ctx, groupID)
+}
+
+func (p *groupPolicy) SetACL(ctx context.Context, entry *acl.Entry) (*acl.Entry, error) {
+	if err := p.policy.RequireGroup(ctx, entry.GroupID(), acl.LevelAdmin); err != nil {
+		return nil, err
+	}
+	return p.next.SetACL(ctx, entry)
+}
+
+func (p *groupPolicy) DeleteACL(ctx context.Context, groupID uuid.UUID, grantee string) error {
+	if err := p.policy.RequireGroup(ctx, groupID, acl.LevelAdmin); err != nil {
+		return err
+	}
+	return p.next.DeleteACL(ctx, groupID, grantee)
+}
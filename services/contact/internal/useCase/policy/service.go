@@ -0,0 +1,171 @@
+package policy
+
+import (
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/audit"
+	"architecture_go/services/contact/internal/domain/delta"
+	"architecture_go/services/contact/internal/domain/outbox"
+	"architecture_go/services/contact/internal/domain/webhook"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+type auditPolicy struct {
+	next   useCase.Audit
+	policy *UseCase
+}
+
+// Audit общий журнал -- только admin, история отдельного контакта доступна всем, кто видит контакт
+func (uc *UseCase) Audit(next useCase.Audit) useCase.Audit {
+	return &auditPolicy{next: next, policy: uc}
+}
+
+func (p *auditPolicy) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*audit.Entry, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return nil, err
+	}
+	return p.next.List(ctx, parameter)
+}
+
+func (p *auditPolicy) Count(ctx context.Context, filters filter.Filters) (uint64, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return 0, err
+	}
+	return p.next.Count(ctx, filters)
+}
+
+func (p *auditPolicy) History(ctx context.Context, contactID uuid.UUID, parameter queryParameter.QueryParameter) ([]*audit.Entry, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.History(ctx, contactID, parameter)
+}
+
+func (p *auditPolicy) CountHistory(ctx context.Context, contactID uuid.UUID, filters filter.Filters) (uint64, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return 0, err
+	}
+	return p.next.CountHistory(ctx, contactID, filters)
+}
+
+type webhookPolicy struct {
+	next   useCase.Webhook
+	policy *UseCase
+}
+
+// Webhook подписка получает все изменения, поэтому вебхуки, включая чтение, -- только admin
+func (uc *UseCase) Webhook(next useCase.Webhook) useCase.Webhook {
+	return &webhookPolicy{next: next, policy: uc}
+}
+
+func (p *webhookPolicy) Create(ctx context.Context, hookCreate *webhook.Webhook) (*webhook.Webhook, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return nil, err
+	}
+	return p.next.Create(ctx, hookCreate)
+}
+
+func (p *webhookPolicy) Update(ctx context.Context, hookUpdate *webhook.Webhook) (*webhook.Webhook, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return nil, err
+	}
+	return p.next.Update(ctx, hookUpdate)
+}
+
+func (p *webhookPolicy) Delete(ctx context.Context, ID uuid.UUID) error {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return err
+	}
+	return p.next.Delete(ctx, ID)
+}
+
+func (p *webhookPolicy) Replay(ctx context.Context, webhookID, ID uuid.UUID) (*webhook.Delivery, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return nil, err
+	}
+	return p.next.Replay(ctx, webhookID, ID)
+}
+
+func (p *webhookPolicy) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*webhook.Webhook, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return nil, err
+	}
+	return p.next.List(ctx, parameter)
+}
+
+func (p *webhookPolicy) ReadByID(ctx context.Context, ID uuid.UUID) (*webhook.Webhook, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return nil, err
+	}
+	return p.next.ReadByID(ctx, ID)
+}
+
+func (p *webhookPolicy) Count(ctx context.Context) (uint64, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return 0, err
+	}
+	return p.next.Count(ctx)
+}
+
+func (p *webhookPolicy) ListDelivery(ctx context.Context, webhookID uuid.UUID, parameter queryParameter.QueryParameter) ([]*webhook.Delivery, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return nil, err
+	}
+	return p.next.ListDelivery(ctx, webhookID, parameter)
+}
+
+func (p *webhookPolicy) CountDelivery(ctx context.Context, webhookID uuid.UUID, filters filter.Filters) (uint64, error) {
+	if err := p.policy.Require(ctx, RoleAdmin); err != nil {
+		return 0, err
+	}
+	return p.next.CountDelivery(ctx, webhookID, filters)
+}
+
+type deltaPolicy struct {
+	next   useCase.Delta
+	policy *UseCase
+}
+
+func (uc *UseCase) Delta(next useCase.Delta) useCase.Delta {
+	return &deltaPolicy{next: next, policy: uc}
+}
+
+func (p *deltaPolicy) Changes(ctx context.Context, since delta.Token, limit uint64) (*delta.Delta, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return nil, err
+	}
+	return p.next.Changes(p.policy.restrict(ctx), since, limit)
+}
+
+type feedPolicy struct {
+	next   useCase.Feed
+	policy *UseCase
+}
+
+func (uc *UseCase) Feed(next useCase.Feed) useCase.Feed {
+	return &feedPolicy{next: next, policy: uc}
+}
+
+func (p *feedPolicy) Follow(ctx context.Context, after int64, fn func(messages []*outbox.Message) error) error {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return err
+	}
+	return p.next.Follow(p.policy.restrict(ctx), after, fn)
+}
+
+func (p *feedPolicy) Check(ctx context.Context, after int64) error {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return err
+	}
+	return p.next.Check(p.policy.restrict(ctx), after)
+}
+
+func (p *feedPolicy) LastSequence(ctx context.Context) (int64, error) {
+	if err := p.policy.Require(ctx, RoleViewer); err != nil {
+		return 0, err
+	}
+	return p.next.LastSequence(ctx)
+}
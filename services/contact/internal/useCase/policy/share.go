@@ -0,0 +1,47 @@
+package policy
+
+import (
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/share"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+type sharePolicy struct {
+	next   useCase.Share
+	policy *UseCase
+}
+
+// Share список доступа видит тот, кому ресурс открыт; выдаёт доступ и передаёт ресурс его владелец
+func (uc *UseCase) Share(next useCase.Share) useCase.Share {
+	return &sharePolicy{next: next, policy: uc}
+}
+
+func (p *sharePolicy) Share(ctx context.Context, value *share.Share) (*share.Share, error) {
+	if err := p.policy.RequireOwner(ctx, value.Resource(), value.ResourceID()); err != nil {
+		return nil, err
+	}
+	return p.next.Share(ctx, value)
+}
+
+func (p *sharePolicy) Unshare(ctx context.Context, resource share.Resource, resourceID uuid.UUID, grantee string) error {
+	if err := p.policy.RequireOwner(ctx, resource, resourceID); err != nil {
+		return err
+	}
+	return p.next.Unshare(ctx, resource, resourceID, grantee)
+}
+
+func (p *sharePolicy) List(ctx context.Context, resource share.Resource, resourceID uuid.UUID) ([]*share.Share, error) {
+	if err := p.policy.RequireShared(ctx, resource, resourceID, share.LevelRead); err != nil {
+		return nil, err
+	}
+	return p.next.List(ctx, resource, resourceID)
+}
+
+func (p *sharePolicy) TransferOwner(ctx context.Context, resource share.Resource, resourceID uuid.UUID, owner string) error {
+	if err := p.policy.RequireOwner(ctx, resource, resourceID); err != nil {
+		return err
+	}
+	return p.next.TransferOwner(ctx, resource, resourceID, owner)
+}
@@ -0,0 +1,84 @@
+package policy
+
+import (
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/tenant"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+type tenantPolicy struct {
+	next   useCase.Tenant
+	policy *UseCase
+}
+
+// Tenant управление арендаторами -- только operator. Resolve и Select вызываются самим сервисом
+// до проверки прав, поэтому не ограничены.
+func (uc *UseCase) Tenant(next useCase.Tenant) useCase.Tenant {
+	return &tenantPolicy{next: next, policy: uc}
+}
+
+func (p *tenantPolicy) Create(ctx context.Context, tenantCreate *tenant.Tenant) (*tenant.Tenant, error) {
+	if err := p.policy.RequireOperator(ctx); err != nil {
+		return nil, err
+	}
+	return p.next.Create(ctx, tenantCreate)
+}
+
+func (p *tenantPolicy) Update(ctx context.Context, tenantUpdate *tenant.Tenant) (*tenant.Tenant, error) {
+	if err := p.policy.RequireOperator(ctx); err != nil {
+		return nil, err
+	}
+	return p.next.Update(ctx, tenantUpdate)
+}
+
+func (p *tenantPolicy) Disable(ctx context.Context, ID string) (*tenant.Tenant, error) {
+	if err := p.policy.RequireOperator(ctx); err != nil {
+		return nil, err
+	}
+	return p.next.Disable(ctx, ID)
+}
+
+func (p *tenantPolicy) Enable(ctx context.Context, ID string) (*tenant.Tenant, error) {
+	if err := p.policy.RequireOperator(ctx); err != nil {
+		return nil, err
+	}
+	return p.next.Enable(ctx, ID)
+}
+
+func (p *tenantPolicy) Resolve(ctx context.Context, ID string) (*tenant.Tenant, error) {
+	return p.next.Resolve(ctx, ID)
+}
+
+func (p *tenantPolicy) Select(ctx context.Context, value *principal.Principal, requested string) (string, error) {
+	return p.next.Select(ctx, value, requested)
+}
+
+func (p *tenantPolicy) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*tenant.Tenant, error) {
+	if err := p.policy.RequireOperator(ctx); err != nil {
+		return nil, err
+	}
+	return p.next.List(ctx, parameter)
+}
+
+func (p *tenantPolicy) ReadByID(ctx context.Context, ID string) (*tenant.Tenant, error) {
+	if err := p.policy.RequireOperator(ctx); err != nil {
+		return nil, err
+	}
+	return p.next.ReadByID(ctx, ID)
+}
+
+func (p *tenantPolicy) Count(ctx context.Context) (uint64, error) {
+	if err := p.policy.RequireOperator(ctx); err != nil {
+		return 0, err
+	}
+	return p.next.Count(ctx)
+}
+
+func (p *tenantPolicy) Usage(ctx context.Context, ID string) (*tenant.Usage, error) {
+	if err := p.policy.RequireOperator(ctx); err != nil {
+		return nil, err
+	}
+	return p.next.Usage(ctx, ID)
+}
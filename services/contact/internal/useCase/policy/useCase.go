@@ -0,0 +1,212 @@
+package policy
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/contact/internal/domain/group/acl"
+	"architecture_go/services/contact/internal/domain/share"
+	"architecture_go/services/contact/internal/useCase"
+	"architecture_go/services/contact/internal/useCase/adapters/storage"
+)
+
+// Роли сервиса, каждая следующая включает предыдущие
+const (
+	// RoleViewer только чтение
+	RoleViewer = "viewer"
+	// RoleEditor изменение контактов, групп без списка доступа и справочников
+	RoleEditor = "editor"
+	// RoleAdmin удаление групп, слияние тегов, удаление настраиваемых полей, вебхуки и журнал аудита
+	RoleAdmin = "admin"
+
+	// RoleOperator управление арендаторами. Роль вне иерархии: admin арендатора её не включает,
+	// а operator не получает доступа к данным арендаторов
+	RoleOperator = "operator"
+)
+
+var rank = map[string]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+// UseCase проверяет права автора запроса до вызова сценария. Обёртки из этого пакета
+// реализуют интерфейсы useCase, поэтому HTTP и gRPC получают одни и те же правила.
+type UseCase struct {
+	adapterStorage storage.Policy
+	options        Options
+}
+
+type Options struct {
+	// AnonymousRoles роли через запятую для запросов без автора, по умолчанию анонимным запрещено всё
+	AnonymousRoles string
+}
+
+func New(storage storage.Policy, options Options) *UseCase {
+	var uc = &UseCase{
+		adapterStorage: storage,
+	}
+	uc.SetOptions(options)
+	return uc
+}
+
+func (uc *UseCase) SetOptions(options Options) {
+	if uc.options != options {
+		uc.options = options
+		log.Info("set new options", zap.Any("options", uc.options))
+	}
+}
+
+// caller автор запроса; анонимный запрос получает роли из options.AnonymousRoles
+func (uc *UseCase) caller(ctx context.Context) principal.Principal {
+	if value := ctx.Principal(); value != nil {
+		return *value
+	}
+
+	var result = principal.Principal{Subject: ctx.Actor()}
+	for _, role := range strings.Split(uc.options.AnonymousRoles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			result.Roles = append(result.Roles, role)
+		}
+	}
+	return result
+}
+
+// granted у p есть роль role или старше
+func granted(p principal.Principal, role string) bool {
+	for _, value := range p.Roles {
+		if rank[value] > 0 && rank[value] >= rank[role] {
+			return true
+		}
+	}
+	return false
+}
+
+// Require действие разрешено автору с ролью role или старше
+func (uc *UseCase) Require(ctx context.Context, role string) error {
+	var p = uc.caller(ctx)
+	if granted(p, role) {
+		return nil
+	}
+	return deny(ctx, p, zap.String("role", role))
+}
+
+// RequireOperator действие оператора сервиса
+func (uc *UseCase) RequireOperator(ctx context.Context) error {
+	var p = uc.caller(ctx)
+	if p.HasRole(RoleOperator) {
+		return nil
+	}
+	return deny(ctx, p, zap.String("role", RoleOperator))
+}
+
+// RequireGroup действие с группой groupID на уровне level. Администратору сервиса разрешено всё.
+// Владельцу группы соответствует уровень admin, доступу на запись -- уровень editor.
+// Пока у группы нет ни владельца, ни списка доступа, уровню editor соответствует роль editor,
+// уровню admin -- роль admin; иначе глобальная роль editor уже не помогает.
+func (uc *UseCase) RequireGroup(ctx context.Context, groupID uuid.UUID, level acl.Level) error {
+	var p = uc.caller(ctx)
+	if granted(p, RoleAdmin) {
+		return nil
+	}
+
+	access, err := uc.adapterStorage.ReadAccess(ctx, share.ResourceGroup, groupID, share.Grantees(p))
+	if err != nil {
+		return err
+	}
+
+	if access.Owned || (level == acl.LevelEditor && access.Level == share.LevelWrite) {
+		return nil
+	}
+
+	entries, err := uc.adapterStorage.ListGroupACL(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 && access.Owner == "" {
+		var role = RoleEditor
+		if level == acl.LevelAdmin {
+			role = RoleAdmin
+		}
+		if granted(p, role) {
+			return nil
+		}
+		return deny(ctx, p, zap.String("role", role), zap.Stringer("groupId", groupID))
+	}
+
+	for _, entry := range entries {
+		if entry.Matches(p) && entry.Level().Allows(level) {
+			return nil
+		}
+	}
+	return deny(ctx, p, zap.Stringer("level", level), zap.Stringer("groupId", groupID))
+}
+
+// RequireShared действие с контактом или группой ID на уровне level. Администратору сервиса разрешено всё.
+// Ресурс без владельца общий: чтению соответствует роль viewer, изменению -- роль editor.
+// У ресурса с владельцем решают владение и записи доступа, а роль viewer нужна как допуск к сервису.
+func (uc *UseCase) RequireShared(ctx context.Context, resource share.Resource, ID uuid.UUID, level share.Level) error {
+	var p = uc.caller(ctx)
+	if granted(p, RoleAdmin) {
+		return nil
+	}
+
+	access, err := uc.adapterStorage.ReadAccess(ctx, resource, ID, share.Grantees(p))
+	if err != nil {
+		return err
+	}
+
+	if access.Owner == "" {
+		var role = RoleViewer
+		if level == share.LevelWrite {
+			role = RoleEditor
+		}
+		if granted(p, role) {
+			return nil
+		}
+		return deny(ctx, p, zap.String("role", role), zap.Stringer(resource.String()+"Id", ID))
+	}
+
+	if granted(p, RoleViewer) && access.Allows(level) {
+		return nil
+	}
+	return deny(ctx, p, zap.Stringer("level", level), zap.Stringer(resource.String()+"Id", ID))
+}
+
+// RequireOwner выдавать доступ и передавать ресурс может его владелец, а общий ресурс -- только администратор
+func (uc *UseCase) RequireOwner(ctx context.Context, resource share.Resource, ID uuid.UUID) error {
+	var p = uc.caller(ctx)
+	if granted(p, RoleAdmin) {
+		return nil
+	}
+
+	access, err := uc.adapterStorage.ReadAccess(ctx, resource, ID, share.Grantees(p))
+	if err != nil {
+		return err
+	}
+
+	if access.Owned {
+		return nil
+	}
+	return deny(ctx, p, zap.String("owner", access.Owner), zap.Stringer(resource.String()+"Id", ID))
+}
+
+// restrict ограничивает списки контактов и групп видимыми автору запроса, администратору видно всё
+func (uc *UseCase) restrict(ctx context.Context) context.Context {
+	var p = uc.caller(ctx)
+	if granted(p, RoleAdmin) {
+		return ctx
+	}
+	return useCase.WithVisibility(ctx, share.Grantees(p))
+}
+
+func deny(ctx context.Context, p principal.Principal, fields ...zap.Field) error {
+	log.WarnWithContext(ctx, "permission denied", append(fields, zap.String("subject", p.Subject), zap.Strings("roles", p.Roles))...)
+	return useCase.ErrPermissionDenied
+}
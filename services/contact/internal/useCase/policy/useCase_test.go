@@ -0,0 +1,148 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/contact/internal/domain/group/acl"
+	"architecture_go/services/contact/internal/domain/share"
+	mockStorage "architecture_go/services/contact/internal/repository/storage/mock"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func TestRequireGroup(t *testing.T) {
+	var (
+		open       = uuid.New()
+		restricted = uuid.New()
+		owned      = uuid.New()
+		now        = time.Now()
+
+		storageMock = new(mockStorage.Policy)
+		uc          = New(storageMock, Options{})
+	)
+
+	storageMock.On("ReadAccess", mock.Anything, share.ResourceGroup, open, mock.Anything).Return(&share.Access{}, nil)
+	storageMock.On("ReadAccess", mock.Anything, share.ResourceGroup, restricted, mock.Anything).Return(&share.Access{}, nil)
+	storageMock.On("ReadAccess", mock.Anything, share.ResourceGroup, owned, []string{"owner"}).Return(&share.Access{Owner: "owner", Owned: true}, nil)
+	storageMock.On("ReadAccess", mock.Anything, share.ResourceGroup, owned, []string{"u3", "role:editor"}).Return(&share.Access{Owner: "owner", Level: share.LevelWrite}, nil)
+	storageMock.On("ReadAccess", mock.Anything, share.ResourceGroup, owned, mock.Anything).Return(&share.Access{Owner: "owner"}, nil)
+	storageMock.On("ListGroupACL", mock.Anything, open).Return([]*acl.Entry{}, nil)
+	storageMock.On("ListGroupACL", mock.Anything, owned).Return([]*acl.Entry{}, nil)
+	storageMock.On("ListGroupACL", mock.Anything, restricted).Return([]*acl.Entry{
+		acl.NewWithID(restricted, "role:sales", acl.LevelEditor, now),
+		acl.NewWithID(restricted, "lead", acl.LevelAdmin, now),
+	}, nil)
+
+	var cases = []struct {
+		name    string
+		caller  *principal.Principal
+		groupID uuid.UUID
+		level   acl.Level
+		allowed bool
+	}{
+		{"editor updates group without acl", caller("u1", RoleEditor), open, acl.LevelEditor, true},
+		{"editor cannot delete group without acl", caller("u1", RoleEditor), open, acl.LevelAdmin, false},
+		{"viewer cannot update group without acl", caller("u1", RoleViewer), open, acl.LevelEditor, false},
+		{"editor outside acl cannot update", caller("u1", RoleEditor), restricted, acl.LevelEditor, false},
+		{"team role updates restricted group", caller("u2", RoleViewer, "sales"), restricted, acl.LevelEditor, true},
+		{"team role cannot delete restricted group", caller("u2", RoleViewer, "sales"), restricted, acl.LevelAdmin, false},
+		{"acl admin deletes restricted group", caller("lead"), restricted, acl.LevelAdmin, true},
+		{"service admin bypasses acl", caller("root", RoleAdmin), restricted, acl.LevelAdmin, true},
+		{"anonymous is denied", nil, open, acl.LevelEditor, false},
+		{"owner deletes owned group", caller("owner"), owned, acl.LevelAdmin, true},
+		{"write share updates owned group", caller("u3", RoleEditor), owned, acl.LevelEditor, true},
+		{"write share cannot delete owned group", caller("u3", RoleEditor), owned, acl.LevelAdmin, false},
+		{"editor role does not help with owned group", caller("u1", RoleEditor), owned, acl.LevelEditor, false},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			var ctx = context.Empty()
+			if test.caller != nil {
+				ctx.WithValue(context.KeyPrincipal, test.caller)
+			}
+
+			err := uc.RequireGroup(ctx, test.groupID, test.level)
+			if test.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, useCase.ErrPermissionDenied)
+			}
+		})
+	}
+}
+
+func TestRequireShared(t *testing.T) {
+	var (
+		common  = uuid.New()
+		private = uuid.New()
+
+		storageMock = new(mockStorage.Policy)
+		uc          = New(storageMock, Options{})
+	)
+
+	storageMock.On("ReadAccess", mock.Anything, share.ResourceContact, common, mock.Anything).Return(&share.Access{}, nil)
+	storageMock.On("ReadAccess", mock.Anything, share.ResourceContact, private, []string{"owner", "role:viewer"}).Return(&share.Access{Owner: "owner", Owned: true}, nil)
+	storageMock.On("ReadAccess", mock.Anything, share.ResourceContact, private, []string{"u2", "role:viewer"}).Return(&share.Access{Owner: "owner", ViaGroup: true}, nil)
+	storageMock.On("ReadAccess", mock.Anything, share.ResourceContact, private, mock.Anything).Return(&share.Access{Owner: "owner"}, nil)
+
+	var cases = []struct {
+		name      string
+		caller    *principal.Principal
+		contactID uuid.UUID
+		level     share.Level
+		allowed   bool
+	}{
+		{"viewer reads common contact", caller("u1", RoleViewer), common, share.LevelRead, true},
+		{"viewer cannot update common contact", caller("u1", RoleViewer), common, share.LevelWrite, false},
+		{"owner updates own contact", caller("owner", RoleViewer), private, share.LevelWrite, true},
+		{"group member reads contact", caller("u2", RoleViewer), private, share.LevelRead, true},
+		{"group member cannot update contact", caller("u2", RoleViewer), private, share.LevelWrite, false},
+		{"editor cannot read foreign contact", caller("u1", RoleEditor), private, share.LevelRead, false},
+		{"service admin reads everything", caller("root", RoleAdmin), private, share.LevelWrite, true},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			var ctx = context.Empty()
+			ctx.WithValue(context.KeyPrincipal, test.caller)
+
+			err := uc.RequireShared(ctx, share.ResourceContact, test.contactID, test.level)
+			if test.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, useCase.ErrPermissionDenied)
+			}
+		})
+	}
+}
+
+func TestRequireAnonymous(t *testing.T) {
+	var uc = New(new(mockStorage.Policy), Options{AnonymousRoles: "viewer"})
+
+	assert.NoError(t, uc.Require(context.Empty(), RoleViewer))
+	assert.ErrorIs(t, uc.Require(context.Empty(), RoleEditor), useCase.ErrPermissionDenied)
+}
+
+func TestRequireOperator(t *testing.T) {
+	var uc = New(new(mockStorage.Policy), Options{})
+
+	var ctx = context.Empty()
+	ctx.WithValue(context.KeyPrincipal, caller("ops", RoleOperator))
+	assert.NoError(t, uc.RequireOperator(ctx))
+	assert.ErrorIs(t, uc.Require(ctx, RoleViewer), useCase.ErrPermissionDenied)
+
+	ctx = context.Empty()
+	ctx.WithValue(context.KeyPrincipal, caller("root", RoleAdmin))
+	assert.ErrorIs(t, uc.RequireOperator(ctx), useCase.ErrPermissionDenied)
+}
+
+func caller(subject string, roles ...string) *principal.Principal {
+	return &principal.Principal{Subject: subject, Roles: roles}
+}
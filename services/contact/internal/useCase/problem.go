@@ -0,0 +1,150 @@
+package useCase
+
+import (
+	"architecture_go/pkg/type/problem"
+	"architecture_go/services/contact/internal/domain/apiKey"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/contact/address"
+	"architecture_go/services/contact/internal/domain/contact/age"
+	"architecture_go/services/contact/internal/domain/contact/birthday"
+	"architecture_go/services/contact/internal/domain/contact/employment"
+	contactName "architecture_go/services/contact/internal/domain/contact/name"
+	"architecture_go/services/contact/internal/domain/contact/patronymic"
+	"architecture_go/services/contact/internal/domain/contact/photo"
+	"architecture_go/services/contact/internal/domain/contact/surname"
+	"architecture_go/services/contact/internal/domain/customField"
+	"architecture_go/services/contact/internal/domain/customField/fieldType"
+	"architecture_go/services/contact/internal/domain/customField/key"
+	"architecture_go/services/contact/internal/domain/customField/label"
+	"architecture_go/services/contact/internal/domain/delta"
+	"architecture_go/services/contact/internal/domain/group/acl"
+	"architecture_go/services/contact/internal/domain/group/description"
+	groupName "architecture_go/services/contact/internal/domain/group/name"
+	"architecture_go/services/contact/internal/domain/note"
+	"architecture_go/services/contact/internal/domain/note/author"
+	"architecture_go/services/contact/internal/domain/note/body"
+	"architecture_go/services/contact/internal/domain/note/noteType"
+	organizationName "architecture_go/services/contact/internal/domain/organization/name"
+	"architecture_go/services/contact/internal/domain/organization/taxID"
+	"architecture_go/services/contact/internal/domain/organization/website"
+	"architecture_go/services/contact/internal/domain/outbox"
+	"architecture_go/services/contact/internal/domain/session"
+	"architecture_go/services/contact/internal/domain/share"
+	tagName "architecture_go/services/contact/internal/domain/tag/name"
+	"architecture_go/services/contact/internal/domain/tenant"
+	"architecture_go/services/contact/internal/domain/webhook"
+)
+
+// CodeValidation запрос не прошёл проверку полей при разборе, причины -- в invalid-params
+const CodeValidation problem.Code = "validation_failed"
+
+// Problems каталог ошибок сервиса: сценариев и доменных значений. Коды -- часть API,
+// их нельзя менять или переиспользовать; новая ошибка получает новый код.
+var Problems = problem.Catalogue{
+	{Err: ErrContactNotFound, Code: "contact_not_found", Kind: problem.KindNotFound},
+	{Err: ErrGroupNotFound, Code: "group_not_found", Kind: problem.KindNotFound},
+	{Err: ErrGroupACLNotFound, Code: "group_acl_not_found", Kind: problem.KindNotFound},
+	{Err: ErrShareNotFound, Code: "share_not_found", Kind: problem.KindNotFound},
+	{Err: ErrCustomFieldNotFound, Code: "custom_field_not_found", Kind: problem.KindNotFound},
+	{Err: ErrCustomFieldExists, Code: "custom_field_exists", Kind: problem.KindConflict, Param: "key"},
+	{Err: ErrTagNotFound, Code: "tag_not_found", Kind: problem.KindNotFound},
+	{Err: ErrTagExists, Code: "tag_exists", Kind: problem.KindConflict, Param: "name"},
+	{Err: ErrOrganizationNotFound, Code: "organization_not_found", Kind: problem.KindNotFound},
+	{Err: ErrOrganizationExists, Code: "organization_exists", Kind: problem.KindConflict, Param: "taxId"},
+	{Err: ErrNoteNotFound, Code: "note_not_found", Kind: problem.KindNotFound},
+	{Err: ErrVersionNotFound, Code: "version_not_found", Kind: problem.KindNotFound},
+	{Err: ErrWebhookNotFound, Code: "webhook_not_found", Kind: problem.KindNotFound},
+	{Err: ErrWebhookDeliveryNotFound, Code: "webhook_delivery_not_found", Kind: problem.KindNotFound},
+	{Err: ErrAPIKeyNotFound, Code: "api_key_not_found", Kind: problem.KindNotFound},
+	{Err: ErrSessionNotFound, Code: "session_not_found", Kind: problem.KindNotFound},
+	{Err: ErrUnauthenticated, Code: "unauthenticated", Kind: problem.KindUnauthenticated},
+	{Err: ErrNoCredentials, Code: "credentials_required", Kind: problem.KindUnauthenticated},
+	{Err: ErrRoleNotGranted, Code: "role_not_granted", Kind: problem.KindPermissionDenied, Param: "roles"},
+	{Err: ErrPermissionDenied, Code: "permission_denied", Kind: problem.KindPermissionDenied},
+	{Err: ErrTenantNotFound, Code: "tenant_not_found", Kind: problem.KindNotFound},
+	{Err: ErrTenantExists, Code: "tenant_exists", Kind: problem.KindConflict, Param: "id"},
+	{Err: ErrTenantDisabled, Code: "tenant_disabled", Kind: problem.KindPermissionDenied},
+	{Err: ErrTenantMismatch, Code: "tenant_mismatch", Kind: problem.KindPermissionDenied},
+	{Err: ErrTenantQuotaExceeded, Code: "tenant_quota_exceeded", Kind: problem.KindConflict},
+	{Err: ErrRateLimited, Code: "rate_limited", Kind: problem.KindRateLimited},
+	{Err: ErrPhotoNotFound, Code: "photo_not_found", Kind: problem.KindNotFound},
+	{Err: ErrPhotoTooLarge, Code: "photo_too_large", Kind: problem.KindTooLarge, Param: "photo"},
+	{Err: ErrBlobNotFound, Code: "blob_not_found", Kind: problem.KindNotFound},
+	{Err: ErrWrongPeriod, Code: "period_invalid", Kind: problem.KindInvalid, Param: "to"},
+	{Err: ErrBatchEmpty, Code: "batch_empty", Kind: problem.KindInvalid, Param: "list"},
+	{Err: ErrBatchTooLarge, Code: "batch_too_large", Kind: problem.KindInvalid, Param: "list"},
+	{Err: ErrBatchRejected, Code: "batch_rejected", Kind: problem.KindInvalid, Param: "list"},
+
+	{Err: ErrNotFound, Code: "not_found", Kind: problem.KindNotFound},
+	{Err: ErrConflict, Code: "conflict", Kind: problem.KindConflict},
+	{Err: ErrReferenceViolation, Code: "reference_violation", Kind: problem.KindConflict},
+	{Err: ErrInvalidData, Code: "invalid_data", Kind: problem.KindInvalid},
+	{Err: ErrConcurrentUpdate, Code: "concurrent_update", Kind: problem.KindConflict},
+	{Err: ErrPreconditionFailed, Code: "precondition_failed", Kind: problem.KindPreconditionFailed},
+	{Err: ErrStorageUnavailable, Code: "storage_unavailable", Kind: problem.KindUnavailable},
+	{Err: ErrStorageTimeout, Code: "storage_timeout", Kind: problem.KindTimeout},
+
+	{Err: contact.ErrPhoneNumberRequired, Code: "contact_phone_number_required", Kind: problem.KindInvalid, Param: "phoneNumber"},
+	{Err: contactName.ErrWrongLength, Code: "contact_name_too_long", Kind: problem.KindInvalid, Param: "name", Params: problem.Params{"max": contactName.MaxLength}},
+	{Err: surname.ErrWrongLength, Code: "contact_surname_too_long", Kind: problem.KindInvalid, Param: "surname", Params: problem.Params{"max": surname.MaxLength}},
+	{Err: patronymic.ErrWrongLength, Code: "contact_patronymic_too_long", Kind: problem.KindInvalid, Param: "patronymic", Params: problem.Params{"max": patronymic.MaxLength}},
+	{Err: age.ErrWrongLength, Code: "contact_age_invalid", Kind: problem.KindInvalid, Param: "age", Params: problem.Params{"max": age.MaxLength}},
+	{Err: employment.ErrWrongLength, Code: "contact_job_title_too_long", Kind: problem.KindInvalid, Param: "jobTitle", Params: problem.Params{"max": employment.MaxJobTitleLength}},
+	{Err: birthday.ErrWrongFormat, Code: "contact_birthday_format_invalid", Kind: problem.KindInvalid, Param: "birthday", Params: problem.Params{"layout": birthday.Layout}},
+	{Err: birthday.ErrWrongDate, Code: "contact_birthday_out_of_range", Kind: problem.KindInvalid, Param: "birthday", Params: problem.Params{"years": age.MaxLength}},
+	{Err: address.ErrWrongCountry, Code: "address_country_invalid", Kind: problem.KindInvalid, Param: "country"},
+	{Err: address.ErrWrongLength, Code: "address_field_too_long", Kind: problem.KindInvalid, Param: "addresses"},
+	{Err: address.ErrWrongPostcode, Code: "address_postcode_invalid", Kind: problem.KindInvalid, Param: "postcode"},
+	{Err: photo.ErrUnsupportedType, Code: "photo_type_unsupported", Kind: problem.KindUnsupportedMediaType, Param: "photo"},
+	{Err: photo.ErrWrongDimensions, Code: "photo_dimensions_too_large", Kind: problem.KindInvalid, Param: "photo", Params: problem.Params{"width": photo.MaxWidth, "height": photo.MaxHeight}},
+
+	{Err: groupName.ErrWrongLength, Code: "group_name_too_long", Kind: problem.KindInvalid, Param: "name", Params: problem.Params{"max": groupName.MaxLength}},
+	{Err: description.ErrWrongLength, Code: "group_description_too_long", Kind: problem.KindInvalid, Param: "description", Params: problem.Params{"max": description.MaxLength}},
+	{Err: acl.ErrWrongGrantee, Code: "group_acl_grantee_invalid", Kind: problem.KindInvalid, Param: "grantee", Params: problem.Params{"max": acl.MaxGranteeLength}},
+	{Err: acl.ErrWrongLevel, Code: "group_acl_level_invalid", Kind: problem.KindInvalid, Param: "level", Params: problem.Params{"levels": acl.LevelEditor.String() + ", " + acl.LevelAdmin.String()}},
+
+	{Err: share.ErrWrongGrantee, Code: "share_grantee_invalid", Kind: problem.KindInvalid, Param: "grantee", Params: problem.Params{"max": share.MaxGranteeLength}},
+	{Err: share.ErrWrongOwner, Code: "share_owner_invalid", Kind: problem.KindInvalid, Param: "owner", Params: problem.Params{"max": share.MaxOwnerLength}},
+	{Err: share.ErrWrongLevel, Code: "share_level_invalid", Kind: problem.KindInvalid, Param: "level", Params: problem.Params{"levels": share.LevelRead.String() + ", " + share.LevelWrite.String()}},
+	{Err: share.ErrWrongResource, Code: "share_resource_invalid", Kind: problem.KindInvalid, Param: "resource", Params: problem.Params{"resources": share.ResourceContact.String() + ", " + share.ResourceGroup.String()}},
+
+	{Err: customField.ErrUnknownField, Code: "custom_field_unknown", Kind: problem.KindInvalid, Param: "customFields"},
+	{Err: customField.ErrRequiredField, Code: "custom_field_required", Kind: problem.KindInvalid, Param: "customFields"},
+	{Err: customField.ErrWrongValue, Code: "custom_field_value_invalid", Kind: problem.KindInvalid, Param: "customFields"},
+	{Err: customField.ErrOptionsRequired, Code: "custom_field_options_required", Kind: problem.KindInvalid, Param: "options"},
+	{Err: customField.ErrOptionsNotAllowed, Code: "custom_field_options_not_allowed", Kind: problem.KindInvalid, Param: "options"},
+	{Err: key.ErrWrongFormat, Code: "custom_field_key_invalid", Kind: problem.KindInvalid, Param: "key", Params: problem.Params{"max": key.MaxLength}},
+	{Err: label.ErrWrongLength, Code: "custom_field_label_too_long", Kind: problem.KindInvalid, Param: "label", Params: problem.Params{"max": label.MaxLength}},
+	{Err: fieldType.ErrUnknown, Code: "custom_field_type_unknown", Kind: problem.KindInvalid, Param: "type"},
+
+	{Err: note.ErrTypeRequired, Code: "note_type_required", Kind: problem.KindInvalid, Param: "type"},
+	{Err: noteType.ErrUnknown, Code: "note_type_unknown", Kind: problem.KindInvalid, Param: "type"},
+	{Err: body.ErrWrongLength, Code: "note_body_invalid", Kind: problem.KindInvalid, Param: "body", Params: problem.Params{"max": body.MaxLength}},
+	{Err: author.ErrWrongLength, Code: "note_author_invalid", Kind: problem.KindInvalid, Param: "author", Params: problem.Params{"max": author.MaxLength}},
+
+	{Err: tagName.ErrWrongLength, Code: "tag_name_invalid", Kind: problem.KindInvalid, Param: "name", Params: problem.Params{"max": tagName.MaxLength}},
+	{Err: tagName.ErrWrongFormat, Code: "tag_name_format_invalid", Kind: problem.KindInvalid, Param: "name"},
+
+	{Err: organizationName.ErrWrongLength, Code: "organization_name_invalid", Kind: problem.KindInvalid, Param: "name", Params: problem.Params{"max": organizationName.MaxLength}},
+	{Err: taxID.ErrWrongFormat, Code: "organization_tax_id_format_invalid", Kind: problem.KindInvalid, Param: "taxId", Params: problem.Params{"max": taxID.MaxLength}},
+	{Err: taxID.ErrWrongINN, Code: "organization_tax_id_checksum_invalid", Kind: problem.KindInvalid, Param: "taxId"},
+	{Err: website.ErrWrongFormat, Code: "organization_website_invalid", Kind: problem.KindInvalid, Param: "website", Params: problem.Params{"max": website.MaxLength}},
+
+	{Err: webhook.ErrWrongURL, Code: "webhook_url_invalid", Kind: problem.KindInvalid, Param: "url", Params: problem.Params{"max": webhook.MaxURLLength}},
+	{Err: webhook.ErrPrivateURL, Code: "webhook_url_private", Kind: problem.KindInvalid, Param: "url"},
+	{Err: webhook.ErrWrongEvent, Code: "webhook_event_unknown", Kind: problem.KindInvalid, Param: "events"},
+	{Err: webhook.ErrWrongSecret, Code: "webhook_secret_invalid", Kind: problem.KindInvalid, Param: "secret", Params: problem.Params{"min": webhook.MinSecretLength, "max": webhook.MaxSecretLength}},
+
+	{Err: tenant.ErrWrongID, Code: "tenant_id_invalid", Kind: problem.KindInvalid, Param: "id"},
+	{Err: tenant.ErrWrongName, Code: "tenant_name_invalid", Kind: problem.KindInvalid, Param: "name", Params: problem.Params{"max": tenant.MaxNameLength}},
+
+	{Err: apiKey.ErrWrongName, Code: "api_key_name_invalid", Kind: problem.KindInvalid, Param: "name", Params: problem.Params{"max": apiKey.MaxNameLength}},
+	{Err: apiKey.ErrWrongSubject, Code: "api_key_subject_required", Kind: problem.KindInvalid, Param: "subject"},
+	{Err: apiKey.ErrWrongTenant, Code: "api_key_tenant_required", Kind: problem.KindInvalid},
+	{Err: apiKey.ErrWrongExpires, Code: "api_key_expires_invalid", Kind: problem.KindInvalid, Param: "expiresAt"},
+
+	{Err: session.ErrWrongTTL, Code: "session_ttl_invalid", Kind: problem.KindInvalid},
+	{Err: delta.ErrWrongToken, Code: "sync_token_invalid", Kind: problem.KindInvalid, Param: "since"},
+	{Err: delta.ErrTokenExpired, Code: "sync_token_expired", Kind: problem.KindGone, Param: "since"},
+	{Err: outbox.ErrEventExpired, Code: "last_event_id_expired", Kind: problem.KindGone, Param: "lastEventId"},
+}
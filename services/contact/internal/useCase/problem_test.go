@@ -0,0 +1,25 @@
+package useCase
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProblems(t *testing.T) {
+	var codes = map[string]bool{CodeValidation.String(): true}
+	for _, entry := range Problems {
+		assert.NotNil(t, entry.Err)
+		assert.Regexp(t, `^[a-z]+(_[a-z]+)*$`, entry.Code.String())
+		assert.False(t, codes[entry.Code.String()], "duplicate code %s", entry.Code)
+		codes[entry.Code.String()] = true
+	}
+
+	entry, ok := Problems.Find(errors.Wrap(ErrContactNotFound, "read contact"))
+	assert.True(t, ok)
+	assert.Equal(t, "contact_not_found", entry.Code.String())
+
+	_, ok = Problems.Find(errors.New("unknown"))
+	assert.False(t, ok)
+}
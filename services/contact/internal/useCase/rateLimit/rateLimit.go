@@ -0,0 +1,43 @@
+package rateLimit
+
+import (
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+)
+
+// Take расходует запрос клиента в группе group. Клиент -- ключ доступа, аутентифицированный субъект
+// или, для анонимного запроса и для группы rateLimit.GroupAddress, address. Ошибка хранилища вёдер не должна останавливать сервис:
+// она попадает в журнал, а запрос проходит без ограничения, как и запрос в группу без лимита (nil).
+func (uc *UseCase) Take(ctx context.Context, group rateLimit.Group, address string) (*rateLimit.Result, error) {
+	var limit = uc.limit(group)
+	if limit.Disabled() {
+		return nil, nil
+	}
+
+	var key = "ip:" + address
+	if group != rateLimit.GroupAddress {
+		key = client(ctx.Principal(), address)
+	}
+
+	result, err := uc.adapterLimiter.Take(ctx, group.String()+":"+key, limit, 1)
+	if err != nil {
+		_ = log.ErrorWithContext(ctx, err)
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+// client ключ клиента: у каждого ключа доступа своё ведро, у субъекта -- общее на все его токены и сессии
+func client(p *principal.Principal, address string) string {
+	switch {
+	case p == nil:
+		return "ip:" + address
+	case p.Method == principal.MethodAPIKey && p.CredentialID != "":
+		return "apiKey:" + p.CredentialID
+	default:
+		return "principal:" + p.Tenant + ":" + p.Subject
+	}
+}
@@ -0,0 +1,60 @@
+package rateLimit
+
+import (
+	"go.uber.org/zap"
+
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+	"architecture_go/services/contact/internal/useCase/adapters/limiter"
+)
+
+type UseCase struct {
+	adapterLimiter limiter.Limiter
+	options        Options
+}
+
+// Options лимиты групп маршрутов; нулевой лимит -- группа без ограничения
+type Options struct {
+	Default  rateLimit.Limit
+	Contacts rateLimit.Limit
+	Groups   rateLimit.Limit
+	Transfer rateLimit.Limit
+	Address  rateLimit.Limit
+}
+
+func New(limiter limiter.Limiter, options Options) *UseCase {
+	var uc = &UseCase{
+		adapterLimiter: limiter,
+	}
+	uc.SetOptions(options)
+	return uc
+}
+
+func (uc *UseCase) SetOptions(options Options) {
+	if uc.options != options {
+		uc.options = options
+		log.Info("set new options",
+			zap.Stringer("default", uc.options.Default),
+			zap.Stringer("contacts", uc.options.Contacts),
+			zap.Stringer("groups", uc.options.Groups),
+			zap.Stringer("transfer", uc.options.Transfer),
+			zap.Stringer("address", uc.options.Address),
+		)
+	}
+}
+
+// limit лимит группы group
+func (uc *UseCase) limit(group rateLimit.Group) rateLimit.Limit {
+	switch group {
+	case rateLimit.GroupContacts:
+		return uc.options.Contacts
+	case rateLimit.GroupGroups:
+		return uc.options.Groups
+	case rateLimit.GroupTransfer:
+		return uc.options.Transfer
+	case rateLimit.GroupAddress:
+		return uc.options.Address
+	default:
+		return uc.options.Default
+	}
+}
@@ -0,0 +1,61 @@
+package rateLimit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/contact/internal/domain/rateLimit"
+	mockLimiter "architecture_go/services/contact/internal/repository/limiter/mock"
+)
+
+func TestTake(t *testing.T) {
+	var (
+		contacts = rateLimit.Limit{Burst: 10, Period: time.Minute}
+		allowed  = &rateLimit.Result{Allowed: true, Limit: contacts, Remaining: 9}
+
+		limiterMock = new(mockLimiter.Limiter)
+		uc          = New(limiterMock, Options{Contacts: contacts, Transfer: contacts, Address: contacts})
+	)
+
+	limiterMock.On("Take", mock.Anything, "contacts:ip:10.0.0.1", contacts, uint64(1)).Return(allowed, nil)
+	limiterMock.On("Take", mock.Anything, "contacts:apiKey:key", contacts, uint64(1)).Return(allowed, nil)
+	limiterMock.On("Take", mock.Anything, "contacts:principal:t1:u1", contacts, uint64(1)).Return(allowed, nil)
+	limiterMock.On("Take", mock.Anything, "address:ip:10.0.0.1", contacts, uint64(1)).Return(allowed, nil)
+	limiterMock.On("Take", mock.Anything, mock.Anything, contacts, uint64(1)).Return(nil, errors.New("store is down"))
+
+	var withPrincipal = func(p *principal.Principal) context.Context {
+		var ctx = context.Empty()
+		if p != nil {
+			ctx.WithValue(context.KeyPrincipal, p)
+		}
+		return ctx
+	}
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		group  rateLimit.Group
+		result *rateLimit.Result
+	}{
+		{name: "anonymous by address", ctx: withPrincipal(nil), group: rateLimit.GroupContacts, result: allowed},
+		{name: "api key", ctx: withPrincipal(&principal.Principal{Subject: "u1", Tenant: "t1", Method: principal.MethodAPIKey, CredentialID: "key"}), group: rateLimit.GroupContacts, result: allowed},
+		{name: "token subject", ctx: withPrincipal(&principal.Principal{Subject: "u1", Tenant: "t1", Method: principal.MethodToken}), group: rateLimit.GroupContacts, result: allowed},
+		{name: "address ignores principal", ctx: withPrincipal(&principal.Principal{Subject: "u1", Tenant: "t1", Method: principal.MethodToken}), group: rateLimit.GroupAddress, result: allowed},
+		{name: "group without limit", ctx: withPrincipal(nil), group: rateLimit.GroupGroups},
+		{name: "store error fails open", ctx: withPrincipal(nil), group: rateLimit.GroupTransfer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.Take(tt.ctx, tt.group, "10.0.0.1")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.result, result)
+		})
+	}
+}
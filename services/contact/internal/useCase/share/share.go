@@ -0,0 +1,32 @@
+package share
+
+import (
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/share"
+)
+
+func (uc *UseCase) Share(ctx context.Context, value *share.Share) (*share.Share, error) {
+	return uc.adapterStorage.SetShare(ctx, value)
+}
+
+func (uc *UseCase) Unshare(ctx context.Context, resource share.Resource, resourceID uuid.UUID, grantee string) error {
+	return uc.adapterStorage.DeleteShare(ctx, resource, resourceID, grantee)
+}
+
+func (uc *UseCase) List(ctx context.Context, resource share.Resource, resourceID uuid.UUID) ([]*share.Share, error) {
+	// у несуществующего ресурса нет и пустого списка
+	if _, err := uc.adapterStorage.ReadAccess(ctx, resource, resourceID, nil); err != nil {
+		return nil, err
+	}
+	return uc.adapterStorage.ListShare(ctx, resource, resourceID)
+}
+
+func (uc *UseCase) TransferOwner(ctx context.Context, resource share.Resource, resourceID uuid.UUID, owner string) error {
+	owner, err := share.NewGrantee(owner)
+	if err != nil {
+		return share.ErrWrongOwner
+	}
+	return uc.adapterStorage.UpdateOwner(ctx, resource, resourceID, owner)
+}
@@ -0,0 +1,52 @@
+package tag
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/tag"
+)
+
+func (uc *UseCase) Update(ctx context.Context, tagUpdate *tag.Tag) (*tag.Tag, error) {
+	return uc.adapterStorage.UpdateTag(ctx, tagUpdate.ID(), func(oldTag *tag.Tag) (*tag.Tag, error) {
+		return tag.NewWithID(
+			oldTag.ID(),
+			oldTag.CreatedAt(),
+			time.Now().UTC(),
+			tagUpdate.Name(),
+			oldTag.ContactCount(),
+		), nil
+	})
+}
+
+// Merge повторы и сам тег targetID среди исходных тегов игнорируются
+func (uc *UseCase) Merge(ctx context.Context, targetID uuid.UUID, sourceIDs ...uuid.UUID) (*tag.Tag, error) {
+	var (
+		sources = make([]uuid.UUID, 0, len(sourceIDs))
+		exists  = map[uuid.UUID]struct{}{targetID: {}}
+	)
+	for _, ID := range sourceIDs {
+		if _, ok := exists[ID]; ok {
+			continue
+		}
+		exists[ID] = struct{}{}
+		sources = append(sources, ID)
+	}
+
+	return uc.adapterStorage.MergeTags(ctx, targetID, sources...)
+}
+
+func (uc *UseCase) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*tag.Tag, error) {
+	return uc.adapterStorage.ListTag(ctx, parameter)
+}
+
+func (uc *UseCase) ReadByID(ctx context.Context, ID uuid.UUID) (*tag.Tag, error) {
+	return uc.adapterStorage.ReadTagByID(ctx, ID)
+}
+
+func (uc *UseCase) Count(ctx context.Context) (uint64, error) {
+	return uc.adapterStorage.CountTag(ctx)
+}
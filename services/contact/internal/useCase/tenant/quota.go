@@ -0,0 +1,106 @@
+package tenant
+
+import (
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/contact"
+	"architecture_go/services/contact/internal/domain/group"
+	"architecture_go/services/contact/internal/domain/tenant"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+// Require создание contacts контактов и groups групп уложится в квоты арендатора запроса.
+// Квота мягкая: одновременные запросы могут превысить её на размер одного запроса.
+func (uc *UseCase) Require(ctx context.Context, contacts, groups uint64) error {
+	var ID = ctx.Tenant()
+	if ID == "" || ID == context.TenantAll {
+		return nil
+	}
+
+	value, err := uc.Resolve(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	var quota = value.Quota()
+	if (quota.MaxContacts == 0 || contacts == 0) && (quota.MaxGroups == 0 || groups == 0) {
+		return nil
+	}
+
+	usage, err := uc.adapterStorage.ReadTenantUsage(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	if tenant.Allows(quota.MaxContacts, usage.Contacts, contacts) && tenant.Allows(quota.MaxGroups, usage.Groups, groups) {
+		return nil
+	}
+
+	log.WarnWithContext(ctx, "tenant quota exceeded",
+		zap.String("tenant", ID),
+		zap.Any("quota", quota),
+		zap.Any("usage", usage),
+		zap.Uint64("contacts", contacts),
+		zap.Uint64("groups", groups),
+	)
+	return useCase.ErrTenantQuotaExceeded
+}
+
+type contactQuota struct {
+	useCase.Contact
+	quota *UseCase
+}
+
+// Contact проверяет квоту контактов перед созданием и восстановлением из архива
+func (uc *UseCase) Contact(next useCase.Contact) useCase.Contact {
+	return &contactQuota{Contact: next, quota: uc}
+}
+
+func (q *contactQuota) Create(ctx context.Context, contacts ...*contact.Contact) ([]*contact.Contact, error) {
+	if err := q.quota.Require(ctx, uint64(len(contacts)), 0); err != nil {
+		return nil, err
+	}
+	return q.Contact.Create(ctx, contacts...)
+}
+
+// CreateBatch квота проверяется на весь пакет, включая записи, которые не пройдут проверку
+func (q *contactQuota) CreateBatch(ctx context.Context, mode useCase.BatchMode, items ...*useCase.BatchItem) ([]*useCase.BatchItem, error) {
+	if err := q.quota.Require(ctx, uint64(len(items)), 0); err != nil {
+		return nil, err
+	}
+	return q.Contact.CreateBatch(ctx, mode, items...)
+}
+
+func (q *contactQuota) Restore(ctx context.Context, ID uuid.UUID) (*contact.Contact, error) {
+	if err := q.quota.Require(ctx, 1, 0); err != nil {
+		return nil, err
+	}
+	return q.Contact.Restore(ctx, ID)
+}
+
+type groupQuota struct {
+	useCase.Group
+	quota *UseCase
+}
+
+// Group проверяет квоту групп и квоту контактов, создаваемых сразу в группе
+func (uc *UseCase) Group(next useCase.Group) useCase.Group {
+	return &groupQuota{Group: next, quota: uc}
+}
+
+func (q *groupQuota) Create(ctx context.Context, groupCreate *group.Group) (*group.Group, error) {
+	if err := q.quota.Require(ctx, 0, 1); err != nil {
+		return nil, err
+	}
+	return q.Group.Create(ctx, groupCreate)
+}
+
+func (q *groupQuota) CreateContactIntoGroup(ctx context.Context, groupID uuid.UUID, contacts ...*contact.Contact) ([]*contact.Contact, error) {
+	if err := q.quota.Require(ctx, uint64(len(contacts)), 0); err != nil {
+		return nil, err
+	}
+	return q.Group.CreateContactIntoGroup(ctx, groupID, contacts...)
+}
@@ -0,0 +1,46 @@
+package tenant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/tenant"
+	mockStorage "architecture_go/services/contact/internal/repository/storage/mock"
+	"architecture_go/services/contact/internal/useCase"
+)
+
+func TestRequire(t *testing.T) {
+	var (
+		now         = time.Now()
+		storageMock = new(mockStorage.Tenant)
+		uc          = New(storageMock, Options{})
+	)
+
+	storageMock.On("ReadTenantByID", mock.Anything, "acme").
+		Return(tenant.NewWithID("acme", now, now, "Acme", tenant.Quota{MaxContacts: 10}, time.Time{}), nil).Once()
+	storageMock.On("ReadTenantByID", mock.Anything, "off").
+		Return(tenant.NewWithID("off", now, now, "Off", tenant.Quota{}, now), nil).Once()
+	storageMock.On("ReadTenantUsage", mock.Anything, "acme").Return(&tenant.Usage{Contacts: 9}, nil)
+
+	var withTenant = func(value string) context.Context {
+		var ctx = context.Empty()
+		ctx.WithValue(context.KeyTenant, value)
+		return ctx
+	}
+
+	assert.NoError(t, uc.Require(withTenant("acme"), 1, 0))
+	assert.ErrorIs(t, uc.Require(withTenant("acme"), 2, 0), useCase.ErrTenantQuotaExceeded)
+	// квоты на группы нет, потребление не запрашивается
+	assert.NoError(t, uc.Require(withTenant("acme"), 0, 100))
+	assert.ErrorIs(t, uc.Require(withTenant("off"), 1, 0), useCase.ErrTenantDisabled)
+	// фоновые обработчики квотам не подчиняются
+	assert.NoError(t, uc.Require(withTenant(context.TenantAll), 100, 100))
+
+	// арендатор читается из хранилища один раз, дальше -- из кэша
+	storageMock.AssertNumberOfCalls(t, "ReadTenantByID", 2)
+	storageMock.AssertNumberOfCalls(t, "ReadTenantUsage", 2)
+}
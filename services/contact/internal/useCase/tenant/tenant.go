@@ -0,0 +1,134 @@
+package tenant
+
+import (
+	"time"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/tenant"
+	"architecture_go/services/contact/internal/useCase"
+	"architecture_go/services/contact/internal/useCase/policy"
+)
+
+func (uc *UseCase) Create(ctx context.Context, tenantCreate *tenant.Tenant) (*tenant.Tenant, error) {
+	return uc.adapterStorage.CreateTenant(ctx, tenantCreate)
+}
+
+func (uc *UseCase) Update(ctx context.Context, tenantUpdate *tenant.Tenant) (*tenant.Tenant, error) {
+	return uc.update(ctx, tenantUpdate.ID(), func(oldTenant *tenant.Tenant) *tenant.Tenant {
+		return tenant.NewWithID(
+			oldTenant.ID(),
+			oldTenant.CreatedAt(),
+			time.Now().UTC(),
+			tenantUpdate.Name(),
+			tenantUpdate.Quota(),
+			oldTenant.DisabledAt(),
+		)
+	})
+}
+
+func (uc *UseCase) Disable(ctx context.Context, ID string) (*tenant.Tenant, error) {
+	return uc.update(ctx, ID, func(oldTenant *tenant.Tenant) *tenant.Tenant {
+		if oldTenant.Disabled() {
+			return oldTenant
+		}
+
+		var timeNow = time.Now().UTC()
+		return tenant.NewWithID(oldTenant.ID(), oldTenant.CreatedAt(), timeNow, oldTenant.Name(), oldTenant.Quota(), timeNow)
+	})
+}
+
+func (uc *UseCase) Enable(ctx context.Context, ID string) (*tenant.Tenant, error) {
+	return uc.update(ctx, ID, func(oldTenant *tenant.Tenant) *tenant.Tenant {
+		return tenant.NewWithID(oldTenant.ID(), oldTenant.CreatedAt(), time.Now().UTC(), oldTenant.Name(), oldTenant.Quota(), time.Time{})
+	})
+}
+
+// update изменённый арендатор сразу вытесняется из кэша этой реплики
+func (uc *UseCase) update(ctx context.Context, ID string, updateFn func(oldTenant *tenant.Tenant) *tenant.Tenant) (*tenant.Tenant, error) {
+	response, err := uc.adapterStorage.UpdateTenant(ctx, ID, func(oldTenant *tenant.Tenant) (*tenant.Tenant, error) {
+		return updateFn(oldTenant), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.mu.Lock()
+	delete(uc.cache, ID)
+	uc.mu.Unlock()
+
+	return response, nil
+}
+
+func (uc *UseCase) Resolve(ctx context.Context, ID string) (*tenant.Tenant, error) {
+	var timeNow = time.Now()
+
+	uc.mu.Lock()
+	value, ok := uc.cache[ID]
+	uc.mu.Unlock()
+
+	if !ok || timeNow.After(value.expiresAt) {
+		response, err := uc.adapterStorage.ReadTenantByID(ctx, ID)
+		if err != nil {
+			return nil, err
+		}
+
+		value = cached{value: response, expiresAt: timeNow.Add(uc.options.CacheTTL)}
+
+		uc.mu.Lock()
+		uc.cache[ID] = value
+		uc.mu.Unlock()
+	}
+
+	if value.value.Disabled() {
+		return nil, useCase.ErrTenantDisabled
+	}
+
+	return value.value, nil
+}
+
+// Select автор запроса привязан к арендатору из своих учётных данных, а без него -- к Options.Default,
+// как и анонимный запрос. Запрошенный арендатор может только повторить его; выбрать другого
+// арендатора может лишь оператор сервиса.
+func (uc *UseCase) Select(ctx context.Context, p *principal.Principal, requested string) (string, error) {
+	var result = uc.options.Default
+	if p != nil && p.Tenant != "" {
+		result = p.Tenant
+	}
+
+	if requested != "" && requested != result {
+		if p == nil || !p.HasRole(policy.RoleOperator) {
+			return "", useCase.ErrTenantMismatch
+		}
+		result = requested
+	}
+
+	if !tenant.ValidID(result) {
+		return "", useCase.ErrTenantNotFound
+	}
+
+	if _, err := uc.Resolve(ctx, result); err != nil {
+		return "", err
+	}
+	return result, nil
+}
+
+func (uc *UseCase) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*tenant.Tenant, error) {
+	return uc.adapterStorage.ListTenant(ctx, parameter)
+}
+
+func (uc *UseCase) ReadByID(ctx context.Context, ID string) (*tenant.Tenant, error) {
+	return uc.adapterStorage.ReadTenantByID(ctx, ID)
+}
+
+func (uc *UseCase) Count(ctx context.Context) (uint64, error) {
+	return uc.adapterStorage.CountTenant(ctx)
+}
+
+func (uc *UseCase) Usage(ctx context.Context, ID string) (*tenant.Usage, error) {
+	if _, err := uc.adapterStorage.ReadTenantByID(ctx, ID); err != nil {
+		return nil, err
+	}
+	return uc.adapterStorage.ReadTenantUsage(ctx, ID)
+}
@@ -0,0 +1,58 @@
+package tenant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/contact/internal/domain/tenant"
+	mockStorage "architecture_go/services/contact/internal/repository/storage/mock"
+	"architecture_go/services/contact/internal/useCase"
+	"architecture_go/services/contact/internal/useCase/policy"
+)
+
+func TestSelect(t *testing.T) {
+	var (
+		now         = time.Now()
+		ctx         = context.Empty()
+		storageMock = new(mockStorage.Tenant)
+		uc          = New(storageMock, Options{Default: "default"})
+	)
+
+	for _, ID := range []string{"default", "acme", "other"} {
+		storageMock.On("ReadTenantByID", mock.Anything, ID).Return(tenant.NewWithID(ID, now, now, ID, tenant.Quota{}, time.Time{}), nil)
+	}
+
+	var (
+		admin      = &principal.Principal{Subject: "alice", Tenant: "acme", Roles: []string{policy.RoleAdmin}}
+		tenantless = &principal.Principal{Subject: "bob", Roles: []string{policy.RoleAdmin}}
+		operator   = &principal.Principal{Subject: "ops", Roles: []string{policy.RoleOperator}}
+	)
+
+	for _, tt := range []struct {
+		name      string
+		principal *principal.Principal
+		requested string
+		want      string
+		err       error
+	}{
+		{name: "tenant from credentials", principal: admin, want: "acme"},
+		{name: "header repeats credentials", principal: admin, requested: "acme", want: "acme"},
+		{name: "header differs from credentials", principal: admin, requested: "other", err: useCase.ErrTenantMismatch},
+		{name: "credentials without tenant", principal: tenantless, want: "default"},
+		{name: "credentials without tenant pick a tenant", principal: tenantless, requested: "acme", err: useCase.ErrTenantMismatch},
+		{name: "anonymous", want: "default"},
+		{name: "anonymous picks a tenant", requested: "acme", err: useCase.ErrTenantMismatch},
+		{name: "operator picks a tenant", principal: operator, requested: "other", want: "other"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := uc.Select(ctx, tt.principal, tt.requested)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package tenant
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/tenant"
+	"architecture_go/services/contact/internal/useCase/adapters/storage"
+)
+
+type UseCase struct {
+	adapterStorage storage.Tenant
+	options        Options
+
+	// cache арендаторы, уже проверенные Resolve: проверка нужна каждому запросу
+	mu    sync.Mutex
+	cache map[string]cached
+}
+
+type cached struct {
+	value     *tenant.Tenant
+	expiresAt time.Time
+}
+
+type Options struct {
+	// CacheTTL сколько Resolve помнит арендатора; отключение арендатора на других репликах
+	// вступает в силу не позже, чем через это время
+	CacheTTL time.Duration
+	// Default арендатор запросов, для которых арендатор не указан
+	Default string
+}
+
+func New(storage storage.Tenant, options Options) *UseCase {
+	var uc = &UseCase{
+		adapterStorage: storage,
+		cache:          make(map[string]cached),
+	}
+	uc.SetOptions(options)
+	return uc
+}
+
+func (uc *UseCase) SetOptions(options Options) {
+	if options.CacheTTL == 0 {
+		options.CacheTTL = time.Second * 30
+		log.Debug("set default options.CacheTTL", zap.Any("cacheTTL", options.CacheTTL))
+	}
+
+	if options.Default == "" {
+		options.Default = tenant.Default
+		log.Debug("set default options.Default", zap.Any("default", options.Default))
+	}
+
+	if uc.options != options {
+		uc.options = options
+		log.Info("set new options", zap.Any("options", uc.options))
+	}
+}
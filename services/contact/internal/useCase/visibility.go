@@ -0,0 +1,29 @@
+package useCase
+
+import (
+	"architecture_go/pkg/type/context"
+)
+
+type visibilityKey struct{}
+
+// WithVisibility копия ctx, в которой списки контактов и групп ограничены ресурсами, видимыми grantees:
+// общими, своими, открытыми им и, для контактов, входящими в открытые им группы
+func WithVisibility(ctx context.Context, grantees []string) context.Context {
+	var result = ctx.Copy()
+	result.WithValue(visibilityKey{}, grantees)
+	return result
+}
+
+// Visibility кем выступает автор запроса при чтении списков; ok == false -- видно всё
+func Visibility(ctx context.Context) (grantees []string, ok bool) {
+	grantees, ok = ctx.Value(visibilityKey{}).([]string)
+	return grantees, ok
+}
+
+// Owner владелец ресурсов, которые создаёт автор запроса; пустая строка -- ресурс общий
+func Owner(ctx context.Context) string {
+	if value := ctx.Principal(); value != nil {
+		return value.Subject
+	}
+	return ""
+}
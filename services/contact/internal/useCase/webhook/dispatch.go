@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/tools/backoff"
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/contact/internal/domain/webhook"
+)
+
+// Run отправляет доставки подписчикам, пока не отменён ctx
+func (uc *UseCase) Run(ctx context.Context) {
+	var ticker = time.NewTicker(uc.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		processed, err := uc.Dispatch(ctx)
+		if err == nil && processed == uc.options.BatchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch один проход: до Concurrency запросов одновременно, неудачные доставки
+// откладываются с экспоненциальной задержкой, после MaxAttempts попыток -- в dead-letter
+func (uc *UseCase) Dispatch(ctx context.Context) (uint64, error) {
+	return uc.adapterStorage.ProcessWebhookDelivery(ctx, uc.options.BatchSize, func(deliveries []*webhook.Delivery, webhooks map[uuid.UUID]*webhook.Webhook) {
+		var (
+			wg        sync.WaitGroup
+			semaphore = make(chan struct{}, uc.options.Concurrency)
+		)
+
+		for _, delivery := range deliveries {
+			hook, ok := webhooks[delivery.WebhookID()]
+			if !ok {
+				continue
+			}
+
+			wg.Add(1)
+			semaphore <- struct{}{}
+			go func(hook *webhook.Webhook, delivery *webhook.Delivery) {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+				uc.send(ctx, hook, delivery)
+			}(hook, delivery)
+		}
+
+		wg.Wait()
+	})
+}
+
+func (uc *UseCase) send(ctx context.Context, hook *webhook.Webhook, delivery *webhook.Delivery) {
+	statusCode, err := uc.adapterSender.Send(ctx, hook, delivery)
+	if err == nil {
+		delivery.MarkDelivered(statusCode, time.Now())
+		return
+	}
+
+	var nextAttemptAt = time.Now().Add(backoff.Exponential(uc.options.MinBackoff, uc.options.MaxBackoff, delivery.Attempts()))
+	delivery.MarkFailed(statusCode, err, nextAttemptAt, uc.options.MaxAttempts)
+
+	log.WarnWithContext(ctx, "webhook delivery failed",
+		zap.String("id", delivery.ID().String()),
+		zap.String("webhookId", hook.ID().String()),
+		zap.String("status", delivery.Status().String()),
+		zap.Uint32("attempts", delivery.Attempts()),
+		zap.Int("statusCode", statusCode),
+		zap.Error(err),
+	)
+}
@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/services/contact/internal/domain/event"
+	"architecture_go/services/contact/internal/domain/webhook"
+	mockStorage "architecture_go/services/contact/internal/repository/storage/mock"
+	mockSender "architecture_go/services/contact/internal/repository/webhook/mock"
+)
+
+func TestDispatch(t *testing.T) {
+	var (
+		assertion = assert.New(t)
+		ctx       = context.Empty()
+		hook      = webhook.NewWithID(uuid.New(), time.Now(), time.Now(), "https://example.com/hook", nil, "0123456789abcdef", true)
+		delivered = newDelivery(hook.ID(), 0)
+		retried   = newDelivery(hook.ID(), 0)
+		dead      = newDelivery(hook.ID(), 2)
+		errSend   = errors.New("connection refused")
+	)
+
+	var storageRepository = new(mockStorage.Webhook)
+	storageRepository.On("ProcessWebhookDelivery", mock.Anything, uint64(100), mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(func([]*webhook.Delivery, map[uuid.UUID]*webhook.Webhook))(
+				[]*webhook.Delivery{delivered, retried, dead},
+				map[uuid.UUID]*webhook.Webhook{hook.ID(): hook},
+			)
+		}).
+		Return(uint64(3), nil)
+
+	var senderMock = new(mockSender.Sender)
+	senderMock.On("Send", mock.Anything, hook, delivered).Return(204, nil)
+	senderMock.On("Send", mock.Anything, hook, retried).Return(503, errSend)
+	senderMock.On("Send", mock.Anything, hook, dead).Return(0, errSend)
+
+	var uc = New(storageRepository, senderMock, Options{MaxAttempts: 3})
+
+	processed, err := uc.Dispatch(ctx)
+	assertion.NoError(err)
+	assertion.Equal(uint64(3), processed)
+
+	assertion.Equal(webhook.StatusDelivered, delivered.Status())
+	assertion.Equal(204, delivered.LastStatusCode())
+
+	assertion.Equal(webhook.StatusPending, retried.Status())
+	assertion.Equal(uint32(1), retried.Attempts())
+	assertion.Equal(503, retried.LastStatusCode())
+	assertion.True(retried.NextAttemptAt().After(time.Now()))
+
+	assertion.Equal(webhook.StatusDead, dead.Status())
+	assertion.Equal(errSend.Error(), dead.LastError())
+}
+
+func newDelivery(webhookID uuid.UUID, attempts uint32) *webhook.Delivery {
+	var now = time.Now()
+	return webhook.NewDeliveryWithID(uuid.New(), webhookID, uuid.New(), event.NameContactCreated, []byte(`{}`), now,
+		webhook.StatusPending, attempts, now, 0, "", time.Time{})
+}
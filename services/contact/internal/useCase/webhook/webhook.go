@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/filter"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/contact/internal/domain/webhook"
+)
+
+// Create если ключ подписи не передан, он генерируется; вернуть его подписчику можно только в ответе на создание
+func (uc *UseCase) Create(ctx context.Context, hookCreate *webhook.Webhook) (*webhook.Webhook, error) {
+	if hookCreate.Secret() == "" {
+		secret, err := webhook.GenerateSecret()
+		if err != nil {
+			return nil, err
+		}
+
+		hookCreate = webhook.NewWithID(
+			hookCreate.ID(),
+			hookCreate.CreatedAt(),
+			hookCreate.ModifiedAt(),
+			hookCreate.URL(),
+			hookCreate.Events(),
+			secret,
+			hookCreate.Active(),
+		)
+	}
+
+	return uc.adapterStorage.CreateWebhook(ctx, hookCreate)
+}
+
+// Update пустой ключ подписи оставляет прежний
+func (uc *UseCase) Update(ctx context.Context, hookUpdate *webhook.Webhook) (*webhook.Webhook, error) {
+	return uc.adapterStorage.UpdateWebhook(ctx, hookUpdate.ID(), func(oldWebhook *webhook.Webhook) (*webhook.Webhook, error) {
+		var secret = hookUpdate.Secret()
+		if secret == "" {
+			secret = oldWebhook.Secret()
+		}
+
+		return webhook.NewWithID(
+			oldWebhook.ID(),
+			oldWebhook.CreatedAt(),
+			time.Now().UTC(),
+			hookUpdate.URL(),
+			hookUpdate.Events(),
+			secret,
+			hookUpdate.Active(),
+		), nil
+	})
+}
+
+func (uc *UseCase) Delete(ctx context.Context, ID uuid.UUID) error {
+	return uc.adapterStorage.DeleteWebhook(ctx, ID)
+}
+
+func (uc *UseCase) List(ctx context.Context, parameter queryParameter.QueryParameter) ([]*webhook.Webhook, error) {
+	return uc.adapterStorage.ListWebhook(ctx, parameter)
+}
+
+func (uc *UseCase) ReadByID(ctx context.Context, ID uuid.UUID) (*webhook.Webhook, error) {
+	return uc.adapterStorage.ReadWebhookByID(ctx, ID)
+}
+
+func (uc *UseCase) Count(ctx context.Context) (uint64, error) {
+	return uc.adapterStorage.CountWebhook(ctx)
+}
+
+func (uc *UseCase) ListDelivery(ctx context.Context, webhookID uuid.UUID, parameter queryParameter.QueryParameter) ([]*webhook.Delivery, error) {
+	if _, err := uc.adapterStorage.ReadWebhookByID(ctx, webhookID); err != nil {
+		return nil, err
+	}
+	return uc.adapterStorage.ListWebhookDelivery(ctx, webhookID, parameter)
+}
+
+func (uc *UseCase) CountDelivery(ctx context.Context, webhookID uuid.UUID, filters filter.Filters) (uint64, error) {
+	return uc.adapterStorage.CountWebhookDelivery(ctx, webhookID, filters)
+}
+
+// Replay ставит доставку в очередь заново, в том числе из dead-letter
+func (uc *UseCase) Replay(ctx context.Context, webhookID, ID uuid.UUID) (*webhook.Delivery, error) {
+	if _, err := uc.adapterStorage.ReadWebhookByID(ctx, webhookID); err != nil {
+		return nil, err
+	}
+
+	return uc.adapterStorage.UpdateWebhookDelivery(ctx, webhookID, ID, func(delivery *webhook.Delivery) (*webhook.Delivery, error) {
+		delivery.Replay(time.Now())
+		return delivery, nil
+	})
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"architecture_go/pkg/store/postgres"
+	"architecture_go/pkg/tracing"
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	deliveryGrpc "architecture_go/services/user/internal/delivery/grpc"
+	deliveryHttp "architecture_go/services/user/internal/delivery/http"
+	repositoryStorage "architecture_go/services/user/internal/repository/storage/postgres"
+	repositoryTokenJwt "architecture_go/services/user/internal/repository/token/jwt"
+	useCaseUser "architecture_go/services/user/internal/useCase/user"
+)
+
+func init() {
+	viper.SetConfigName(".env")
+	viper.SetConfigType("dotenv")
+	viper.AddConfigPath(".")
+	viper.AutomaticEnv()
+	viper.SetDefault("SERVICE_NAME", "userService")
+	// регистрация по умолчанию только через admin: пользователь получает арендатор admin и роль USER_DEFAULT_ROLE.
+	// При USER_OPEN_REGISTRATION пользователь регистрируется сам в USER_DEFAULT_TENANT и без ролей
+	viper.SetDefault("USER_OPEN_REGISTRATION", false)
+	viper.SetDefault("USER_DEFAULT_TENANT", "default")
+	viper.SetDefault("USER_DEFAULT_ROLE", "viewer")
+	viper.SetDefault("USER_TOKEN_TTL", "1h")
+}
+
+func main() {
+	conn, err := postgres.New(postgres.Settings{})
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Pool.Close()
+
+	closer, err := tracing.New(context.Empty())
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err = closer.Close(); err != nil {
+			log.Error(err)
+		}
+	}()
+
+	repoStorage, err := repositoryStorage.New(conn.Pool, repositoryStorage.Options{})
+	if err != nil {
+		panic(err)
+	}
+
+	// токены подписываются ключом JWT_PRIVATE_KEY или секретом JWT_SECRET; сервис contact проверяет их
+	// вызовом Authenticate или сам, если ему задан тот же секрет или открытый ключ
+	repoToken, err := repositoryTokenJwt.New(repositoryTokenJwt.Options{})
+	if err != nil {
+		panic(err)
+	}
+
+	var (
+		ucUser = useCaseUser.New(repoStorage, repoToken, useCaseUser.Options{
+			TokenTTL:      viper.GetDuration("USER_TOKEN_TTL"),
+			DefaultTenant: viper.GetString("USER_DEFAULT_TENANT"),
+			DefaultRole:   viper.GetString("USER_DEFAULT_ROLE"),
+
+			OpenRegistration: viper.GetBool("USER_OPEN_REGISTRATION"),
+		})
+		listenerHttp = deliveryHttp.New(ucUser, deliveryHttp.Options{})
+		listenerGrpc = deliveryGrpc.New(ucUser, deliveryGrpc.Options{})
+		serverGrpc   = grpc.NewServer()
+	)
+
+	go func() {
+		fmt.Printf("service started successfully on http port: %d", viper.GetUint("HTTP_PORT"))
+		if err = listenerHttp.Run(); err != nil {
+			panic(err)
+		}
+	}()
+
+	go func() {
+		fmt.Printf("service started successfully on grpc port: %d", viper.GetUint("GRPC_PORT"))
+		if err = listenerGrpc.Run(serverGrpc); err != nil {
+			panic(err)
+		}
+	}()
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, syscall.SIGINT, syscall.SIGTERM)
+	<-signalCh
+
+	serverGrpc.GracefulStop()
+
+}
@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	user "architecture_go/pkg/protobuff/user"
+	"architecture_go/services/user/internal/useCase"
+)
+
+func init() {
+	viper.SetDefault("GRPC_PORT", 9090)
+}
+
+type Delivery struct {
+	user.UnimplementedUserServiceServer
+	ucUser useCase.User
+
+	options Options
+}
+
+type Options struct{}
+
+func New(ucUser useCase.User, o Options) *Delivery {
+	var d = &Delivery{
+		ucUser: ucUser,
+	}
+
+	d.SetOptions(o)
+	return d
+}
+
+func (d *Delivery) SetOptions(options Options) {
+	if d.options != options {
+		d.options = options
+	}
+}
+
+// Run принимает запросы на GRPC_PORT до остановки server
+func (d *Delivery) Run(server *grpc.Server) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", uint16(viper.GetUint("GRPC_PORT"))))
+	if err != nil {
+		return err
+	}
+
+	user.RegisterUserServiceServer(server, d)
+	return server.Serve(listener)
+}
@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	domainUser "architecture_go/services/user/internal/domain/user"
+	"architecture_go/services/user/internal/useCase"
+)
+
+// toStatus ошибки сценариев пользователя; остальные -- Internal
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, useCase.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, useCase.ErrUnauthenticated), errors.Is(err, useCase.ErrWrongCredentials):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, useCase.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, useCase.ErrUserExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domainUser.ErrWrongEmail), errors.Is(err, domainUser.ErrWrongName), errors.Is(err, domainUser.ErrWrongPassword):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	stdContext "context"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	user "architecture_go/pkg/protobuff/user"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	domainToken "architecture_go/services/user/internal/domain/token"
+	domainUser "architecture_go/services/user/internal/domain/user"
+)
+
+// metadataAuthorization токен автора вызова ReadUser, как заголовок Authorization в HTTP
+const metadataAuthorization = "authorization"
+
+func (d *Delivery) Login(c stdContext.Context, request *user.LoginRequest) (*user.LoginResponse, error) {
+	var ctx = context.New(c)
+
+	_, token, err := d.ucUser.Login(ctx, request.GetEmail(), request.GetPassword())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &user.LoginResponse{
+		AccessToken: token.Value(),
+		TokenType:   domainToken.Type,
+		ExpiresAt:   timestamppb.New(token.ExpiresAt()),
+	}, nil
+}
+
+// Authenticate проверка токена для других сервисов: contact принимает токены этого сервиса через этот вызов
+func (d *Delivery) Authenticate(c stdContext.Context, request *user.AuthenticateRequest) (*user.AuthenticateResponse, error) {
+	var ctx = context.New(c)
+
+	result, err := d.ucUser.Authenticate(ctx, request.GetAccessToken())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &user.AuthenticateResponse{Principal: toPrincipal(result)}, nil
+}
+
+func (d *Delivery) ReadUser(c stdContext.Context, request *user.ReadUserRequest) (*user.ReadUserResponse, error) {
+	var ctx = context.New(c)
+
+	if err := d.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	ID, err := uuid.Parse(request.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response, err := d.ucUser.ReadByID(ctx, ID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &user.ReadUserResponse{Response: toUserResponse(response)}, nil
+}
+
+// authenticate автор вызова из метаданных "authorization: Bearer <token>"
+func (d *Delivery) authenticate(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var values = md.Get(metadataAuthorization)
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "credentials are required: bearer token")
+	}
+
+	scheme, value, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, domainToken.Type) {
+		return status.Error(codes.Unauthenticated, "credentials are required: bearer token")
+	}
+
+	result, err := d.ucUser.Authenticate(ctx, strings.TrimSpace(value))
+	if err != nil {
+		return toStatus(err)
+	}
+
+	ctx.WithValue(context.KeyTenant, result.Tenant)
+	ctx.WithValue(context.KeyPrincipal, result)
+	ctx.WithValue(context.KeyActor, result.Subject)
+	return nil
+}
+
+func toPrincipal(value *principal.Principal) *user.Principal {
+	var result = &user.Principal{
+		Subject:      value.Subject,
+		Name:         value.Name,
+		Roles:        value.Roles,
+		Tenant:       value.Tenant,
+		CredentialId: value.CredentialID,
+	}
+
+	if !value.ExpiresAt.IsZero() {
+		result.ExpiresAt = timestamppb.New(value.ExpiresAt)
+	}
+
+	return result
+}
+
+func toUserResponse(value *domainUser.User) *user.UserResponse {
+	return &user.UserResponse{
+		Id:         value.ID().String(),
+		Email:      value.Email().String(),
+		Name:       value.Name(),
+		Roles:      value.Roles(),
+		Tenant:     value.Tenant(),
+		CreatedAt:  timestamppb.New(value.CreatedAt()),
+		ModifiedAt: timestamppb.New(value.ModifiedAt()),
+	}
+}
@@ -0,0 +1,108 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	jsonUser "architecture_go/services/user/internal/delivery/http/user"
+	"architecture_go/services/user/internal/useCase"
+)
+
+const (
+	actorAnonymous = "anonymous"
+
+	headerAuthorization   = "Authorization"
+	headerAuthenticate    = "WWW-Authenticate"
+	schemeBearer          = "Bearer"
+	authenticateChallenge = `Bearer realm="user"`
+)
+
+var errNoCredentials = errors.New("credentials are required: bearer token")
+
+// authenticate определяет автора запроса по bearer-токену и кладёт его в контекст под context.KeyPrincipal.
+// Запрос без токена проходит анонимно: вход и открытая регистрация доступны без него, остальные обработчики
+// требуют автора сами. Переданный, но неверный токен -- всегда 401.
+func (d *Delivery) authenticate(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	var header = c.GetHeader(headerAuthorization)
+	if header == "" {
+		c.Set(context.KeyActor, actorAnonymous)
+		c.Next()
+		return
+	}
+
+	scheme, value, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, schemeBearer) || strings.TrimSpace(value) == "" {
+		SetError(c, http.StatusUnauthorized, useCase.ErrUnauthenticated)
+		c.Abort()
+		return
+	}
+
+	result, err := d.ucUser.Authenticate(ctx, strings.TrimSpace(value))
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		c.Abort()
+		return
+	}
+
+	c.Set(context.KeyTenant, result.Tenant)
+	c.Set(context.KeyPrincipal, result)
+	c.Set(context.KeyActor, result.Subject)
+
+	c.Next()
+}
+
+// requirePrincipal автор запроса; при анонимном запросе отвечает 401
+func requirePrincipal(c *gin.Context, ctx context.Context) (*principal.Principal, bool) {
+	var result = ctx.Principal()
+	if result == nil {
+		c.Header(headerAuthenticate, authenticateChallenge)
+		SetError(c, http.StatusUnauthorized, errNoCredentials)
+		return nil, false
+	}
+	return result, true
+}
+
+// Login
+// @Summary Войти по адресу и паролю.
+// @Description Метод проверяет пароль и выпускает токен доступа. Токен принимают этот сервис и сервис contact.
+// @Description Неизвестный адрес и неверный пароль не различаются.
+// @Tags auth
+// @Accept  json
+// @Produce json
+// @Param   login 		body 		jsonUser.Login 			true  "Адрес и пароль"
+// @Success 200			{object}  	jsonUser.TokenResponse 	true  "Токен доступа"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse			"Неверный адрес или пароль"
+// @Router /auth/login [post]
+func (d *Delivery) Login(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	value := jsonUser.Login{}
+	if err := c.ShouldBindJSON(&value); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, token, err := d.ucUser.Login(ctx, value.Email, value.Password)
+	if err != nil {
+		if errors.Is(err, useCase.ErrWrongCredentials) {
+			c.Header(headerAuthenticate, authenticateChallenge)
+			SetError(c, http.StatusUnauthorized, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonUser.ToTokenResponse(response, token))
+}
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+
+	"architecture_go/services/user/internal/useCase"
+)
+
+// @title slurm user service on clean architecture
+// @version 1.0
+// @description user service on clean architecture: registration, login and profiles
+// @license.name kolyadkons
+
+// @contact.name API Support
+// @contact.email kolyadkons@gmail.com
+
+// @BasePath /
+
+// @securityDefinitions.apikey Bearer
+// @in header
+// @name Authorization
+// @description Токен доступа из POST /auth/login в виде "Bearer <token>"
+
+func init() {
+	viper.SetConfigName(".env")
+	viper.SetConfigType("dotenv")
+	viper.AddConfigPath(".")
+	viper.AutomaticEnv()
+
+	viper.SetDefault("HTTP_PORT", 80)
+}
+
+type Delivery struct {
+	ucUser useCase.User
+	router *gin.Engine
+
+	options Options
+}
+
+type Options struct{}
+
+func New(ucUser useCase.User, options Options) *Delivery {
+	var d = &Delivery{
+		ucUser: ucUser,
+	}
+
+	d.SetOptions(options)
+
+	d.router = d.initRouter()
+	return d
+}
+
+func (d *Delivery) SetOptions(options Options) {
+	if d.options != options {
+		d.options = options
+	}
+}
+
+func (d *Delivery) Run() error {
+	return d.router.Run(fmt.Sprintf(":%d", uint16(viper.GetUint("HTTP_PORT"))))
+}
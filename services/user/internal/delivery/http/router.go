@@ -0,0 +1,70 @@
+package http
+
+import (
+	"strings"
+
+	"github.com/gin-contrib/zap"
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+
+	"architecture_go/pkg/type/logger"
+	docs "architecture_go/services/user/internal/delivery/http/swagger/docs"
+)
+
+func (d *Delivery) initRouter() *gin.Engine {
+
+	if viper.GetBool("IS_PRODUCTION") {
+		switch strings.ToUpper(strings.TrimSpace(viper.GetString("LOG_LEVEL"))) {
+		case "DEBUG":
+			gin.SetMode(gin.DebugMode)
+		default:
+			gin.SetMode(gin.ReleaseMode)
+		}
+	} else {
+		gin.SetMode(gin.DebugMode)
+	}
+
+	var router = gin.New()
+
+	router.Use(Tracer())
+
+	// Logs all panic to error log
+	//   - stack means whether output the stack info.
+	router.Use(ginzap.RecoveryWithZap(logger.GetLogger(), true))
+
+	d.routerDocs(router.Group("/docs"))
+
+	router.Use(d.authenticate)
+
+	d.routerAuth(router.Group("/auth"))
+
+	d.routerUsers(router.Group("/users"))
+
+	return router
+}
+
+func (d *Delivery) routerAuth(router *gin.RouterGroup) {
+	router.POST("/login", d.Login)
+}
+
+func (d *Delivery) routerUsers(router *gin.RouterGroup) {
+	router.POST("/", d.Register)
+
+	router.GET("/me", d.ReadMe)
+	router.PUT("/me", d.UpdateMe)
+	router.DELETE("/me", d.DeleteMe)
+	router.PUT("/me/password", d.ChangePassword)
+
+	router.GET("/", d.ListUser)
+	router.GET("/:id", d.ReadUserByID)
+	router.PUT("/:id/roles", d.SetUserRoles)
+	router.DELETE("/:id", d.DeleteUser)
+}
+
+func (d *Delivery) routerDocs(router *gin.RouterGroup) {
+	docs.SwaggerInfo.BasePath = "/"
+
+	router.Any("/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+}
@@ -0,0 +1,414 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/pagination"
+	"architecture_go/pkg/type/query"
+	"architecture_go/pkg/type/queryParameter"
+	jsonUser "architecture_go/services/user/internal/delivery/http/user"
+	"architecture_go/services/user/internal/domain/user"
+	"architecture_go/services/user/internal/useCase"
+)
+
+var mappingSortsUser = query.SortsOptions{
+	"id":        {},
+	"email":     {},
+	"name":      {},
+	"createdAt": {},
+}
+
+// wrongUser ошибка проверки данных пользователя в домене
+func wrongUser(err error) bool {
+	return errors.Is(err, user.ErrWrongEmail) ||
+		errors.Is(err, user.ErrWrongName) ||
+		errors.Is(err, user.ErrWrongPassword)
+}
+
+// Register
+// @Summary Зарегистрировать пользователя.
+// @Description Метод создаёт учётную запись. Admin регистрирует пользователя в своём арендаторе с ролью по умолчанию; без токена admin зарегистрироваться можно только при открытой регистрации, и тогда у учётной записи нет ролей. Пароль хранится в виде хеша argon2id.
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param   user 		body 		jsonUser.Register 		true  "Данные пользователя"
+// @Success 201			{object}  	jsonUser.UserResponse 	true  "Пользователь"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse			"Регистрация только по приглашению"
+// @Failure 403 		{object}    ErrorResponse
+// @Failure 409 		{object}    ErrorResponse			"Адрес уже занят"
+// @Security Bearer
+// @Router /users/ [post]
+func (d *Delivery) Register(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	value := jsonUser.Register{}
+	if err := c.ShouldBindJSON(&value); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucUser.Register(ctx, value.Email, value.Name, value.Password)
+	if err != nil {
+		switch {
+		case wrongUser(err):
+			SetError(c, http.StatusBadRequest, err)
+		case errors.Is(err, useCase.ErrUserExists):
+			SetError(c, http.StatusConflict, err)
+		default:
+			SetError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, jsonUser.ToUserResponse(response))
+}
+
+// ReadMe
+// @Summary Профиль автора запроса.
+// @Description Метод возвращает учётную запись, которой выпущен токен.
+// @Tags users
+// @Produce json
+// @Success 200			{object}  	jsonUser.UserResponse 	true  "Пользователь"
+// @Failure 401 		{object}    ErrorResponse
+// @Security Bearer
+// @Router /users/me [get]
+func (d *Delivery) ReadMe(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	ID, ok := currentUserID(c, ctx)
+	if !ok {
+		return
+	}
+
+	d.readUser(c, ctx, ID)
+}
+
+// UpdateMe
+// @Summary Изменить профиль автора запроса.
+// @Description Метод позволяет изменить имя пользователя. Адрес и роли этим методом не меняются.
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param   user 		body 		jsonUser.UpdateProfile 	true  "Данные профиля"
+// @Success 200			{object}  	jsonUser.UserResponse 	true  "Пользователь"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse
+// @Security Bearer
+// @Router /users/me [put]
+func (d *Delivery) UpdateMe(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	ID, ok := currentUserID(c, ctx)
+	if !ok {
+		return
+	}
+
+	value := jsonUser.UpdateProfile{}
+	if err := c.ShouldBindJSON(&value); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucUser.UpdateProfile(ctx, ID, value.Name)
+	if err != nil {
+		switch {
+		case wrongUser(err):
+			SetError(c, http.StatusBadRequest, err)
+		case errors.Is(err, useCase.ErrUserNotFound):
+			SetError(c, http.StatusNotFound, err)
+		default:
+			SetError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonUser.ToUserResponse(response))
+}
+
+// DeleteMe
+// @Summary Удалить учётную запись автора запроса.
+// @Description Метод удаляет учётную запись, выпущенные ей токены перестают приниматься, адрес освобождается.
+// @Tags users
+// @Success 204
+// @Failure 401 		{object}    ErrorResponse
+// @Security Bearer
+// @Router /users/me [delete]
+func (d *Delivery) DeleteMe(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	ID, ok := currentUserID(c, ctx)
+	if !ok {
+		return
+	}
+
+	d.deleteUser(c, ctx, ID)
+}
+
+// ChangePassword
+// @Summary Сменить пароль.
+// @Description Метод меняет пароль автора запроса, если текущий пароль указан верно.
+// @Tags users
+// @Accept  json
+// @Param   password 	body 		jsonUser.ChangePassword true  "Текущий и новый пароль"
+// @Success 204
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse
+// @Failure 403 		{object}    ErrorResponse			"Текущий пароль неверен"
+// @Security Bearer
+// @Router /users/me/password [put]
+func (d *Delivery) ChangePassword(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	ID, ok := currentUserID(c, ctx)
+	if !ok {
+		return
+	}
+
+	value := jsonUser.ChangePassword{}
+	if err := c.ShouldBindJSON(&value); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := d.ucUser.ChangePassword(ctx, ID, value.OldPassword, value.NewPassword); err != nil {
+		switch {
+		case wrongUser(err):
+			SetError(c, http.StatusBadRequest, err)
+		case errors.Is(err, useCase.ErrWrongCredentials):
+			SetError(c, http.StatusForbidden, err)
+		case errors.Is(err, useCase.ErrUserNotFound):
+			SetError(c, http.StatusNotFound, err)
+		default:
+			SetError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListUser
+// @Summary Получить список пользователей.
+// @Description Метод возвращает пользователей арендатора автора запроса. Доступен роли admin.
+// @Tags users
+// @Produce json
+// @Param 	limit 		query 		int 					false "Количество записей" default(10) mininum(0) maxinum(100)
+// @Param 	offset 		query 		int 					false "Смещение при получении записей" default(0) mininum(0)
+// @Param 	sort 		query 		string 					false "Сортировка по полю" default(createdAt)
+// @Success 200			{object}  	jsonUser.ListUser 		true  "Список пользователей"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Security Bearer
+// @Router /users/ [get]
+func (d *Delivery) ListUser(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	actor, ok := requirePrincipal(c, ctx)
+	if !ok {
+		return
+	}
+
+	params, err := query.ParseQuery(c, query.Options{
+		Sorts: mappingSortsUser,
+	})
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	users, err := d.ucUser.List(ctx, actor.Tenant, queryParameter.QueryParameter{
+		Sorts: params.Sorts,
+		Pagination: pagination.Pagination{
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	})
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	count, err := d.ucUser.Count(ctx, actor.Tenant)
+	if err != nil {
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var result = jsonUser.ListUser{
+		Total:  count,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+		List:   []*jsonUser.UserResponse{},
+	}
+	for _, value := range users {
+		result.List = append(result.List, jsonUser.ToUserResponse(value))
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReadUserByID
+// @Summary Получить пользователя.
+// @Description Метод возвращает пользователя своего арендатора. Чужая учётная запись доступна роли admin.
+// @Tags users
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор пользователя"
+// @Success 200			{object}  	jsonUser.UserResponse 	true  "Пользователь"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Security Bearer
+// @Router /users/{id} [get]
+func (d *Delivery) ReadUserByID(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	ID, ok := bindUserID(c)
+	if !ok {
+		return
+	}
+
+	d.readUser(c, ctx, ID)
+}
+
+// SetUserRoles
+// @Summary Назначить роли пользователю.
+// @Description Метод заменяет роли пользователя своего арендатора. Доступен роли admin.
+// @Description Новые роли попадают в проверку токенов сразу, без повторного входа.
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Param   id 			path 		string 					true  "Идентификатор пользователя"
+// @Param   roles 		body 		jsonUser.SetRoles 		true  "Роли"
+// @Success 200			{object}  	jsonUser.UserResponse 	true  "Пользователь"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Security Bearer
+// @Router /users/{id}/roles [put]
+func (d *Delivery) SetUserRoles(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	ID, ok := bindUserID(c)
+	if !ok {
+		return
+	}
+
+	value := jsonUser.SetRoles{}
+	if err := c.ShouldBindJSON(&value); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	response, err := d.ucUser.SetRoles(ctx, ID, value.Roles)
+	if err != nil {
+		if errors.Is(err, useCase.ErrUserNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonUser.ToUserResponse(response))
+}
+
+// DeleteUser
+// @Summary Удалить пользователя.
+// @Description Метод удаляет учётную запись пользователя своего арендатора. Доступен роли admin.
+// @Tags users
+// @Param   id 			path 		string 					true  "Идентификатор пользователя"
+// @Success 204
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401 		{object}    ErrorResponse
+// @Failure 403	 		"Forbidden"
+// @Failure 404 	    {object} 	ErrorResponse			"404 Not Found"
+// @Security Bearer
+// @Router /users/{id} [delete]
+func (d *Delivery) DeleteUser(c *gin.Context) {
+
+	var ctx = context.New(c)
+
+	ID, ok := bindUserID(c)
+	if !ok {
+		return
+	}
+
+	d.deleteUser(c, ctx, ID)
+}
+
+func (d *Delivery) readUser(c *gin.Context, ctx context.Context, ID uuid.UUID) {
+	response, err := d.ucUser.ReadByID(ctx, ID)
+	if err != nil {
+		if errors.Is(err, useCase.ErrUserNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, jsonUser.ToUserResponse(response))
+}
+
+func (d *Delivery) deleteUser(c *gin.Context, ctx context.Context, ID uuid.UUID) {
+	if err := d.ucUser.Delete(ctx, ID); err != nil {
+		if errors.Is(err, useCase.ErrUserNotFound) {
+			SetError(c, http.StatusNotFound, err)
+			return
+		}
+
+		SetError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// currentUserID идентификатор пользователя, от имени которого выполняется запрос
+func currentUserID(c *gin.Context, ctx context.Context) (uuid.UUID, bool) {
+	actor, ok := requirePrincipal(c, ctx)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	ID, err := uuid.Parse(actor.Subject)
+	if err != nil {
+		SetError(c, http.StatusUnauthorized, useCase.ErrUnauthenticated)
+		return uuid.Nil, false
+	}
+	return ID, true
+}
+
+func bindUserID(c *gin.Context) (uuid.UUID, bool) {
+	var id jsonUser.ID
+	if err := c.ShouldBindUri(&id); err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return uuid.Nil, false
+	}
+
+	ID, err := uuid.Parse(id.Value)
+	if err != nil {
+		SetError(c, http.StatusBadRequest, err)
+		return uuid.Nil, false
+	}
+	return ID, true
+}
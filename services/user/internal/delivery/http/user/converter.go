@@ -0,0 +1,33 @@
+package user
+
+import (
+	domainToken "architecture_go/services/user/internal/domain/token"
+	domainUser "architecture_go/services/user/internal/domain/user"
+)
+
+func ToUserResponse(response *domainUser.User) *UserResponse {
+	var result = &UserResponse{
+		ID:         response.ID().String(),
+		CreatedAt:  response.CreatedAt(),
+		ModifiedAt: response.ModifiedAt(),
+		Email:      response.Email().String(),
+		Name:       response.Name(),
+		Roles:      response.Roles(),
+		Tenant:     response.Tenant(),
+	}
+
+	if lastLoginAt := response.LastLoginAt(); !lastLoginAt.IsZero() {
+		result.LastLoginAt = &lastLoginAt
+	}
+
+	return result
+}
+
+func ToTokenResponse(user *domainUser.User, token *domainToken.Token) *TokenResponse {
+	return &TokenResponse{
+		AccessToken: token.Value(),
+		TokenType:   domainToken.Type,
+		ExpiresAt:   token.ExpiresAt(),
+		User:        ToUserResponse(user),
+	}
+}
@@ -0,0 +1,84 @@
+package user
+
+import (
+	"time"
+)
+
+type ID struct {
+	// Идентификатор пользователя
+	Value string `json:"id" uri:"id" binding:"required,uuid" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+}
+
+type Register struct {
+	// Электронная почта, по ней пользователь входит
+	Email string `json:"email" binding:"required,max=250,email" maxLength:"250" example:"ivan@example.com" format:"email"`
+	// Имя пользователя
+	Name string `json:"name" binding:"required,max=100" maxLength:"100" example:"Иван Иванов"`
+	// Пароль от 8 до 72 байт
+	Password string `json:"password" binding:"required,min=8,max=72" minLength:"8" maxLength:"72" example:"correct horse battery"`
+}
+
+type Login struct {
+	// Электронная почта
+	Email string `json:"email" binding:"required,max=250" maxLength:"250" example:"ivan@example.com" format:"email"`
+	// Пароль
+	Password string `json:"password" binding:"required,max=72" maxLength:"72" example:"correct horse battery"`
+}
+
+type TokenResponse struct {
+	// Токен доступа, передаётся в заголовке Authorization
+	AccessToken string `json:"accessToken" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	// Схема передачи токена
+	TokenType string `json:"tokenType" example:"Bearer"`
+	// Окончание действия токена
+	ExpiresAt time.Time `json:"expiresAt"`
+	// Пользователь, для которого выпущен токен
+	User *UserResponse `json:"user"`
+}
+
+type UpdateProfile struct {
+	// Имя пользователя
+	Name string `json:"name" binding:"required,max=100" maxLength:"100" example:"Иван Иванов"`
+}
+
+type ChangePassword struct {
+	// Текущий пароль
+	OldPassword string `json:"oldPassword" binding:"required,max=72" maxLength:"72"`
+	// Новый пароль от 8 до 72 байт
+	NewPassword string `json:"newPassword" binding:"required,min=8,max=72" minLength:"8" maxLength:"72"`
+}
+
+type SetRoles struct {
+	// Роли пользователя в сервисах
+	Roles []string `json:"roles" binding:"required,dive,required,max=50" example:"editor"`
+}
+
+type UserResponse struct {
+	// Идентификатор пользователя
+	ID string `json:"id" example:"00000000-0000-0000-0000-000000000000" format:"uuid"`
+	// Дата регистрации
+	CreatedAt time.Time `json:"createdAt"`
+	// Дата последнего изменения
+	ModifiedAt time.Time `json:"modifiedAt"`
+	// Электронная почта
+	Email string `json:"email" example:"ivan@example.com" format:"email"`
+	// Имя пользователя
+	Name string `json:"name" example:"Иван Иванов"`
+	// Роли пользователя
+	Roles []string `json:"roles" example:"viewer"`
+	// Арендатор пользователя
+	Tenant string `json:"tenant" example:"default"`
+	// Последний вход, не передаётся, если пользователь ещё не входил
+	LastLoginAt *time.Time `json:"lastLoginAt,omitempty"`
+}
+
+type ListUser struct {
+	// Всего пользователей арендатора
+	Total uint64 `json:"total" example:"10" default:"0" binding:"min=0" minimum:"0"`
+	// Количество записей
+	Limit uint64 `json:"limit" example:"10" default:"10" binding:"min=0" minimum:"0"`
+	// Смещение при получении записей
+	Offset uint64 `json:"offset" example:"20" default:"0" binding:"min=0" minimum:"0"`
+
+	List []*UserResponse `json:"list"`
+}
@@ -0,0 +1,34 @@
+package token
+
+import "time"
+
+// Type схема передачи токена в заголовке Authorization
+const Type = "Bearer"
+
+// Token выпущенный токен доступа
+type Token struct {
+	id        string
+	value     string
+	expiresAt time.Time
+}
+
+func New(id, value string, expiresAt time.Time) *Token {
+	return &Token{
+		id:        id,
+		value:     value,
+		expiresAt: expiresAt.UTC(),
+	}
+}
+
+// ID идентификатор токена, claim jti
+func (t Token) ID() string {
+	return t.id
+}
+
+func (t Token) Value() string {
+	return t.value
+}
+
+func (t Token) ExpiresAt() time.Time {
+	return t.expiresAt
+}
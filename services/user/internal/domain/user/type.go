@@ -0,0 +1,167 @@
+package user
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"architecture_go/pkg/type/email"
+	"architecture_go/pkg/type/principal"
+)
+
+var (
+	MaxNameLength = 100
+	// MinPasswordLength и MaxPasswordLength: длиннее 72 байт bcrypt пароль не различает
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+
+	ErrWrongEmail    = errors.New("user email is required and must be valid")
+	ErrWrongName     = errors.Errorf("user name must be from 1 to %d characters", MaxNameLength)
+	ErrWrongPassword = errors.Errorf("password must be from %d to %d bytes", MinPasswordLength, MaxPasswordLength)
+	ErrWrongTenant   = errors.New("user tenant is required")
+)
+
+// User учётная запись. Пароль хранится только в виде хеша
+type User struct {
+	id         uuid.UUID
+	createdAt  time.Time
+	modifiedAt time.Time
+
+	email        email.Email
+	name         string
+	passwordHash string
+	// roles роли в сервисах, попадают в токен без изменений
+	roles  []string
+	tenant string
+
+	lastLoginAt time.Time
+}
+
+func NewWithID(
+	id uuid.UUID,
+	createdAt time.Time,
+	modifiedAt time.Time,
+	email email.Email,
+	name string,
+	passwordHash string,
+	roles []string,
+	tenant string,
+	lastLoginAt time.Time,
+) *User {
+	return &User{
+		id:           id,
+		createdAt:    createdAt.UTC(),
+		modifiedAt:   modifiedAt.UTC(),
+		email:        email,
+		name:         name,
+		passwordHash: passwordHash,
+		roles:        roles,
+		tenant:       tenant,
+		lastLoginAt:  lastLoginAt.UTC(),
+	}
+}
+
+// New адрес приводится к нижнему регистру: по нему пользователь входит, регистр не должен мешать
+func New(rawEmail, name, passwordHash, tenant string, roles []string) (*User, error) {
+	value, err := NewEmail(rawEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return nil, ErrWrongName
+	}
+
+	if tenant == "" {
+		return nil, ErrWrongTenant
+	}
+
+	if roles == nil {
+		roles = []string{}
+	}
+
+	var timeNow = time.Now().UTC()
+	return &User{
+		id:           uuid.New(),
+		createdAt:    timeNow,
+		modifiedAt:   timeNow,
+		email:        value,
+		name:         name,
+		passwordHash: passwordHash,
+		roles:        roles,
+		tenant:       tenant,
+	}, nil
+}
+
+// NewEmail адрес для входа: обязательный, в нижнем регистре
+func NewEmail(value string) (email.Email, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return email.Email{}, ErrWrongEmail
+	}
+
+	result, err := email.New(value)
+	if err != nil {
+		return email.Email{}, ErrWrongEmail
+	}
+	return result, nil
+}
+
+// ValidatePassword проверка открытого пароля до хеширования
+func ValidatePassword(value string) error {
+	if len(value) < MinPasswordLength || len(value) > MaxPasswordLength {
+		return ErrWrongPassword
+	}
+	return nil
+}
+
+func (u User) ID() uuid.UUID {
+	return u.id
+}
+
+func (u User) CreatedAt() time.Time {
+	return u.createdAt
+}
+
+func (u User) ModifiedAt() time.Time {
+	return u.modifiedAt
+}
+
+func (u User) Email() email.Email {
+	return u.email
+}
+
+func (u User) Name() string {
+	return u.name
+}
+
+func (u User) PasswordHash() string {
+	return u.passwordHash
+}
+
+func (u User) Roles() []string {
+	return u.roles
+}
+
+func (u User) Tenant() string {
+	return u.tenant
+}
+
+func (u User) LastLoginAt() time.Time {
+	return u.lastLoginAt
+}
+
+// Principal автор запросов с токеном этого пользователя
+func (u User) Principal() principal.Principal {
+	return principal.Principal{
+		Subject: u.id.String(),
+		Name:    u.name,
+		Method:  principal.MethodToken,
+		Tenant:  u.tenant,
+		Roles:   u.roles,
+	}
+}
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	result, err := New(" Ivan@Example.com ", "Иван", "$argon2id$", "default", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Email().String() != "ivan@example.com" {
+		t.Errorf("email must be normalized, got %q", result.Email().String())
+	}
+	if result.Principal().Subject != result.ID().String() {
+		t.Error("principal subject must be the user id")
+	}
+
+	if _, err = New("", "Иван", "", "default", nil); !errors.Is(err, ErrWrongEmail) {
+		t.Errorf("expected ErrWrongEmail, got %v", err)
+	}
+	if _, err = New("ivan@example.com", " ", "", "default", nil); !errors.Is(err, ErrWrongName) {
+		t.Errorf("expected ErrWrongName, got %v", err)
+	}
+	if err = ValidatePassword("short"); !errors.Is(err, ErrWrongPassword) {
+		t.Errorf("expected ErrWrongPassword, got %v", err)
+	}
+}
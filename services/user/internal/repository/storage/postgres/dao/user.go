@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/email"
+	"architecture_go/services/user/internal/domain/user"
+)
+
+type User struct {
+	ID           uuid.UUID  `db:"id"`
+	CreatedAt    time.Time  `db:"created_at"`
+	ModifiedAt   time.Time  `db:"modified_at"`
+	Email        string     `db:"email"`
+	Name         string     `db:"name"`
+	PasswordHash string     `db:"password_hash"`
+	Roles        []string   `db:"roles"`
+	Tenant       string     `db:"tenant_id"`
+	LastLoginAt  *time.Time `db:"last_login_at"`
+}
+
+var ColumnUser = []string{
+	"id",
+	"created_at",
+	"modified_at",
+	"email",
+	"name",
+	"password_hash",
+	"roles",
+	"tenant_id",
+	"last_login_at",
+}
+
+func (u *User) ToDomainUser() (*user.User, error) {
+	value, err := email.New(u.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastLoginAt time.Time
+	if u.LastLoginAt != nil {
+		lastLoginAt = *u.LastLoginAt
+	}
+
+	return user.NewWithID(
+		u.ID,
+		u.CreatedAt,
+		u.ModifiedAt,
+		value,
+		u.Name,
+		u.PasswordHash,
+		u.Roles,
+		u.Tenant,
+		lastLoginAt,
+	), nil
+}
+
+// NullTime нулевое время хранится как NULL
+func NullTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE SCHEMA IF NOT EXISTS slurm_user;
+
+CREATE TABLE IF NOT EXISTS slurm_user.account
+(
+    id            uuid         DEFAULT gen_random_uuid() NOT NULL
+    CONSTRAINT pk_account
+    PRIMARY KEY,
+    created_at    timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    modified_at   timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    email         varchar(250)                           NOT NULL,
+    name          varchar(100)                           NOT NULL,
+    password_hash varchar(250)                           NOT NULL,
+    roles         text[]       DEFAULT '{}'              NOT NULL,
+    tenant_id     varchar(63)                            NOT NULL,
+    last_login_at timestamp,
+    is_archived   boolean      DEFAULT FALSE             NOT NULL
+    );
+
+-- адрес занят, пока учётная запись не удалена
+CREATE UNIQUE INDEX IF NOT EXISTS account_email_uindex
+    ON slurm_user.account (lower(email))
+    WHERE is_archived = FALSE;
+
+CREATE INDEX IF NOT EXISTS account_tenant_id_index
+    ON slurm_user.account (tenant_id)
+    WHERE is_archived = FALSE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS slurm_user.account;
+DROP SCHEMA IF EXISTS slurm_user;
+
+-- +goose StatementEnd
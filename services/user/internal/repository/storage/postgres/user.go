@@ -0,0 +1,239 @@
+package postgres
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"architecture_go/pkg/tools/transaction"
+	"architecture_go/pkg/type/columnCode"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/email"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/user/internal/domain/user"
+	"architecture_go/services/user/internal/repository/storage/postgres/dao"
+	"architecture_go/services/user/internal/useCase"
+)
+
+var mappingSortUser = map[columnCode.ColumnCode]string{
+	"id":        "id",
+	"email":     "email",
+	"name":      "name",
+	"createdAt": "created_at",
+}
+
+func (r *Repository) CreateUser(c context.Context, value *user.User) (*user.User, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Insert("slurm_user.account").
+		Columns(dao.ColumnUser...).
+		Values(
+			value.ID(),
+			value.CreatedAt(),
+			value.ModifiedAt(),
+			value.Email().String(),
+			value.Name(),
+			value.PasswordHash(),
+			value.Roles(),
+			value.Tenant(),
+			dao.NullTime(value.LastLoginAt()),
+		).
+		ToSql()
+	if err != nil {
+		return nil, log.ErrorWithContext(ctx, err)
+	}
+
+	if _, err = r.db.Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == codeUniqueViolation {
+			return nil, useCase.ErrUserExists
+		}
+		return nil, log.ErrorWithContext(ctx, err)
+	}
+
+	return value, nil
+}
+
+func (r *Repository) UpdateUser(c context.Context, ID uuid.UUID, updateFn func(value *user.User) (*user.User, error)) (response *user.User, err error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, log.ErrorWithContext(ctx, err)
+	}
+
+	defer func(ctx context.Context, t pgx.Tx) {
+		err = transaction.Finish(ctx, t, err)
+	}(ctx, tx)
+
+	users, err := r.queryUsers(ctx, tx, r.selectUser().Where(squirrel.Eq{"id": ID}).Suffix("FOR UPDATE"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, useCase.ErrUserNotFound
+	}
+
+	response, err = updateFn(users[0])
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := r.genSQL.Update("slurm_user.account").
+		Set("name", response.Name()).
+		Set("password_hash", response.PasswordHash()).
+		Set("roles", response.Roles()).
+		Set("last_login_at", dao.NullTime(response.LastLoginAt())).
+		Set("modified_at", response.ModifiedAt()).
+		Where(squirrel.Eq{"id": ID}).
+		ToSql()
+	if err != nil {
+		return nil, log.ErrorWithContext(ctx, err)
+	}
+
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, log.ErrorWithContext(ctx, err)
+	}
+
+	return response, nil
+}
+
+func (r *Repository) DeleteUser(c context.Context, ID uuid.UUID) error {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Update("slurm_user.account").
+		Set("is_archived", true).
+		Set("modified_at", squirrel.Expr("NOW()")).
+		Where(squirrel.And{squirrel.Eq{"id": ID}, squirrel.Eq{"is_archived": false}}).
+		ToSql()
+	if err != nil {
+		return log.ErrorWithContext(ctx, err)
+	}
+
+	result, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return log.ErrorWithContext(ctx, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return useCase.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ReadUserByID(c context.Context, ID uuid.UUID) (*user.User, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	return r.readUser(ctx, r.selectUser().Where(squirrel.Eq{"id": ID}))
+}
+
+func (r *Repository) ReadUserByEmail(c context.Context, value email.Email) (*user.User, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	return r.readUser(ctx, r.selectUser().Where(squirrel.Expr("lower(email) = ?", strings.ToLower(value.String()))))
+}
+
+func (r *Repository) ListUser(c context.Context, tenant string, parameter queryParameter.QueryParameter) ([]*user.User, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if parameter.Pagination.Limit == 0 {
+		parameter.Pagination.Limit = r.options.DefaultLimit
+	}
+
+	var builder = r.selectUser().Where(squirrel.Eq{"tenant_id": tenant})
+
+	if len(parameter.Sorts) > 0 {
+		builder = builder.OrderBy(parameter.Sorts.Parsing(mappingSortUser)...)
+	} else {
+		builder = builder.OrderBy("created_at")
+	}
+
+	builder = builder.Limit(parameter.Pagination.Limit)
+	if parameter.Pagination.Offset > 0 {
+		builder = builder.Offset(parameter.Pagination.Offset)
+	}
+
+	return r.queryUsers(ctx, r.db, builder)
+}
+
+func (r *Repository) CountUser(c context.Context, tenant string) (uint64, error) {
+
+	ctx := c.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	query, args, err := r.genSQL.Select("COUNT(id)").
+		From("slurm_user.account").
+		Where(squirrel.And{squirrel.Eq{"tenant_id": tenant}, squirrel.Eq{"is_archived": false}}).
+		ToSql()
+	if err != nil {
+		return 0, log.ErrorWithContext(ctx, err)
+	}
+
+	var total uint64
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&total); err != nil {
+		return 0, log.ErrorWithContext(ctx, err)
+	}
+
+	return total, nil
+}
+
+func (r *Repository) readUser(ctx context.Context, builder squirrel.SelectBuilder) (*user.User, error) {
+	users, err := r.queryUsers(ctx, r.db, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return nil, useCase.ErrUserNotFound
+	}
+
+	return users[0], nil
+}
+
+// selectUser удалённые учётные записи не видны
+func (r *Repository) selectUser() squirrel.SelectBuilder {
+	return r.genSQL.Select(dao.ColumnUser...).
+		From("slurm_user.account").
+		Where(squirrel.Eq{"is_archived": false})
+}
+
+func (r *Repository) queryUsers(ctx context.Context, db pgxscan.Querier, builder squirrel.SelectBuilder) ([]*user.User, error) {
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, log.ErrorWithContext(ctx, err)
+	}
+
+	var daoUsers []*dao.User
+	if err = pgxscan.Select(ctx, db, &daoUsers, query, args...); err != nil {
+		return nil, log.ErrorWithContext(ctx, err)
+	}
+
+	var result = make([]*user.User, len(daoUsers))
+	for i, value := range daoUsers {
+		if result[i], err = value.ToDomainUser(); err != nil {
+			return nil, log.ErrorWithContext(ctx, err)
+		}
+	}
+
+	return result, nil
+}
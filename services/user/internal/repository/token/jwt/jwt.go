@@ -0,0 +1,223 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/user/internal/domain/token"
+)
+
+func init() {
+	viper.SetDefault("JWT_ISSUER", "user")
+}
+
+var (
+	ErrNoKey        = errors.New("jwt signing key is not configured: set JWT_PRIVATE_KEY or JWT_SECRET")
+	ErrWrongClaims  = errors.New("jwt claims are not valid")
+	ErrWrongSubject = errors.New("jwt has no subject")
+)
+
+// Repository выпускает и проверяет JWT закрытым ключом из PEM или общим секретом HMAC.
+// Claims совпадают с теми, что ожидает проверка токенов в сервисе contact: sub, name, roles, tenant, jti.
+type Repository struct {
+	method jwt.SigningMethod
+	// signKey ключ подписи, verifyKey ключ проверки; для HMAC это один и тот же секрет
+	signKey   interface{}
+	verifyKey interface{}
+	parser    *jwt.Parser
+	options   Options
+}
+
+type Options struct {
+	// PrivateKey PEM закрытого ключа RSA (RS256), ECDSA (ES256/384/512 по кривой) или Ed25519 (EdDSA)
+	PrivateKey string
+	// Secret общий секрет HMAC (HS256)
+	Secret string
+
+	// Issuer значение iss выпускаемых токенов, проверяется при Verify
+	Issuer string
+	// Audience значение aud, пустая строка -- не выставляется и не проверяется
+	Audience string
+	// Leeway допустимое расхождение часов при проверке exp и nbf
+	Leeway time.Duration
+}
+
+func New(o Options) (*Repository, error) {
+	var r = &Repository{}
+	r.SetOptions(o)
+
+	switch {
+	case r.options.PrivateKey != "":
+		key, method, err := parsePrivateKey([]byte(r.options.PrivateKey))
+		if err != nil {
+			return nil, err
+		}
+		r.method = method
+		r.signKey = key
+		r.verifyKey = key.Public()
+
+	case r.options.Secret != "":
+		r.method = jwt.SigningMethodHS256
+		r.signKey = []byte(r.options.Secret)
+		r.verifyKey = r.signKey
+
+	default:
+		return nil, ErrNoKey
+	}
+
+	r.parser = jwt.NewParser(jwt.WithValidMethods([]string{r.method.Alg()}), jwt.WithoutClaimsValidation())
+	return r, nil
+}
+
+func (r *Repository) SetOptions(options Options) {
+	if options.PrivateKey == "" {
+		options.PrivateKey = viper.GetString("JWT_PRIVATE_KEY")
+	}
+
+	if options.Secret == "" {
+		options.Secret = viper.GetString("JWT_SECRET")
+	}
+
+	if options.Issuer == "" {
+		options.Issuer = viper.GetString("JWT_ISSUER")
+		log.Debug("set default options.Issuer", zap.Any("issuer", options.Issuer))
+	}
+
+	if options.Audience == "" {
+		options.Audience = viper.GetString("JWT_AUDIENCE")
+	}
+
+	if options.Leeway == 0 {
+		options.Leeway = time.Minute
+		log.Debug("set default options.Leeway", zap.Any("leeway", options.Leeway))
+	}
+
+	if r.options != options {
+		r.options = options
+		// ключи в лог не попадают
+		log.Info("set new options",
+			zap.Bool("privateKey", r.options.PrivateKey != ""),
+			zap.Bool("secret", r.options.Secret != ""),
+			zap.String("issuer", r.options.Issuer),
+			zap.String("audience", r.options.Audience),
+			zap.Duration("leeway", r.options.Leeway),
+		)
+	}
+}
+
+func (r *Repository) Issue(_ context.Context, p principal.Principal, ttl time.Duration) (*token.Token, error) {
+	var (
+		now       = time.Now().UTC()
+		id        = uuid.NewString()
+		expiresAt = now.Add(ttl)
+	)
+
+	var claims = jwt.MapClaims{
+		"sub":    p.Subject,
+		"iss":    r.options.Issuer,
+		"iat":    now.Unix(),
+		"nbf":    now.Unix(),
+		"exp":    expiresAt.Unix(),
+		"jti":    id,
+		"name":   p.Name,
+		"roles":  p.Roles,
+		"tenant": p.Tenant,
+	}
+	if r.options.Audience != "" {
+		claims["aud"] = r.options.Audience
+	}
+
+	value, err := jwt.NewWithClaims(r.method, claims).SignedString(r.signKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return token.New(id, value, time.Unix(expiresAt.Unix(), 0)), nil
+}
+
+func (r *Repository) Verify(_ context.Context, value string) (*principal.Principal, error) {
+	var claims = jwt.MapClaims{}
+	if _, err := r.parser.ParseWithClaims(value, claims, func(*jwt.Token) (interface{}, error) { return r.verifyKey, nil }); err != nil {
+		return nil, err
+	}
+
+	var now = time.Now()
+	if !claims.VerifyExpiresAt(now.Add(-r.options.Leeway).Unix(), true) {
+		return nil, errors.Wrap(ErrWrongClaims, "token is expired or has no exp")
+	}
+	if !claims.VerifyNotBefore(now.Add(r.options.Leeway).Unix(), false) {
+		return nil, errors.Wrap(ErrWrongClaims, "token is not valid yet")
+	}
+	if !claims.VerifyIssuer(r.options.Issuer, true) {
+		return nil, errors.Wrap(ErrWrongClaims, "unexpected issuer")
+	}
+	if r.options.Audience != "" && !claims.VerifyAudience(r.options.Audience, true) {
+		return nil, errors.Wrap(ErrWrongClaims, "unexpected audience")
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, ErrWrongSubject
+	}
+
+	var result = &principal.Principal{
+		Subject: subject,
+		Method:  principal.MethodToken,
+	}
+
+	result.Name, _ = claims["name"].(string)
+	result.Tenant, _ = claims["tenant"].(string)
+	result.CredentialID, _ = claims["jti"].(string)
+
+	if roles, ok := claims["roles"].([]interface{}); ok {
+		result.Roles = make([]string, 0, len(roles))
+		for _, item := range roles {
+			if role, ok := item.(string); ok {
+				result.Roles = append(result.Roles, role)
+			}
+		}
+	}
+
+	if exp, ok := claims["exp"].(float64); ok {
+		result.ExpiresAt = time.Unix(int64(exp), 0).UTC()
+	}
+
+	return result, nil
+}
+
+// parsePrivateKey закрытый ключ и алгоритм подписи, который ему подходит
+func parsePrivateKey(pem []byte) (crypto.Signer, jwt.SigningMethod, error) {
+	if key, err := jwt.ParseRSAPrivateKeyFromPEM(pem); err == nil {
+		return key, jwt.SigningMethodRS256, nil
+	}
+
+	if key, err := jwt.ParseECPrivateKeyFromPEM(pem); err == nil {
+		switch key.Curve.Params().BitSize {
+		case 384:
+			return key, jwt.SigningMethodES384, nil
+		case 521:
+			return key, jwt.SigningMethodES512, nil
+		default:
+			return key, jwt.SigningMethodES256, nil
+		}
+	}
+
+	if key, err := jwt.ParseEdPrivateKeyFromPEM(pem); err == nil {
+		if value, ok := key.(ed25519.PrivateKey); ok {
+			return value, jwt.SigningMethodEdDSA, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("jwt private key must be a PEM encoded RSA, ECDSA or Ed25519 key")
+}
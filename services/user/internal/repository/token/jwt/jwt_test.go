@@ -0,0 +1,70 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+)
+
+func TestIssueVerify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var privateKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	for name, options := range map[string]Options{
+		"secret":     {Secret: "0123456789abcdef", Issuer: "user"},
+		"privateKey": {PrivateKey: privateKey, Issuer: "user"},
+	} {
+		r, err := New(options)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+
+		var p = principal.Principal{Subject: "42", Name: "Иван", Tenant: "acme", Roles: []string{"viewer", "editor"}}
+		value, err := r.Issue(context.Empty(), p, time.Hour)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+
+		result, err := r.Verify(context.Empty(), value.Value())
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if result.Subject != "42" || result.Name != "Иван" || result.Tenant != "acme" || !result.HasRole("editor") ||
+			result.CredentialID != value.ID() || !result.ExpiresAt.Equal(value.ExpiresAt()) {
+			t.Errorf("%s: unexpected principal %+v", name, result)
+		}
+
+		expired, err := r.Issue(context.Empty(), p, -time.Hour)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		if _, err = r.Verify(context.Empty(), expired.Value()); err == nil {
+			t.Errorf("%s: expired token must be rejected", name)
+		}
+	}
+
+	// токен, подписанный другим секретом, не проходит
+	first, _ := New(Options{Secret: "first-secret-value", Issuer: "user"})
+	second, _ := New(Options{Secret: "second-secret-value", Issuer: "user"})
+	value, err := first.Issue(context.Empty(), principal.Principal{Subject: "42"}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = second.Verify(context.Empty(), value.Value()); err == nil {
+		t.Error("token signed with another secret must be rejected")
+	}
+}
@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/email"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/user/internal/domain/user"
+)
+
+type Storage interface {
+	User
+}
+
+type User interface {
+	// CreateUser ErrUserExists, если адрес уже занят
+	CreateUser(ctx context.Context, value *user.User) (*user.User, error)
+	UpdateUser(ctx context.Context, ID uuid.UUID, updateFn func(u *user.User) (*user.User, error)) (*user.User, error)
+	// DeleteUser архивирует учётную запись, адрес освобождается
+	DeleteUser(ctx context.Context, ID uuid.UUID) error
+
+	UserReader
+}
+
+type UserReader interface {
+	ReadUserByID(ctx context.Context, ID uuid.UUID) (*user.User, error)
+	ReadUserByEmail(ctx context.Context, value email.Email) (*user.User, error)
+	ListUser(ctx context.Context, tenant string, parameter queryParameter.QueryParameter) ([]*user.User, error)
+	CountUser(ctx context.Context, tenant string) (uint64, error)
+}
@@ -0,0 +1 @@
+mockery --all --keeptree --output ../../../repository/storage/mock --outpkg mockStorage
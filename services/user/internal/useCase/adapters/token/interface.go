@@ -0,0 +1,17 @@
+package token
+
+import (
+	"time"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/services/user/internal/domain/token"
+)
+
+// Issuer выпускает и проверяет токены доступа сервиса
+type Issuer interface {
+	// Issue токен для p, действующий ttl
+	Issue(ctx context.Context, p principal.Principal, ttl time.Duration) (*token.Token, error)
+	// Verify автор запроса из токена; ошибка -- неверная подпись, истёкший или чужой токен
+	Verify(ctx context.Context, value string) (*principal.Principal, error)
+}
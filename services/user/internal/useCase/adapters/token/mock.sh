@@ -0,0 +1 @@
+mockery --all --keeptree --output ../../../repository/token/mock --outpkg mockToken
@@ -0,0 +1,15 @@
+package useCase
+
+import "errors"
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+	ErrUserExists   = errors.New("user with this email already exists")
+
+	// ErrWrongCredentials адрес или пароль неверны; что именно -- не сообщается
+	ErrWrongCredentials = errors.New("wrong email or password")
+	// ErrUnauthenticated токен не передан, неверен или истёк
+	ErrUnauthenticated = errors.New("authentication required")
+	// ErrPermissionDenied автор запроса известен, но действие ему не разрешено
+	ErrPermissionDenied = errors.New("permission denied")
+)
@@ -0,0 +1,36 @@
+package useCase
+
+import (
+	"github.com/google/uuid"
+
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/user/internal/domain/token"
+	"architecture_go/services/user/internal/domain/user"
+)
+
+type User interface {
+	// Register создаёт учётную запись по приглашению admin или, при открытой регистрации, без ролей
+	Register(c context.Context, email, name, password string) (*user.User, error)
+	// Login проверяет пароль и выпускает токен доступа. Неизвестный адрес и неверный пароль
+	// дают одну и ту же ошибку ErrWrongCredentials за одинаковое время.
+	Login(c context.Context, email, password string) (*user.User, *token.Token, error)
+	// Authenticate автор запроса по токену доступа, выпущенному Login
+	Authenticate(c context.Context, accessToken string) (*principal.Principal, error)
+
+	UpdateProfile(c context.Context, ID uuid.UUID, name string) (*user.User, error)
+	ChangePassword(c context.Context, ID uuid.UUID, oldPassword, newPassword string) error
+	// SetRoles роли пользователя меняет только admin
+	SetRoles(c context.Context, ID uuid.UUID, roles []string) (*user.User, error)
+	Delete(c context.Context, ID uuid.UUID) error
+
+	UserReader
+}
+
+type UserReader interface {
+	ReadByID(c context.Context, ID uuid.UUID) (*user.User, error)
+	// List пользователи арендатора tenant
+	List(c context.Context, tenant string, parameter queryParameter.QueryParameter) ([]*user.User, error)
+	Count(c context.Context, tenant string) (uint64, error)
+}
@@ -0,0 +1,81 @@
+package user
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/tools/password"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/services/user/internal/useCase/adapters/storage"
+	"architecture_go/services/user/internal/useCase/adapters/token"
+)
+
+// RoleAdmin роль, которой доступны чужие учётные записи своего арендатора
+const RoleAdmin = "admin"
+
+type UseCase struct {
+	adapterStorage storage.User
+	adapterToken   token.Issuer
+	options        Options
+
+	// dummyHash хеш, с которым Login сверяет пароль неизвестного адреса, чтобы время ответа
+	// не выдавало, зарегистрирован ли адрес
+	dummyHash string
+}
+
+type Options struct {
+	// TokenTTL срок действия токена доступа
+	TokenTTL time.Duration
+	// DefaultTenant арендатор пользователей, зарегистрировавшихся сами
+	DefaultTenant string
+	// DefaultRole роль пользователей, которых зарегистрировал admin
+	DefaultRole string
+	// OpenRegistration регистрация без приглашения: такой пользователь попадает в DefaultTenant без ролей,
+	// роли ему выдаёт admin. По умолчанию регистрировать пользователей может только admin своего арендатора
+	OpenRegistration bool
+	// Password параметры хеширования новых паролей
+	Password password.Params
+}
+
+func New(storage storage.User, issuer token.Issuer, options Options) *UseCase {
+	var uc = &UseCase{
+		adapterStorage: storage,
+		adapterToken:   issuer,
+	}
+	uc.SetOptions(options)
+	return uc
+}
+
+func (uc *UseCase) SetOptions(options Options) {
+	if options.TokenTTL <= 0 {
+		options.TokenTTL = time.Hour
+		log.Debug("set default options.TokenTTL", zap.Any("tokenTTL", options.TokenTTL))
+	}
+
+	if options.DefaultTenant == "" {
+		options.DefaultTenant = "default"
+		log.Debug("set default options.DefaultTenant", zap.Any("defaultTenant", options.DefaultTenant))
+	}
+
+	if options.DefaultRole == "" {
+		options.DefaultRole = "viewer"
+		log.Debug("set default options.DefaultRole", zap.Any("defaultRole", options.DefaultRole))
+	}
+
+	if options.Password.Algorithm == "" {
+		options.Password = password.Default
+		log.Debug("set default options.Password", zap.Any("password", options.Password))
+	}
+
+	if uc.options != options {
+		uc.options = options
+		log.Info("set new options", zap.Any("options", uc.options))
+
+		hash, err := password.Hash(time.Now().String(), options.Password)
+		if err != nil {
+			log.Fatal(err)
+		}
+		uc.dummyHash = hash
+	}
+}
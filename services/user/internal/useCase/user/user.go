@@ -0,0 +1,301 @@
+package user
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"architecture_go/pkg/tools/password"
+	"architecture_go/pkg/type/context"
+	log "architecture_go/pkg/type/logger"
+	"architecture_go/pkg/type/principal"
+	"architecture_go/pkg/type/queryParameter"
+	"architecture_go/services/user/internal/domain/token"
+	"architecture_go/services/user/internal/domain/user"
+	"architecture_go/services/user/internal/useCase"
+)
+
+// Register пользователя, которого регистрирует admin, создаёт в арендаторе admin с ролью по умолчанию.
+// Сам зарегистрироваться можно только при открытой регистрации, и тогда ролей у учётной записи нет:
+// иначе любой желающий получал бы доступ к данным арендатора по умолчанию
+func (uc *UseCase) Register(ctx context.Context, email, name, value string) (*user.User, error) {
+	var (
+		actor  = ctx.Principal()
+		tenant = uc.options.DefaultTenant
+		roles  = []string{}
+	)
+	switch {
+	case actor != nil && actor.HasRole(RoleAdmin):
+		tenant, roles = actor.Tenant, []string{uc.options.DefaultRole}
+	case !uc.options.OpenRegistration && actor == nil:
+		return nil, useCase.ErrUnauthenticated
+	case !uc.options.OpenRegistration:
+		return nil, useCase.ErrPermissionDenied
+	}
+
+	if err := user.ValidatePassword(value); err != nil {
+		return nil, err
+	}
+
+	hash, err := password.Hash(value, uc.options.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	newUser, err := user.New(email, name, hash, tenant, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.adapterStorage.CreateUser(ctx, newUser)
+}
+
+func (uc *UseCase) Login(ctx context.Context, email, value string) (*user.User, *token.Token, error) {
+	address, err := user.NewEmail(email)
+	if err != nil {
+		return nil, nil, useCase.ErrWrongCredentials
+	}
+
+	response, err := uc.adapterStorage.ReadUserByEmail(ctx, address)
+	if err != nil {
+		if errors.Is(err, useCase.ErrUserNotFound) {
+			_, _ = password.Verify(uc.dummyHash, value)
+			return nil, nil, useCase.ErrWrongCredentials
+		}
+		return nil, nil, err
+	}
+
+	ok, err := password.Verify(response.PasswordHash(), value)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, useCase.ErrWrongCredentials
+	}
+
+	// параметры хеширования могли измениться: пароль известен только сейчас, пересчитываем хеш
+	var passwordHash = response.PasswordHash()
+	if password.NeedsRehash(passwordHash, uc.options.Password) {
+		if passwordHash, err = password.Hash(value, uc.options.Password); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	response, err = uc.adapterStorage.UpdateUser(ctx, response.ID(), func(oldUser *user.User) (*user.User, error) {
+		return user.NewWithID(
+			oldUser.ID(),
+			oldUser.CreatedAt(),
+			oldUser.ModifiedAt(),
+			oldUser.Email(),
+			oldUser.Name(),
+			passwordHash,
+			oldUser.Roles(),
+			oldUser.Tenant(),
+			time.Now().UTC(),
+		), nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accessToken, err := uc.adapterToken.Issue(ctx, response.Principal(), uc.options.TokenTTL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, accessToken, nil
+}
+
+// Authenticate роли и имя берутся из учётной записи, а не из токена: удаление пользователя
+// и смена ролей действуют сразу, не дожидаясь истечения токена
+func (uc *UseCase) Authenticate(ctx context.Context, accessToken string) (*principal.Principal, error) {
+	claims, err := uc.adapterToken.Verify(ctx, accessToken)
+	if err != nil {
+		// неверный или истёкший токен -- ошибка клиента, а не сервиса
+		log.WarnWithContext(ctx, "access token rejected", zap.Error(err))
+		return nil, useCase.ErrUnauthenticated
+	}
+
+	ID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, useCase.ErrUnauthenticated
+	}
+
+	response, err := uc.adapterStorage.ReadUserByID(ctx, ID)
+	if err != nil {
+		if errors.Is(err, useCase.ErrUserNotFound) {
+			return nil, useCase.ErrUnauthenticated
+		}
+		return nil, err
+	}
+
+	var result = response.Principal()
+	result.CredentialID = claims.CredentialID
+	result.ExpiresAt = claims.ExpiresAt
+	return &result, nil
+}
+
+func (uc *UseCase) UpdateProfile(ctx context.Context, ID uuid.UUID, name string) (*user.User, error) {
+	if err := uc.checkAccess(ctx, ID); err != nil {
+		return nil, err
+	}
+
+	return uc.adapterStorage.UpdateUser(ctx, ID, func(oldUser *user.User) (*user.User, error) {
+		value, err := user.New(oldUser.Email().String(), name, oldUser.PasswordHash(), oldUser.Tenant(), oldUser.Roles())
+		if err != nil {
+			return nil, err
+		}
+
+		return user.NewWithID(
+			oldUser.ID(),
+			oldUser.CreatedAt(),
+			time.Now().UTC(),
+			oldUser.Email(),
+			value.Name(),
+			oldUser.PasswordHash(),
+			oldUser.Roles(),
+			oldUser.Tenant(),
+			oldUser.LastLoginAt(),
+		), nil
+	})
+}
+
+// ChangePassword только сам пользователь и только зная текущий пароль
+func (uc *UseCase) ChangePassword(ctx context.Context, ID uuid.UUID, oldPassword, newPassword string) error {
+	if actor := ctx.Principal(); actor == nil || actor.Subject != ID.String() {
+		return useCase.ErrPermissionDenied
+	}
+
+	if err := user.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
+	hash, err := password.Hash(newPassword, uc.options.Password)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.adapterStorage.UpdateUser(ctx, ID, func(oldUser *user.User) (*user.User, error) {
+		ok, err := password.Verify(oldUser.PasswordHash(), oldPassword)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, useCase.ErrWrongCredentials
+		}
+
+		return user.NewWithID(
+			oldUser.ID(),
+			oldUser.CreatedAt(),
+			time.Now().UTC(),
+			oldUser.Email(),
+			oldUser.Name(),
+			hash,
+			oldUser.Roles(),
+			oldUser.Tenant(),
+			oldUser.LastLoginAt(),
+		), nil
+	})
+	return err
+}
+
+func (uc *UseCase) SetRoles(ctx context.Context, ID uuid.UUID, roles []string) (*user.User, error) {
+	var actor = ctx.Principal()
+	if actor == nil || !actor.HasRole(RoleAdmin) {
+		return nil, useCase.ErrPermissionDenied
+	}
+
+	if roles == nil {
+		roles = []string{}
+	}
+
+	return uc.adapterStorage.UpdateUser(ctx, ID, func(oldUser *user.User) (*user.User, error) {
+		if oldUser.Tenant() != actor.Tenant {
+			return nil, useCase.ErrUserNotFound
+		}
+
+		return user.NewWithID(
+			oldUser.ID(),
+			oldUser.CreatedAt(),
+			time.Now().UTC(),
+			oldUser.Email(),
+			oldUser.Name(),
+			oldUser.PasswordHash(),
+			roles,
+			oldUser.Tenant(),
+			oldUser.LastLoginAt(),
+		), nil
+	})
+}
+
+func (uc *UseCase) Delete(ctx context.Context, ID uuid.UUID) error {
+	if err := uc.checkAccess(ctx, ID); err != nil {
+		return err
+	}
+
+	return uc.adapterStorage.DeleteUser(ctx, ID)
+}
+
+func (uc *UseCase) ReadByID(ctx context.Context, ID uuid.UUID) (*user.User, error) {
+	if err := uc.checkAccess(ctx, ID); err != nil {
+		return nil, err
+	}
+
+	return uc.adapterStorage.ReadUserByID(ctx, ID)
+}
+
+func (uc *UseCase) List(ctx context.Context, tenant string, parameter queryParameter.QueryParameter) ([]*user.User, error) {
+	if err := uc.checkAdmin(ctx, tenant); err != nil {
+		return nil, err
+	}
+
+	return uc.adapterStorage.ListUser(ctx, tenant, parameter)
+}
+
+func (uc *UseCase) Count(ctx context.Context, tenant string) (uint64, error) {
+	if err := uc.checkAdmin(ctx, tenant); err != nil {
+		return 0, err
+	}
+
+	return uc.adapterStorage.CountUser(ctx, tenant)
+}
+
+// checkAccess к учётной записи ID есть доступ у её владельца и у admin того же арендатора.
+// Чужая учётная запись другого арендатора выглядит как несуществующая.
+func (uc *UseCase) checkAccess(ctx context.Context, ID uuid.UUID) error {
+	var actor = ctx.Principal()
+	if actor == nil {
+		return useCase.ErrUnauthenticated
+	}
+
+	if actor.Subject == ID.String() {
+		return nil
+	}
+
+	if !actor.HasRole(RoleAdmin) {
+		return useCase.ErrPermissionDenied
+	}
+
+	response, err := uc.adapterStorage.ReadUserByID(ctx, ID)
+	if err != nil {
+		return err
+	}
+	if response.Tenant() != actor.Tenant {
+		return useCase.ErrUserNotFound
+	}
+	return nil
+}
+
+func (uc *UseCase) checkAdmin(ctx context.Context, tenant string) error {
+	var actor = ctx.Principal()
+	if actor == nil {
+		return useCase.ErrUnauthenticated
+	}
+
+	if !actor.HasRole(RoleAdmin) || actor.Tenant != tenant {
+		return useCase.ErrPermissionDenied
+	}
+	return nil
+}
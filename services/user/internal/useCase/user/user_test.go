@@ -0,0 +1,135 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"architecture_go/pkg/tools/password"
+	"architecture_go/pkg/type/context"
+	"architecture_go/pkg/type/principal"
+	domainToken "architecture_go/services/user/internal/domain/token"
+	"architecture_go/services/user/internal/domain/user"
+	mockStorage "architecture_go/services/user/internal/repository/storage/mock"
+	mockToken "architecture_go/services/user/internal/repository/token/mock"
+	"architecture_go/services/user/internal/useCase"
+)
+
+// fast параметры, с которыми тест не тратит 64 МиБ на каждый хеш
+var fast = password.Params{Algorithm: password.Argon2id, Memory: 1024, Iterations: 1, Parallelism: 1}
+
+func TestLogin(t *testing.T) {
+	var (
+		storageMock = new(mockStorage.User)
+		tokenMock   = new(mockToken.Issuer)
+		uc          = New(storageMock, tokenMock, Options{Password: fast})
+	)
+
+	// хеш с устаревшими параметрами: после входа он должен быть пересчитан
+	hash, err := password.Hash("correct horse", password.Params{Algorithm: password.Bcrypt, Cost: 4})
+	assert.NoError(t, err)
+
+	value, err := user.New("ivan@example.com", "Иван", hash, "acme", []string{"viewer"})
+	assert.NoError(t, err)
+	email := value.Email()
+
+	storageMock.On("ReadUserByEmail", mock.Anything, email).Return(value, nil)
+	storageMock.On("ReadUserByEmail", mock.Anything, mock.Anything).Return(nil, useCase.ErrUserNotFound)
+	storageMock.On("UpdateUser", mock.Anything, value.ID(), mock.Anything).
+		Return(func(_ context.Context, _ uuid.UUID, updateFn func(*user.User) (*user.User, error)) *user.User {
+			result, _ := updateFn(value)
+			return result
+		}, nil).Once()
+	tokenMock.On("Issue", mock.Anything, mock.AnythingOfType("principal.Principal"), time.Hour).
+		Return(domainToken.New("jti", "token", time.Now().Add(time.Hour)), nil).Once()
+
+	_, _, err = uc.Login(context.Empty(), "ivan@example.com", "wrong password")
+	assert.ErrorIs(t, err, useCase.ErrWrongCredentials)
+
+	// неизвестный адрес неотличим от неверного пароля
+	_, _, err = uc.Login(context.Empty(), "nobody@example.com", "correct horse")
+	assert.ErrorIs(t, err, useCase.ErrWrongCredentials)
+
+	response, token, err := uc.Login(context.Empty(), " IVAN@example.com", "correct horse")
+	assert.NoError(t, err)
+	assert.Equal(t, "token", token.Value())
+	assert.False(t, response.LastLoginAt().IsZero())
+	assert.False(t, password.NeedsRehash(response.PasswordHash(), fast))
+}
+
+func TestRegister(t *testing.T) {
+	var (
+		storageMock = new(mockStorage.User)
+		closed      = New(storageMock, new(mockToken.Issuer), Options{Password: fast})
+		open        = New(storageMock, new(mockToken.Issuer), Options{Password: fast, OpenRegistration: true})
+	)
+
+	storageMock.On("CreateUser", mock.Anything, mock.Anything).
+		Return(func(_ context.Context, value *user.User) *user.User { return value }, nil)
+
+	var as = func(p *principal.Principal) context.Context {
+		var ctx = context.Empty()
+		if p != nil {
+			ctx.WithValue(context.KeyPrincipal, p)
+		}
+		return ctx
+	}
+
+	var (
+		viewer = &principal.Principal{Subject: "viewer", Tenant: "acme", Roles: []string{"viewer"}}
+		admin  = &principal.Principal{Subject: "admin", Tenant: "acme", Roles: []string{RoleAdmin}}
+	)
+
+	_, err := closed.Register(as(nil), "ivan@example.com", "Иван", "correct horse")
+	assert.ErrorIs(t, err, useCase.ErrUnauthenticated)
+
+	_, err = closed.Register(as(viewer), "ivan@example.com", "Иван", "correct horse")
+	assert.ErrorIs(t, err, useCase.ErrPermissionDenied)
+
+	// приглашённый admin пользователь попадает в его арендатор с ролью по умолчанию
+	response, err := closed.Register(as(admin), "ivan@example.com", "Иван", "correct horse")
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", response.Tenant())
+	assert.Equal(t, []string{"viewer"}, response.Roles())
+
+	// при открытой регистрации доступа к данным арендатора по умолчанию нет, пока admin не выдаст роль
+	response, err = open.Register(as(nil), "ivan@example.com", "Иван", "correct horse")
+	assert.NoError(t, err)
+	assert.Equal(t, "default", response.Tenant())
+	assert.Empty(t, response.Roles())
+}
+
+func TestCheckAccess(t *testing.T) {
+	var (
+		storageMock = new(mockStorage.User)
+		uc          = New(storageMock, new(mockToken.Issuer), Options{Password: fast})
+		other, _    = user.New("other@example.com", "Пётр", "", "other", nil)
+		own, _      = user.New("own@example.com", "Анна", "", "acme", nil)
+	)
+
+	storageMock.On("ReadUserByID", mock.Anything, other.ID()).Return(other, nil)
+	storageMock.On("ReadUserByID", mock.Anything, own.ID()).Return(own, nil)
+
+	var as = func(p *principal.Principal) context.Context {
+		var ctx = context.Empty()
+		if p != nil {
+			ctx.WithValue(context.KeyPrincipal, p)
+		}
+		return ctx
+	}
+
+	var (
+		viewer = &principal.Principal{Subject: own.ID().String(), Tenant: "acme", Roles: []string{"viewer"}}
+		admin  = &principal.Principal{Subject: "admin", Tenant: "acme", Roles: []string{RoleAdmin}}
+	)
+
+	assert.ErrorIs(t, uc.checkAccess(as(nil), own.ID()), useCase.ErrUnauthenticated)
+	assert.NoError(t, uc.checkAccess(as(viewer), own.ID()))
+	assert.ErrorIs(t, uc.checkAccess(as(viewer), other.ID()), useCase.ErrPermissionDenied)
+	assert.NoError(t, uc.checkAccess(as(admin), own.ID()))
+	// пользователь другого арендатора для admin как будто не существует
+	assert.ErrorIs(t, uc.checkAccess(as(admin), other.ID()), useCase.ErrUserNotFound)
+}
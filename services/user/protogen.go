@@ -0,0 +1,8 @@
+//go:build proto
+// +build proto
+
+package main
+
+// Сгенерированный код лежит в pkg/protobuff/user: им пользуются и сервер, и клиенты из других сервисов
+//go:generate protoc --proto_path=. --proto_path=proto	--go_opt=paths=source_relative	--go_out=../../pkg/protobuff/user user.proto
+//go:generate protoc --proto_path=. --proto_path=proto	--go-grpc_opt=paths=source_relative	--go-grpc_out=../../pkg/protobuff/user user.proto